@@ -12,6 +12,7 @@ import (
 	"io"
 	"os"
 	"strconv"
+	"strings"
 
 	"github.com/gogo/protobuf/jsonpb"
 	"github.com/gogo/protobuf/proto"
@@ -113,6 +114,18 @@ var (
 		Args:  cobra.MinimumNArgs(1),
 		RunE:  CreateCSVStats,
 	}
+	listStatesCmd = &cobra.Command{
+		Use:   "list-states <active|suspended|disqualified>",
+		Short: "List the nodes in a state",
+		Args:  cobra.ExactArgs(1),
+		RunE:  ListNodeStates,
+	}
+	setStateCmd = &cobra.Command{
+		Use:   "set-state <node_id> <active|suspended|disqualified> [reason]",
+		Short: "Change the state of a node",
+		Args:  cobra.RangeArgs(2, 3),
+		RunE:  SetNodeState,
+	}
 )
 
 // Inspector gives access to kademlia and overlay cache
@@ -459,6 +472,72 @@ func CreateCSVStats(cmd *cobra.Command, args []string) (err error) {
 	return nil
 }
 
+// ListNodeStates lists the nodes in a state
+func ListNodeStates(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	state, err := parseNodeState(args[0])
+	if err != nil {
+		return err
+	}
+
+	res, err := i.statdbclient.ListNodeStates(context.Background(), &pb.ListNodeStatesRequest{
+		State: state,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	for _, node := range res.Nodes {
+		fmt.Println(prettyPrint(node))
+	}
+	return nil
+}
+
+// SetNodeState changes the state of a node
+func SetNodeState(cmd *cobra.Command, args []string) (err error) {
+	i, err := NewInspector(*Addr)
+	if err != nil {
+		return ErrInspectorDial.Wrap(err)
+	}
+
+	nodeID, err := storj.NodeIDFromString(args[0])
+	if err != nil {
+		return err
+	}
+	state, err := parseNodeState(args[1])
+	if err != nil {
+		return err
+	}
+	reason := "changed with the inspector"
+	if len(args) > 2 {
+		reason = args[2]
+	}
+
+	res, err := i.statdbclient.SetNodeState(context.Background(), &pb.SetNodeStateRequest{
+		NodeId: nodeID,
+		State:  state,
+		Reason: reason,
+	})
+	if err != nil {
+		return ErrRequest.Wrap(err)
+	}
+
+	fmt.Println(prettyPrint(res.Node))
+	return nil
+}
+
+func parseNodeState(arg string) (pb.NodeState, error) {
+	state, ok := pb.NodeState_value[strings.ToUpper(arg)]
+	if !ok {
+		return 0, ErrArgs.New("unknown node state %q", arg)
+	}
+	return pb.NodeState(state), nil
+}
+
 func init() {
 	rootCmd.AddCommand(kadCmd)
 	rootCmd.AddCommand(statsCmd)
@@ -474,6 +553,8 @@ func init() {
 	statsCmd.AddCommand(getCSVStatsCmd)
 	statsCmd.AddCommand(createStatsCmd)
 	statsCmd.AddCommand(createCSVStatsCmd)
+	statsCmd.AddCommand(listStatesCmd)
+	statsCmd.AddCommand(setStateCmd)
 
 	flag.Parse()
 }
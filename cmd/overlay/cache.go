@@ -10,6 +10,7 @@ import (
 	"github.com/zeebo/errs"
 
 	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/satellite/satellitedb"
 )
 
@@ -30,5 +31,5 @@ func (c cacheConfig) open(ctx context.Context) (cache *overlay.Cache, dbClose fu
 		}
 	}
 
-	return overlay.NewCache(database.OverlayCache(), database.StatDB()), dbClose, nil
+	return overlay.NewCache(database.OverlayCache(), database.StatDB(), overlay.NodeSelectionConfig{}, statdb.ReputationParams{}), dbClose, nil
 }
@@ -30,6 +30,7 @@ func main() {
 	flag.StringVar(&conf.APIKey, "apikey", "abc123", "api key")
 	flag.StringVar(&conf.EncryptionKey, "encryptionkey", "abc123", "encryption key")
 	flag.BoolVar(&conf.NoSSL, "no-ssl", false, "disable ssl")
+	flag.IntVar(&conf.ParallelSegments, "parallel-segments", 0, "segments uploaded or downloaded at the same time by the uplink client (0 uses the uplink config)")
 
 	clientName := flag.String("client", "minio", "client to use for requests (supported: minio, aws-cli, uplink)")
 
@@ -27,6 +27,8 @@ import (
 	"storj.io/storj/pkg/datarepair/checker"
 	"storj.io/storj/pkg/datarepair/repairer"
 	"storj.io/storj/pkg/discovery"
+	"storj.io/storj/pkg/gc"
+	"storj.io/storj/pkg/gracefulexit"
 	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/overlay"
@@ -34,6 +36,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/process"
+	"storj.io/storj/pkg/reaper"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
@@ -45,20 +48,23 @@ type Satellite struct {
 	CA       identity.CASetupConfig `setup:"true"`
 	Identity identity.SetupConfig   `setup:"true"`
 
-	Server      server.Config
-	Kademlia    kademlia.SatelliteConfig
-	PointerDB   pointerdb.Config
-	Overlay     overlay.Config
-	Checker     checker.Config
-	Repairer    repairer.Config
-	Audit       audit.Config
-	BwAgreement bwagreement.Config
-	Discovery   discovery.Config
-	Database    string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
-	StatDB      statdb.Config
-	Tally       tally.Config
-	Rollup      rollup.Config
-	Payments    payments.Config
+	Server       server.Config
+	Kademlia     kademlia.SatelliteConfig
+	PointerDB    pointerdb.Config
+	Overlay      overlay.Config
+	Checker      checker.Config
+	Repairer     repairer.Config
+	Reaper       reaper.Config
+	Audit        audit.Config
+	BwAgreement  bwagreement.Config
+	Discovery    discovery.Config
+	Database     string `help:"satellite database connection string" default:"sqlite3://$CONFDIR/master.db"`
+	StatDB       statdb.Config
+	Tally        tally.Config
+	Rollup       rollup.Config
+	Payments     payments.Config
+	GracefulExit gracefulexit.Config
+	GC           gc.Config
 }
 
 var (
@@ -145,18 +151,22 @@ func cmdRun(cmd *cobra.Command, args []string) (err error) {
 	return runCfg.Server.Run(
 		ctx,
 		grpcauth.NewAPIKeyInterceptor(),
+		// statdb configures the reputations of the overlay and audits
+		runCfg.StatDB,
 		runCfg.Kademlia,
 		runCfg.Overlay,
 		runCfg.PointerDB,
 		runCfg.Checker,
 		runCfg.Repairer,
+		runCfg.Reaper,
 		runCfg.Audit,
 		runCfg.BwAgreement,
 		runCfg.Discovery,
-		runCfg.StatDB,
 		runCfg.Tally,
 		runCfg.Rollup,
 		runCfg.Payments,
+		runCfg.GracefulExit,
+		runCfg.GC,
 	)
 }
 
@@ -13,24 +13,28 @@ import (
 	"regexp"
 	"runtime"
 	"sort"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/fatih/color"
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/spf13/cobra"
+	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/fpath"
 	"storj.io/storj/pkg/cfgstruct"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/pb"
+	pstore "storj.io/storj/pkg/piecestore"
 	"storj.io/storj/pkg/piecestore/psclient"
 	"storj.io/storj/pkg/piecestore/psserver"
 	"storj.io/storj/pkg/piecestore/psserver/psdb"
 	"storj.io/storj/pkg/process"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
 )
 
 // StorageNode defines storage node configuration
@@ -75,6 +79,16 @@ var (
 		Short: "Display a dashbaord",
 		RunE:  dashCmd,
 	}
+	rebuildUsageCmd = &cobra.Command{
+		Use:   "rebuild-usage",
+		Short: "Rebuild the used space and bandwidth counters of a stopped storagenode",
+		RunE:  cmdRebuildUsage,
+	}
+	exitCmd = &cobra.Command{
+		Use:   "exit [<satellite id>@<address> ...]",
+		Short: "Transfer the stored pieces to other nodes and leave the given or the configured trusted satellites",
+		RunE:  cmdExit,
+	}
 	runCfg       StorageNode
 	setupCfg     StorageNode
 	dashboardCfg struct {
@@ -84,6 +98,12 @@ var (
 	diagCfg struct {
 	}
 
+	rebuildUsageCfg struct {
+		Storage psserver.Config
+	}
+
+	exitCfg StorageNode
+
 	defaultConfDir  string
 	defaultDiagDir  string
 	defaultCredsDir string
@@ -118,11 +138,15 @@ func init() {
 	rootCmd.AddCommand(configCmd)
 	rootCmd.AddCommand(diagCmd)
 	rootCmd.AddCommand(dashboardCmd)
+	rootCmd.AddCommand(rebuildUsageCmd)
+	rootCmd.AddCommand(exitCmd)
 	cfgstruct.Bind(runCmd.Flags(), &runCfg, cfgstruct.ConfDir(defaultConfDir))
 	cfgstruct.BindSetup(setupCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir))
 	cfgstruct.BindSetup(configCmd.Flags(), &setupCfg, cfgstruct.ConfDir(defaultConfDir))
 	cfgstruct.Bind(diagCmd.Flags(), &diagCfg, cfgstruct.ConfDir(defaultDiagDir))
 	cfgstruct.Bind(dashboardCmd.Flags(), &dashboardCfg)
+	cfgstruct.Bind(rebuildUsageCmd.Flags(), &rebuildUsageCfg, cfgstruct.ConfDir(defaultConfDir))
+	cfgstruct.Bind(exitCmd.Flags(), &exitCfg, cfgstruct.ConfDir(defaultConfDir))
 }
 
 func cmdRun(cmd *cobra.Command, args []string) (err error) {
@@ -308,6 +332,98 @@ func cmdDiag(cmd *cobra.Command, args []string) (err error) {
 	return err
 }
 
+func cmdRebuildUsage(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+	path := rebuildUsageCfg.Storage.Path
+
+	storage, err := pstore.NewStorage(filepath.Join(path, "piece-store-data"))
+	if err != nil {
+		return err
+	}
+
+	db, err := psdb.Open(ctx, storage, filepath.Join(path, "piecestore.db"))
+	if err != nil {
+		fmt.Println("Storagenode database couldnt open:", path)
+		return errs.Combine(err, storage.Close())
+	}
+	defer func() { err = errs.Combine(err, db.Close(), storage.Close()) }()
+
+	usedSpace, usedBandwidth, err := db.RebuildUsage(ctx)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Used space: %d bytes\n", usedSpace)
+	fmt.Printf("Used bandwidth this month: %d bytes\n", usedBandwidth)
+	return nil
+}
+
+func cmdExit(cmd *cobra.Command, args []string) (err error) {
+	ctx := process.Ctx(cmd)
+
+	ident, err := exitCfg.Server.Identity.Load()
+	if err != nil {
+		return err
+	}
+	tc := transport.NewClient(ident)
+
+	trust, err := psserver.NewTrustedSatellites(zap.L(), exitCfg.Storage.TrustedSatellites, exitCfg.Storage.TrustUnknownSatellites, nil, tc)
+	if err != nil {
+		return err
+	}
+
+	// nodes trusting unknown satellites have to name the ones they leave
+	exiting := trust
+	if len(args) > 0 {
+		exiting, err = psserver.NewTrustedSatellites(zap.L(), strings.Join(args, ","), false, nil, tc)
+		if err != nil {
+			return err
+		}
+	}
+
+	satellites, err := exiting.Nodes(ctx)
+	if err != nil {
+		return err
+	}
+	if len(satellites) == 0 {
+		return errs.New("no satellites to exit, pass them as <satellite id>@<address> arguments or configure --storage.trusted-satellites")
+	}
+
+	path := exitCfg.Storage.Path
+	storage, err := pstore.NewStorage(filepath.Join(path, "piece-store-data"))
+	if err != nil {
+		return err
+	}
+
+	db, err := psdb.Open(ctx, storage, filepath.Join(path, "piecestore.db"))
+	if err != nil {
+		fmt.Println("Storagenode database couldnt open:", path)
+		return errs.Combine(err, storage.Close())
+	}
+
+	server, err := psserver.New(zap.L(), storage, db, exitCfg.Storage, ident.Key, trust)
+	if err != nil {
+		return errs.Combine(err, db.Close(), storage.Close())
+	}
+	defer func() { err = errs.Combine(err, server.Stop(ctx)) }()
+
+	for _, satellite := range satellites {
+		fmt.Printf("Exiting satellite %s\n", satellite.Id)
+
+		err = server.Exit(ctx, satellite, tc, func(progress *pb.ExitProgress) {
+			fmt.Printf("\rTransferred pieces: %d, failed pieces: %d", progress.GetPiecesTransferred(), progress.GetPiecesFailed())
+		})
+		fmt.Println()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Exited satellite %s\n", satellite.Id)
+	}
+
+	return nil
+}
+
 func dashCmd(cmd *cobra.Command, args []string) (err error) {
 	ctx := context.Background()
 
@@ -360,6 +476,17 @@ func dashCmd(cmd *cobra.Command, args []string) (err error) {
 		if stats != nil {
 			fmt.Fprintf(color.Output, "Bandwidth\t\t%+v\t\t\t%+v\n", whiteInt(stats.GetAvailableBandwidth()), whiteInt(stats.GetUsedBandwidth()))
 			fmt.Fprintf(color.Output, "Disk\t\t\t%+v\t\t\t%+v\n", whiteInt(stats.GetAvailableSpace()), whiteInt(stats.GetUsedSpace()))
+
+			if satellites := stats.GetSatellites(); len(satellites) > 0 {
+				color.Green("\nSatellite\t\t\t\t\t\tDisk Used\tDisk Cap\tBandwidth Used\n---------\t\t\t\t\t\t---------\t--------\t--------------")
+				for _, satellite := range satellites {
+					allocated := "none"
+					if satellite.GetAllocatedSpace() > 0 {
+						allocated = fmt.Sprint(satellite.GetAllocatedSpace())
+					}
+					fmt.Fprintf(color.Output, "%s\t%+v\t%s\t%+v\n", satellite.SatelliteId, whiteInt(satellite.GetUsedSpace()), color.WhiteString(allocated), whiteInt(satellite.GetUsedBandwidth()))
+				}
+			}
 		} else {
 			color.Yellow("Loading...")
 		}
@@ -158,6 +158,10 @@ func newNetwork(flags *Flags) (*Processes, error) {
 				"--audit.satellite-addr", process.Address,
 				"--repairer.overlay-addr", process.Address,
 				"--repairer.pointer-db-addr", process.Address,
+
+				// all the storage nodes run on the same host
+				"--overlay.node.distinct-network-bits", "0",
+				"--overlay.node.distinct-network-bits-v6", "0",
 			},
 		})
 	}
@@ -218,6 +222,7 @@ func newNetwork(flags *Flags) (*Processes, error) {
 				"--kademlia.operator.email", fmt.Sprintf("storage%d@example.com", i),
 				"--kademlia.operator.wallet", "0x0123456789012345678901234567890123456789",
 				"--server.address", process.Address,
+				"--storage.trust-unknown-satellites",
 			},
 		})
 	}
@@ -10,6 +10,7 @@ import (
 	"io"
 	"os"
 	"strings"
+	"time"
 
 	progressbar "github.com/cheggaaa/pb"
 	"github.com/spf13/cobra"
@@ -24,6 +25,8 @@ import (
 
 var (
 	progress *bool
+	resume   *bool
+	expires  *string
 )
 
 func init() {
@@ -33,10 +36,34 @@ func init() {
 		RunE:  copyMain,
 	}, CLICmd)
 	progress = cpCmd.Flags().Bool("progress", true, "if true, show progress")
+	resume = cpCmd.Flags().Bool("resume", false, "if true, continue an interrupted upload or make the upload resumable")
+	expires = cpCmd.Flags().String("expires", "", "optional expiration of the new object, as RFC3339 date or duration from now like 720h")
+}
+
+// parseExpiration parses the expiration date, or the duration until it, of
+// the --expires flag. Empty means the object never expires.
+func parseExpiration(value string) (time.Time, error) {
+	if value == "" {
+		return time.Time{}, nil
+	}
+	if duration, err := time.ParseDuration(value); err == nil {
+		if duration <= 0 {
+			return time.Time{}, fmt.Errorf("expiration must be in the future: %s", value)
+		}
+		return time.Now().Add(duration), nil
+	}
+	expiration, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid expiration, use RFC3339 date or duration: %s", value)
+	}
+	if !expiration.After(time.Now()) {
+		return time.Time{}, fmt.Errorf("expiration must be in the future: %s", value)
+	}
+	return expiration, nil
 }
 
 // upload transfers src from local machine to s3 compatible object dst
-func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress bool) error {
+func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, expiration time.Time, showProgress bool, resumable bool) error {
 	if !src.IsLocal() {
 		return fmt.Errorf("source must be local path: %s", src)
 	}
@@ -76,24 +103,58 @@ func upload(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress
 		return err
 	}
 
-	createInfo := storj.CreateObject{
-		RedundancyScheme: cfg.GetRedundancyScheme(),
-		EncryptionScheme: cfg.GetEncryptionScheme(),
+	var obj storj.MutableObject
+	var offset int64
+	if resumable {
+		obj, err = metainfo.ModifyPendingObject(ctx, dst.Bucket(), dst.Path())
+		if err != nil && !storj.ErrObjectNotFound.Has(err) {
+			return convertError(err, dst)
+		}
+		if err == nil {
+			offset = obj.Info().Size
+		}
 	}
-	obj, err := metainfo.CreateObject(ctx, dst.Bucket(), dst.Path(), &createInfo)
-	if err != nil {
-		return convertError(err, dst)
+
+	if offset > 0 {
+		if file == os.Stdin {
+			return fmt.Errorf("cannot continue an interrupted upload from stdin: %s", dst)
+		}
+		if offset > fileInfo.Size() {
+			return fmt.Errorf("source is smaller than the already uploaded part of %s", dst)
+		}
+		_, err = file.Seek(offset, io.SeekStart)
+		if err != nil {
+			return err
+		}
+	} else {
+		createInfo := storj.CreateObject{
+			Expires:          expiration,
+			RedundancyScheme: cfg.GetRedundancyScheme(),
+			EncryptionScheme: cfg.GetEncryptionScheme(),
+		}
+		obj, err = metainfo.CreateObject(ctx, dst.Bucket(), dst.Path(), &createInfo)
+		if err != nil {
+			return convertError(err, dst)
+		}
 	}
 
 	reader := io.Reader(file)
 	var bar *progressbar.ProgressBar
 	if showProgress {
 		bar = progressbar.New(int(fileInfo.Size())).SetUnits(progressbar.U_BYTES)
+		bar.Set64(offset)
 		bar.Start()
 		reader = bar.NewProxyReader(reader)
 	}
 
-	err = uploadStream(ctx, streams, obj, reader)
+	switch {
+	case offset > 0:
+		err = continueStream(ctx, streams, obj, reader)
+	case resumable:
+		err = uploadResumableStream(ctx, streams, obj, reader)
+	default:
+		err = uploadStream(ctx, streams, obj, reader)
+	}
 	if err != nil {
 		return err
 	}
@@ -120,6 +181,32 @@ func uploadStream(ctx context.Context, streams streams.Store, mutableObject stor
 	return utils.CombineErrors(err, upload.Close())
 }
 
+func uploadResumableStream(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) error {
+	mutableStream, err := mutableObject.CreateStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	upload := stream.NewResumableUpload(ctx, mutableStream, streams)
+
+	_, err = io.Copy(upload, reader)
+
+	return utils.CombineErrors(err, upload.Close())
+}
+
+func continueStream(ctx context.Context, streams streams.Store, mutableObject storj.MutableObject, reader io.Reader) error {
+	mutableStream, err := mutableObject.ContinueStream(ctx)
+	if err != nil {
+		return err
+	}
+
+	upload := stream.NewContinuedUpload(ctx, mutableStream, streams)
+
+	_, err = io.Copy(upload, reader)
+
+	return utils.CombineErrors(err, upload.Close())
+}
+
 // download transfers s3 compatible object src to dst on local machine
 func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgress bool) error {
 	if src.IsLocal() {
@@ -135,7 +222,7 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 		return err
 	}
 
-	readOnlyStream, err := metainfo.GetObjectStream(ctx, src.Bucket(), src.Path())
+	readOnlyStream, err := metainfo.GetObjectStream(ctx, src.Bucket(), src.Path(), "")
 	if err != nil {
 		return convertError(err, src)
 	}
@@ -185,7 +272,7 @@ func download(ctx context.Context, src fpath.FPath, dst fpath.FPath, showProgres
 }
 
 // copy copies s3 compatible object src to s3 compatible object dst
-func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) error {
+func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath, expiration time.Time) error {
 	if src.IsLocal() {
 		return fmt.Errorf("source must be Storj URL: %s", src)
 	}
@@ -199,7 +286,7 @@ func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) error {
 		return err
 	}
 
-	readOnlyStream, err := metainfo.GetObjectStream(ctx, src.Bucket(), src.Path())
+	readOnlyStream, err := metainfo.GetObjectStream(ctx, src.Bucket(), src.Path(), "")
 	if err != nil {
 		return convertError(err, src)
 	}
@@ -223,6 +310,7 @@ func copy(ctx context.Context, src fpath.FPath, dst fpath.FPath) error {
 	}
 
 	createInfo := storj.CreateObject{
+		Expires:          expiration,
 		RedundancyScheme: cfg.GetRedundancyScheme(),
 		EncryptionScheme: cfg.GetEncryptionScheme(),
 	}
@@ -271,9 +359,14 @@ func copyMain(cmd *cobra.Command, args []string) (err error) {
 		return errors.New("At least one of the source or the desination must be a Storj URL")
 	}
 
+	expiration, err := parseExpiration(*expires)
+	if err != nil {
+		return err
+	}
+
 	// if uploading
 	if src.IsLocal() {
-		return upload(ctx, src, dst, *progress)
+		return upload(ctx, src, dst, expiration, *progress, *resume)
 	}
 
 	// if downloading
@@ -282,5 +375,5 @@ func copyMain(cmd *cobra.Command, args []string) (err error) {
 	}
 
 	// if copying from one remote location to another
-	return copy(ctx, src, dst)
+	return copy(ctx, src, dst, expiration)
 }
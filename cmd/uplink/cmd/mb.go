@@ -13,12 +13,17 @@ import (
 	"storj.io/storj/pkg/storj"
 )
 
+var (
+	versioningFlag *bool
+)
+
 func init() {
-	addCmd(&cobra.Command{
+	mbCmd := addCmd(&cobra.Command{
 		Use:   "mb",
 		Short: "Create a new bucket",
 		RunE:  makeBucket,
 	}, CLICmd)
+	versioningFlag = mbCmd.Flags().Bool("versioning", false, "if true, keep the prior versions of objects in the bucket")
 }
 
 func makeBucket(cmd *cobra.Command, args []string) error {
@@ -53,7 +58,7 @@ func makeBucket(cmd *cobra.Command, args []string) error {
 	if !storj.ErrBucketNotFound.Has(err) {
 		return err
 	}
-	_, err = metainfo.CreateBucket(ctx, dst.Bucket(), &storj.Bucket{PathCipher: storj.Cipher(cfg.Enc.PathType)})
+	_, err = metainfo.CreateBucket(ctx, dst.Bucket(), &storj.Bucket{PathCipher: storj.Cipher(cfg.Enc.PathType), Versioning: *versioningFlag})
 	if err != nil {
 		return err
 	}
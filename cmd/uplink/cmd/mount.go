@@ -138,7 +138,7 @@ func (sf *storjFS) GetAttr(name string, context *fuse.Context) (*fuse.Attr, fuse
 		return &fuse.Attr{Mode: fuse.S_IFDIR | 0755}, fuse.OK
 	}
 
-	object, err := sf.metainfo.GetObject(sf.ctx, sf.bucket.Name, name)
+	object, err := sf.metainfo.GetObject(sf.ctx, sf.bucket.Name, name, "")
 	if err != nil && !storj.ErrObjectNotFound.Has(err) {
 		return nil, fuse.EIO
 	}
@@ -397,7 +397,7 @@ func (f *storjFile) Write(data []byte, off int64) (uint32, fuse.Status) {
 
 func (f *storjFile) getReader(off int64) (io.ReadCloser, error) {
 	if f.reader == nil {
-		readOnlyStream, err := f.metainfo.GetObjectStream(f.ctx, f.bucket.Name, f.name)
+		readOnlyStream, err := f.metainfo.GetObjectStream(f.ctx, f.bucket.Name, f.name, "")
 		if err != nil {
 			return nil, err
 		}
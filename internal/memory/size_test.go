@@ -146,6 +146,8 @@ func TestInvalidParse(t *testing.T) {
 		"z1.0Q",
 		"1.0zQ",
 		"1.0zQB",
+		"KB",
+		"lots",
 	}
 
 	for i, test := range tests {
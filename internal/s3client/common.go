@@ -12,6 +12,10 @@ type Config struct {
 	APIKey        string
 	EncryptionKey string
 	NoSSL         bool
+
+	// ParallelSegments is the number of segments uploaded or downloaded at
+	// the same time by the uplink client, 0 uses the configured value
+	ParallelSegments int
 }
 
 // Client is the common interface for different implementations
@@ -7,6 +7,7 @@ import (
 	"bytes"
 	"os"
 	"os/exec"
+	"strconv"
 	"strings"
 
 	"github.com/zeebo/errs"
@@ -42,6 +43,9 @@ func (client *Uplink) cmd(subargs ...string) *exec.Cmd {
 	args := []string{}
 
 	args = append(args, subargs...)
+	if client.conf.ParallelSegments > 0 {
+		args = append(args, "--client.parallel-segments", strconv.Itoa(client.conf.ParallelSegments))
+	}
 
 	cmd := exec.Command("uplink", args...)
 	return cmd
@@ -22,6 +22,7 @@ import (
 	"google.golang.org/grpc"
 
 	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/gracefulexit"
 	"storj.io/storj/pkg/kademlia"
 	"storj.io/storj/pkg/node"
 	"storj.io/storj/pkg/overlay"
@@ -124,6 +125,7 @@ func NewWithLogger(log *zap.Logger, satelliteCount, storageNodeCount, uplinkCoun
 	// init Satellites
 	for _, node := range planet.Satellites {
 		pointerServer := pointerdb.NewServer(
+			teststore.New(),
 			teststore.New(),
 			node.Overlay,
 			node.Log.Named("pdb"),
@@ -131,6 +133,7 @@ func NewWithLogger(log *zap.Logger, satelliteCount, storageNodeCount, uplinkCoun
 				MinRemoteSegmentSize: 1240,
 				MaxInlineSegmentSize: 8000,
 				Overlay:              true,
+				BwExpiration:         45,
 			},
 			node.Identity)
 		pb.RegisterPointerDBServer(node.Provider.GRPC(), pointerServer)
@@ -141,16 +144,12 @@ func NewWithLogger(log *zap.Logger, satelliteCount, storageNodeCount, uplinkCoun
 			}
 		}(node)
 
-		ns := &pb.NodeStats{
-			UptimeCount:       0,
-			UptimeRatio:       0,
-			AuditSuccessRatio: 0,
-			AuditCount:        0,
-		}
-
-		overlayServer := overlay.NewServer(node.Log.Named("overlay"), node.Overlay, ns)
+		overlayServer := overlay.NewServer(node.Log.Named("overlay"), node.Overlay, overlay.NodeSelectionConfig{})
 		pb.RegisterOverlayServer(node.Provider.GRPC(), overlayServer)
 
+		gracefulExit := gracefulexit.NewEndpoint(node.Log.Named("gracefulexit"), pointerServer, node.Overlay, overlayServer)
+		pb.RegisterGracefulExitServer(node.Provider.GRPC(), gracefulExit)
+
 		node.Dependencies = append(node.Dependencies,
 			closerFunc(func() error {
 				// TODO: implement
@@ -266,6 +265,12 @@ func (planet *Planet) newStorageNodes(count int) ([]*storagenode.Peer, error) {
 		}
 	}()
 
+	// the storage nodes trust the satellites of the planet
+	var trusted []string
+	for _, satellite := range planet.Satellites {
+		trusted = append(trusted, satellite.ID().String()+"@"+satellite.Addr())
+	}
+
 	for i := 0; i < count; i++ {
 		prefix := "storage" + strconv.Itoa(i)
 		log := planet.log.Named(prefix)
@@ -296,6 +301,7 @@ func (planet *Planet) newStorageNodes(count int) ([]*storagenode.Peer, error) {
 				AllocatedDiskSpace:     memory.TB,
 				AllocatedBandwidth:     memory.TB,
 				KBucketRefreshInterval: time.Minute,
+				TrustedSatellites:      strings.Join(trusted, ","),
 			},
 		}
 
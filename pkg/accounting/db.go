@@ -7,6 +7,8 @@ import (
 	"context"
 	"time"
 
+	"github.com/skyrings/skyring-common/tools/uuid"
+
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 )
@@ -40,14 +42,30 @@ type Rollup struct {
 	AtRestTotal    float64
 }
 
+//BucketTally contains the at-rest data of a bucket of a project
+type BucketTally struct {
+	ProjectID   uuid.UUID
+	BucketName  string
+	Bytes       int64
+	ObjectCount int64
+}
+
+//BucketBandwidth contains the bandwidth used by a bucket of a project for an action
+type BucketBandwidth struct {
+	ProjectID  uuid.UUID
+	BucketName string
+	Action     pb.PayerBandwidthAllocation_Action
+	Total      int64
+}
+
 // DB stores information about bandwidth usage
 type DB interface {
 	// LastRawTime records the latest last tallied time.
 	LastRawTime(ctx context.Context, timestampType string) (time.Time, bool, error)
-	// SaveBWRaw records raw sums of agreement values to the database and updates the LastRawTime.
-	SaveBWRaw(ctx context.Context, latestBwa time.Time, bwTotals BWTally) error
-	// SaveAtRestRaw records raw tallies of at-rest-data.
-	SaveAtRestRaw(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64) error
+	// SaveBWRaw records raw sums of agreement values and the bandwidth used by the buckets of every project to the database and updates the LastRawTime.
+	SaveBWRaw(ctx context.Context, latestBwa time.Time, bwTotals BWTally, bucketBandwidth []*BucketBandwidth) error
+	// SaveAtRestRaw records raw tallies of at-rest-data and the at-rest data of the buckets of every project.
+	SaveAtRestRaw(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64, bucketTallies []*BucketTally) error
 	// GetRaw retrieves all raw tallies
 	GetRaw(ctx context.Context) ([]*Raw, error)
 	// GetRawSince r retrieves all raw tallies sinces
@@ -5,9 +5,11 @@ package tally
 
 import (
 	"context"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/accounting"
@@ -79,6 +81,8 @@ func (t *tally) calculateAtRestData(ctx context.Context) (err error) {
 	}
 
 	var nodeData = make(map[storj.NodeID]float64)
+	var bucketTallies = make(map[string]*accounting.BucketTally)
+	start := time.Now()
 	err = t.pointerdb.Iterate(ctx, &pb.IterateRequest{Recurse: true},
 		func(it storage.Iterator) error {
 			var item storage.ListItem
@@ -88,6 +92,11 @@ func (t *tally) calculateAtRestData(ctx context.Context) (err error) {
 				if err != nil {
 					return Error.Wrap(err)
 				}
+				// expired segments aren't stored anymore
+				if pointerdb.Expired(pointer, start) {
+					continue
+				}
+				tallyBucket(bucketTallies, item.Key.String(), pointer)
 				remote := pointer.GetRemote()
 				if remote == nil {
 					continue
@@ -117,21 +126,61 @@ func (t *tally) calculateAtRestData(ctx context.Context) (err error) {
 			return nil
 		},
 	)
-	if len(nodeData) == 0 {
-		return nil
-	}
 	if err != nil {
 		return Error.Wrap(err)
 	}
+	now := time.Now().UTC()
+	if len(nodeData) == 0 && len(bucketTallies) == 0 {
+		return nil
+	}
+	tallies := make([]*accounting.BucketTally, 0, len(bucketTallies))
+	for _, tally := range bucketTallies {
+		tallies = append(tallies, tally)
+	}
 	//store byte hours, not just bytes
 	numHours := 1.0 //todo: something more considered?
 	if !isNil {
-		numHours = time.Now().UTC().Sub(latestTally).Hours()
+		numHours = now.Sub(latestTally).Hours()
 	}
 	for k := range nodeData {
 		nodeData[k] *= numHours
 	}
-	return Error.Wrap(t.accountingDB.SaveAtRestRaw(ctx, latestTally, nodeData))
+	return Error.Wrap(t.accountingDB.SaveAtRestRaw(ctx, now, nodeData, tallies))
+}
+
+// tallyBucket adds the segment at path to the tally of the bucket it belongs
+// to, ignoring paths which aren't the ones of segments of a project
+func tallyBucket(tallies map[string]*accounting.BucketTally, path storj.Path, pointer *pb.Pointer) {
+	// segments of a project are stored at <project id>/<segment>/<bucket>/<path>
+	components := storj.SplitPath(path)
+	if len(components) < 4 {
+		return
+	}
+	projectID, err := uuid.Parse(components[0])
+	if err != nil {
+		return
+	}
+	segment, bucket := components[1], components[2]
+	// the segments of versions are stored at v/ and v<N>/, they take up
+	// storage without counting as objects
+	if segment != "l" && !strings.HasPrefix(segment, "s") && !strings.HasPrefix(segment, "v") {
+		return
+	}
+
+	key := storj.JoinPaths(components[0], bucket)
+	tally, ok := tallies[key]
+	if !ok {
+		tally = &accounting.BucketTally{ProjectID: *projectID, BucketName: bucket}
+		tallies[key] = tally
+	}
+	if segment == "l" {
+		tally.ObjectCount++
+	}
+	if pointer.GetType() == pb.Pointer_INLINE {
+		tally.Bytes += int64(len(pointer.GetInlineSegment()))
+	} else {
+		tally.Bytes += pointer.GetSegmentSize()
+	}
 }
 
 // queryBW queries bandwidth allocation database, selecting all new contracts since the last collection run time.
@@ -162,6 +211,7 @@ func (t *tally) queryBW(ctx context.Context) error {
 	for i := range bwTotals {
 		bwTotals[i] = make(map[storj.NodeID]int64)
 	}
+	var bucketBandwidth = make(map[string]*accounting.BucketBandwidth)
 	var latestBwa time.Time
 	for _, baRow := range bwAgreements {
 		rbad := &pb.RenterBandwidthAllocation_Data{}
@@ -177,6 +227,28 @@ func (t *tally) queryBW(ctx context.Context) error {
 			latestBwa = baRow.CreatedAt
 		}
 		bwTotals[pbad.GetAction()][rbad.StorageNodeId] += rbad.GetTotal()
+		tallyBucketBandwidth(bucketBandwidth, pbad, rbad.GetTotal())
+	}
+	bandwidth := make([]*accounting.BucketBandwidth, 0, len(bucketBandwidth))
+	for _, bw := range bucketBandwidth {
+		bandwidth = append(bandwidth, bw)
+	}
+	return Error.Wrap(t.accountingDB.SaveBWRaw(ctx, latestBwa, bwTotals, bandwidth))
+}
+
+// tallyBucketBandwidth adds the total of an agreement to the bandwidth of the
+// bucket it was allocated for, ignoring agreements without a project
+func tallyBucketBandwidth(bandwidth map[string]*accounting.BucketBandwidth, pbad *pb.PayerBandwidthAllocation_Data, total int64) {
+	projectID, err := uuid.Parse(pbad.GetProjectId())
+	if err != nil {
+		return
+	}
+
+	key := storj.JoinPaths(pbad.GetProjectId(), pbad.GetBucket(), pbad.GetAction().String())
+	bw, ok := bandwidth[key]
+	if !ok {
+		bw = &accounting.BucketBandwidth{ProjectID: *projectID, BucketName: pbad.GetBucket(), Action: pbad.GetAction()}
+		bandwidth[key] = bw
 	}
-	return Error.Wrap(t.accountingDB.SaveBWRaw(ctx, lastBwTally, bwTotals))
+	bw.Total += total
 }
@@ -9,19 +9,25 @@ import (
 	"testing"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/zap"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/bwagreement/test"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/overlay/mocks"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/satellite/satellitedb"
+	"storj.io/storj/storage"
 	"storj.io/storj/storage/teststore"
 )
 
@@ -29,7 +35,7 @@ func TestQueryNoAgreements(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
 
-	pointerdb := pointerdb.NewServer(teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
+	pointerdb := pointerdb.NewServer(teststore.New(), teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
 	overlayServer := mocks.NewOverlay([]*pb.Node{})
 	db, err := satellitedb.NewInMemory()
 	assert.NoError(t, err)
@@ -46,7 +52,7 @@ func TestQueryWithBw(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
 
-	pointerdb := pointerdb.NewServer(teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
+	pointerdb := pointerdb.NewServer(teststore.New(), teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
 	overlayServer := mocks.NewOverlay([]*pb.Node{})
 
 	db, err := satellitedb.NewInMemory()
@@ -75,6 +81,72 @@ func TestQueryWithBw(t *testing.T) {
 	assert.NoError(t, err)
 }
 
+func TestTallyRecordsEndOfRange(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	pointers := teststore.New()
+	pointerdb := pointerdb.NewServer(pointers, teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
+	overlayServer := mocks.NewOverlay([]*pb.Node{})
+
+	db, err := satellitedb.NewInMemory()
+	assert.NoError(t, err)
+	defer ctx.Check(db.Close)
+	assert.NoError(t, db.CreateTables())
+
+	bwDb := db.BandwidthAgreement()
+	tally := newTally(zap.NewNop(), db.Accounting(), bwDb, pointerdb, overlayServer, 0, time.Second)
+
+	pointer, err := proto.Marshal(&pb.Pointer{
+		Type:        pb.Pointer_REMOTE,
+		SegmentSize: 1000,
+		Remote: &pb.RemoteSegment{
+			Redundancy:   &pb.RedundancyScheme{MinReq: 1},
+			RemotePieces: []*pb.RemotePiece{{NodeId: teststorj.NodeIDFromString("StorageNodeID")}},
+		},
+	})
+	assert.NoError(t, err)
+	assert.NoError(t, pointers.Put(storage.Key("segment"), pointer))
+
+	// the at rest data is tallied up to now, not up to the previous tally
+	before := time.Now().UTC()
+	assert.NoError(t, tally.calculateAtRestData(auth.WithAPIKey(ctx, nil)))
+	latestTally, isNil, err := db.Accounting().LastRawTime(ctx, accounting.LastAtRestTally)
+	assert.NoError(t, err)
+	assert.False(t, isNil)
+	assert.False(t, latestTally.Before(before))
+
+	//get a private key
+	fiC, err := testidentity.NewTestIdentity(ctx)
+	assert.NoError(t, err)
+	k, ok := fiC.Key.(*ecdsa.PrivateKey)
+	assert.True(t, ok)
+
+	makeBWA(ctx, t, bwDb, "1", k, pb.PayerBandwidthAllocation_PUT)
+	makeBWA(ctx, t, bwDb, "2", k, pb.PayerBandwidthAllocation_GET)
+
+	agreements, err := bwDb.GetAgreements(ctx)
+	assert.NoError(t, err)
+	var latestBwa time.Time
+	for _, agreement := range agreements {
+		if agreement.CreatedAt.After(latestBwa) {
+			latestBwa = agreement.CreatedAt
+		}
+	}
+
+	// the bandwidth is tallied up to the latest agreement, so the next run
+	// doesn't tally the same agreements again
+	assert.NoError(t, tally.queryBW(ctx))
+	lastBwTally, isNil, err := db.Accounting().LastRawTime(ctx, accounting.LastBandwidthTally)
+	assert.NoError(t, err)
+	assert.False(t, isNil)
+	assert.True(t, latestBwa.Equal(lastBwTally), "%v != %v", latestBwa, lastBwTally)
+
+	since, err := bwDb.GetAgreementsSince(ctx, lastBwTally)
+	assert.NoError(t, err)
+	assert.Empty(t, since)
+}
+
 func makeBWA(ctx context.Context, t *testing.T, bwDb bwagreement.DB, serialNum string, k *ecdsa.PrivateKey, action pb.PayerBandwidthAllocation_Action) {
 	//generate an agreement with the key
 	pba, err := test.GeneratePayerBandwidthAllocation(action, k, k, time.Hour)
@@ -85,3 +157,36 @@ func makeBWA(ctx context.Context, t *testing.T, bwDb bwagreement.DB, serialNum s
 	err = bwDb.CreateAgreement(ctx, serialNum, bwagreement.Agreement{Signature: rba.GetSignature(), Agreement: rba.GetData()})
 	assert.NoError(t, err)
 }
+
+func TestTallyBucket(t *testing.T) {
+	projectID, err := uuid.New()
+	assert.NoError(t, err)
+
+	remote := &pb.Pointer{Type: pb.Pointer_REMOTE, SegmentSize: 1000}
+	inline := &pb.Pointer{Type: pb.Pointer_INLINE, InlineSegment: []byte("data")}
+
+	tallies := make(map[string]*accounting.BucketTally)
+	for _, segment := range []struct {
+		path    storj.Path
+		pointer *pb.Pointer
+	}{
+		{storj.JoinPaths(projectID.String(), "s0", "bucket", "object"), remote},
+		{storj.JoinPaths(projectID.String(), "l", "bucket", "object"), inline},
+		{storj.JoinPaths(projectID.String(), "l", "bucket", "object2"), remote},
+		{storj.JoinPaths(projectID.String(), "p", "bucket", "object3"), remote},
+		{storj.JoinPaths(projectID.String(), "v0", "bucket", "object", "version"), remote},
+		{storj.JoinPaths(projectID.String(), "v", "bucket", "object", "version"), inline},
+		{storj.JoinPaths(projectID.String(), "l", "bucket"), inline},
+		{storj.JoinPaths("l", "bucket", "object"), remote},
+	} {
+		tallyBucket(tallies, segment.path, segment.pointer)
+	}
+
+	if assert.Equal(t, 1, len(tallies)) {
+		tally := tallies[storj.JoinPaths(projectID.String(), "bucket")]
+		assert.Equal(t, *projectID, tally.ProjectID)
+		assert.Equal(t, "bucket", tally.BucketName)
+		assert.Equal(t, int64(3008), tally.Bytes)
+		assert.Equal(t, int64(2), tally.ObjectCount)
+	}
+}
@@ -9,13 +9,18 @@ import (
 	"math/big"
 	"sync"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/vivint/infectious"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 )
 
 // Stripe keeps track of a stripe's index and its parent segment
@@ -29,13 +34,16 @@ type Stripe struct {
 // Cursor keeps track of audit location in pointer db
 type Cursor struct {
 	pointers *pointerdb.Server
+	cache    *overlay.Cache
 	lastPath storj.Path
 	mutex    sync.Mutex
 }
 
-// NewCursor creates a Cursor which iterates over pointer db
-func NewCursor(pointers *pointerdb.Server) *Cursor {
-	return &Cursor{pointers: pointers}
+// NewCursor creates a Cursor which iterates over pointer db. With a cache,
+// the segments with pieces on unvetted nodes are audited first, so the nodes
+// are vetted faster.
+func NewCursor(pointers *pointerdb.Server, cache *overlay.Cache) *Cursor {
+	return &Cursor{pointers: pointers, cache: cache}
 }
 
 // NextStripe returns a random stripe to be audited
@@ -44,7 +52,6 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 	defer cursor.mutex.Unlock()
 
 	var pointerItems []*pb.ListResponse_Item
-	var path storj.Path
 	var more bool
 
 	listRes, err := cursor.pointers.List(ctx, &pb.ListRequest{
@@ -66,13 +73,6 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 		return nil, nil
 	}
 
-	pointerItem, err := getRandomPointer(pointerItems)
-	if err != nil {
-		return nil, err
-	}
-
-	path = pointerItem.Path
-
 	// keep track of last path listed
 	if !more {
 		cursor.lastPath = ""
@@ -80,9 +80,8 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 		cursor.lastPath = pointerItems[len(pointerItems)-1].Path
 	}
 
-	// get pointer info
-	getRes, err := cursor.pointers.Get(ctx, &pb.GetRequest{Path: path})
-	if err != nil {
+	getRes, err := cursor.choosePointer(ctx, pointerItems)
+	if err != nil || getRes == nil {
 		return nil, err
 	}
 	pointer := getRes.GetPointer()
@@ -116,6 +115,95 @@ func (cursor *Cursor) NextStripe(ctx context.Context) (stripe *Stripe, err error
 	}, nil
 }
 
+// choosePointer returns a random pointer of the items. With a cache, an
+// unvetted node with pieces in the listed segments is chosen at random and
+// one of its segments is returned, so every unvetted node is audited equally
+// often, regardless of how many pieces it stores. It returns nil, when the
+// chosen segment expired or was deleted since it was listed.
+func (cursor *Cursor) choosePointer(ctx context.Context, pointerItems []*pb.ListResponse_Item) (_ *pb.GetResponse, err error) {
+	path, err := cursor.chooseUnvetted(ctx, pointerItems)
+	if err != nil {
+		return nil, err
+	}
+	if path == "" {
+		pointerItem, err := getRandomPointer(pointerItems)
+		if err != nil {
+			return nil, err
+		}
+		path = pointerItem.Path
+	}
+
+	getRes, err := cursor.pointers.Get(ctx, &pb.GetRequest{Path: path})
+	if err != nil {
+		// the segment expired or was deleted since it was listed
+		if status.Code(err) == codes.NotFound {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return getRes, nil
+}
+
+// chooseUnvetted returns the path of a random listed segment with a piece on
+// a random unvetted node. The path is empty, when no listed segment has
+// pieces on unvetted nodes.
+func (cursor *Cursor) chooseUnvetted(ctx context.Context, pointerItems []*pb.ListResponse_Item) (_ storj.Path, err error) {
+	if cursor.cache == nil {
+		return "", nil
+	}
+
+	var keys storage.Keys
+	for _, item := range pointerItems {
+		if !item.IsPrefix {
+			keys = append(keys, storage.Key(item.Path))
+		}
+	}
+	if len(keys) == 0 {
+		return "", nil
+	}
+
+	values, err := cursor.pointers.DB.GetAll(keys)
+	if err != nil {
+		return "", err
+	}
+
+	var nodeIDs storj.NodeIDList
+	segments := make(map[storj.NodeID][]storj.Path)
+	for i, value := range values {
+		if value == nil {
+			continue
+		}
+
+		pointer := &pb.Pointer{}
+		if err := proto.Unmarshal(value, pointer); err != nil {
+			return "", err
+		}
+		for _, piece := range pointer.GetRemote().GetRemotePieces() {
+			if _, ok := segments[piece.NodeId]; !ok {
+				nodeIDs = append(nodeIDs, piece.NodeId)
+			}
+			segments[piece.NodeId] = append(segments[piece.NodeId], keys[i].String())
+		}
+	}
+
+	unvetted, err := cursor.cache.Unvetted(ctx, nodeIDs)
+	if err != nil || len(unvetted) == 0 {
+		return "", err
+	}
+
+	node, err := randomIndex(len(unvetted))
+	if err != nil {
+		return "", err
+	}
+	paths := segments[unvetted[node]]
+
+	segment, err := randomIndex(len(paths))
+	if err != nil {
+		return "", err
+	}
+	return paths[segment], nil
+}
+
 func makeErasureScheme(rs *pb.RedundancyScheme) (eestream.ErasureScheme, error) {
 	required := int(rs.GetMinReq())
 	total := int(rs.GetTotal())
@@ -144,11 +232,18 @@ func getRandomStripe(es eestream.ErasureScheme, pointer *pb.Pointer) (index int,
 }
 
 func getRandomPointer(pointerItems []*pb.ListResponse_Item) (pointer *pb.ListResponse_Item, err error) {
-	randomNum, err := rand.Int(rand.Reader, big.NewInt(int64(len(pointerItems))))
+	index, err := randomIndex(len(pointerItems))
 	if err != nil {
 		return &pb.ListResponse_Item{}, err
 	}
-	randomNumInt64 := randomNum.Int64()
-	pointerItem := pointerItems[randomNumInt64]
-	return pointerItem, nil
+	return pointerItems[index], nil
+}
+
+// randomIndex returns a random index of a slice of length n
+func randomIndex(n int) (int, error) {
+	index, err := rand.Int(rand.Reader, big.NewInt(int64(n)))
+	if err != nil {
+		return 0, err
+	}
+	return int(index.Int64()), nil
 }
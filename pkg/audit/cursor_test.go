@@ -5,7 +5,10 @@ package audit
 
 import (
 	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
 	"errors"
+	"fmt"
 	"math"
 	"math/big"
 	"reflect"
@@ -15,13 +18,17 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
 	"storj.io/storj/internal/teststorj"
 	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storj"
 	"storj.io/storj/storage/teststore"
@@ -107,10 +114,10 @@ func TestAuditSegment(t *testing.T) {
 
 	//TODO: use planet PointerDB directly
 	cache := planet.Satellites[0].Overlay
-	pointers := pointerdb.NewServer(db, cache, zap.NewNop(), c, planet.Satellites[0].Identity)
+	pointers := pointerdb.NewServer(db, teststore.New(), cache, zap.NewNop(), c, planet.Satellites[0].Identity)
 
 	// create a pdb client and instance of audit
-	cursor := NewCursor(pointers)
+	cursor := NewCursor(pointers, cache)
 
 	// put 10 paths in db
 	t.Run("putToDB", func(t *testing.T) {
@@ -221,6 +228,54 @@ func TestAuditSegment(t *testing.T) {
 	})
 }
 
+func TestCursorUnvetted(t *testing.T) {
+	tctx := testcontext.New(t)
+	defer tctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 0, 0)
+	require.NoError(t, err)
+	defer tctx.Check(planet.Shutdown)
+
+	planet.Start(tctx)
+
+	satellite := planet.Satellites[0]
+	config := overlay.NodeSelectionConfig{NewNodeAuditThreshold: 1}
+	cache := overlay.NewCache(satellite.Database.OverlayCache(), satellite.Database.StatDB(), config, statdb.ReputationParams{})
+
+	unvetted := teststorj.NodeIDFromString("unvetted")
+	require.NoError(t, cache.Put(tctx, unvetted, pb.Node{
+		Id:      unvetted,
+		Type:    pb.NodeType_STORAGE,
+		Address: &pb.NodeAddress{Address: "127.0.0.1:7777"},
+	}))
+
+	// the pointers are read with the identity of the satellite
+	info := credentials.TLSInfo{State: tls.ConnectionState{
+		PeerCertificates: []*x509.Certificate{satellite.Identity.Leaf, satellite.Identity.CA},
+	}}
+	ctx := peer.NewContext(auth.WithAPIKey(tctx, nil), &peer.Peer{AuthInfo: info})
+	pointers := pointerdb.NewServer(teststore.New(), teststore.New(), cache, zap.NewNop(), pointerdb.Config{MaxInlineSegmentSize: 8000}, satellite.Identity)
+
+	// only one segment has a piece on the unvetted node, the nodes of the
+	// other segments aren't in the cache
+	for i := 0; i < 10; i++ {
+		req := makePutRequest(fmt.Sprintf("folder/file%d", i))
+		if i == 7 {
+			req.Pointer.Remote.RemotePieces[0].NodeId = unvetted
+		}
+		_, err := pointers.Put(ctx, &req)
+		require.NoError(t, err)
+	}
+
+	cursor := NewCursor(pointers, cache)
+	for i := 0; i < 10; i++ {
+		stripe, err := cursor.NextStripe(ctx)
+		require.NoError(t, err)
+		require.NotNil(t, stripe)
+		assert.Equal(t, unvetted, stripe.Segment.GetRemote().GetRemotePieces()[0].NodeId)
+	}
+}
+
 func makePutRequest(path storj.Path) pb.PutRequest {
 	var rps []*pb.RemotePiece
 	rps = append(rps, &pb.RemotePiece{
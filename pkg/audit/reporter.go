@@ -19,6 +19,7 @@ type reporter interface {
 // Reporter records audit reports in statdb and implements the reporter interface
 type Reporter struct {
 	statdb     statdb.DB
+	reputation statdb.ReputationConfig
 	maxRetries int
 }
 
@@ -37,7 +38,7 @@ func NewReporter(ctx context.Context, statDBPort string, maxRetries int, apiKey
 	if !ok {
 		return nil, errs.New("unable to get master db instance")
 	}
-	return &Reporter{statdb: sdb.StatDB(), maxRetries: maxRetries}, nil
+	return &Reporter{statdb: sdb.StatDB(), reputation: statdb.LoadReputationFromContext(ctx), maxRetries: maxRetries}, nil
 }
 
 // RecordAudits saves failed audit details to statdb
@@ -96,6 +97,9 @@ func (reporter *Reporter) recordAuditFailStatus(ctx context.Context, failedAudit
 			NodeID:       nodeID,
 			IsUp:         true,
 			AuditSuccess: false,
+
+			AuditReputation:  reporter.reputation.Audit,
+			UptimeReputation: reporter.reputation.Uptime,
 		})
 		if err != nil {
 			failedIDs = append(failedIDs, nodeID)
@@ -113,7 +117,7 @@ func (reporter *Reporter) recordOfflineStatus(ctx context.Context, offlineNodeID
 	failedIDs := storj.NodeIDList{}
 
 	for _, nodeID := range offlineNodeIDs {
-		_, err := reporter.statdb.UpdateUptime(ctx, nodeID, false)
+		_, err := reporter.statdb.UpdateUptime(ctx, nodeID, false, reporter.reputation.Uptime)
 		if err != nil {
 			failedIDs = append(failedIDs, nodeID)
 		}
@@ -133,6 +137,9 @@ func (reporter *Reporter) recordAuditSuccessStatus(ctx context.Context, successN
 			NodeID:       nodeID,
 			IsUp:         true,
 			AuditSuccess: true,
+
+			AuditReputation:  reporter.reputation.Audit,
+			UptimeReputation: reporter.reputation.Uptime,
 		})
 		if err != nil {
 			failedIDs = append(failedIDs, nodeID)
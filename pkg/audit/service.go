@@ -40,14 +40,17 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 		return Error.New("programmer error: pointerdb responsibility unstarted")
 	}
 
-	overlay, err := overlay.NewClient(identity, c.SatelliteAddr)
+	// the cache is optional, it prioritizes the unvetted nodes
+	cache := overlay.LoadFromContext(ctx)
+
+	overlayClient, err := overlay.NewClient(identity, c.SatelliteAddr)
 	if err != nil {
 		return err
 	}
 	transport := transport.NewClient(identity)
 
 	log := zap.L()
-	service, err := NewService(ctx, log, c.SatelliteAddr, c.Interval, c.MaxRetriesStatDB, pointers, transport, overlay, *identity, c.APIKey)
+	service, err := NewService(ctx, log, c.SatelliteAddr, c.Interval, c.MaxRetriesStatDB, pointers, cache, transport, overlayClient, *identity, c.APIKey)
 	if err != nil {
 		return err
 	}
@@ -59,9 +62,9 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 }
 
 // NewService instantiates a Service with access to a Cursor and Verifier
-func NewService(ctx context.Context, log *zap.Logger, statDBPort string, interval time.Duration, maxRetries int, pointers *pointerdb.Server, transport transport.Client, overlay overlay.Client,
+func NewService(ctx context.Context, log *zap.Logger, statDBPort string, interval time.Duration, maxRetries int, pointers *pointerdb.Server, cache *overlay.Cache, transport transport.Client, overlay overlay.Client,
 	identity provider.FullIdentity, apiKey string) (service *Service, err error) {
-	cursor := NewCursor(pointers)
+	cursor := NewCursor(pointers, cache)
 	verifier := NewVerifier(transport, overlay, identity)
 	reporter, err := NewReporter(ctx, statDBPort, maxRetries, apiKey)
 	if err != nil {
@@ -6,11 +6,15 @@ package audit
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"io"
+	"io/ioutil"
 
 	"github.com/vivint/infectious"
+	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/piecestore/psclient"
@@ -22,6 +26,9 @@ import (
 
 var mon = monkit.Package()
 
+// errPieceHash is the error of pieces whose data doesn't match their hash
+var errPieceHash = errs.Class("piece hash mismatch")
+
 type share struct {
 	Error       error
 	PieceNumber int
@@ -56,9 +63,10 @@ func NewVerifier(transport transport.Client, overlay overlay.Client, id provider
 	return &Verifier{downloader: newDefaultDownloader(transport, overlay, id)}
 }
 
-// getShare use piece store clients to download shares from a given node
+// getShare use piece store clients to download shares from a given node,
+// the whole piece is downloaded and verified when its hash is known
 func (d *defaultDownloader) getShare(ctx context.Context, stripeIndex, shareSize, pieceNumber int,
-	id psclient.PieceID, pieceSize int64, fromNode *pb.Node, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (s share, err error) {
+	id psclient.PieceID, pieceSize int64, pieceHash []byte, fromNode *pb.Node, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (s share, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if fromNode == nil {
@@ -81,18 +89,32 @@ func (d *defaultDownloader) getShare(ctx context.Context, stripeIndex, shareSize
 		return s, err
 	}
 
-	offset := shareSize * stripeIndex
+	offset := int64(shareSize * stripeIndex)
 
-	rc, err := rr.Range(ctx, int64(offset), int64(shareSize))
-	if err != nil {
-		return s, err
-	}
-	defer utils.LogClose(rc)
+	var buf []byte
+	if pieceHash == nil {
+		rc, err := rr.Range(ctx, offset, int64(shareSize))
+		if err != nil {
+			return s, err
+		}
+		defer utils.LogClose(rc)
 
-	buf := make([]byte, shareSize)
-	_, err = io.ReadFull(rc, buf)
-	if err != nil {
-		return s, err
+		buf = make([]byte, shareSize)
+		_, err = io.ReadFull(rc, buf)
+		if err != nil {
+			return s, err
+		}
+	} else {
+		rc, err := rr.Range(ctx, 0, pieceSize)
+		if err != nil {
+			return s, err
+		}
+		defer utils.LogClose(rc)
+
+		buf, err = readVerifiedShare(rc, offset, int64(shareSize), pieceSize, pieceHash)
+		if err != nil {
+			return s, err
+		}
 	}
 
 	s = share{
@@ -103,6 +125,38 @@ func (d *defaultDownloader) getShare(ctx context.Context, stripeIndex, shareSize
 	return s, nil
 }
 
+// readVerifiedShare reads a whole piece and returns its share at offset, if
+// the piece matches its hash
+func readVerifiedShare(piece io.Reader, offset, shareSize, pieceSize int64, pieceHash []byte) (buf []byte, err error) {
+	hasher := sha256.New()
+	piece = io.TeeReader(piece, hasher)
+
+	_, err = io.CopyN(ioutil.Discard, piece, offset)
+	if err == nil {
+		buf = make([]byte, shareSize)
+		_, err = io.ReadFull(piece, buf)
+	}
+	if err == io.EOF || err == io.ErrUnexpectedEOF {
+		return nil, errPieceHash.New("piece truncated")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	rest, err := io.Copy(ioutil.Discard, piece)
+	if err != nil {
+		return nil, err
+	}
+	if offset+shareSize+rest != pieceSize {
+		return nil, errPieceHash.New("piece size %d, expected %d", offset+shareSize+rest, pieceSize)
+	}
+
+	if !bytes.Equal(hasher.Sum(nil), pieceHash) {
+		return nil, errPieceHash.New("piece doesn't match its hash")
+	}
+	return buf, nil
+}
+
 // Download Shares downloads shares from the nodes where remote pieces are located
 func (d *defaultDownloader) DownloadShares(ctx context.Context, pointer *pb.Pointer,
 	stripeIndex int, pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (shares map[int]share, nodes map[int]*pb.Node, err error) {
@@ -124,15 +178,20 @@ func (d *defaultDownloader) DownloadShares(ctx context.Context, pointer *pb.Poin
 	shares = make(map[int]share, len(nodeSlice))
 	nodes = make(map[int]*pb.Node, len(nodeSlice))
 
-	shareSize := int(pointer.Remote.Redundancy.GetErasureShareSize())
+	redundancy := pointer.Remote.GetRedundancy()
+	shareSize := int(redundancy.GetErasureShareSize())
 	pieceID := psclient.PieceID(pointer.Remote.GetPieceId())
 
+	fc, err := infectious.NewFEC(int(redundancy.GetMinReq()), int(redundancy.GetTotal()))
+	if err != nil {
+		return nil, nodes, err
+	}
+	// the hashes are of the whole pieces, which include the padding
+	pieceSize := eestream.CalcPieceSize(pointer.GetSegmentSize(), eestream.NewRSScheme(fc, shareSize))
+
 	// this downloads shares from nodes at the given stripe index
 	for i, node := range nodeSlice {
-		paddedSize := calcPadded(pointer.GetSegmentSize(), shareSize)
-		pieceSize := paddedSize / int64(pointer.Remote.Redundancy.GetMinReq())
-
-		s, err := d.getShare(ctx, stripeIndex, shareSize, int(pieces[i].PieceNum), pieceID, pieceSize, node, pba, authorization)
+		s, err := d.getShare(ctx, stripeIndex, shareSize, int(pieces[i].PieceNum), pieceID, pieceSize, pieces[i].GetHash(), node, pba, authorization)
 		if err != nil {
 			s = share{
 				Error:       err,
@@ -188,14 +247,6 @@ func auditShares(ctx context.Context, required, total int, originals map[int]sha
 	return pieceNums, nil
 }
 
-func calcPadded(size int64, blockSize int) int64 {
-	mod := size % int64(blockSize)
-	if mod == 0 {
-		return size
-	}
-	return size + int64(blockSize) - mod
-}
-
 // verify downloads shares then verifies the data correctness at the given stripe
 func (verifier *Verifier) verify(ctx context.Context, stripe *Stripe) (verifiedNodes *RecordAuditsInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -205,9 +256,15 @@ func (verifier *Verifier) verify(ctx context.Context, stripe *Stripe) (verifiedN
 		return nil, err
 	}
 
-	var offlineNodes storj.NodeIDList
+	// the nodes sending pieces which don't match their hashes fail, the
+	// others which didn't send their shares are offline
+	var failedNodes, offlineNodes storj.NodeIDList
 	for pieceNum := range shares {
-		if shares[pieceNum].Error != nil {
+		switch err := shares[pieceNum].Error; {
+		case err == nil:
+		case errPieceHash.Has(err):
+			failedNodes = append(failedNodes, nodes[pieceNum].Id)
+		default:
 			offlineNodes = append(offlineNodes, nodes[pieceNum].Id)
 		}
 	}
@@ -220,7 +277,6 @@ func (verifier *Verifier) verify(ctx context.Context, stripe *Stripe) (verifiedN
 		return nil, err
 	}
 
-	var failedNodes storj.NodeIDList
 	for _, pieceNum := range pieceNums {
 		failedNodes = append(failedNodes, nodes[pieceNum].Id)
 	}
@@ -4,8 +4,10 @@
 package audit
 
 import (
+	"bytes"
 	"context"
 	"crypto/rand"
+	"crypto/sha256"
 	"strconv"
 	"testing"
 
@@ -14,6 +16,7 @@ import (
 
 	"storj.io/storj/internal/teststorj"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 )
 
 type mockDownloader struct {
@@ -186,18 +189,55 @@ func TestNotEnoughShares(t *testing.T) {
 	assert.Contains(t, err.Error(), "infectious: must specify at least the number of required shares")
 }
 
-func TestCalcPadded(t *testing.T) {
-	for _, tt := range []struct {
-		segSize    int64
-		blockSize  int
-		paddedSize int64
-	}{
-		{segSize: int64(5 * 1024), blockSize: 1024, paddedSize: int64(5120)},
-		{segSize: int64(5 * 1023), blockSize: 1024, paddedSize: int64(5120)},
-	} {
-		result := calcPadded(tt.segSize, tt.blockSize)
-		assert.Equal(t, result, tt.paddedSize)
+func TestHashMismatchFailsAudit(t *testing.T) {
+	ctx := context.Background()
+	mockShares := make(map[int]share)
+
+	someData := randData(32 * 1024)
+	for i := 0; i < 30; i++ {
+		mockShares[i] = share{
+			PieceNumber: i,
+			Data:        someData,
+		}
+	}
+	mockShares[0] = share{Error: errPieceHash.New("piece doesn't match its hash"), PieceNumber: 0}
+	mockShares[1] = share{Error: Error.New("unable to get node"), PieceNumber: 1}
+
+	md := mockDownloader{shares: mockShares}
+	verifier := &Verifier{downloader: &md}
+	pointer := makePointer(30)
+	verifiedNodes, err := verifier.verify(ctx, &Stripe{Index: 6, Segment: pointer, PBA: nil, Authorization: nil})
+	if !assert.NoError(t, err) {
+		return
 	}
+
+	assert.Equal(t, storj.NodeIDList{teststorj.NodeIDFromString("0")}, verifiedNodes.FailNodeIDs)
+	assert.Equal(t, storj.NodeIDList{teststorj.NodeIDFromString("1")}, verifiedNodes.OfflineNodeIDs)
+	assert.Len(t, verifiedNodes.SuccessNodeIDs, 28)
+}
+
+func TestReadVerifiedShare(t *testing.T) {
+	piece := randData(64)
+	hash := sha256.Sum256(piece)
+
+	buf, err := readVerifiedShare(bytes.NewReader(piece), 16, 8, 64, hash[:])
+	if assert.NoError(t, err) {
+		assert.Equal(t, piece[16:24], buf)
+	}
+
+	// a corrupted piece
+	corrupted := append([]byte{}, piece...)
+	corrupted[60]++
+	_, err = readVerifiedShare(bytes.NewReader(corrupted), 16, 8, 64, hash[:])
+	assert.True(t, errPieceHash.Has(err), err)
+
+	// a piece truncated after the share
+	_, err = readVerifiedShare(bytes.NewReader(piece[:32]), 16, 8, 64, hash[:])
+	assert.True(t, errPieceHash.Has(err), err)
+
+	// a piece truncated before the share
+	_, err = readVerifiedShare(bytes.NewReader(piece[:20]), 16, 8, 64, hash[:])
+	assert.True(t, errPieceHash.Has(err), err)
 }
 
 func (m *mockDownloader) DownloadShares(ctx context.Context, pointer *pb.Pointer, stripeIndex int,
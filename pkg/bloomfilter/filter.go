@@ -0,0 +1,132 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"math"
+
+	"github.com/zeebo/errs"
+)
+
+// Error is the default error class for the bloomfilter package
+var Error = errs.Class("bloomfilter error")
+
+const (
+	version = 1
+	// headerSize is the size of the version, seed and hash count in front of
+	// the table of encoded filters
+	headerSize   = 3
+	maxHashCount = 32
+)
+
+// Filter is a bloom filter of byte strings. A filter never reports a missing
+// element for an added one, but reports elements which weren't added with the
+// false positive rate it was created for.
+type Filter struct {
+	seed      byte
+	hashCount byte
+	table     []byte
+}
+
+// NewOptimal returns a filter sized for the expected number of elements and
+// the false positive rate. Every filter gets a random seed, so the false
+// positives of two filters of the same elements differ.
+func NewOptimal(expectedElements int, falsePositiveRate float64) *Filter {
+	if expectedElements < 1 {
+		expectedElements = 1
+	}
+	if falsePositiveRate <= 0 || falsePositiveRate >= 1 {
+		falsePositiveRate = 0.01
+	}
+
+	// m = -n*ln(p) / ln(2)^2 and k = m/n * ln(2)
+	bits := -float64(expectedElements) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)
+	hashCount := int(math.Ceil(bits / float64(expectedElements) * math.Ln2))
+	if hashCount < 1 {
+		hashCount = 1
+	}
+	if hashCount > maxHashCount {
+		hashCount = maxHashCount
+	}
+
+	var seed [1]byte
+	_, _ = rand.Read(seed[:])
+
+	return &Filter{
+		seed:      seed[0],
+		hashCount: byte(hashCount),
+		table:     make([]byte, int(math.Ceil(bits/8))),
+	}
+}
+
+// NewFromBytes decodes a filter encoded with Bytes
+func NewFromBytes(data []byte) (*Filter, error) {
+	if len(data) < headerSize+1 {
+		return nil, Error.New("not enough data")
+	}
+	if data[0] != version {
+		return nil, Error.New("unsupported version %d", data[0])
+	}
+	if data[2] < 1 || data[2] > maxHashCount {
+		return nil, Error.New("invalid hash count %d", data[2])
+	}
+
+	return &Filter{
+		seed:      data[1],
+		hashCount: data[2],
+		table:     append([]byte(nil), data[headerSize:]...),
+	}, nil
+}
+
+// Add adds the element to the filter
+func (filter *Filter) Add(element []byte) {
+	h1, h2 := filter.hash(element)
+	bits := uint64(len(filter.table)) * 8
+	for i := uint64(0); i < uint64(filter.hashCount); i++ {
+		bit := (h1 + i*h2) % bits
+		filter.table[bit/8] |= 1 << (bit % 8)
+	}
+}
+
+// Contains returns whether the element may have been added to the filter
+func (filter *Filter) Contains(element []byte) bool {
+	h1, h2 := filter.hash(element)
+	bits := uint64(len(filter.table)) * 8
+	for i := uint64(0); i < uint64(filter.hashCount); i++ {
+		bit := (h1 + i*h2) % bits
+		if filter.table[bit/8]&(1<<(bit%8)) == 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Size returns the size of the filter encoded with Bytes
+func (filter *Filter) Size() int {
+	return headerSize + len(filter.table)
+}
+
+// Bytes encodes the filter, NewFromBytes decodes it
+func (filter *Filter) Bytes() []byte {
+	data := make([]byte, 0, filter.Size())
+	data = append(data, version, filter.seed, filter.hashCount)
+	return append(data, filter.table...)
+}
+
+// hash returns the two hashes of the element, which are combined to the
+// positions of the element in the table
+func (filter *Filter) hash(element []byte) (h1, h2 uint64) {
+	hasher := sha256.New()
+	_, _ = hasher.Write([]byte{filter.seed})
+	_, _ = hasher.Write(element)
+	sum := hasher.Sum(nil)
+
+	h1 = binary.LittleEndian.Uint64(sum[0:8])
+	// an odd second hash visits distinct positions for every hash
+	h2 = binary.LittleEndian.Uint64(sum[8:16]) | 1
+	return h1, h2
+}
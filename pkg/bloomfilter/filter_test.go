@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package bloomfilter_test
+
+import (
+	"crypto/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/bloomfilter"
+)
+
+func randomElements(t *testing.T, count int) [][]byte {
+	elements := make([][]byte, count)
+	for i := range elements {
+		elements[i] = make([]byte, 32)
+		_, err := rand.Read(elements[i])
+		require.NoError(t, err)
+	}
+	return elements
+}
+
+func TestFilter(t *testing.T) {
+	const count = 10000
+
+	added := randomElements(t, count)
+	missing := randomElements(t, count)
+
+	for _, rate := range []float64{0.5, 0.1, 0.01} {
+		filter := bloomfilter.NewOptimal(count, rate)
+		for _, element := range added {
+			filter.Add(element)
+		}
+
+		for _, element := range added {
+			assert.True(t, filter.Contains(element))
+		}
+
+		falsePositives := 0
+		for _, element := range missing {
+			if filter.Contains(element) {
+				falsePositives++
+			}
+		}
+		assert.InDelta(t, rate, float64(falsePositives)/count, rate/2, "rate %v", rate)
+	}
+}
+
+func TestFilterBytes(t *testing.T) {
+	elements := randomElements(t, 100)
+
+	filter := bloomfilter.NewOptimal(len(elements), 0.1)
+	for _, element := range elements {
+		filter.Add(element)
+	}
+
+	data := filter.Bytes()
+	assert.Equal(t, filter.Size(), len(data))
+
+	decoded, err := bloomfilter.NewFromBytes(data)
+	require.NoError(t, err)
+	for _, element := range elements {
+		assert.True(t, decoded.Contains(element))
+	}
+	assert.Equal(t, data, decoded.Bytes())
+
+	_, err = bloomfilter.NewFromBytes(data[:3])
+	assert.Error(t, err)
+
+	invalid := append([]byte{0}, data[1:]...)
+	_, err = bloomfilter.NewFromBytes(invalid)
+	assert.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/x509"
+	"io"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -22,6 +23,10 @@ import (
 type DB interface {
 	// CreateAgreement adds a new bandwidth agreement.
 	CreateAgreement(context.Context, string, Agreement) error
+	// CreateAgreements adds the bandwidth agreements by serial number in one
+	// transaction, the serial numbers which exist already are returned as
+	// duplicates and their agreements aren't added.
+	CreateAgreements(context.Context, map[string]Agreement) (duplicates []string, err error)
 	// GetAgreements gets all bandwidth agreements.
 	GetAgreements(context.Context) ([]Agreement, error)
 	// GetAgreementsSince gets all bandwidth agreements since specific time.
@@ -62,61 +67,143 @@ func (s *Server) BandwidthAgreements(ctx context.Context, ba *pb.RenterBandwidth
 		Status: pb.AgreementsSummary_REJECTED,
 	}
 
+	serialNum, agreement, err := s.verifyAgreement(ctx, ba)
+	if err != nil {
+		return reply, err
+	}
+
+	err = s.db.CreateAgreement(ctx, serialNum, agreement)
+	if err != nil {
+		//todo:  better classify transport errors (AgreementsSummary_FAIL) vs logical (AgreementsSummary_REJECTED)
+		return reply, BwAgreementError.New("SerialNumber already exists in the PayerBandwidthAllocation")
+	}
+
+	reply.Status = pb.AgreementsSummary_OK
+	s.logger.Debug("Stored Agreement...")
+	return reply, nil
+}
+
+// Settlement receives batches of bandwidth agreements from storage nodes and
+// replies with the status of every agreement of a batch. The agreements of a
+// batch are stored in one transaction.
+func (s *Server) Settlement(stream pb.Bandwidth_SettlementServer) (err error) {
+	ctx := stream.Context()
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		req, err := stream.Recv()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return BwAgreementError.Wrap(err)
+		}
+
+		statuses := s.settle(ctx, req.GetAllocations())
+		if err := stream.Send(&pb.SettlementResponse{Statuses: statuses}); err != nil {
+			return BwAgreementError.Wrap(err)
+		}
+	}
+}
+
+// settle stores the valid agreements of the batch and returns the status of
+// every agreement. When the batch can't be stored the agreements fail, so
+// the storage node sends them again.
+func (s *Server) settle(ctx context.Context, allocations []*pb.RenterBandwidthAllocation) []pb.AgreementsSummary_Status {
+	statuses := make([]pb.AgreementsSummary_Status, len(allocations))
+
+	agreements := make(map[string]Agreement, len(allocations))
+	indexes := make(map[string]int, len(allocations))
+	for i, ba := range allocations {
+		statuses[i] = pb.AgreementsSummary_REJECTED
+
+		serialNum, agreement, err := s.verifyAgreement(ctx, ba)
+		if err != nil {
+			s.logger.Debug("Rejected Agreement", zap.Error(err))
+			continue
+		}
+		if _, ok := agreements[serialNum]; ok {
+			s.logger.Debug("Rejected Agreement", zap.String("duplicate serial number", serialNum))
+			continue
+		}
+
+		agreements[serialNum] = agreement
+		indexes[serialNum] = i
+	}
+
+	if len(agreements) == 0 {
+		return statuses
+	}
+
+	duplicates, err := s.db.CreateAgreements(ctx, agreements)
+	if err != nil {
+		s.logger.Error("Failed to store Agreements", zap.Int("count", len(agreements)), zap.Error(err))
+		for _, i := range indexes {
+			statuses[i] = pb.AgreementsSummary_FAIL
+		}
+		return statuses
+	}
+
+	for _, i := range indexes {
+		statuses[i] = pb.AgreementsSummary_OK
+	}
+	for _, serialNum := range duplicates {
+		statuses[indexes[serialNum]] = pb.AgreementsSummary_REJECTED
+	}
+
+	s.logger.Debug("Stored Agreements", zap.Int("count", len(agreements)-len(duplicates)))
+	return statuses
+}
+
+// verifyAgreement checks the agreement and returns it with its serial number
+func (s *Server) verifyAgreement(ctx context.Context, ba *pb.RenterBandwidthAllocation) (serialNum string, agreement Agreement, err error) {
 	// storagenode signature is empty
 	if len(ba.GetSignature()) == 0 {
-		return reply, BwAgreementError.New("Invalid Storage Node Signature length in the RenterBandwidthAllocation")
+		return "", agreement, BwAgreementError.New("Invalid Storage Node Signature length in the RenterBandwidthAllocation")
 	}
 
 	rbad := &pb.RenterBandwidthAllocation_Data{}
 	if err = proto.Unmarshal(ba.GetData(), rbad); err != nil {
-		return reply, BwAgreementError.New("Failed to unmarshal RenterBandwidthAllocation: %+v", err)
+		return "", agreement, BwAgreementError.New("Failed to unmarshal RenterBandwidthAllocation: %+v", err)
 	}
 
 	pba := rbad.GetPayerAllocation()
 	pbad := &pb.PayerBandwidthAllocation_Data{}
 	if err := proto.Unmarshal(pba.GetData(), pbad); err != nil {
-		return reply, BwAgreementError.New("Failed to unmarshal PayerBandwidthAllocation: %+v", err)
+		return "", agreement, BwAgreementError.New("Failed to unmarshal PayerBandwidthAllocation: %+v", err)
 	}
 
 	// satellite signature is empty
 	if len(pba.GetSignature()) == 0 {
-		return reply, BwAgreementError.New("Invalid Satellite Signature length in the PayerBandwidthAllocation")
+		return "", agreement, BwAgreementError.New("Invalid Satellite Signature length in the PayerBandwidthAllocation")
 	}
 
 	if len(pbad.SerialNumber) == 0 {
-		return reply, BwAgreementError.New("Invalid SerialNumber in the PayerBandwidthAllocation")
+		return "", agreement, BwAgreementError.New("Invalid SerialNumber in the PayerBandwidthAllocation")
 	}
 
 	if err = s.verifySignature(ctx, ba); err != nil {
-		return reply, err
+		return "", agreement, err
 	}
 
-	serialNum := pbad.GetSerialNumber() + rbad.StorageNodeId.String()
+	serialNum = pbad.GetSerialNumber() + rbad.StorageNodeId.String()
 
 	// get and check expiration
 	exp := time.Unix(pbad.GetExpirationUnixSec(), 0).UTC()
 	if exp.Before(time.Now().UTC()) {
-		return reply, BwAgreementError.New("Bandwidth agreement is expired (%v)", exp)
+		return "", agreement, BwAgreementError.New("Bandwidth agreement is expired (%v)", exp)
 	}
 
-	err = s.db.CreateAgreement(ctx, serialNum, Agreement{
+	return serialNum, Agreement{
 		Signature: ba.GetSignature(),
 		Agreement: ba.GetData(),
 		ExpiresAt: exp,
-	})
-
-	if err != nil {
-		//todo:  better classify transport errors (AgreementsSummary_FAIL) vs logical (AgreementsSummary_REJECTED)
-		return reply, BwAgreementError.New("SerialNumber already exists in the PayerBandwidthAllocation")
-	}
-
-	reply.Status = pb.AgreementsSummary_OK
-	s.logger.Debug("Stored Agreement...")
-	return reply, nil
+	}, nil
 }
 
 func (s *Server) verifySignature(ctx context.Context, ba *pb.RenterBandwidthAllocation) error {
-	// TODO(security): detect replay attacks
+	// replays are rejected by CreateAgreement, the serial number is unique
+	// for every storage node
 
 	//Deserealize RenterBandwidthAllocation.GetData() so we can get public key
 	rbad := &pb.RenterBandwidthAllocation_Data{}
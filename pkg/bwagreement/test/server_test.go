@@ -6,6 +6,7 @@ package test
 import (
 	"context"
 	"crypto/ecdsa"
+	"io"
 	"testing"
 	"time"
 
@@ -301,6 +302,76 @@ func testDatabase(ctx context.Context, t *testing.T, bwdb bwagreement.DB) {
 			assert.Equal(t, pb.AgreementsSummary_REJECTED, reply.Status)
 		}
 	}
+
+	{ // TestSettlement
+		node := teststorj.NodeIDFromString("Storage node 3")
+
+		newAgreement := func() *pb.RenterBandwidthAllocation {
+			pba, err := GeneratePayerBandwidthAllocation(pb.PayerBandwidthAllocation_GET, satellitePrivKey, uplinkPrivKey, time.Hour)
+			assert.NoError(t, err)
+
+			rba, err := GenerateRenterBandwidthAllocation(pba, node, uplinkPrivKey)
+			assert.NoError(t, err)
+			return rba
+		}
+
+		settled, duplicate := newAgreement(), newAgreement()
+		reply, err := satellite.BandwidthAgreements(ctx, settled)
+		assert.NoError(t, err)
+		assert.Equal(t, pb.AgreementsSummary_OK, reply.Status)
+
+		valid1, valid2 := newAgreement(), newAgreement()
+		stream := &settlementStream{
+			ctx: ctx,
+			requests: []*pb.SettlementRequest{
+				{Allocations: []*pb.RenterBandwidthAllocation{valid1, settled, {Data: valid2.Data}, duplicate, duplicate}},
+				{Allocations: []*pb.RenterBandwidthAllocation{valid2, valid1}},
+			},
+		}
+
+		err = satellite.Settlement(stream)
+		assert.NoError(t, err)
+
+		assert.Equal(t, []*pb.SettlementResponse{
+			{Statuses: []pb.AgreementsSummary_Status{
+				pb.AgreementsSummary_OK,       // valid1
+				pb.AgreementsSummary_REJECTED, // settled before
+				pb.AgreementsSummary_REJECTED, // without signature
+				pb.AgreementsSummary_OK,       // duplicate
+				pb.AgreementsSummary_REJECTED, // duplicate in the batch
+			}},
+			{Statuses: []pb.AgreementsSummary_Status{
+				pb.AgreementsSummary_OK,       // valid2
+				pb.AgreementsSummary_REJECTED, // valid1 settled in the first batch
+			}},
+		}, stream.responses)
+	}
+}
+
+// settlementStream is a settlement stream of a storage node sending the
+// requests
+type settlementStream struct {
+	pb.Bandwidth_SettlementServer
+
+	ctx       context.Context
+	requests  []*pb.SettlementRequest
+	responses []*pb.SettlementResponse
+}
+
+func (stream *settlementStream) Context() context.Context { return stream.ctx }
+
+func (stream *settlementStream) Recv() (*pb.SettlementRequest, error) {
+	if len(stream.requests) == 0 {
+		return nil, io.EOF
+	}
+	req := stream.requests[0]
+	stream.requests = stream.requests[1:]
+	return req, nil
+}
+
+func (stream *settlementStream) Send(resp *pb.SettlementResponse) error {
+	stream.responses = append(stream.responses, resp)
+	return nil
 }
 
 func generateKeys(ctx context.Context, t *testing.T) (satellitePubKey *ecdsa.PublicKey, satellitePrivKey *ecdsa.PrivateKey, uplinkPrivKey *ecdsa.PrivateKey) {
@@ -72,6 +72,8 @@ func (c *checker) Run(ctx context.Context) (err error) {
 func (c *checker) identifyInjuredSegments(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	now := time.Now()
+
 	err = c.pointerdb.Iterate(ctx, &pb.IterateRequest{Recurse: true},
 		func(it storage.Iterator) error {
 			var item storage.ListItem
@@ -87,6 +89,11 @@ func (c *checker) identifyInjuredSegments(ctx context.Context) (err error) {
 					return Error.New("error unmarshalling pointer %s", err)
 				}
 
+				// expired segments aren't repaired, the reaper deletes them
+				if pointerdb.Expired(pointer, now) {
+					continue
+				}
+
 				remote := pointer.GetRemote()
 				if remote == nil {
 					continue
@@ -163,17 +170,12 @@ func (c *checker) offlineNodes(ctx context.Context, nodeIDs storj.NodeIDList) (o
 	return offline, nil
 }
 
-// Find invalidNodes by checking the audit results that are place in statdb
+// Find invalidNodes by checking which nodes were disqualified in statdb
 func (c *checker) invalidNodes(ctx context.Context, nodeIDs storj.NodeIDList) (invalidNodes []int32, err error) {
-	// filter if nodeIDs have invalid pieces from auditing results
-	maxStats := &statdb.NodeStats{
-		AuditSuccessRatio: 0, // TODO: update when we have stats added to statdb
-		UptimeRatio:       0, // TODO: update when we have stats added to statdb
-	}
-
-	invalidIDs, err := c.statdb.FindInvalidNodes(ctx, nodeIDs, maxStats)
+	// pieces on disqualified nodes are lost, even when the nodes are online
+	invalidIDs, err := c.statdb.FindNodesInState(ctx, nodeIDs, statdb.Disqualified)
 	if err != nil {
-		return nil, Error.New("error getting valid nodes from statdb %s", err)
+		return nil, Error.New("error getting disqualified nodes from statdb %s", err)
 	}
 
 	invalidNodesMap := make(map[storj.NodeID]bool)
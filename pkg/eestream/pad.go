@@ -30,6 +30,14 @@ func makePadding(dataLen int64, blockSize int) []byte {
 	return paddingBytes
 }
 
+// CalcPieceSize returns the size of the pieces of dataSize bytes padded and
+// erasure coded with the scheme
+func CalcPieceSize(dataSize int64, scheme ErasureScheme) int64 {
+	stripeSize := int64(scheme.StripeSize())
+	stripes := (dataSize + uint32Size + stripeSize - 1) / stripeSize
+	return stripes * int64(scheme.ErasureShareSize())
+}
+
 // Pad takes a Ranger and returns another Ranger that is a multiple of
 // blockSize in length. The return value padding is a convenience to report how
 // much padding was added.
@@ -10,6 +10,8 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/vivint/infectious"
+
 	"storj.io/storj/pkg/ranger"
 )
 
@@ -76,3 +78,34 @@ func TestPad(t *testing.T) {
 		}
 	}
 }
+
+func TestCalcPieceSize(t *testing.T) {
+	fc, err := infectious.NewFEC(2, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scheme := NewRSScheme(fc, 8)
+
+	for _, example := range []struct {
+		dataSize  int64
+		pieceSize int64
+	}{
+		{0, 8},
+		{12, 8},
+		{13, 16},
+		{16, 16},
+		{28, 16},
+		{29, 24},
+	} {
+		pieceSize := CalcPieceSize(example.dataSize, scheme)
+		if pieceSize != example.pieceSize {
+			t.Fatalf("data size %d: expected piece size %d, got %d", example.dataSize, example.pieceSize, pieceSize)
+		}
+
+		// the pieces of the padded data have the same size
+		padded, _ := Pad(ranger.ByteRanger(make([]byte, example.dataSize)), scheme.StripeSize())
+		if padded.Size()/int64(scheme.RequiredCount()) != pieceSize {
+			t.Fatalf("data size %d: padded to %d", example.dataSize, padded.Size())
+		}
+	}
+}
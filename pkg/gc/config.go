@@ -0,0 +1,57 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/transport"
+)
+
+// Config contains configurable values for garbage collection
+type Config struct {
+	Enabled           bool          `help:"whether the storage nodes are sent filters of the pieces to keep" default:"false"`
+	Interval          time.Duration `help:"how frequently the storage nodes are sent filters of the pieces to keep" default:"120h0m0s"`
+	InitialPieces     int           `help:"the expected number of pieces per storage node, the filters are sized for" default:"400000"`
+	FalsePositiveRate float64       `help:"the false positive rate of the filters, which is the part of the garbage pieces the nodes keep" default:"0.1"`
+}
+
+// Run runs the garbage collection service with the configured values.
+// Run assumes the overlay and pointerdb responsibilities have been started
+// before this one.
+func (c Config) Run(ctx context.Context, server *provider.Provider) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !c.Enabled {
+		return server.Run(ctx)
+	}
+
+	pdb := pointerdb.LoadFromContext(ctx)
+	if pdb == nil {
+		return Error.New("failed to load pointerdb from context")
+	}
+
+	cache := overlay.LoadFromContext(ctx)
+	if cache == nil {
+		return Error.New("failed to load overlay cache from context")
+	}
+
+	service := NewService(zap.L().Named("gc"), c, pdb, cache, transport.NewClient(server.Identity()))
+
+	ctx, cancel := context.WithCancel(ctx)
+	go func() {
+		if err := service.Run(ctx); err != nil {
+			defer cancel()
+			zap.L().Debug("Garbage collection is shutting down", zap.Error(err))
+		}
+	}()
+
+	return server.Run(ctx)
+}
@@ -0,0 +1,169 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storage"
+)
+
+// Service periodically sends every storage node a bloom filter of the pieces
+// the satellite references on the node. The nodes delete the pieces of the
+// satellite, which aren't in the filter, as the pointers of the pieces were
+// deleted while the node was offline.
+type Service struct {
+	log       *zap.Logger
+	config    Config
+	pointers  *pointerdb.Server
+	cache     *overlay.Cache
+	transport transport.Client
+	ticker    *time.Ticker
+}
+
+// NewService creates the garbage collection service
+func NewService(log *zap.Logger, config Config, pointers *pointerdb.Server, cache *overlay.Cache, transport transport.Client) *Service {
+	return &Service{
+		log:       log,
+		config:    config,
+		pointers:  pointers,
+		cache:     cache,
+		transport: transport,
+		ticker:    time.NewTicker(config.Interval),
+	}
+}
+
+// Run sends the filters every interval until the context is canceled
+func (service *Service) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		if err := service.Collect(ctx); err != nil {
+			service.log.Error("garbage collection failed", zap.Error(err))
+		}
+
+		select {
+		case <-service.ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the service is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// Collect builds the filters of all nodes and sends them to the nodes
+func (service *Service) Collect(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	// the filters are created before the pointers are read, so the nodes
+	// keep the pieces of segments uploaded during the iteration
+	createdAt := time.Now()
+
+	filters, err := service.buildFilters(ctx)
+	if err != nil {
+		return err
+	}
+
+	creationDate, err := ptypes.TimestampProto(createdAt)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	var sent int
+	for nodeID, filter := range filters {
+		if err := service.send(ctx, nodeID, filter, creationDate); err != nil {
+			// the node gets the next filter
+			service.log.Warn("failed to send garbage collection filter", zap.String("node", nodeID.String()), zap.Error(err))
+			continue
+		}
+		sent++
+	}
+
+	service.log.Info("sent garbage collection filters", zap.Int("nodes", len(filters)), zap.Int("sent", sent))
+	return nil
+}
+
+// buildFilters returns the filters of the ids of the pieces referenced by
+// the pointers for every node. The nodes without referenced pieces don't get
+// a filter.
+func (service *Service) buildFilters(ctx context.Context) (filters map[storj.NodeID]*bloomfilter.Filter, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	filters = make(map[storj.NodeID]*bloomfilter.Filter)
+	err = service.pointers.DB.Iterate(storage.IterateOptions{Recurse: true}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			pointer := &pb.Pointer{}
+			if err := proto.Unmarshal(item.Value, pointer); err != nil {
+				return Error.New("error unmarshalling pointer %s", err)
+			}
+
+			remote := pointer.GetRemote()
+			if remote == nil {
+				continue
+			}
+
+			pieceID := psclient.PieceID(remote.GetPieceId())
+			for _, piece := range remote.GetRemotePieces() {
+				// nodes store the pieces by the id derived for them
+				derivedID, err := pieceID.Derive(piece.NodeId.Bytes())
+				if err != nil {
+					return Error.Wrap(err)
+				}
+
+				filter, ok := filters[piece.NodeId]
+				if !ok {
+					filter = bloomfilter.NewOptimal(service.config.InitialPieces, service.config.FalsePositiveRate)
+					filters[piece.NodeId] = filter
+				}
+				filter.Add([]byte(derivedID.String()))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return filters, nil
+}
+
+// send sends the filter to the node
+func (service *Service) send(ctx context.Context, nodeID storj.NodeID, filter *bloomfilter.Filter, creationDate *timestamp.Timestamp) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := service.cache.Get(ctx, nodeID)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	conn, err := service.transport.DialNode(ctx, node)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	resp, err := pb.NewPieceStoreRoutesClient(conn).Retain(ctx, &pb.RetainRequest{
+		Filter:       filter.Bytes(),
+		CreationDate: creationDate,
+	})
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	service.log.Debug("node collected garbage", zap.String("node", nodeID.String()), zap.Int64("deleted", resp.GetDeleted()))
+	return nil
+}
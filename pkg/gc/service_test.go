@@ -0,0 +1,69 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gc
+
+import (
+	"context"
+	"testing"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestBuildFilters(t *testing.T) {
+	ctx := context.Background()
+
+	db := teststore.New()
+	pdb := pointerdb.NewServer(db, teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
+
+	node1, node2 := teststorj.NodeIDFromString("node1"), teststorj.NodeIDFromString("node2")
+
+	for path, pointer := range map[string]*pb.Pointer{
+		"inline": {Type: pb.Pointer_INLINE, InlineSegment: []byte("inline")},
+		"remote1": {Type: pb.Pointer_REMOTE, Remote: &pb.RemoteSegment{
+			PieceId: "piece1",
+			RemotePieces: []*pb.RemotePiece{
+				{PieceNum: 0, NodeId: node1},
+				{PieceNum: 1, NodeId: node2},
+			},
+		}},
+		"remote2": {Type: pb.Pointer_REMOTE, Remote: &pb.RemoteSegment{
+			PieceId:      "piece2",
+			RemotePieces: []*pb.RemotePiece{{PieceNum: 0, NodeId: node2}},
+		}},
+	} {
+		data, err := proto.Marshal(pointer)
+		require.NoError(t, err)
+		require.NoError(t, db.Put(storage.Key(path), data))
+	}
+
+	service := NewService(zap.NewNop(), Config{Interval: 1, InitialPieces: 10, FalsePositiveRate: 0.000001}, pdb, nil, nil)
+	filters, err := service.buildFilters(ctx)
+	require.NoError(t, err)
+	require.Len(t, filters, 2)
+
+	derive := func(pieceID psclient.PieceID, node []byte) []byte {
+		derived, err := pieceID.Derive(node)
+		require.NoError(t, err)
+		return []byte(derived.String())
+	}
+
+	assert.True(t, filters[node1].Contains(derive("piece1", node1.Bytes())))
+	assert.False(t, filters[node1].Contains(derive("piece2", node1.Bytes())))
+
+	assert.True(t, filters[node2].Contains(derive("piece1", node2.Bytes())))
+	assert.True(t, filters[node2].Contains(derive("piece2", node2.Bytes())))
+	// the ids are derived for every node
+	assert.False(t, filters[node2].Contains(derive("piece1", node1.Bytes())))
+}
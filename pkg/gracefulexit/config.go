@@ -0,0 +1,45 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"context"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/provider"
+)
+
+// Config is a configuration struct that is everything you need to start the
+// graceful exit responsibility of a satellite
+type Config struct {
+}
+
+// Run implements the provider.Responsibility interface. Run assumes the
+// overlay and pointerdb responsibilities have been started before this one.
+func (c Config) Run(ctx context.Context, server *provider.Provider) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pdb := pointerdb.LoadFromContext(ctx)
+	if pdb == nil {
+		return Error.New("failed to load pointerdb from context")
+	}
+
+	cache := overlay.LoadFromContext(ctx)
+	if cache == nil {
+		return Error.New("failed to load overlay cache from context")
+	}
+
+	srv := overlay.LoadServerFromContext(ctx)
+	if srv == nil {
+		return Error.New("failed to load overlay server from context")
+	}
+
+	pb.RegisterGracefulExitServer(server.GRPC(), NewEndpoint(zap.L().Named("gracefulexit"), pdb, cache, srv))
+
+	return server.Run(ctx)
+}
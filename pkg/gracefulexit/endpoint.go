@@ -0,0 +1,513 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit
+
+import (
+	"bytes"
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
+)
+
+const (
+	// maxTransfers is the maximum number of pieces returned by one Transfers
+	// call
+	maxTransfers = 100
+	// maxListedSegments is the maximum number of segments one Transfers call
+	// lists the pieces of the node from
+	maxListedSegments = 10000
+)
+
+// Endpoint implements the graceful exit service of the satellite. Exiting
+// storage nodes fetch the pieces they store with new target nodes, upload
+// them and report the transfers, which moves the pieces in the pointers.
+// The satellite records the target assigned to every piece and lists the
+// segments once, continuing from the last listed segment on every call.
+type Endpoint struct {
+	log      *zap.Logger
+	pointers *pointerdb.Server
+	cache    *overlay.Cache
+	overlay  pb.OverlayServer
+}
+
+// NewEndpoint creates the graceful exit endpoint
+func NewEndpoint(log *zap.Logger, pointers *pointerdb.Server, cache *overlay.Cache, overlay pb.OverlayServer) *Endpoint {
+	return &Endpoint{
+		log:      log,
+		pointers: pointers,
+		cache:    cache,
+		overlay:  overlay,
+	}
+}
+
+// Initiate marks the node of the request as exiting
+func (endpoint *Endpoint) Initiate(ctx context.Context, req *pb.InitiateRequest) (progress *pb.ExitProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, err := peerNodeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exit, err := endpoint.cache.InitiateExit(ctx, nodeID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	endpoint.log.Info("node initiated graceful exit", zap.String("node", nodeID.String()))
+	return exitProgress(exit)
+}
+
+// Transfers lists the pieces of the node of the request together with the
+// nodes to transfer them to. The transfers, which results weren't reported,
+// are returned first, then the pieces of the segments after the ones listed
+// before are assigned to target nodes.
+func (endpoint *Endpoint) Transfers(ctx context.Context, req *pb.TransfersRequest) (resp *pb.TransfersResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, err := peerNodeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exit, err := endpoint.exitStatus(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > maxTransfers {
+		limit = maxTransfers
+	}
+
+	resp = &pb.TransfersResponse{}
+
+	pending, err := endpoint.cache.PendingExitTransfers(ctx, nodeID, limit)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	for _, transfer := range pending {
+		pieceTransfer, err := endpoint.pendingTransfer(ctx, transfer)
+		if err != nil {
+			endpoint.log.Error("failed to list pending transfers", zap.String("node", nodeID.String()), zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		if pieceTransfer != nil {
+			resp.Transfers = append(resp.Transfers, pieceTransfer)
+		}
+	}
+
+	listed := exit.TransfersListed
+	if len(pending) < limit && !listed {
+		var transfers []*pb.PieceTransfer
+		transfers, listed, err = endpoint.listTransfers(ctx, exit, limit-len(pending))
+		if err != nil {
+			endpoint.log.Error("failed to list transfers", zap.String("node", nodeID.String()), zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		resp.Transfers = append(resp.Transfers, transfers...)
+	}
+
+	resp.More = len(pending) >= limit || !listed
+	return resp, nil
+}
+
+// pendingTransfer returns the transfer of a piece, which result wasn't
+// reported yet, to the target node assigned to it. It returns nil if the
+// piece doesn't have to be transferred anymore.
+func (endpoint *Endpoint) pendingTransfer(ctx context.Context, transfer *overlay.ExitTransfer) (*pb.PieceTransfer, error) {
+	pointer, err := endpoint.pointer(transfer.Path)
+	if err != nil {
+		return nil, err
+	}
+
+	if nodePiece(pointer, transfer.NodeID, transfer.PieceNum) == nil {
+		err = endpoint.cache.FinishExitTransfer(ctx, transfer.NodeID, transfer.Path, transfer.PieceNum, overlay.ExitTransferObsolete)
+		if err != nil && err != overlay.ErrExitTransferFinished {
+			return nil, err
+		}
+		return nil, nil
+	}
+
+	target, err := endpoint.cache.Get(ctx, transfer.TargetID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return endpoint.pieceTransfer(ctx, transfer.Path, pointer, transfer.PieceNum, target)
+}
+
+// listTransfers assigns the pieces of the node in the segments after the
+// ones listed before to target nodes, records the transfers and returns up
+// to limit of them. listed is set once all segments were listed.
+func (endpoint *Endpoint) listTransfers(ctx context.Context, exit *overlay.ExitStatus, limit int) (transfers []*pb.PieceTransfer, listed bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	cursor := exit.TransfersCursor
+	listed = true
+
+	var records []*overlay.ExitTransfer
+	var segments int
+
+	opts := storage.IterateOptions{First: storage.Key(cursor), Recurse: true}
+	err = endpoint.pointers.DB.Iterate(opts, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for it.Next(&item) {
+			if exit.TransfersCursor != "" && item.Key.String() == exit.TransfersCursor {
+				continue
+			}
+			if len(transfers) >= limit || segments >= maxListedSegments {
+				listed = false
+				return nil
+			}
+			segments++
+			cursor = item.Key.String()
+
+			pointer := &pb.Pointer{}
+			if err := proto.Unmarshal(item.Value, pointer); err != nil {
+				return Error.New("error unmarshalling pointer %s", err)
+			}
+
+			// expired segments aren't transferred, the reaper deletes them
+			if pointerdb.Expired(pointer, now) {
+				continue
+			}
+
+			for _, piece := range pointer.GetRemote().GetRemotePieces() {
+				if piece.NodeId != exit.NodeID {
+					continue
+				}
+
+				record := &overlay.ExitTransfer{
+					NodeID:   exit.NodeID,
+					Path:     cursor,
+					PieceNum: piece.PieceNum,
+					State:    overlay.ExitTransferPending,
+				}
+				records = append(records, record)
+
+				// the transfers of pieces without hashes can't be verified,
+				// they are left to the repair
+				if len(piece.GetHash()) == 0 {
+					record.State = overlay.ExitTransferFailed
+					continue
+				}
+
+				target, err := endpoint.selectTarget(ctx, pointer)
+				if err != nil {
+					return err
+				}
+				record.TargetID = target.Id
+
+				transfer, err := endpoint.pieceTransfer(ctx, cursor, pointer, piece.PieceNum, target)
+				if err != nil {
+					return err
+				}
+				transfers = append(transfers, transfer)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	err = endpoint.cache.AddExitTransfers(ctx, exit.NodeID, records, cursor, listed)
+	if err != nil {
+		return nil, false, err
+	}
+	return transfers, listed, nil
+}
+
+// selectTarget selects a node for a piece of the segment, which doesn't store
+// another piece of the segment
+func (endpoint *Endpoint) selectTarget(ctx context.Context, pointer *pb.Pointer) (*pb.Node, error) {
+	remote := pointer.GetRemote()
+
+	var excluded storj.NodeIDList
+	for _, p := range remote.GetRemotePieces() {
+		excluded = append(excluded, p.NodeId)
+	}
+
+	var pieceSize int64
+	if minReq := int64(remote.GetRedundancy().GetMinReq()); minReq > 0 {
+		pieceSize = pointer.GetSegmentSize() / minReq
+	}
+
+	found, err := endpoint.overlay.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+		Opts: &pb.OverlayOptions{
+			Amount:        1,
+			Restrictions:  &pb.NodeRestrictions{FreeDisk: pieceSize, FreeBandwidth: pieceSize},
+			ExcludedNodes: excluded,
+		},
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if len(found.GetNodes()) == 0 {
+		return nil, Error.New("no node found for a piece of %s", remote.GetPieceId())
+	}
+	return found.GetNodes()[0], nil
+}
+
+// pieceTransfer creates the allocation to upload a piece of the segment to
+// the target node with
+func (endpoint *Endpoint) pieceTransfer(ctx context.Context, path string, pointer *pb.Pointer, pieceNum int32, target *pb.Node) (*pb.PieceTransfer, error) {
+	pba, authorization, err := endpoint.pointers.TransferAllocation(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &pb.PieceTransfer{
+		Path:            path,
+		PieceId:         pointer.GetRemote().GetPieceId(),
+		PieceNum:        pieceNum,
+		Target:          target,
+		ExpirationDate:  pointer.GetExpirationDate(),
+		PayerAllocation: pba,
+		Authorization:   authorization,
+	}, nil
+}
+
+// Transferred moves the piece of the result to its target node in the
+// pointer of the segment and updates the progress of the exit. Only the
+// target node assigned to the piece is accepted and the reported hash has to
+// match the hash of the piece in the pointer, otherwise the transfer is
+// counted as failed. The result of every transfer is counted once.
+func (endpoint *Endpoint) Transferred(ctx context.Context, result *pb.TransferResult) (resp *pb.TransferredResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, err := peerNodeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = endpoint.exitStatus(ctx, nodeID); err != nil {
+		return nil, err
+	}
+
+	transfer, err := endpoint.cache.ExitTransfer(ctx, nodeID, result.GetPath(), result.GetPieceNum())
+	if err == overlay.ErrExitTransferNotFound {
+		return nil, status.Errorf(codes.NotFound, "piece %d of %s wasn't listed for transfer", result.GetPieceNum(), result.GetPath())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if transfer.State != overlay.ExitTransferPending {
+		return nil, status.Errorf(codes.AlreadyExists, "transfer of piece %d of %s already reported", result.GetPieceNum(), result.GetPath())
+	}
+
+	state, err := endpoint.verifyTransfer(ctx, transfer, result)
+	if err != nil {
+		return nil, err
+	}
+
+	err = endpoint.cache.FinishExitTransfer(ctx, nodeID, transfer.Path, transfer.PieceNum, state)
+	if err == overlay.ErrExitTransferFinished {
+		return nil, status.Errorf(codes.AlreadyExists, "transfer of piece %d of %s already reported", result.GetPieceNum(), result.GetPath())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	exit, err := endpoint.cache.ExitStatus(ctx, nodeID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	progress, err := exitProgress(exit)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.TransferredResponse{
+		Progress: progress,
+		Verified: state == overlay.ExitTransferSucceeded,
+	}, nil
+}
+
+// verifyTransfer checks the result of the transfer and moves the piece to
+// the target node, it returns the state the transfer finished with
+func (endpoint *Endpoint) verifyTransfer(ctx context.Context, transfer *overlay.ExitTransfer, result *pb.TransferResult) (_ overlay.ExitTransferState, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := endpoint.pointer(transfer.Path)
+	if err != nil {
+		return 0, status.Error(codes.Internal, err.Error())
+	}
+
+	// the segment was deleted or the piece was moved in the meantime
+	piece := nodePiece(pointer, transfer.NodeID, transfer.PieceNum)
+	if piece == nil {
+		return overlay.ExitTransferObsolete, nil
+	}
+
+	if result.GetFailed() {
+		endpoint.log.Debug("piece transfer failed",
+			zap.String("node", transfer.NodeID.String()), zap.String("path", transfer.Path), zap.Int32("piece", transfer.PieceNum))
+		return overlay.ExitTransferFailed, nil
+	}
+
+	if result.TargetId != transfer.TargetID {
+		return 0, status.Errorf(codes.PermissionDenied, "piece %d of %s was assigned to node %s", transfer.PieceNum, transfer.Path, transfer.TargetID)
+	}
+
+	if !bytes.Equal(piece.GetHash(), result.GetHash()) {
+		endpoint.log.Warn("transferred piece hash mismatch",
+			zap.String("node", transfer.NodeID.String()), zap.String("path", transfer.Path), zap.Int32("piece", transfer.PieceNum))
+		return overlay.ExitTransferFailed, nil
+	}
+
+	err = endpoint.pointers.ReplacePiece(ctx, storage.Key(transfer.Path), transfer.PieceNum, transfer.NodeID, transfer.TargetID, piece.GetHash())
+	if err != nil {
+		return 0, err
+	}
+	return overlay.ExitTransferSucceeded, nil
+}
+
+// Complete marks the node of the request as exited. It fails until the
+// pieces of all segments were listed and the results of all transfers were
+// reported.
+func (endpoint *Endpoint) Complete(ctx context.Context, req *pb.CompleteRequest) (progress *pb.ExitProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, err := peerNodeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exit, err := endpoint.exitStatus(ctx, nodeID)
+	if err != nil {
+		return nil, err
+	}
+	if !exit.TransfersListed {
+		return nil, status.Errorf(codes.FailedPrecondition, "pieces of the node weren't listed yet")
+	}
+
+	pending, err := endpoint.cache.CountPendingExitTransfers(ctx, nodeID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if pending > 0 {
+		return nil, status.Errorf(codes.FailedPrecondition, "%d transfers weren't reported", pending)
+	}
+
+	err = endpoint.cache.CompleteExit(ctx, nodeID)
+	if err == overlay.ErrExitIncomplete {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	exit, err = endpoint.cache.ExitStatus(ctx, nodeID)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	endpoint.log.Info("node completed graceful exit",
+		zap.String("node", nodeID.String()),
+		zap.Int64("transferred", exit.PiecesTransferred),
+		zap.Int64("failed", exit.PiecesFailed))
+	return exitProgress(exit)
+}
+
+// Progress returns the progress of the exit of the node of the request
+func (endpoint *Endpoint) Progress(ctx context.Context, req *pb.ProgressRequest) (progress *pb.ExitProgress, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	nodeID, err := peerNodeID(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	exit, err := endpoint.cache.ExitStatus(ctx, nodeID)
+	if err == overlay.ErrExitNotFound {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return exitProgress(exit)
+}
+
+// exitStatus returns the status of the exit of the node, failing if the node
+// isn't exiting
+func (endpoint *Endpoint) exitStatus(ctx context.Context, nodeID storj.NodeID) (*overlay.ExitStatus, error) {
+	exit, err := endpoint.cache.ExitStatus(ctx, nodeID)
+	if err == overlay.ErrExitNotFound {
+		return nil, status.Errorf(codes.FailedPrecondition, "graceful exit not initiated")
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	if exit.Exited {
+		return nil, status.Errorf(codes.FailedPrecondition, "node already exited")
+	}
+	return exit, nil
+}
+
+// pointer returns the pointer at path or nil if it doesn't exist
+func (endpoint *Endpoint) pointer(path string) (*pb.Pointer, error) {
+	value, err := endpoint.pointers.DB.Get(storage.Key(path))
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	pointer := &pb.Pointer{}
+	if err := proto.Unmarshal(value, pointer); err != nil {
+		return nil, Error.New("error unmarshalling pointer %s", err)
+	}
+	return pointer, nil
+}
+
+// nodePiece returns the piece with the number stored on the node in the
+// pointer, nil if there's none
+func nodePiece(pointer *pb.Pointer, nodeID storj.NodeID, pieceNum int32) *pb.RemotePiece {
+	for _, piece := range pointer.GetRemote().GetRemotePieces() {
+		if piece.NodeId == nodeID && piece.PieceNum == pieceNum {
+			return piece
+		}
+	}
+	return nil
+}
+
+// peerNodeID returns the id of the node of the request
+func peerNodeID(ctx context.Context) (storj.NodeID, error) {
+	pi, err := provider.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return storj.NodeID{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return pi.ID, nil
+}
+
+func exitProgress(exit *overlay.ExitStatus) (*pb.ExitProgress, error) {
+	initiatedAt, err := ptypes.TimestampProto(exit.InitiatedAt)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &pb.ExitProgress{
+		PiecesTransferred: exit.PiecesTransferred,
+		PiecesFailed:      exit.PiecesFailed,
+		Exited:            exit.Exited,
+		InitiatedAt:       initiatedAt,
+	}, nil
+}
@@ -0,0 +1,205 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package gracefulexit_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vivint/infectious"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/pb"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode"
+)
+
+// TestAPIKey is the api key of the satellite pointerdb
+const TestAPIKey = "test-api-key"
+
+func TestEndpoint(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 6, 1)
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	// we wait for the satellite to discover the storage nodes
+	time.Sleep(2 * time.Second)
+
+	// TODO: use a better way for configuring the api key of the satellite
+	require.NoError(t, flag.Set("pointer-db.auth.api-key", TestAPIKey))
+
+	satellite := planet.Satellites[0]
+	reportRestrictions(ctx, t, planet)
+
+	pdb, err := planet.Uplinks[0].DialPointerDB(satellite, TestAPIKey)
+	require.NoError(t, err)
+
+	path := uploadSegment(ctx, t, planet)
+
+	pointer, _, _, err := pdb.Get(ctx, path)
+	require.NoError(t, err)
+	piece := pointer.GetRemote().GetRemotePieces()[0]
+	exiting := storageNode(t, planet, piece.NodeId)
+
+	conn, err := transport.NewClient(exiting.Identity).DialNode(ctx, &satellite.Info)
+	require.NoError(t, err)
+	defer ctx.Check(conn.Close)
+	client := pb.NewGracefulExitClient(conn)
+
+	// the transfers are listed after the exit was initiated
+	_, err = client.Transfers(ctx, &pb.TransfersRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	progress, err := client.Initiate(ctx, &pb.InitiateRequest{})
+	require.NoError(t, err)
+	assert.False(t, progress.GetExited())
+
+	transfers, err := client.Transfers(ctx, &pb.TransfersRequest{})
+	require.NoError(t, err)
+	require.Len(t, transfers.GetTransfers(), 1)
+	assert.False(t, transfers.GetMore())
+
+	transfer := transfers.GetTransfers()[0]
+	assert.Equal(t, path, transfer.GetPath())
+	assert.Equal(t, piece.PieceNum, transfer.GetPieceNum())
+	for _, p := range pointer.GetRemote().GetRemotePieces() {
+		assert.NotEqual(t, p.NodeId, transfer.GetTarget().Id)
+	}
+
+	// the target is kept for the transfers, which results weren't reported
+	again, err := client.Transfers(ctx, &pb.TransfersRequest{})
+	require.NoError(t, err)
+	require.Len(t, again.GetTransfers(), 1)
+	assert.Equal(t, transfer.GetTarget().Id, again.GetTransfers()[0].GetTarget().Id)
+
+	result := &pb.TransferResult{
+		Path:     transfer.GetPath(),
+		PieceNum: transfer.GetPieceNum(),
+		TargetId: transfer.GetTarget().Id,
+		Hash:     piece.GetHash(),
+	}
+
+	// only listed pieces and their assigned targets are accepted
+	_, err = client.Transferred(ctx, &pb.TransferResult{Path: "l/bucket/unknown", PieceNum: result.PieceNum, TargetId: result.TargetId})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = client.Transferred(ctx, &pb.TransferResult{Path: result.Path, PieceNum: result.PieceNum, TargetId: piece.NodeId, Hash: result.Hash})
+	assert.Equal(t, codes.PermissionDenied, status.Code(err))
+
+	// the node doesn't exit while a transfer is pending
+	_, err = client.Complete(ctx, &pb.CompleteRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// a piece, which hash doesn't match the pointer, is counted as failed
+	transferred, err := client.Transferred(ctx, &pb.TransferResult{Path: result.Path, PieceNum: result.PieceNum, TargetId: result.TargetId, Hash: []byte("invalid")})
+	require.NoError(t, err)
+	assert.False(t, transferred.GetVerified())
+	assert.Equal(t, int64(0), transferred.GetProgress().GetPiecesTransferred())
+	assert.Equal(t, int64(1), transferred.GetProgress().GetPiecesFailed())
+
+	// and its result is counted once
+	_, err = client.Transferred(ctx, result)
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+	_, err = client.Transferred(ctx, &pb.TransferResult{Path: result.Path, PieceNum: result.PieceNum, Failed: true})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	progress, err = client.Complete(ctx, &pb.CompleteRequest{})
+	require.NoError(t, err)
+	assert.True(t, progress.GetExited())
+	assert.Equal(t, int64(1), progress.GetPiecesFailed())
+
+	_, err = client.Transfers(ctx, &pb.TransfersRequest{})
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	// the failed piece is left on the node
+	pointer, _, _, err = pdb.Get(ctx, path)
+	require.NoError(t, err)
+	assert.Equal(t, exiting.Identity.ID, pointer.GetRemote().GetRemotePieces()[0].NodeId)
+}
+
+// uploadSegment uploads a remote segment with random data through the
+// uplink of the planet and returns its path
+func uploadSegment(ctx context.Context, t *testing.T, planet *testplanet.Planet) storj.Path {
+	uplink, satellite := planet.Uplinks[0], planet.Satellites[0]
+
+	oc, err := uplink.DialOverlay(satellite)
+	require.NoError(t, err)
+
+	pdb, err := uplink.DialPointerDB(satellite, TestAPIKey)
+	require.NoError(t, err)
+
+	fc, err := infectious.NewFEC(2, 4)
+	require.NoError(t, err)
+
+	rs, err := eestream.NewRedundancyStrategy(eestream.NewRSScheme(fc, int(1*memory.KB)), 3, 4)
+	require.NoError(t, err)
+
+	store := segments.NewSegmentStore(oc, ecclient.NewClient(uplink.Identity, 0), pdb, rs, int(1*memory.KB))
+
+	data := make([]byte, 10*memory.KB)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	// the pointerdb client gets the authorization of the uploads from the
+	// first pointer it gets
+	inline := storj.Path("l/bucket/inline")
+	_, err = store.Put(ctx, bytes.NewReader([]byte("inline")), time.Time{}, func() (storj.Path, []byte, error) {
+		return inline, nil, nil
+	})
+	require.NoError(t, err)
+	_, _, _, err = pdb.Get(ctx, inline)
+	require.NoError(t, err)
+
+	path := storj.Path("l/bucket/object")
+	_, err = store.Put(ctx, bytes.NewReader(data), time.Time{}, func() (storj.Path, []byte, error) {
+		return path, nil, nil
+	})
+	require.NoError(t, err)
+
+	return path
+}
+
+// reportRestrictions updates the free space of the storage nodes in the
+// overlay of the satellite, which they didn't report before their first
+// refresh
+func reportRestrictions(ctx context.Context, t *testing.T, planet *testplanet.Planet) {
+	for _, node := range planet.StorageNodes {
+		local := node.Local()
+		local.Restrictions = &pb.NodeRestrictions{
+			FreeBandwidth: memory.TB.Int64(),
+			FreeDisk:      memory.TB.Int64(),
+		}
+		require.NoError(t, planet.Satellites[0].Overlay.Put(ctx, local.Id, local))
+	}
+}
+
+// storageNode returns the storage node of the planet with the id
+func storageNode(t *testing.T, planet *testplanet.Planet, id storj.NodeID) *storagenode.Peer {
+	for _, node := range planet.StorageNodes {
+		if node.Identity.ID == id {
+			return node
+		}
+	}
+	t.Fatalf("storage node %s not found", id)
+	return nil
+}
@@ -18,7 +18,30 @@ func (db *DB) CreateBucket(ctx context.Context, bucket string, info *storj.Bucke
 		return storj.Bucket{}, storj.ErrNoBucket.New("")
 	}
 
-	meta, err := db.buckets.Put(ctx, bucket, getPathCipher(info))
+	meta, err := db.buckets.Put(ctx, bucket, getPathCipher(info), info != nil && info.Versioning)
+	if err != nil {
+		return storj.Bucket{}, err
+	}
+
+	if info != nil && len(info.ExpirationRules) > 0 {
+		meta, err = db.buckets.SetExpirationRules(ctx, bucket, info.ExpirationRules)
+		if err != nil {
+			return storj.Bucket{}, err
+		}
+	}
+
+	return bucketFromMeta(bucket, meta), nil
+}
+
+// SetBucketExpirationRules replaces the expiration rules of a bucket
+func (db *DB) SetBucketExpirationRules(ctx context.Context, bucket string, rules []storj.ExpirationRule) (bucketInfo storj.Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket == "" {
+		return storj.Bucket{}, storj.ErrNoBucket.New("")
+	}
+
+	meta, err := db.buckets.SetExpirationRules(ctx, bucket, rules)
 	if err != nil {
 		return storj.Bucket{}, err
 	}
@@ -106,8 +129,10 @@ func getPathCipher(info *storj.Bucket) storj.Cipher {
 
 func bucketFromMeta(bucket string, meta buckets.Meta) storj.Bucket {
 	return storj.Bucket{
-		Name:       bucket,
-		Created:    meta.Created,
-		PathCipher: meta.PathEncryptionType,
+		Name:            bucket,
+		Created:         meta.Created,
+		PathCipher:      meta.PathEncryptionType,
+		Versioning:      meta.Versioning,
+		ExpirationRules: meta.ExpirationRules,
 	}
 }
@@ -72,7 +72,7 @@ func TestBucketsBasic(t *testing.T) {
 func TestBucketsReadNewWayWriteOldWay(t *testing.T) {
 	runTest(t, func(ctx context.Context, db *DB) {
 		// (Old API) Create new bucket
-		_, err := db.buckets.Put(ctx, TestBucket, storj.AESGCM)
+		_, err := db.buckets.Put(ctx, TestBucket, storj.AESGCM, false)
 		assert.NoError(t, err)
 
 		// (New API) Check that bucket list include the new bucket
@@ -361,7 +361,7 @@ func newDB(planet *testplanet.Planet) (*DB, error) {
 	key := new(storj.Key)
 	copy(key[:], TestEncKey)
 
-	streams, err := streams.NewStreamStore(segments, int64(64*memory.MB), key, int(1*memory.KB), storj.AESGCM)
+	streams, err := streams.NewStreamStore(segments, int64(64*memory.MB), key, int(1*memory.KB), storj.AESGCM, 1)
 	if err != nil {
 		return nil, err
 	}
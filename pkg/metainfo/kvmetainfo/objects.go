@@ -5,7 +5,10 @@ package kvmetainfo
 
 import (
 	"context"
+	"crypto/rand"
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -27,6 +30,11 @@ import (
 const (
 	// commitedPrefix is prefix where completed object info is stored
 	committedPrefix = "l/"
+	// pendingPrefix is prefix where the info of partially uploaded objects is stored
+	pendingPrefix = "p/"
+	// versionPrefix is prefix where the versions of objects in buckets with
+	// versioning are stored
+	versionPrefix = "v/"
 )
 
 var defaultRS = storj.RedundancyScheme{
@@ -43,20 +51,22 @@ var defaultES = storj.EncryptionScheme{
 	BlockSize: 1 * memory.KB.Int32(),
 }
 
-// GetObject returns information about an object
-func (db *DB) GetObject(ctx context.Context, bucket string, path storj.Path) (info storj.Object, err error) {
+// GetObject returns information about an object, or about one of its
+// versions when the version is not empty
+func (db *DB) GetObject(ctx context.Context, bucket string, path storj.Path, version string) (info storj.Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, info, err = db.getInfo(ctx, committedPrefix, bucket, path)
+	_, info, err = db.getObject(ctx, bucket, path, version)
 
 	return info, err
 }
 
-// GetObjectStream returns interface for reading the object stream
-func (db *DB) GetObjectStream(ctx context.Context, bucket string, path storj.Path) (stream storj.ReadOnlyStream, err error) {
+// GetObjectStream returns interface for reading the object stream, or the
+// stream of one of its versions when the version is not empty
+func (db *DB) GetObjectStream(ctx context.Context, bucket string, path storj.Path, version string) (stream storj.ReadOnlyStream, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	meta, info, err := db.getInfo(ctx, committedPrefix, bucket, path)
+	meta, info, err := db.getObject(ctx, bucket, path, version)
 	if err != nil {
 		return nil, err
 	}
@@ -69,7 +79,9 @@ func (db *DB) GetObjectStream(ctx context.Context, bucket string, path storj.Pat
 	return &readonlyStream{
 		db:            db,
 		info:          info,
+		prefix:        meta.prefix,
 		encryptedPath: meta.encryptedPath,
+		streamInfo:    meta.streamInfo,
 		streamKey:     streamKey,
 	}, nil
 }
@@ -100,6 +112,10 @@ func (db *DB) CreateObject(ctx context.Context, bucket string, path storj.Path,
 		info.EncryptionScheme = createInfo.EncryptionScheme
 	}
 
+	if info.Expires.IsZero() {
+		info.Expires = bucketInfo.Expiration(path, time.Now())
+	}
+
 	// TODO: autodetect content type from the path extension
 	// if info.ContentType == "" {}
 
@@ -114,6 +130,13 @@ func (db *DB) CreateObject(ctx context.Context, bucket string, path storj.Path,
 		}
 	}
 
+	if bucketInfo.Versioning {
+		info.VersionID, err = newVersionID()
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &mutableObject{
 		db:   db,
 		info: info,
@@ -138,21 +161,193 @@ func (db *DB) DeleteObject(ctx context.Context, bucket string, path storj.Path)
 	return store.Delete(ctx, path)
 }
 
+// DeleteObjectVersion deletes a version of an object from database. Deleting
+// the current version of an object deletes the object as well.
+func (db *DB) DeleteObjectVersion(ctx context.Context, bucket string, path storj.Path, version string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	if !bucketInfo.Versioning {
+		return storj.ErrVersioningDisabled.New("%q", bucket)
+	}
+
+	if path == "" {
+		return storj.ErrNoPath.New("")
+	}
+
+	err = db.streams.DeleteVersion(ctx, storj.JoinPaths(bucket, path), bucketInfo.PathCipher, version)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrObjectNotFound.Wrap(err)
+		}
+		return err
+	}
+
+	current, err := db.GetObject(ctx, bucket, path, "")
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			return nil
+		}
+		return err
+	}
+
+	if current.VersionID != version {
+		return nil
+	}
+	return db.DeleteObject(ctx, bucket, path)
+}
+
+// CopyObject copies an object to another path, possibly in another bucket,
+// sharing the segments of the source object
+func (db *DB) CopyObject(ctx context.Context, sourceBucket string, sourcePath storj.Path, bucket string, path storj.Path) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if sourcePath == "" || path == "" {
+		return storj.Object{}, storj.ErrNoPath.New("")
+	}
+
+	sourceBucketInfo, err := db.GetBucket(ctx, sourceBucket)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	// the copy gets a version id of its own, or none outside of buckets with
+	// versioning
+	var metadata []byte
+	if sourceBucketInfo.Versioning || bucketInfo.Versioning {
+		source, err := db.GetObject(ctx, sourceBucket, sourcePath, "")
+		if err != nil {
+			return storj.Object{}, err
+		}
+
+		serMetaInfo := pb.SerializableMeta{
+			ContentType: source.ContentType,
+			UserDefined: source.Metadata,
+		}
+		if bucketInfo.Versioning {
+			serMetaInfo.VersionId, err = newVersionID()
+			if err != nil {
+				return storj.Object{}, err
+			}
+		}
+
+		metadata, err = proto.Marshal(&serMetaInfo)
+		if err != nil {
+			return storj.Object{}, err
+		}
+	}
+
+	_, err = db.streams.Copy(ctx,
+		storj.JoinPaths(sourceBucket, sourcePath), sourceBucketInfo.PathCipher,
+		storj.JoinPaths(bucket, path), bucketInfo.PathCipher, metadata)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrObjectNotFound.Wrap(err)
+		}
+		return storj.Object{}, err
+	}
+
+	info, err = db.GetObject(ctx, bucket, path, "")
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	return info, db.saveVersion(ctx, info)
+}
+
+// ConcatPendingObjects commits the pending objects at sourcePaths as a single
+// object, sharing the segments of the pending objects
+func (db *DB) ConcatPendingObjects(ctx context.Context, bucket string, sourcePaths []storj.Path, path storj.Path, createInfo *storj.CreateObject) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if path == "" {
+		return storj.Object{}, storj.ErrNoPath.New("")
+	}
+
+	bucketInfo, err := db.GetBucket(ctx, bucket)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	fullpaths := make([]storj.Path, 0, len(sourcePaths))
+	for _, sourcePath := range sourcePaths {
+		if sourcePath == "" {
+			return storj.Object{}, storj.ErrNoPath.New("")
+		}
+		fullpaths = append(fullpaths, storj.JoinPaths(bucket, sourcePath))
+	}
+
+	serMetaInfo := pb.SerializableMeta{
+		ContentType: createInfo.ContentType,
+		UserDefined: createInfo.Metadata,
+	}
+	if bucketInfo.Versioning {
+		serMetaInfo.VersionId, err = newVersionID()
+		if err != nil {
+			return storj.Object{}, err
+		}
+	}
+
+	metadata, err := proto.Marshal(&serMetaInfo)
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	_, err = db.streams.Concat(ctx, fullpaths, bucketInfo.PathCipher, storj.JoinPaths(bucket, path), bucketInfo.PathCipher, metadata)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrObjectNotFound.Wrap(err)
+		}
+		return storj.Object{}, err
+	}
+
+	info, err = db.GetObject(ctx, bucket, path, "")
+	if err != nil {
+		return storj.Object{}, err
+	}
+
+	return info, db.saveVersion(ctx, info)
+}
+
 // ModifyPendingObject creates an interface for updating a partially uploaded object
 func (db *DB) ModifyPendingObject(ctx context.Context, bucket string, path storj.Path) (object storj.MutableObject, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return nil, errors.New("not implemented")
+
+	_, info, err := db.getInfo(ctx, pendingPrefix, bucket, path, "")
+	if err != nil {
+		return nil, err
+	}
+
+	return &mutableObject{
+		db:      db,
+		info:    info,
+		pending: true,
+	}, nil
 }
 
 // ListPendingObjects lists pending objects in bucket based on the ListOptions
 func (db *DB) ListPendingObjects(ctx context.Context, bucket string, options storj.ListOptions) (list storj.ObjectList, err error) {
 	defer mon.Task()(&ctx)(&err)
-	return storj.ObjectList{}, errors.New("not implemented")
+	return db.listObjects(ctx, bucket, options, true)
 }
 
 // ListObjects lists objects in bucket based on the ListOptions
 func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.ListOptions) (list storj.ObjectList, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return db.listObjects(ctx, bucket, options, false)
+}
+
+func (db *DB) listObjects(ctx context.Context, bucket string, options storj.ListOptions, pending bool) (list storj.ObjectList, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	bucketInfo, err := db.GetBucket(ctx, bucket)
 	if err != nil {
@@ -187,7 +382,19 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 		endBefore = "\x7f\x7f\x7f\x7f\x7f\x7f\x7f"
 	}
 
-	items, more, err := objects.List(ctx, options.Prefix, startAfter, endBefore, options.Recursive, options.Limit, meta.All)
+	if options.Versions {
+		if pending || !bucketInfo.Versioning {
+			return storj.ObjectList{}, storj.ErrVersioningDisabled.New("%q", bucket)
+		}
+		return db.listVersions(ctx, bucketInfo, objects, options, startAfter, endBefore)
+	}
+
+	listItems := objects.List
+	if pending {
+		listItems = objects.ListPending
+	}
+
+	items, more, err := listItems(ctx, options.Prefix, startAfter, endBefore, options.Recursive, options.Limit, meta.All)
 	if err != nil {
 		return storj.ObjectList{}, err
 	}
@@ -200,7 +407,62 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 	}
 
 	for _, item := range items {
-		list.Items = append(list.Items, objectFromMeta(bucketInfo, item.Path, item.IsPrefix, item.Meta))
+		info := objectFromMeta(bucketInfo, item.Path, item.IsPrefix, item.Meta)
+		if !pending && !item.IsPrefix {
+			info.IsLatest = true
+		}
+		list.Items = append(list.Items, info)
+	}
+
+	return list, nil
+}
+
+// listVersions lists the versions of the objects in a bucket with versioning
+func (db *DB) listVersions(ctx context.Context, bucketInfo storj.Bucket, objects objects.Store, options storj.ListOptions, startAfter, endBefore string) (list storj.ObjectList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	items, more, err := objects.ListVersions(ctx, options.Prefix, startAfter, endBefore, options.Limit, meta.All)
+	if err != nil {
+		return storj.ObjectList{}, err
+	}
+
+	list = storj.ObjectList{
+		Bucket: bucketInfo.Name,
+		Prefix: options.Prefix,
+		More:   more,
+		Items:  make([]storj.Object, 0, len(items)),
+	}
+
+	// version ids of the current objects
+	latest := make(map[storj.Path]string)
+
+	for _, item := range items {
+		split := strings.LastIndex(item.Path, "/")
+		if split < 0 {
+			return storj.ObjectList{}, errClass.New("invalid version path %q", item.Path)
+		}
+		path, version := item.Path[:split], item.Path[split+1:]
+
+		latestVersion, ok := latest[path]
+		if !ok {
+			currentPath := path
+			if options.Prefix != "" {
+				currentPath = storj.JoinPaths(strings.TrimSuffix(options.Prefix, "/"), path)
+			}
+
+			current, err := objects.Meta(ctx, currentPath)
+			if err == nil {
+				latestVersion = current.VersionId
+			} else if !storj.ErrObjectNotFound.Has(err) {
+				return storj.ObjectList{}, err
+			}
+			latest[path] = latestVersion
+		}
+
+		info := objectFromMeta(bucketInfo, path, false, item.Meta)
+		info.VersionID = version
+		info.IsLatest = version == latestVersion
+		list.Items = append(list.Items, info)
 	}
 
 	return list, nil
@@ -208,13 +470,37 @@ func (db *DB) ListObjects(ctx context.Context, bucket string, options storj.List
 
 type object struct {
 	fullpath        string
+	prefix          string
 	encryptedPath   string
 	lastSegmentMeta segments.Meta
 	streamInfo      pb.StreamInfo
 	streamMeta      pb.StreamMeta
 }
 
-func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path storj.Path) (obj object, info storj.Object, err error) {
+// getObject returns the committed object at path, or one of its versions
+// when the version is not empty
+func (db *DB) getObject(ctx context.Context, bucket string, path storj.Path, version string) (obj object, info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if version == "" {
+		return db.getInfo(ctx, committedPrefix, bucket, path, "")
+	}
+
+	obj, info, err = db.getInfo(ctx, versionPrefix, bucket, path, version)
+	if err != nil {
+		return object{}, storj.Object{}, err
+	}
+
+	_, current, err := db.getInfo(ctx, committedPrefix, bucket, path, "")
+	if err != nil && !storj.ErrObjectNotFound.Has(err) {
+		return object{}, storj.Object{}, err
+	}
+	info.IsLatest = err == nil && current.VersionID == version
+
+	return obj, info, nil
+}
+
+func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path storj.Path, version string) (obj object, info storj.Object, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	bucketInfo, err := db.GetBucket(ctx, bucket)
@@ -226,7 +512,16 @@ func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path st
 		return object{}, storj.Object{}, storj.ErrNoPath.New("")
 	}
 
+	if version != "" && !bucketInfo.Versioning {
+		return object{}, storj.Object{}, storj.ErrVersioningDisabled.New("%q", bucket)
+	}
+
+	// versions are stored below the path of their object, in the namespace
+	// of the versions
 	fullpath := bucket + "/" + path
+	if version != "" {
+		fullpath = storj.JoinPaths(fullpath, version)
+	}
 
 	encryptedPath, err := streams.EncryptAfterBucket(fullpath, bucketInfo.PathCipher, db.rootKey)
 	if err != nil {
@@ -285,8 +580,13 @@ func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path st
 		return object{}, storj.Object{}, err
 	}
 
+	if prefix == committedPrefix {
+		info.IsLatest = true
+	}
+
 	return object{
 		fullpath:        fullpath,
+		prefix:          prefix,
 		encryptedPath:   encryptedPath,
 		lastSegmentMeta: lastSegmentMeta,
 		streamInfo:      streamInfo,
@@ -296,10 +596,11 @@ func (db *DB) getInfo(ctx context.Context, prefix string, bucket string, path st
 
 func objectFromMeta(bucket storj.Bucket, path storj.Path, isPrefix bool, meta objects.Meta) storj.Object {
 	return storj.Object{
-		Version:  0, // TODO:
-		Bucket:   bucket,
-		Path:     path,
-		IsPrefix: isPrefix,
+		Version:   0, // TODO:
+		VersionID: meta.VersionId,
+		Bucket:    bucket,
+		Path:      path,
+		IsPrefix:  isPrefix,
 
 		Metadata: meta.UserDefined,
 
@@ -317,7 +618,7 @@ func objectFromMeta(bucket storj.Bucket, path storj.Path, isPrefix bool, meta ob
 
 func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segments.Meta, stream pb.StreamInfo, streamMeta pb.StreamMeta, redundancyScheme *pb.RedundancyScheme) (storj.Object, error) {
 	var nonce storj.Nonce
-	copy(nonce[:], streamMeta.LastSegmentMeta.KeyNonce)
+	copy(nonce[:], streamMeta.GetLastSegmentMeta().GetKeyNonce())
 
 	serMetaInfo := pb.SerializableMeta{}
 	err := proto.Unmarshal(stream.Metadata, &serMetaInfo)
@@ -325,11 +626,17 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		return storj.Object{}, err
 	}
 
+	fixedSegmentSize := stream.SegmentsSize
+	if len(stream.SegmentSizes) > 0 {
+		fixedSegmentSize = -1
+	}
+
 	return storj.Object{
-		Version:  0, // TODO:
-		Bucket:   bucket,
-		Path:     path,
-		IsPrefix: false,
+		Version:   0, // TODO:
+		VersionID: serMetaInfo.VersionId,
+		Bucket:    bucket,
+		Path:      path,
+		IsPrefix:  false,
 
 		Metadata: serMetaInfo.UserDefined,
 
@@ -339,11 +646,11 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 		Expires:     lastSegment.Expiration, // TODO: use correct field
 
 		Stream: storj.Stream{
-			Size: stream.SegmentsSize*(stream.NumberOfSegments-1) + stream.LastSegmentSize,
+			Size: streams.StreamSize(&stream),
 			// Checksum: []byte(object.Checksum),
 
 			SegmentCount:     stream.NumberOfSegments,
-			FixedSegmentSize: stream.SegmentsSize,
+			FixedSegmentSize: fixedSegmentSize,
 
 			RedundancyScheme: storj.RedundancyScheme{
 				Algorithm:      storj.ReedSolomon,
@@ -360,12 +667,41 @@ func objectStreamFromMeta(bucket storj.Bucket, path storj.Path, lastSegment segm
 			LastSegment: storj.LastSegment{
 				Size:              stream.LastSegmentSize,
 				EncryptedKeyNonce: nonce,
-				EncryptedKey:      streamMeta.LastSegmentMeta.EncryptedKey,
+				EncryptedKey:      streamMeta.GetLastSegmentMeta().GetEncryptedKey(),
 			},
 		},
 	}, nil
 }
 
+// newVersionID returns a new id for a version of an object. The ids start
+// with the time they were created at, followed by random bytes, so they sort
+// by time and don't repeat when objects are committed at the same time.
+func newVersionID() (string, error) {
+	var random [8]byte
+	_, err := rand.Read(random[:])
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%016x%x", time.Now().UnixNano(), random[:]), nil
+}
+
+// saveVersion keeps a copy of a committed object as its version when the
+// bucket has versioning enabled
+func (db *DB) saveVersion(ctx context.Context, info storj.Object) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if !info.Bucket.Versioning {
+		return nil
+	}
+
+	if info.VersionID == "" {
+		return errClass.New("object %q has no version id", info.Path)
+	}
+
+	_, err = db.streams.SaveVersion(ctx, storj.JoinPaths(info.Bucket.Name, info.Path), info.Bucket.PathCipher, info.VersionID)
+	return err
+}
+
 // convertTime converts gRPC timestamp to Go time
 func convertTime(ts *timestamp.Timestamp) time.Time {
 	if ts == nil {
@@ -379,8 +715,9 @@ func convertTime(ts *timestamp.Timestamp) time.Time {
 }
 
 type mutableObject struct {
-	db   *DB
-	info storj.Object
+	db      *DB
+	info    storj.Object
+	pending bool
 }
 
 func (object *mutableObject) Info() storj.Object { return object.info }
@@ -393,15 +730,35 @@ func (object *mutableObject) CreateStream(ctx context.Context) (storj.MutableStr
 }
 
 func (object *mutableObject) ContinueStream(ctx context.Context) (storj.MutableStream, error) {
-	return nil, errors.New("not implemented")
+	if !object.pending {
+		return nil, errClass.New("object %q is not a pending object", object.info.Path)
+	}
+
+	return &mutableStream{
+		db:   object.db,
+		info: object.info,
+	}, nil
 }
 
 func (object *mutableObject) DeleteStream(ctx context.Context) error {
-	return errors.New("not implemented")
+	if !object.pending {
+		return errClass.New("object %q is not a pending object", object.info.Path)
+	}
+
+	err := object.db.streams.DeletePending(ctx, storj.JoinPaths(object.info.Bucket.Name, object.info.Path), object.info.Bucket.PathCipher)
+	if storage.ErrKeyNotFound.Has(err) {
+		err = storj.ErrObjectNotFound.Wrap(err)
+	}
+	return err
 }
 
 func (object *mutableObject) Commit(ctx context.Context) error {
-	_, info, err := object.db.getInfo(ctx, committedPrefix, object.info.Bucket.Name, object.info.Path)
+	_, info, err := object.db.getInfo(ctx, committedPrefix, object.info.Bucket.Name, object.info.Path, "")
 	object.info = info
-	return err
+	if err != nil {
+		return err
+	}
+
+	object.pending = false
+	return object.db.saveVersion(ctx, info)
 }
@@ -92,19 +92,19 @@ func TestGetObject(t *testing.T) {
 
 		upload(ctx, t, db, bucket, TestFile, nil)
 
-		_, err = db.GetObject(ctx, "", "")
+		_, err = db.GetObject(ctx, "", "", "")
 		assert.True(t, storj.ErrNoBucket.Has(err))
 
-		_, err = db.GetObject(ctx, bucket.Name, "")
+		_, err = db.GetObject(ctx, bucket.Name, "", "")
 		assert.True(t, storj.ErrNoPath.Has(err))
 
-		_, err = db.GetObject(ctx, "non-existing-bucket", TestFile)
+		_, err = db.GetObject(ctx, "non-existing-bucket", TestFile, "")
 		assert.True(t, storj.ErrBucketNotFound.Has(err))
 
-		_, err = db.GetObject(ctx, bucket.Name, "non-existing-file")
+		_, err = db.GetObject(ctx, bucket.Name, "non-existing-file", "")
 		assert.True(t, storj.ErrObjectNotFound.Has(err))
 
-		object, err := db.GetObject(ctx, bucket.Name, TestFile)
+		object, err := db.GetObject(ctx, bucket.Name, TestFile, "")
 		if assert.NoError(t, err) {
 			assert.Equal(t, TestFile, object.Path)
 			assert.Equal(t, TestBucket, object.Bucket.Name)
@@ -133,16 +133,16 @@ func TestGetObjectStream(t *testing.T) {
 		upload(ctx, t, db, bucket, "small-file", []byte("test"))
 		upload(ctx, t, db, bucket, "large-file", data)
 
-		_, err = db.GetObjectStream(ctx, "", "")
+		_, err = db.GetObjectStream(ctx, "", "", "")
 		assert.True(t, storj.ErrNoBucket.Has(err))
 
-		_, err = db.GetObjectStream(ctx, bucket.Name, "")
+		_, err = db.GetObjectStream(ctx, bucket.Name, "", "")
 		assert.True(t, storj.ErrNoPath.Has(err))
 
-		_, err = db.GetObjectStream(ctx, "non-existing-bucket", "small-file")
+		_, err = db.GetObjectStream(ctx, "non-existing-bucket", "small-file", "")
 		assert.True(t, storj.ErrBucketNotFound.Has(err))
 
-		_, err = db.GetObjectStream(ctx, bucket.Name, "non-existing-file")
+		_, err = db.GetObjectStream(ctx, bucket.Name, "non-existing-file", "")
 		assert.True(t, storj.ErrObjectNotFound.Has(err))
 
 		assertStream(ctx, t, db, bucket, "empty-file", 0, []byte{})
@@ -181,7 +181,7 @@ func upload(ctx context.Context, t *testing.T, db *DB, bucket storj.Bucket, path
 }
 
 func assertStream(ctx context.Context, t *testing.T, db *DB, bucket storj.Bucket, path storj.Path, size int64, content []byte) {
-	readOnly, err := db.GetObjectStream(ctx, bucket.Name, path)
+	readOnly, err := db.GetObjectStream(ctx, bucket.Name, path, "")
 	if !assert.NoError(t, err) {
 		return
 	}
@@ -278,6 +278,131 @@ func TestDeleteObject(t *testing.T) {
 	})
 }
 
+func TestCopyObject(t *testing.T) {
+	runTest(t, func(ctx context.Context, db *DB) {
+		// we wait a second for all the nodes to complete bootstrapping off the satellite
+		time.Sleep(2 * time.Second)
+
+		data := make([]byte, 32*memory.KB)
+		_, err := rand.Read(data)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		upload(ctx, t, db, bucket, "small-file", []byte("test"))
+		upload(ctx, t, db, bucket, "large-file", data)
+
+		_, err = db.CopyObject(ctx, bucket.Name, "", bucket.Name, "copy")
+		assert.True(t, storj.ErrNoPath.Has(err))
+
+		_, err = db.CopyObject(ctx, "non-existing-bucket", "small-file", bucket.Name, "copy")
+		assert.True(t, storj.ErrBucketNotFound.Has(err))
+
+		_, err = db.CopyObject(ctx, bucket.Name, "non-existing-file", bucket.Name, "copy")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		for _, path := range []storj.Path{"small-file", "large-file"} {
+			info, err := db.CopyObject(ctx, bucket.Name, path, bucket.Name, path+"-copy")
+			if assert.NoError(t, err) {
+				assert.Equal(t, path+"-copy", info.Path)
+			}
+
+			err = db.DeleteObject(ctx, bucket.Name, path)
+			assert.NoError(t, err)
+		}
+
+		assertStream(ctx, t, db, bucket, "small-file-copy", 4, []byte("test"))
+		assertStream(ctx, t, db, bucket, "large-file-copy", int64(32*memory.KB), data)
+	})
+}
+
+func TestObjectVersions(t *testing.T) {
+	runTest(t, func(ctx context.Context, db *DB) {
+		bucket, err := db.CreateBucket(ctx, TestBucket, &storj.Bucket{PathCipher: storj.Unencrypted, Versioning: true})
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.True(t, bucket.Versioning)
+
+		upload(ctx, t, db, bucket, TestFile, []byte("first"))
+		first, err := db.GetObject(ctx, bucket.Name, TestFile, "")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		upload(ctx, t, db, bucket, TestFile, []byte("second"))
+		second, err := db.GetObject(ctx, bucket.Name, TestFile, "")
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		assert.NotEqual(t, first.VersionID, second.VersionID)
+		assert.True(t, second.IsLatest)
+
+		object, err := db.GetObject(ctx, bucket.Name, TestFile, first.VersionID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, first.VersionID, object.VersionID)
+			assert.False(t, object.IsLatest)
+			assert.EqualValues(t, 5, object.Size)
+		}
+
+		_, err = db.GetObject(ctx, bucket.Name, TestFile, "non-existing-version")
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		list, err := db.ListObjects(ctx, bucket.Name, storj.ListOptions{Direction: storj.After, Versions: true})
+		if assert.NoError(t, err) {
+			assert.False(t, list.More)
+			if assert.Equal(t, 2, len(list.Items)) {
+				assert.Equal(t, first.VersionID, list.Items[0].VersionID)
+				assert.False(t, list.Items[0].IsLatest)
+				assert.Equal(t, second.VersionID, list.Items[1].VersionID)
+				assert.True(t, list.Items[1].IsLatest)
+			}
+		}
+
+		// copies are new versions
+		copied, err := db.CopyObject(ctx, bucket.Name, TestFile, bucket.Name, "copy")
+		if assert.NoError(t, err) {
+			assert.NotEmpty(t, copied.VersionID)
+			assert.NotEqual(t, second.VersionID, copied.VersionID)
+		}
+
+		// objects at the path of a version don't replace the version
+		upload(ctx, t, db, bucket, storj.JoinPaths(TestFile, first.VersionID), []byte("other object"))
+		object, err = db.GetObject(ctx, bucket.Name, TestFile, first.VersionID)
+		if assert.NoError(t, err) {
+			assert.EqualValues(t, 5, object.Size)
+		}
+
+		err = db.DeleteObject(ctx, bucket.Name, TestFile)
+		assert.NoError(t, err)
+
+		object, err = db.GetObject(ctx, bucket.Name, TestFile, second.VersionID)
+		if assert.NoError(t, err) {
+			assert.False(t, object.IsLatest)
+		}
+
+		err = db.DeleteObjectVersion(ctx, bucket.Name, TestFile, first.VersionID)
+		assert.NoError(t, err)
+
+		_, err = db.GetObject(ctx, bucket.Name, TestFile, first.VersionID)
+		assert.True(t, storj.ErrObjectNotFound.Has(err))
+
+		otherBucket, err := db.CreateBucket(ctx, "otherbucket", nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		_, err = db.GetObject(ctx, otherBucket.Name, TestFile, first.VersionID)
+		assert.True(t, storj.ErrVersioningDisabled.Has(err))
+	})
+}
+
 func TestListObjectsEmpty(t *testing.T) {
 	runTest(t, func(ctx context.Context, db *DB) {
 		bucket, err := db.CreateBucket(ctx, TestBucket, nil)
@@ -32,7 +32,12 @@ func keyAfter(cursor string) string {
 	return cursor + "\x00"
 }
 
-// getSegmentPath returns the unique path for a particular segment
-func getSegmentPath(encryptedPath storj.Path, segNum int64) storj.Path {
+// getSegmentPath returns the unique path for a particular segment of the
+// stream whose last segment is stored at prefix. The segments of versions
+// are stored apart from the segments of the objects.
+func getSegmentPath(prefix string, encryptedPath storj.Path, segNum int64) storj.Path {
+	if prefix == versionPrefix {
+		return storj.JoinPaths(fmt.Sprintf("v%d", segNum), encryptedPath)
+	}
 	return storj.JoinPaths(fmt.Sprintf("s%d", segNum), encryptedPath)
 }
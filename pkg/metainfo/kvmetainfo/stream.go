@@ -11,6 +11,7 @@ import (
 
 	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 )
 
@@ -20,7 +21,9 @@ type readonlyStream struct {
 	db *DB
 
 	info          storj.Object
+	prefix        string
 	encryptedPath storj.Path
+	streamInfo    pb.StreamInfo
 	streamKey     *storj.Key // lazySegmentReader derivedKey
 }
 
@@ -47,7 +50,7 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 	var segmentPath storj.Path
 	isLastSegment := segment.Index+1 == stream.info.SegmentCount
 	if !isLastSegment {
-		segmentPath = getSegmentPath(stream.encryptedPath, index)
+		segmentPath = getSegmentPath(stream.prefix, stream.encryptedPath, index)
 		_, meta, err := stream.db.segments.Get(ctx, segmentPath)
 		if err != nil {
 			return segment, err
@@ -59,11 +62,11 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 			return segment, err
 		}
 
-		segment.Size = stream.info.FixedSegmentSize
+		segment.Size = streams.SegmentSize(&stream.streamInfo, index)
 		copy(segment.EncryptedKeyNonce[:], segmentMeta.KeyNonce)
 		segment.EncryptedKey = segmentMeta.EncryptedKey
 	} else {
-		segmentPath = storj.JoinPaths("l", stream.encryptedPath)
+		segmentPath = stream.prefix + stream.encryptedPath
 		segment.Size = stream.info.LastSegment.Size
 		segment.EncryptedKeyNonce = stream.info.LastSegment.EncryptedKeyNonce
 		segment.EncryptedKey = stream.info.LastSegment.EncryptedKey
@@ -74,8 +77,7 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 		return segment, err
 	}
 
-	nonce := new(storj.Nonce)
-	_, err = encryption.Increment(nonce, index+1)
+	nonce, err := streams.ContentNonce(&stream.streamInfo, index)
 	if err != nil {
 		return segment, err
 	}
@@ -86,7 +88,7 @@ func (stream *readonlyStream) segment(ctx context.Context, index int64) (segment
 	}
 
 	if pointer.GetType() == pb.Pointer_INLINE {
-		segment.Inline, err = encryption.Decrypt(pointer.InlineSegment, stream.info.EncryptionScheme.Cipher, contentKey, nonce)
+		segment.Inline, err = encryption.Decrypt(pointer.InlineSegment, stream.info.EncryptionScheme.Cipher, contentKey, &nonce)
 	} else {
 		segment.PieceID = storj.PieceID(pointer.Remote.PieceId)
 		segment.Pieces = make([]storj.Piece, 0, len(pointer.Remote.RemotePieces))
@@ -6,10 +6,15 @@ package miniogw
 import (
 	"context"
 	"errors"
+	"net"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
 	"os"
 
 	"github.com/minio/cli"
 	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/auth"
 	"github.com/vivint/infectious"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -66,6 +71,9 @@ type ClientConfig struct {
 	APIKey        string      `help:"API Key (TODO: this needs to change to macaroons somehow)"`
 	MaxInlineSize memory.Size `help:"max inline segment size in bytes" default:"4K"`
 	SegmentSize   memory.Size `help:"the size of a segment in bytes" default:"64M"`
+	Versioning    bool        `help:"keep the prior versions of objects in the buckets created through the gateway" default:"false"`
+
+	ParallelSegments int `help:"the number of segments of an object uploaded or downloaded at the same time, sharing the maximum buffer memory" default:"4"`
 }
 
 // ServerConfig determines how minio listens for requests
@@ -93,11 +101,18 @@ func (c Config) Run(ctx context.Context) (err error) {
 		return err
 	}
 
+	// minio serves the S3 API on a local address, behind the handler of
+	// the calls it doesn't route to the gateway
+	minioAddress, err := localAddress()
+	if err != nil {
+		return err
+	}
+
 	err = minio.RegisterGatewayCommand(cli.Command{
 		Name:  "storj",
 		Usage: "Storj",
 		Action: func(cliCtx *cli.Context) error {
-			return c.action(ctx, cliCtx, identity)
+			return c.action(ctx, cliCtx, identity, minioAddress)
 		},
 		HideHelpCommand: true,
 	})
@@ -116,11 +131,11 @@ func (c Config) Run(ctx context.Context) (err error) {
 	}
 
 	minio.Main([]string{"storj", "gateway", "storj",
-		"--address", c.Server.Address, "--config-dir", c.Minio.Dir, "--quiet"})
+		"--address", minioAddress, "--config-dir", c.Minio.Dir, "--quiet"})
 	return Error.New("unexpected minio exit")
 }
 
-func (c Config) action(ctx context.Context, cliCtx *cli.Context, identity *provider.FullIdentity) (err error) {
+func (c Config) action(ctx context.Context, cliCtx *cli.Context, identity *provider.FullIdentity, minioAddress string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	gw, err := c.NewGateway(ctx, identity)
@@ -128,10 +143,37 @@ func (c Config) action(ctx context.Context, cliCtx *cli.Context, identity *provi
 		return err
 	}
 
+	listener, err := net.Listen("tcp", c.Server.Address)
+	if err != nil {
+		return err
+	}
+
+	handler, err := NewHandler(gw,
+		auth.Credentials{AccessKey: c.Minio.AccessKey, SecretKey: c.Minio.SecretKey},
+		httputil.NewSingleHostReverseProxy(&url.URL{Scheme: "http", Host: minioAddress}),
+		zap.L())
+	if err != nil {
+		return errs.Combine(err, listener.Close())
+	}
+
+	go func() {
+		zap.S().Fatal(http.Serve(listener, handler))
+	}()
+
 	minio.StartGateway(cliCtx, Logging(gw, zap.L()))
 	return Error.New("unexpected minio exit")
 }
 
+// localAddress returns a free address on the loopback interface
+func localAddress() (string, error) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		return "", err
+	}
+	address := listener.Addr().String()
+	return address, listener.Close()
+}
+
 // GetMetainfo returns an implementation of storj.Metainfo
 func (c Config) GetMetainfo(ctx context.Context, identity *provider.FullIdentity) (db storj.Metainfo, ss streams.Store, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -157,7 +199,12 @@ func (c Config) GetMetainfo(ctx context.Context, identity *provider.FullIdentity
 		return nil, nil, Error.New("failed to connect to pointer DB: %v", err)
 	}
 
-	ec := ecclient.NewClient(identity, c.RS.MaxBufferMem.Int())
+	if c.Client.ParallelSegments <= 0 {
+		return nil, nil, Error.New("parallel segments must be larger than 0")
+	}
+
+	// the segments transferred at the same time share the buffer memory
+	ec := ecclient.NewClient(identity, c.RS.MaxBufferMem.Int()/c.Client.ParallelSegments)
 	fc, err := infectious.NewFEC(c.RS.MinThreshold, c.RS.MaxThreshold)
 	if err != nil {
 		return nil, nil, Error.New("failed to create erasure coding client: %v", err)
@@ -177,7 +224,7 @@ func (c Config) GetMetainfo(ctx context.Context, identity *provider.FullIdentity
 	key := new(storj.Key)
 	copy(key[:], c.Enc.Key)
 
-	streams, err := streams.NewStreamStore(segments, c.Client.SegmentSize.Int64(), key, c.Enc.BlockSize.Int(), storj.Cipher(c.Enc.DataType))
+	streams, err := streams.NewStreamStore(segments, c.Client.SegmentSize.Int64(), key, c.Enc.BlockSize.Int(), storj.Cipher(c.Enc.DataType), c.Client.ParallelSegments)
 	if err != nil {
 		return nil, nil, Error.New("failed to create stream store: %v", err)
 	}
@@ -215,5 +262,5 @@ func (c Config) NewGateway(ctx context.Context, identity *provider.FullIdentity)
 		return nil, err
 	}
 
-	return NewStorjGateway(metainfo, streams, storj.Cipher(c.Enc.PathType), c.GetEncryptionScheme(), c.GetRedundancyScheme()), nil
+	return NewStorjGateway(metainfo, streams, storj.Cipher(c.Enc.PathType), c.GetEncryptionScheme(), c.GetRedundancyScheme(), c.Client.Versioning), nil
 }
@@ -29,14 +29,14 @@ var (
 )
 
 // NewStorjGateway creates a *Storj object from an existing ObjectStore
-func NewStorjGateway(metainfo storj.Metainfo, streams streams.Store, pathCipher storj.Cipher, encryption storj.EncryptionScheme, redundancy storj.RedundancyScheme) *Gateway {
+func NewStorjGateway(metainfo storj.Metainfo, streams streams.Store, pathCipher storj.Cipher, encryption storj.EncryptionScheme, redundancy storj.RedundancyScheme, versioning bool) *Gateway {
 	return &Gateway{
 		metainfo:   metainfo,
 		streams:    streams,
 		pathCipher: pathCipher,
 		encryption: encryption,
 		redundancy: redundancy,
-		multipart:  NewMultipartUploads(),
+		versioning: versioning,
 	}
 }
 
@@ -47,7 +47,7 @@ type Gateway struct {
 	pathCipher storj.Cipher
 	encryption storj.EncryptionScheme
 	redundancy storj.RedundancyScheme
-	multipart  *MultipartUploads
+	versioning bool
 }
 
 // Name implements cmd.Gateway
@@ -82,6 +82,16 @@ func (layer *gatewayLayer) DeleteBucket(ctx context.Context, bucket string) (err
 		return minio.BucketNotEmpty{Bucket: bucket}
 	}
 
+	// the versions of deleted objects have to be deleted as well
+	list, err = layer.gateway.metainfo.ListObjects(ctx, bucket, storj.ListOptions{Direction: storj.After, Versions: true, Limit: 1})
+	if err != nil && !storj.ErrVersioningDisabled.Has(err) {
+		return convertError(err, bucket, "")
+	}
+
+	if len(list.Items) > 0 {
+		return minio.BucketNotEmpty{Bucket: bucket}
+	}
+
 	err = layer.gateway.metainfo.DeleteBucket(ctx, bucket)
 
 	return convertError(err, bucket, "")
@@ -109,8 +119,13 @@ func (layer *gatewayLayer) GetBucketInfo(ctx context.Context, bucket string) (bu
 
 func (layer *gatewayLayer) GetObject(ctx context.Context, bucket, object string, startOffset int64, length int64, writer io.Writer, etag string) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	return layer.getObject(ctx, bucket, object, "", startOffset, length, writer)
+}
+
+func (layer *gatewayLayer) getObject(ctx context.Context, bucket, object, version string, startOffset int64, length int64, writer io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	readOnlyStream, err := layer.gateway.metainfo.GetObjectStream(ctx, bucket, object)
+	readOnlyStream, err := layer.gateway.metainfo.GetObjectStream(ctx, bucket, object, version)
 	if err != nil {
 		return convertError(err, bucket, object)
 	}
@@ -143,7 +158,7 @@ func (layer *gatewayLayer) GetObject(ctx context.Context, bucket, object string,
 func (layer *gatewayLayer) GetObjectInfo(ctx context.Context, bucket, object string) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	obj, err := layer.gateway.metainfo.GetObject(ctx, bucket, object)
+	obj, err := layer.gateway.metainfo.GetObject(ctx, bucket, object, "")
 	if err != nil {
 		return minio.ObjectInfo{}, convertError(err, bucket, object)
 	}
@@ -333,7 +348,10 @@ func (layer *gatewayLayer) MakeBucketWithLocation(ctx context.Context, bucket st
 		return convertError(err, bucket, "")
 	}
 
-	_, err = layer.gateway.metainfo.CreateBucket(ctx, bucket, &storj.Bucket{PathCipher: layer.gateway.pathCipher})
+	_, err = layer.gateway.metainfo.CreateBucket(ctx, bucket, &storj.Bucket{
+		PathCipher: layer.gateway.pathCipher,
+		Versioning: layer.gateway.versioning,
+	})
 
 	return err
 }
@@ -341,24 +359,32 @@ func (layer *gatewayLayer) MakeBucketWithLocation(ctx context.Context, bucket st
 func (layer *gatewayLayer) CopyObject(ctx context.Context, srcBucket, srcObject, destBucket, destObject string, srcInfo minio.ObjectInfo) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	readOnlyStream, err := layer.gateway.metainfo.GetObjectStream(ctx, srcBucket, srcObject)
+	// the source is looked up first, so the errors of its bucket are told
+	// apart from the ones of the destination bucket
+	_, err = layer.gateway.metainfo.GetObject(ctx, srcBucket, srcObject, "")
 	if err != nil {
 		return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
 	}
 
-	download := stream.NewDownload(ctx, readOnlyStream, layer.gateway.streams)
-	defer utils.LogClose(download)
-
-	info := readOnlyStream.Info()
-	createInfo := storj.CreateObject{
-		ContentType:      info.ContentType,
-		Expires:          info.Expires,
-		Metadata:         info.Metadata,
-		RedundancyScheme: info.RedundancyScheme,
-		EncryptionScheme: info.EncryptionScheme,
+	info, err := layer.gateway.metainfo.CopyObject(ctx, srcBucket, srcObject, destBucket, destObject)
+	if err != nil {
+		// only the source object can be missing, the errors of missing
+		// buckets are object errors too
+		if storj.ErrObjectNotFound.Has(err) && !storj.ErrBucketNotFound.Has(err) {
+			return minio.ObjectInfo{}, convertError(err, srcBucket, srcObject)
+		}
+		return minio.ObjectInfo{}, convertError(err, destBucket, destObject)
 	}
 
-	return layer.putObject(ctx, destBucket, destObject, download, &createInfo)
+	return minio.ObjectInfo{
+		Name:        destObject,
+		Bucket:      destBucket,
+		ModTime:     info.Modified,
+		Size:        info.Size,
+		ETag:        hex.EncodeToString(info.Checksum),
+		ContentType: info.ContentType,
+		UserDefined: info.Metadata,
+	}, nil
 }
 
 func (layer *gatewayLayer) putObject(ctx context.Context, bucket, object string, reader io.Reader, createInfo *storj.CreateObject) (objInfo minio.ObjectInfo, err error) {
@@ -447,5 +473,9 @@ func convertError(err error, bucket, object string) error {
 		return minio.ObjectNotFound{Bucket: bucket, Object: object}
 	}
 
+	if storj.ErrVersioningDisabled.Has(err) {
+		return minio.NotImplemented{}
+	}
+
 	return err
 }
@@ -6,6 +6,7 @@ package miniogw
 import (
 	"bytes"
 	"context"
+	"crypto/sha256"
 	"encoding/hex"
 	"flag"
 	"fmt"
@@ -204,7 +205,7 @@ func TestPutObject(t *testing.T) {
 		}
 
 		// Check that the object is uploaded using the Metainfo API
-		obj, err := metainfo.GetObject(ctx, TestBucket, TestFile)
+		obj, err := metainfo.GetObject(ctx, TestBucket, TestFile, "")
 		if assert.NoError(t, err) {
 			assert.Equal(t, TestFile, obj.Path)
 			assert.Equal(t, TestBucket, obj.Bucket.Name)
@@ -382,7 +383,7 @@ func TestCopyObject(t *testing.T) {
 		}
 
 		// Check that the destination object is uploaded using the Metainfo API
-		obj, err = metainfo.GetObject(ctx, DestBucket, DestFile)
+		obj, err = metainfo.GetObject(ctx, DestBucket, DestFile, "")
 		if assert.NoError(t, err) {
 			assert.Equal(t, DestFile, obj.Path)
 			assert.Equal(t, DestBucket, obj.Bucket.Name)
@@ -396,6 +397,90 @@ func TestCopyObject(t *testing.T) {
 	})
 }
 
+func TestMultipartUpload(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
+		// Create the bucket using the Metainfo API
+		_, err := metainfo.CreateBucket(ctx, TestBucket, nil)
+		assert.NoError(t, err)
+
+		// Start two uploads to the same object using the Minio API
+		uploadID, err := layer.NewMultipartUpload(ctx, TestBucket, TestFile, map[string]string{"content-type": "text/plain", "key1": "value1"})
+		if !assert.NoError(t, err) {
+			return
+		}
+		otherID, err := layer.NewMultipartUpload(ctx, TestBucket, TestFile, nil)
+		if !assert.NoError(t, err) {
+			return
+		}
+		assert.NotEqual(t, uploadID, otherID)
+
+		// Check that both uploads are listed
+		uploads, err := layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "", 1000)
+		if assert.NoError(t, err) && assert.Len(t, uploads.Uploads, 2) {
+			for _, upload := range uploads.Uploads {
+				assert.Equal(t, TestFile, upload.Object)
+			}
+		}
+
+		// Check the error when using the upload ID with another object
+		_, err = layer.ListObjectParts(ctx, TestBucket, DestFile, uploadID, 0, 0)
+		assert.Equal(t, minio.InvalidUploadID{UploadID: uploadID}, err)
+
+		// Upload a remote and an inline part
+		part1 := bytes.Repeat([]byte("1"), int(20*memory.KB))
+		part2 := []byte("part two")
+		var completeParts []minio.CompletePart
+		for i, part := range [][]byte{part1, part2} {
+			sha := sha256.Sum256(part)
+			data, err := hash.NewReader(bytes.NewReader(part), int64(len(part)), "", hex.EncodeToString(sha[:]))
+			if !assert.NoError(t, err) {
+				return
+			}
+
+			info, err := layer.PutObjectPart(ctx, TestBucket, TestFile, uploadID, i+1, data)
+			if !assert.NoError(t, err) {
+				return
+			}
+			assert.Equal(t, int64(len(part)), info.Size)
+
+			completeParts = append(completeParts, minio.CompletePart{PartNumber: info.PartNumber, ETag: info.ETag})
+		}
+
+		// Complete the upload and check that the parts were concatenated
+		info, err := layer.CompleteMultipartUpload(ctx, TestBucket, TestFile, uploadID, completeParts)
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(len(part1)+len(part2)), info.Size)
+			assert.Equal(t, "text/plain", info.ContentType)
+			assert.Equal(t, map[string]string{"key1": "value1"}, info.UserDefined)
+		}
+
+		var buf bytes.Buffer
+		err = layer.GetObject(ctx, TestBucket, TestFile, 0, -1, &buf, "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, append(append([]byte{}, part1...), part2...), buf.Bytes())
+		}
+
+		// Read across the boundary of the parts
+		buf.Reset()
+		err = layer.GetObject(ctx, TestBucket, TestFile, int64(len(part1))-2, 6, &buf, "")
+		if assert.NoError(t, err) {
+			assert.Equal(t, "11part", buf.String())
+		}
+
+		// Check that only the other upload is left
+		uploads, err = layer.ListMultipartUploads(ctx, TestBucket, "", "", "", "", 1000)
+		if assert.NoError(t, err) && assert.Len(t, uploads.Uploads, 1) {
+			assert.Equal(t, otherID, uploads.Uploads[0].UploadID)
+		}
+
+		_, err = layer.ListObjectParts(ctx, TestBucket, TestFile, uploadID, 0, 0)
+		assert.Equal(t, minio.InvalidUploadID{UploadID: uploadID}, err)
+
+		err = layer.AbortMultipartUpload(ctx, TestBucket, TestFile, otherID)
+		assert.NoError(t, err)
+	})
+}
+
 func TestDeleteObject(t *testing.T) {
 	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
 		// Check the error when deleting an object from a bucket with empty name
@@ -427,7 +512,7 @@ func TestDeleteObject(t *testing.T) {
 		assert.NoError(t, err)
 
 		// Check that the object is deleted using the Metainfo API
-		_, err = metainfo.GetObject(ctx, TestBucket, TestFile)
+		_, err = metainfo.GetObject(ctx, TestBucket, TestFile, "")
 		assert.True(t, storj.ErrObjectNotFound.Has(err))
 	})
 }
@@ -646,6 +731,9 @@ func runTest(t *testing.T, test func(context.Context, minio.ObjectLayer, storj.M
 
 	planet.Start(ctx)
 
+	// we wait for the satellite to discover the storage nodes
+	time.Sleep(2 * time.Second)
+
 	layer, metainfo, streams, err := initEnv(planet)
 	if !assert.NoError(t, err) {
 		return
@@ -687,7 +775,7 @@ func initEnv(planet *testplanet.Planet) (minio.ObjectLayer, storj.Metainfo, stre
 	key := new(storj.Key)
 	copy(key[:], TestEncKey)
 
-	streams, err := streams.NewStreamStore(segments, int64(64*memory.MB), key, int(1*memory.KB), storj.AESGCM)
+	streams, err := streams.NewStreamStore(segments, int64(64*memory.MB), key, int(1*memory.KB), storj.AESGCM, 1)
 	if err != nil {
 		return nil, nil, nil, err
 	}
@@ -712,6 +800,7 @@ func initEnv(planet *testplanet.Planet) (minio.ObjectLayer, storj.Metainfo, stre
 			TotalShares:    int16(rs.TotalCount()),
 			ShareSize:      int32(rs.ErasureShareSize()),
 		},
+		false,
 	)
 
 	layer, err := gateway.NewGatewayLayer(auth.Credentials{})
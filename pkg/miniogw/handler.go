@@ -0,0 +1,533 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"encoding/xml"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/auth"
+	"go.uber.org/zap"
+)
+
+// versioningLayer is the part of the gateway layer serving the S3
+// versioning API
+type versioningLayer interface {
+	ListObjectVersions(ctx context.Context, bucket, prefix, keyMarker, versionIDMarker string, maxKeys int) (result ListObjectVersionsInfo, err error)
+	GetObjectVersion(ctx context.Context, bucket, object, versionID string, startOffset int64, length int64, writer io.Writer) error
+	GetObjectVersionInfo(ctx context.Context, bucket, object, versionID string) (objInfo ObjectVersionInfo, err error)
+	DeleteObjectVersion(ctx context.Context, bucket, object, versionID string) error
+}
+
+// lifecycleLayer is the part of the gateway layer serving the S3 lifecycle
+// API
+type lifecycleLayer interface {
+	SetBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) error
+	GetBucketLifecycle(ctx context.Context, bucket string) (rules []LifecycleRule, err error)
+	DeleteBucketLifecycle(ctx context.Context, bucket string) error
+}
+
+// extendedLayer is the part of the gateway layer serving the calls which
+// minio doesn't route
+type extendedLayer interface {
+	versioningLayer
+	lifecycleLayer
+}
+
+// Handler serves the calls of the S3 API which the minio release used by
+// the gateway doesn't route to the gateway layer, and passes every other
+// request on to minio
+type Handler struct {
+	layer extendedLayer
+	creds auth.Credentials
+	next  http.Handler
+	log   *zap.Logger
+	now   func() time.Time
+}
+
+// NewHandler returns a handler serving the versioning and lifecycle APIs of
+// the gateway with the credentials of minio, in front of the minio handler
+// next
+func NewHandler(gateway minio.Gateway, creds auth.Credentials, next http.Handler, log *zap.Logger) (*Handler, error) {
+	layer, err := gateway.NewGatewayLayer(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	extended, ok := layer.(extendedLayer)
+	if !ok {
+		return nil, Error.New("gateway %q has no versioning or lifecycle API", gateway.Name())
+	}
+
+	return newHandler(extended, creds, next, log), nil
+}
+
+func newHandler(layer extendedLayer, creds auth.Credentials, next http.Handler, log *zap.Logger) *Handler {
+	return &Handler{
+		layer: layer,
+		creds: creds,
+		next:  next,
+		log:   log,
+		now:   time.Now,
+	}
+}
+
+// ServeHTTP implements http.Handler
+func (handler *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	bucket, object := splitRequestPath(r.URL.Path)
+	query := r.URL.Query()
+
+	var serve func(ctx context.Context, w http.ResponseWriter, r *http.Request, bucket, object string) error
+	switch {
+	case bucket != "" && object == "" && r.Method == http.MethodGet && hasQuery(query, "versions"):
+		serve = handler.listObjectVersions
+	case bucket != "" && object == "" && hasQuery(query, "lifecycle"):
+		switch r.Method {
+		case http.MethodGet:
+			serve = handler.getBucketLifecycle
+		case http.MethodPut:
+			serve = handler.putBucketLifecycle
+		case http.MethodDelete:
+			serve = handler.deleteBucketLifecycle
+		}
+	case bucket != "" && object != "" && hasQuery(query, "versionId"):
+		switch r.Method {
+		case http.MethodGet:
+			serve = handler.getObjectVersion
+		case http.MethodHead:
+			serve = handler.headObjectVersion
+		case http.MethodDelete:
+			serve = handler.deleteObjectVersion
+		}
+	}
+
+	if serve == nil {
+		handler.next.ServeHTTP(w, r)
+		return
+	}
+
+	err := verifySignature(r, handler.creds, handler.now())
+	if err == nil {
+		err = serve(r.Context(), w, r, bucket, object)
+	}
+	if err != nil {
+		handler.writeError(w, r, err)
+	}
+}
+
+func (handler *Handler) listObjectVersions(ctx context.Context, w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	query := r.URL.Query()
+
+	maxKeys := 1000
+	if value := query.Get("max-keys"); value != "" {
+		maxKeys, err = strconv.Atoi(value)
+		if err != nil || maxKeys < 0 {
+			return errInvalidMaxKeys
+		}
+	}
+
+	result, err := handler.layer.ListObjectVersions(ctx, bucket, query.Get("prefix"), query.Get("key-marker"), query.Get("version-id-marker"), maxKeys)
+	if err != nil {
+		return err
+	}
+
+	response := listVersionsResponse{
+		Name:                bucket,
+		Prefix:              query.Get("prefix"),
+		KeyMarker:           query.Get("key-marker"),
+		VersionIDMarker:     query.Get("version-id-marker"),
+		NextKeyMarker:       result.NextKeyMarker,
+		NextVersionIDMarker: result.NextVersionIDMarker,
+		MaxKeys:             maxKeys,
+		IsTruncated:         result.IsTruncated,
+	}
+	for _, version := range result.Versions {
+		response.Versions = append(response.Versions, objectVersion{
+			Key:          version.Name,
+			VersionID:    version.VersionID,
+			IsLatest:     version.IsLatest,
+			LastModified: version.ModTime.UTC().Format(time.RFC3339),
+			ETag:         `"` + version.ETag + `"`,
+			Size:         version.Size,
+			StorageClass: "STANDARD",
+		})
+	}
+
+	return writeXML(w, http.StatusOK, response)
+}
+
+func (handler *Handler) headObjectVersion(ctx context.Context, w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := handler.layer.GetObjectVersionInfo(ctx, bucket, object, r.URL.Query().Get("versionId"))
+	if err != nil {
+		return err
+	}
+
+	setObjectHeaders(w, info)
+	w.Header().Set("Content-Length", strconv.FormatInt(info.Size, 10))
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (handler *Handler) getObjectVersion(ctx context.Context, w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versionID := r.URL.Query().Get("versionId")
+
+	info, err := handler.layer.GetObjectVersionInfo(ctx, bucket, object, versionID)
+	if err != nil {
+		return err
+	}
+
+	offset, length, err := parseRange(r.Header.Get("Range"), info.Size)
+	if err != nil {
+		return err
+	}
+
+	setObjectHeaders(w, info)
+	w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+	status := http.StatusOK
+	if length != info.Size {
+		w.Header().Set("Content-Range", "bytes "+strconv.FormatInt(offset, 10)+"-"+strconv.FormatInt(offset+length-1, 10)+"/"+strconv.FormatInt(info.Size, 10))
+		status = http.StatusPartialContent
+	}
+	w.WriteHeader(status)
+
+	if length == 0 {
+		return nil
+	}
+
+	// the status is sent already, errors can only be logged
+	err = handler.layer.GetObjectVersion(ctx, bucket, object, versionID, offset, length, w)
+	if err != nil {
+		handler.log.Error("gateway error:", zap.Error(err))
+	}
+	return nil
+}
+
+func (handler *Handler) deleteObjectVersion(ctx context.Context, w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versionID := r.URL.Query().Get("versionId")
+
+	err = handler.layer.DeleteObjectVersion(ctx, bucket, object, versionID)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("x-amz-version-id", versionID)
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+func (handler *Handler) getBucketLifecycle(ctx context.Context, w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rules, err := handler.layer.GetBucketLifecycle(ctx, bucket)
+	if err != nil {
+		return err
+	}
+	if len(rules) == 0 {
+		return errNoSuchLifecycleConfiguration
+	}
+
+	var response lifecycleConfiguration
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, lifecycleRule{
+			Filter:     &lifecycleFilter{Prefix: rule.Prefix},
+			Status:     "Enabled",
+			Expiration: &lifecycleExpiration{Days: rule.ExpirationDays},
+		})
+	}
+
+	return writeXML(w, http.StatusOK, response)
+}
+
+func (handler *Handler) putBucketLifecycle(ctx context.Context, w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var request lifecycleConfiguration
+	err = xml.NewDecoder(io.LimitReader(r.Body, maxSignedBodySize)).Decode(&request)
+	if err != nil {
+		return errMalformedXML
+	}
+
+	var rules []LifecycleRule
+	for _, rule := range request.Rules {
+		if rule.Status != "Enabled" {
+			continue
+		}
+		// only expirations after a number of days are supported
+		if rule.Expiration == nil || rule.Expiration.Days <= 0 {
+			return errNotImplemented
+		}
+
+		prefix := rule.Prefix
+		if rule.Filter != nil {
+			prefix = rule.Filter.Prefix
+		}
+		rules = append(rules, LifecycleRule{
+			Prefix:         prefix,
+			ExpirationDays: rule.Expiration.Days,
+		})
+	}
+
+	err = handler.layer.SetBucketLifecycle(ctx, bucket, rules)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusOK)
+	return nil
+}
+
+func (handler *Handler) deleteBucketLifecycle(ctx context.Context, w http.ResponseWriter, r *http.Request, bucket, object string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = handler.layer.DeleteBucketLifecycle(ctx, bucket)
+	if err != nil {
+		return err
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+	return nil
+}
+
+// writeError writes the S3 error response matching err
+func (handler *Handler) writeError(w http.ResponseWriter, r *http.Request, err error) {
+	apiErr, ok := err.(apiError)
+	if !ok {
+		apiErr = toAPIError(err, r.URL.Query().Get("versionId") != "")
+		if apiErr == errInternal {
+			handler.log.Error("gateway error:", zap.Error(err))
+		}
+	}
+
+	if r.Method == http.MethodHead {
+		w.WriteHeader(apiErr.StatusCode)
+		return
+	}
+
+	_ = writeXML(w, apiErr.StatusCode, errorResponse{
+		Code:     apiErr.Code,
+		Message:  apiErr.Message,
+		Resource: r.URL.Path,
+	})
+}
+
+// apiError is an error of the S3 API
+type apiError struct {
+	Code       string
+	Message    string
+	StatusCode int
+}
+
+func (err apiError) Error() string { return err.Code + ": " + err.Message }
+
+var (
+	errAccessDenied                 = apiError{"AccessDenied", "Access Denied.", http.StatusForbidden}
+	errInvalidAccessKeyID           = apiError{"InvalidAccessKeyId", "The access key ID you provided does not exist in our records.", http.StatusForbidden}
+	errSignatureDoesNotMatch        = apiError{"SignatureDoesNotMatch", "The request signature we calculated does not match the signature you provided.", http.StatusForbidden}
+	errRequestTimeTooSkewed         = apiError{"RequestTimeTooSkewed", "The difference between the request time and the server's time is too large.", http.StatusForbidden}
+	errContentSHA256Mismatch        = apiError{"XAmzContentSHA256Mismatch", "The provided 'x-amz-content-sha256' header does not match what was computed.", http.StatusBadRequest}
+	errInvalidMaxKeys               = apiError{"InvalidArgument", "Argument maxKeys must be an integer between 0 and 2147483647.", http.StatusBadRequest}
+	errMalformedXML                 = apiError{"MalformedXML", "The XML you provided was not well-formed or did not validate against our published schema.", http.StatusBadRequest}
+	errInvalidRange                 = apiError{"InvalidRange", "The requested range is not satisfiable.", http.StatusRequestedRangeNotSatisfiable}
+	errNoSuchBucket                 = apiError{"NoSuchBucket", "The specified bucket does not exist.", http.StatusNotFound}
+	errNoSuchKey                    = apiError{"NoSuchKey", "The specified key does not exist.", http.StatusNotFound}
+	errNoSuchVersion                = apiError{"NoSuchVersion", "The specified version does not exist.", http.StatusNotFound}
+	errNoSuchLifecycleConfiguration = apiError{"NoSuchLifecycleConfiguration", "The lifecycle configuration does not exist.", http.StatusNotFound}
+	errInvalidBucketName            = apiError{"InvalidBucketName", "The specified bucket is not valid.", http.StatusBadRequest}
+	errInvalidObjectName            = apiError{"XMinioInvalidObjectName", "Object name contains unsupported characters.", http.StatusBadRequest}
+	errNotImplemented               = apiError{"NotImplemented", "A header you provided implies functionality that is not implemented.", http.StatusNotImplemented}
+	errInternal                     = apiError{"InternalError", "We encountered an internal error, please try again.", http.StatusInternalServerError}
+)
+
+// toAPIError converts the errors of the gateway layer to S3 errors
+func toAPIError(err error, version bool) apiError {
+	switch err.(type) {
+	case minio.BucketNotFound:
+		return errNoSuchBucket
+	case minio.BucketNameInvalid:
+		return errInvalidBucketName
+	case minio.ObjectNotFound:
+		if version {
+			return errNoSuchVersion
+		}
+		return errNoSuchKey
+	case minio.ObjectNameInvalid:
+		return errInvalidObjectName
+	case minio.NotImplemented:
+		return errNotImplemented
+	}
+	return errInternal
+}
+
+// listVersionsResponse is the response of ListObjectVersions
+type listVersionsResponse struct {
+	XMLName xml.Name `xml:"http://s3.amazonaws.com/doc/2006-03-01/ ListVersionsResult"`
+
+	Name                string
+	Prefix              string
+	KeyMarker           string
+	VersionIDMarker     string `xml:"VersionIdMarker"`
+	NextKeyMarker       string `xml:",omitempty"`
+	NextVersionIDMarker string `xml:"NextVersionIdMarker,omitempty"`
+	MaxKeys             int
+	IsTruncated         bool
+
+	Versions []objectVersion `xml:"Version"`
+}
+
+// objectVersion is a version listed by ListObjectVersions
+type objectVersion struct {
+	Key          string
+	VersionID    string `xml:"VersionId"`
+	IsLatest     bool
+	LastModified string
+	ETag         string
+	Size         int64
+	StorageClass string
+}
+
+// lifecycleConfiguration is the body of PutBucketLifecycle and the response
+// of GetBucketLifecycle
+type lifecycleConfiguration struct {
+	XMLName xml.Name `xml:"LifecycleConfiguration"`
+
+	Rules []lifecycleRule `xml:"Rule"`
+}
+
+// lifecycleRule is a rule of a lifecycle configuration
+type lifecycleRule struct {
+	ID         string           `xml:",omitempty"`
+	Prefix     string           `xml:",omitempty"`
+	Filter     *lifecycleFilter `xml:",omitempty"`
+	Status     string
+	Expiration *lifecycleExpiration `xml:",omitempty"`
+}
+
+// lifecycleFilter selects the objects a lifecycle rule applies to
+type lifecycleFilter struct {
+	Prefix string
+}
+
+// lifecycleExpiration is the expiration of a lifecycle rule
+type lifecycleExpiration struct {
+	Days int    `xml:",omitempty"`
+	Date string `xml:",omitempty"`
+}
+
+// errorResponse is the body of S3 error responses
+type errorResponse struct {
+	XMLName xml.Name `xml:"Error"`
+
+	Code     string
+	Message  string
+	Resource string
+}
+
+// writeXML writes an XML response with the status
+func writeXML(w http.ResponseWriter, status int, response interface{}) error {
+	data, err := xml.Marshal(response)
+	if err != nil {
+		return err
+	}
+
+	w.Header().Set("Content-Type", "application/xml")
+	w.Header().Set("Content-Length", strconv.Itoa(len(xml.Header)+len(data)))
+	w.WriteHeader(status)
+	_, err = io.WriteString(w, xml.Header)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// setObjectHeaders sets the headers describing a version of an object
+func setObjectHeaders(w http.ResponseWriter, info ObjectVersionInfo) {
+	header := w.Header()
+	header.Set("Last-Modified", info.ModTime.UTC().Format(http.TimeFormat))
+	header.Set("ETag", `"`+info.ETag+`"`)
+	header.Set("Accept-Ranges", "bytes")
+	header.Set("x-amz-version-id", info.VersionID)
+	if info.ContentType != "" {
+		header.Set("Content-Type", info.ContentType)
+	}
+	for key, value := range info.UserDefined {
+		header.Set("x-amz-meta-"+key, value)
+	}
+}
+
+// parseRange returns the offset and length of the bytes of an object of the
+// given size selected by the Range header
+func parseRange(header string, size int64) (offset, length int64, err error) {
+	if header == "" {
+		return 0, size, nil
+	}
+
+	spec := strings.TrimPrefix(header, "bytes=")
+	dash := strings.Index(spec, "-")
+	if spec == header || dash < 0 || strings.Contains(spec, ",") {
+		return 0, 0, errInvalidRange
+	}
+
+	start, end := spec[:dash], spec[dash+1:]
+	switch {
+	case start == "":
+		// the last bytes of the object
+		suffix, err := strconv.ParseInt(end, 10, 64)
+		if err != nil || suffix <= 0 {
+			return 0, 0, errInvalidRange
+		}
+		if suffix > size {
+			suffix = size
+		}
+		return size - suffix, suffix, nil
+	default:
+		offset, err = strconv.ParseInt(start, 10, 64)
+		if err != nil || offset < 0 || offset >= size {
+			return 0, 0, errInvalidRange
+		}
+		last := size - 1
+		if end != "" {
+			last, err = strconv.ParseInt(end, 10, 64)
+			if err != nil || last < offset {
+				return 0, 0, errInvalidRange
+			}
+			if last >= size {
+				last = size - 1
+			}
+		}
+		return offset, last - offset + 1, nil
+	}
+}
+
+// splitRequestPath returns the bucket and the object of a path-style request
+func splitRequestPath(path string) (bucket, object string) {
+	path = strings.TrimPrefix(path, "/")
+	split := strings.Index(path, "/")
+	if split < 0 {
+		return path, ""
+	}
+	return path[:split], path[split+1:]
+}
+
+// hasQuery returns whether the query has the parameter, with or without a
+// value
+func hasQuery(query url.Values, key string) bool {
+	_, ok := query[key]
+	return ok
+}
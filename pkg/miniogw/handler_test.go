@@ -0,0 +1,227 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/xml"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/minio/minio-go/pkg/s3signer"
+	minio "github.com/minio/minio/cmd"
+	"github.com/minio/minio/pkg/auth"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap/zaptest"
+
+	"storj.io/storj/pkg/storage/streams"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestHandlerVersions(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
+		creds := auth.Credentials{AccessKey: "access-key", SecretKey: "secret-key"}
+
+		// the other calls are passed on to minio
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+
+		server := httptest.NewServer(newHandler(layer.(extendedLayer), creds, next, zaptest.NewLogger(t)))
+		defer server.Close()
+
+		do := func(method, path string, header http.Header, sign bool) (*http.Response, []byte) {
+			return doRequest(t, server.URL, creds, method, path, header, "", sign)
+		}
+
+		_, err := metainfo.CreateBucket(ctx, TestBucket, &storj.Bucket{PathCipher: storj.AESGCM, Versioning: true})
+		require.NoError(t, err)
+
+		first, err := createFile(ctx, metainfo, streams, TestBucket, TestFile, nil, []byte("first"))
+		require.NoError(t, err)
+		second, err := createFile(ctx, metainfo, streams, TestBucket, TestFile, nil, []byte("second"))
+		require.NoError(t, err)
+		require.NotEqual(t, first.VersionID, second.VersionID)
+
+		resp, _ := do(http.MethodGet, "/"+TestBucket+"/"+TestFile, nil, true)
+		assert.Equal(t, http.StatusTeapot, resp.StatusCode)
+
+		resp, _ = do(http.MethodGet, "/"+TestBucket+"?versions", nil, false)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp, body := do(http.MethodGet, "/"+TestBucket+"?versions", nil, true)
+		if assert.Equal(t, http.StatusOK, resp.StatusCode, string(body)) {
+			var list listVersionsResponse
+			require.NoError(t, xml.Unmarshal(body, &list))
+			assert.Equal(t, TestBucket, list.Name)
+			assert.False(t, list.IsTruncated)
+			// the order of versions with encrypted paths is arbitrary
+			latest := make(map[string]bool)
+			for _, version := range list.Versions {
+				assert.Equal(t, TestFile, version.Key)
+				latest[version.VersionID] = version.IsLatest
+			}
+			assert.Equal(t, map[string]bool{first.VersionID: false, second.VersionID: true}, latest)
+		}
+
+		resp, body = do(http.MethodGet, "/"+TestBucket+"/"+TestFile+"?versionId="+first.VersionID, nil, true)
+		if assert.Equal(t, http.StatusOK, resp.StatusCode, string(body)) {
+			assert.Equal(t, "first", string(body))
+			assert.Equal(t, first.VersionID, resp.Header.Get("x-amz-version-id"))
+		}
+
+		resp, body = do(http.MethodGet, "/"+TestBucket+"/"+TestFile+"?versionId="+second.VersionID, http.Header{"Range": {"bytes=1-3"}}, true)
+		if assert.Equal(t, http.StatusPartialContent, resp.StatusCode, string(body)) {
+			assert.Equal(t, "eco", string(body))
+			assert.Equal(t, "bytes 1-3/6", resp.Header.Get("Content-Range"))
+		}
+
+		resp, _ = do(http.MethodHead, "/"+TestBucket+"/"+TestFile+"?versionId="+first.VersionID, nil, true)
+		if assert.Equal(t, http.StatusOK, resp.StatusCode) {
+			assert.Equal(t, "5", resp.Header.Get("Content-Length"))
+		}
+
+		resp, _ = do(http.MethodDelete, "/"+TestBucket+"/"+TestFile+"?versionId="+first.VersionID, nil, true)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		resp, body = do(http.MethodGet, "/"+TestBucket+"/"+TestFile+"?versionId="+first.VersionID, nil, true)
+		if assert.Equal(t, http.StatusNotFound, resp.StatusCode) {
+			var apiErr errorResponse
+			require.NoError(t, xml.Unmarshal(body, &apiErr))
+			assert.Equal(t, "NoSuchVersion", apiErr.Code)
+		}
+
+		// the current object is still there
+		_, err = metainfo.GetObject(ctx, TestBucket, TestFile, "")
+		assert.NoError(t, err)
+	})
+}
+
+func TestHandlerLifecycle(t *testing.T) {
+	runTest(t, func(ctx context.Context, layer minio.ObjectLayer, metainfo storj.Metainfo, streams streams.Store) {
+		creds := auth.Credentials{AccessKey: "access-key", SecretKey: "secret-key"}
+
+		next := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusTeapot)
+		})
+
+		server := httptest.NewServer(newHandler(layer.(extendedLayer), creds, next, zaptest.NewLogger(t)))
+		defer server.Close()
+
+		do := func(method, body string, sign bool) (*http.Response, []byte) {
+			return doRequest(t, server.URL, creds, method, "/"+TestBucket+"?lifecycle", nil, body, sign)
+		}
+
+		_, err := metainfo.CreateBucket(ctx, TestBucket, nil)
+		require.NoError(t, err)
+
+		resp, body := do(http.MethodGet, "", true)
+		if assert.Equal(t, http.StatusNotFound, resp.StatusCode) {
+			var apiErr errorResponse
+			require.NoError(t, xml.Unmarshal(body, &apiErr))
+			assert.Equal(t, "NoSuchLifecycleConfiguration", apiErr.Code)
+		}
+
+		configuration := `<LifecycleConfiguration>` +
+			`<Rule><ID>logs</ID><Filter><Prefix>logs/</Prefix></Filter><Status>Enabled</Status><Expiration><Days>7</Days></Expiration></Rule>` +
+			`<Rule><ID>tmp</ID><Prefix>tmp/</Prefix><Status>Enabled</Status><Expiration><Days>1</Days></Expiration></Rule>` +
+			`<Rule><ID>old</ID><Prefix>old/</Prefix><Status>Disabled</Status><Expiration><Days>1</Days></Expiration></Rule>` +
+			`</LifecycleConfiguration>`
+
+		resp, _ = do(http.MethodPut, configuration, false)
+		assert.Equal(t, http.StatusForbidden, resp.StatusCode)
+
+		resp, body = do(http.MethodPut, configuration, true)
+		assert.Equal(t, http.StatusOK, resp.StatusCode, string(body))
+
+		bucket, err := metainfo.GetBucket(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Equal(t, []storj.ExpirationRule{
+			{Prefix: "logs/", TTL: 7 * day},
+			{Prefix: "tmp/", TTL: day},
+		}, bucket.ExpirationRules)
+
+		resp, body = do(http.MethodGet, "", true)
+		if assert.Equal(t, http.StatusOK, resp.StatusCode, string(body)) {
+			var response lifecycleConfiguration
+			require.NoError(t, xml.Unmarshal(body, &response))
+			if assert.Len(t, response.Rules, 2) {
+				assert.Equal(t, "logs/", response.Rules[0].Filter.Prefix)
+				assert.Equal(t, 7, response.Rules[0].Expiration.Days)
+				assert.Equal(t, "tmp/", response.Rules[1].Filter.Prefix)
+				assert.Equal(t, 1, response.Rules[1].Expiration.Days)
+			}
+		}
+
+		resp, _ = do(http.MethodPut, `<LifecycleConfiguration><Rule><Status>Enabled</Status><Expiration><Date>2020-01-01T00:00:00Z</Date></Expiration></Rule></LifecycleConfiguration>`, true)
+		assert.Equal(t, http.StatusNotImplemented, resp.StatusCode)
+
+		resp, _ = do(http.MethodPut, `<LifecycleConfiguration>`, true)
+		assert.Equal(t, http.StatusBadRequest, resp.StatusCode)
+
+		resp, _ = do(http.MethodDelete, "", true)
+		assert.Equal(t, http.StatusNoContent, resp.StatusCode)
+
+		bucket, err = metainfo.GetBucket(ctx, TestBucket)
+		require.NoError(t, err)
+		assert.Empty(t, bucket.ExpirationRules)
+	})
+}
+
+// doRequest sends a request to the handler served at url, signed with creds
+// if sign is set, and returns the response with its body
+func doRequest(t *testing.T, url string, creds auth.Credentials, method, path string, header http.Header, body string, sign bool) (*http.Response, []byte) {
+	req, err := http.NewRequest(method, url+path, strings.NewReader(body))
+	require.NoError(t, err)
+	for key, values := range header {
+		req.Header[key] = values
+	}
+	if sign {
+		sum := sha256.Sum256([]byte(body))
+		req.Header.Set("X-Amz-Content-Sha256", hex.EncodeToString(sum[:]))
+		req = s3signer.SignV4(*req, creds.AccessKey, creds.SecretKey, "", "us-east-1")
+	}
+
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(resp.Body)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	return resp, data
+}
+
+func TestParseRange(t *testing.T) {
+	for _, test := range []struct {
+		header         string
+		offset, length int64
+		err            bool
+	}{
+		{header: "", offset: 0, length: 10},
+		{header: "bytes=0-", offset: 0, length: 10},
+		{header: "bytes=2-4", offset: 2, length: 3},
+		{header: "bytes=5-100", offset: 5, length: 5},
+		{header: "bytes=-3", offset: 7, length: 3},
+		{header: "bytes=-30", offset: 0, length: 10},
+		{header: "bytes=10-", err: true},
+		{header: "bytes=4-2", err: true},
+		{header: "bytes=0-1,3-4", err: true},
+		{header: "items=0-1", err: true},
+	} {
+		offset, length, err := parseRange(test.header, 10)
+		if test.err {
+			assert.Error(t, err, test.header)
+			continue
+		}
+		if assert.NoError(t, err, test.header) {
+			assert.Equal(t, test.offset, offset, test.header)
+			assert.Equal(t, test.length, length, test.header)
+		}
+	}
+}
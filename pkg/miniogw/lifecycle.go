@@ -0,0 +1,76 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"time"
+
+	minio "github.com/minio/minio/cmd"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// The object layer of the minio release used by the gateway has no calls for
+// the S3 lifecycle API either. The methods below implement the expiration
+// rules of the PutBucketLifecycle, GetBucketLifecycle and
+// DeleteBucketLifecycle calls, which Handler routes to them and which the
+// satellite applies to the objects created after them.
+
+const day = 24 * time.Hour
+
+// LifecycleRule makes the objects created below a prefix of a bucket expire
+// after a number of days
+type LifecycleRule struct {
+	Prefix         string
+	ExpirationDays int
+}
+
+// SetBucketLifecycle replaces the lifecycle rules of a bucket
+func (layer *gatewayLayer) SetBucketLifecycle(ctx context.Context, bucket string, rules []LifecycleRule) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	expirationRules := make([]storj.ExpirationRule, 0, len(rules))
+	for _, rule := range rules {
+		if rule.ExpirationDays <= 0 {
+			return minio.NotImplemented{}
+		}
+		expirationRules = append(expirationRules, storj.ExpirationRule{
+			Prefix: rule.Prefix,
+			TTL:    time.Duration(rule.ExpirationDays) * day,
+		})
+	}
+
+	_, err = layer.gateway.metainfo.SetBucketExpirationRules(ctx, bucket, expirationRules)
+
+	return convertError(err, bucket, "")
+}
+
+// GetBucketLifecycle returns the lifecycle rules of a bucket
+func (layer *gatewayLayer) GetBucketLifecycle(ctx context.Context, bucket string) (rules []LifecycleRule, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	info, err := layer.gateway.metainfo.GetBucket(ctx, bucket)
+	if err != nil {
+		return nil, convertError(err, bucket, "")
+	}
+
+	for _, rule := range info.ExpirationRules {
+		rules = append(rules, LifecycleRule{
+			Prefix:         rule.Prefix,
+			ExpirationDays: int(rule.TTL / day),
+		})
+	}
+
+	return rules, nil
+}
+
+// DeleteBucketLifecycle removes the lifecycle rules of a bucket
+func (layer *gatewayLayer) DeleteBucketLifecycle(ctx context.Context, bucket string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = layer.gateway.metainfo.SetBucketExpirationRules(ctx, bucket, nil)
+
+	return convertError(err, bucket, "")
+}
@@ -4,133 +4,193 @@
 package miniogw
 
 import (
+	"bytes"
 	"context"
-	"io"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
 	"sort"
 	"strconv"
-	"sync"
-	"sync/atomic"
+	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	minio "github.com/minio/minio/cmd"
 	"github.com/minio/minio/pkg/hash"
 
+	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storj"
 )
 
+// Pending multipart uploads are kept as uncommitted streams in the bucket,
+// so they survive restarts of the gateway. Every upload is stored at
+// multipartPrefix/<upload ID> and holds the object key and the user
+// metadata, so any number of uploads to the same key can be in progress.
+// Every part is stored as its own stream under multipartPrefix/<upload ID>/.
+const (
+	// multipartPrefix is the prefix of the pending paths holding the uploads
+	multipartPrefix = ".multipart"
+	// uploadIDKey is the metadata key holding the ID of a multipart upload
+	uploadIDKey = "x-storj-multipart-upload-id"
+	// objectKeyKey is the metadata key holding the object of an upload
+	objectKeyKey = "x-storj-multipart-object"
+	// partETagKey is the metadata key holding the ETag of a part
+	partETagKey = "etag"
+)
+
 func (layer *gatewayLayer) NewMultipartUpload(ctx context.Context, bucket, object string, metadata map[string]string) (uploadID string, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	// Check that the bucket exists
-	_, err = layer.gateway.metainfo.GetBucket(ctx, bucket)
+	bucketInfo, err := layer.gateway.metainfo.GetBucket(ctx, bucket)
 	if err != nil {
 		return "", convertError(err, bucket, "")
 	}
 
-	uploads := layer.gateway.multipart
-
-	upload, err := uploads.Create(bucket, object, metadata)
+	var id [16]byte
+	_, err = rand.Read(id[:])
 	if err != nil {
 		return "", err
 	}
+	uploadID = hex.EncodeToString(id[:])
 
-	go func() {
-		contentType := metadata["content-type"]
-		delete(metadata, "content-type")
-
-		createInfo := storj.CreateObject{
-			ContentType:      contentType,
-			Metadata:         metadata,
-			RedundancyScheme: layer.gateway.redundancy,
-			EncryptionScheme: layer.gateway.encryption,
+	userDefined := make(map[string]string, len(metadata)+1)
+	for key, value := range metadata {
+		if key != "content-type" {
+			userDefined[key] = value
 		}
-		objInfo, err := layer.putObject(ctx, bucket, object, upload.Stream, &createInfo)
+	}
+	userDefined[uploadIDKey] = uploadID
+	userDefined[objectKeyKey] = object
 
-		uploads.RemoveByID(upload.ID)
+	serMetaInfo, err := proto.Marshal(&pb.SerializableMeta{
+		ContentType: metadata["content-type"],
+		UserDefined: userDefined,
+	})
+	if err != nil {
+		return "", err
+	}
 
-		if err != nil {
-			upload.fail(err)
-		} else {
-			upload.complete(objInfo)
-		}
-	}()
+	_, err = layer.gateway.streams.PutPending(ctx, storj.JoinPaths(bucket, uploadPath(uploadID)), bucketInfo.PathCipher, bytes.NewReader(nil), serMetaInfo, time.Time{})
+	if err != nil {
+		return "", convertError(err, bucket, object)
+	}
 
-	return upload.ID, nil
+	return uploadID, nil
 }
 
 func (layer *gatewayLayer) PutObjectPart(ctx context.Context, bucket, object, uploadID string, partID int, data *hash.Reader) (info minio.PartInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-
-	upload, err := uploads.Get(bucket, object, uploadID)
+	upload, err := layer.getMultipartUpload(ctx, bucket, object, uploadID)
 	if err != nil {
 		return minio.PartInfo{}, err
 	}
 
-	part, err := upload.Stream.AddPart(partID, data)
+	etag := data.SHA256HexString()
+
+	serMetaInfo, err := proto.Marshal(&pb.SerializableMeta{
+		UserDefined: map[string]string{partETagKey: etag},
+	})
 	if err != nil {
 		return minio.PartInfo{}, err
 	}
 
-	err = <-part.Done
+	meta, err := layer.gateway.streams.PutPending(ctx, storj.JoinPaths(bucket, partPath(uploadID, partID)), upload.Bucket.PathCipher, data, serMetaInfo, time.Time{})
 	if err != nil {
 		return minio.PartInfo{}, err
 	}
 
-	partInfo := minio.PartInfo{
-		PartNumber:   part.Number,
-		LastModified: time.Now(),
-		ETag:         data.SHA256HexString(),
-		Size:         atomic.LoadInt64(&part.Size),
-	}
-
-	upload.addCompletedPart(partInfo)
-
-	return partInfo, nil
+	return minio.PartInfo{
+		PartNumber:   partID,
+		LastModified: meta.Modified,
+		ETag:         etag,
+		Size:         meta.Size,
+	}, nil
 }
 
 func (layer *gatewayLayer) AbortMultipartUpload(ctx context.Context, bucket, object, uploadID string) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-
-	upload, err := uploads.Remove(bucket, object, uploadID)
+	upload, err := layer.getMultipartUpload(ctx, bucket, object, uploadID)
 	if err != nil {
 		return err
 	}
 
-	errAbort := Error.New("abort")
-	upload.Stream.Abort(errAbort)
-	r := <-upload.Done
-	if r.Error != errAbort {
-		return r.Error
-	}
-	return nil
+	return layer.deleteMultipartUpload(ctx, upload, uploadID)
 }
 
 func (layer *gatewayLayer) CompleteMultipartUpload(ctx context.Context, bucket, object, uploadID string, uploadedParts []minio.CompletePart) (objInfo minio.ObjectInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-	upload, err := uploads.Remove(bucket, object, uploadID)
+	upload, err := layer.getMultipartUpload(ctx, bucket, object, uploadID)
 	if err != nil {
 		return minio.ObjectInfo{}, err
 	}
 
-	// notify stream that there aren't more parts coming
-	upload.Stream.Close()
-	// wait for completion
-	result := <-upload.Done
-	// return the final info
-	return result.Info, result.Error
+	if len(uploadedParts) == 0 {
+		return minio.ObjectInfo{}, minio.InvalidPart{}
+	}
+
+	parts, err := layer.listParts(ctx, upload.Bucket, uploadID)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+
+	etags := make(map[int]string, len(parts))
+	for _, part := range parts {
+		etags[part.PartNumber] = part.ETag
+	}
+
+	// stitch the parts together in the order given by the client
+	partPaths := make([]storj.Path, 0, len(uploadedParts))
+	for _, uploadedPart := range uploadedParts {
+		etag, ok := etags[uploadedPart.PartNumber]
+		if !ok {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
+		if uploadedPart.ETag != "" && strings.Trim(uploadedPart.ETag, `"`) != etag {
+			return minio.ObjectInfo{}, minio.InvalidPart{}
+		}
+
+		partPaths = append(partPaths, partPath(uploadID, uploadedPart.PartNumber))
+	}
+
+	info, err := layer.gateway.metainfo.ConcatPendingObjects(ctx, bucket, partPaths, object, &storj.CreateObject{
+		ContentType: upload.ContentType,
+		Metadata:    userMetadata(upload.Metadata),
+	})
+	if err != nil {
+		return minio.ObjectInfo{}, convertError(err, bucket, object)
+	}
+
+	objInfo = minio.ObjectInfo{
+		Name:        object,
+		Bucket:      bucket,
+		ModTime:     info.Modified,
+		Size:        info.Size,
+		ETag:        hex.EncodeToString(info.Checksum),
+		ContentType: info.ContentType,
+		UserDefined: info.Metadata,
+	}
+
+	err = layer.deleteMultipartUpload(ctx, upload, uploadID)
+	if err != nil {
+		return minio.ObjectInfo{}, err
+	}
+
+	return objInfo, nil
 }
 
 func (layer *gatewayLayer) ListObjectParts(ctx context.Context, bucket, object, uploadID string, partNumberMarker int, maxParts int) (result minio.ListPartsInfo, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	uploads := layer.gateway.multipart
-	upload, err := uploads.Get(bucket, object, uploadID)
+	upload, err := layer.getMultipartUpload(ctx, bucket, object, uploadID)
+	if err != nil {
+		return minio.ListPartsInfo{}, err
+	}
+
+	parts, err := layer.listParts(ctx, upload.Bucket, uploadID)
 	if err != nil {
 		return minio.ListPartsInfo{}, err
 	}
@@ -142,316 +202,257 @@ func (layer *gatewayLayer) ListObjectParts(ctx context.Context, bucket, object,
 	list.UploadID = uploadID
 	list.PartNumberMarker = partNumberMarker
 	list.MaxParts = maxParts
-	list.UserDefined = upload.Metadata
-	list.Parts = upload.getCompletedParts()
-
-	sort.Slice(list.Parts, func(i, k int) bool {
-		return list.Parts[i].PartNumber < list.Parts[k].PartNumber
-	})
+	list.UserDefined = userMetadata(upload.Metadata)
 
-	var first int
-	for i, p := range list.Parts {
-		first = i
-		if partNumberMarker <= p.PartNumber {
-			break
+	for _, part := range parts {
+		if part.PartNumber > partNumberMarker {
+			list.Parts = append(list.Parts, part)
 		}
 	}
 
-	list.Parts = list.Parts[first:]
-	if len(list.Parts) > maxParts {
-		list.NextPartNumberMarker = list.Parts[maxParts].PartNumber
+	if maxParts > 0 && len(list.Parts) > maxParts {
 		list.Parts = list.Parts[:maxParts]
+		list.NextPartNumberMarker = list.Parts[maxParts-1].PartNumber
 		list.IsTruncated = true
 	}
 
 	return list, nil
 }
 
-// TODO: implement
-// func (layer *gatewayLayer) ListMultipartUploads(ctx context.Context, bucket, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (result minio.ListMultipartsInfo, err error) {
-// func (layer *gatewayLayer) CopyObjectPart(ctx context.Context, srcBucket, srcObject, destBucket, destObject string, uploadID string, partID int, startOffset int64, length int64, srcInfo minio.ObjectInfo) (info minio.PartInfo, err error) {
-
-// MultipartUploads manages pending multipart uploads
-type MultipartUploads struct {
-	mu      sync.RWMutex
-	lastID  int
-	pending map[string]*MultipartUpload
-}
+func (layer *gatewayLayer) ListMultipartUploads(ctx context.Context, bucket, prefix, keyMarker, uploadIDMarker, delimiter string, maxUploads int) (result minio.ListMultipartsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-// NewMultipartUploads creates new MultipartUploads
-func NewMultipartUploads() *MultipartUploads {
-	return &MultipartUploads{
-		pending: map[string]*MultipartUpload{},
+	if delimiter != "" && delimiter != "/" {
+		return minio.ListMultipartsInfo{}, minio.UnsupportedDelimiter{Delimiter: delimiter}
 	}
-}
-
-// Create creates a new upload
-func (uploads *MultipartUploads) Create(bucket, object string, metadata map[string]string) (*MultipartUpload, error) {
-	uploads.mu.Lock()
-	defer uploads.mu.Unlock()
 
-	for id, upload := range uploads.pending {
-		if upload.Bucket == bucket && upload.Object == object {
-			upload.Stream.Abort(Error.New("aborted by another upload to the same location"))
-			delete(uploads.pending, id)
-		}
+	result = minio.ListMultipartsInfo{
+		KeyMarker:      keyMarker,
+		UploadIDMarker: uploadIDMarker,
+		MaxUploads:     maxUploads,
+		Prefix:         prefix,
+		Delimiter:      delimiter,
 	}
 
-	uploads.lastID++
-	uploadID := "Upload" + strconv.Itoa(uploads.lastID)
+	// the uploads are stored by upload ID, so all of them have to be read
+	// to list them by object key
+	uploads, err := layer.listMultipartUploads(ctx, bucket)
+	if err != nil {
+		return minio.ListMultipartsInfo{}, err
+	}
 
-	upload := NewMultipartUpload(uploadID, bucket, object, metadata)
-	uploads.pending[uploadID] = upload
+	commonPrefixes := make(map[string]bool)
+	for _, upload := range uploads {
+		if !strings.HasPrefix(upload.Object, prefix) {
+			continue
+		}
+		if upload.Object < keyMarker || upload.Object == keyMarker && (uploadIDMarker == "" || upload.UploadID <= uploadIDMarker) {
+			continue
+		}
 
-	return upload, nil
-}
+		if delimiter != "" {
+			if i := strings.Index(upload.Object[len(prefix):], delimiter); i >= 0 {
+				commonPrefix := upload.Object[:len(prefix)+i+len(delimiter)]
+				if !commonPrefixes[commonPrefix] {
+					commonPrefixes[commonPrefix] = true
+					result.CommonPrefixes = append(result.CommonPrefixes, commonPrefix)
+				}
+				continue
+			}
+		}
 
-// Get finds a pending upload
-func (uploads *MultipartUploads) Get(bucket, object, uploadID string) (*MultipartUpload, error) {
-	uploads.mu.Lock()
-	defer uploads.mu.Unlock()
+		if maxUploads > 0 && len(result.Uploads) >= maxUploads {
+			result.IsTruncated = true
+			break
+		}
 
-	upload, ok := uploads.pending[uploadID]
-	if !ok {
-		return nil, Error.New("pending upload %q missing", uploadID)
-	}
-	if upload.Bucket != bucket || upload.Object != object {
-		return nil, Error.New("pending upload %q bucket/object name mismatch", uploadID)
+		result.Uploads = append(result.Uploads, upload)
+		result.NextKeyMarker = upload.Object
+		result.NextUploadIDMarker = upload.UploadID
 	}
 
-	return upload, nil
+	return result, nil
 }
 
-// Remove returns and removes a pending upload
-func (uploads *MultipartUploads) Remove(bucket, object, uploadID string) (*MultipartUpload, error) {
-	uploads.mu.RLock()
-	defer uploads.mu.RUnlock()
+// TODO: implement
+// func (layer *gatewayLayer) CopyObjectPart(ctx context.Context, srcBucket, srcObject, destBucket, destObject string, uploadID string, partID int, startOffset int64, length int64, srcInfo minio.ObjectInfo) (info minio.PartInfo, err error) {
 
-	upload, ok := uploads.pending[uploadID]
-	if !ok {
-		return nil, Error.New("pending upload %q missing", uploadID)
-	}
-	if upload.Bucket != bucket || upload.Object != object {
-		return nil, Error.New("pending upload %q bucket/object name mismatch", uploadID)
-	}
+// listMultipartUploads returns all uploads of the bucket sorted by object key
+// and upload ID
+func (layer *gatewayLayer) listMultipartUploads(ctx context.Context, bucket string) (uploads []minio.MultipartInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	delete(uploads.pending, uploadID)
+	cursor := ""
+	for {
+		list, err := layer.gateway.metainfo.ListPendingObjects(ctx, bucket, storj.ListOptions{
+			Direction: storj.After,
+			Cursor:    cursor,
+			Prefix:    multipartPrefix + "/",
+		})
+		if err != nil {
+			return nil, convertError(err, bucket, "")
+		}
 
-	return upload, nil
-}
+		for _, item := range list.Items {
+			cursor = item.Path
 
-// RemoveByID removes pending upload by id
-func (uploads *MultipartUploads) RemoveByID(uploadID string) {
-	uploads.mu.RLock()
-	defer uploads.mu.RUnlock()
-	delete(uploads.pending, uploadID)
-}
+			// skip the prefixes holding the parts
+			if item.IsPrefix {
+				continue
+			}
 
-// MultipartUpload is partial info about a pending upload
-type MultipartUpload struct {
-	ID       string
-	Bucket   string
-	Object   string
-	Metadata map[string]string
-	Done     chan (*MultipartUploadResult)
-	Stream   *MultipartStream
-
-	mu        sync.Mutex
-	completed []minio.PartInfo
-}
+			uploadID, ok := item.Metadata[uploadIDKey]
+			if !ok || uploadID != item.Path {
+				continue
+			}
 
-// MultipartUploadResult contains either an Error or the uploaded ObjectInfo
-type MultipartUploadResult struct {
-	Error error
-	Info  minio.ObjectInfo
-}
+			uploads = append(uploads, minio.MultipartInfo{
+				Object:    item.Metadata[objectKeyKey],
+				UploadID:  uploadID,
+				Initiated: item.Created,
+			})
+		}
 
-// NewMultipartUpload creates a new MultipartUpload
-func NewMultipartUpload(uploadID string, bucket, object string, metadata map[string]string) *MultipartUpload {
-	upload := &MultipartUpload{
-		ID:       uploadID,
-		Bucket:   bucket,
-		Object:   object,
-		Metadata: metadata,
-		Done:     make(chan *MultipartUploadResult, 1),
-		Stream:   NewMultipartStream(),
-	}
-	return upload
-}
+		if !list.More {
+			break
+		}
+	}
 
-// addCompletedPart adds a completed part to the list
-func (upload *MultipartUpload) addCompletedPart(part minio.PartInfo) {
-	upload.mu.Lock()
-	defer upload.mu.Unlock()
+	sort.Slice(uploads, func(i, k int) bool {
+		if uploads[i].Object != uploads[k].Object {
+			return uploads[i].Object < uploads[k].Object
+		}
+		return uploads[i].UploadID < uploads[k].UploadID
+	})
 
-	upload.completed = append(upload.completed, part)
+	return uploads, nil
 }
 
-func (upload *MultipartUpload) getCompletedParts() []minio.PartInfo {
-	upload.mu.Lock()
-	defer upload.mu.Unlock()
+// getMultipartUpload returns the pending upload with the upload ID, if it
+// belongs to the object
+func (layer *gatewayLayer) getMultipartUpload(ctx context.Context, bucket, object, uploadID string) (info storj.Object, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	return append([]minio.PartInfo{}, upload.completed...)
-}
+	if !validUploadID(uploadID) {
+		return storj.Object{}, minio.InvalidUploadID{UploadID: uploadID}
+	}
 
-// fail aborts the upload with an error
-func (upload *MultipartUpload) fail(err error) {
-	upload.Done <- &MultipartUploadResult{Error: err}
-	close(upload.Done)
-}
+	upload, err := layer.gateway.metainfo.ModifyPendingObject(ctx, bucket, uploadPath(uploadID))
+	if err != nil {
+		if storj.ErrObjectNotFound.Has(err) {
+			return storj.Object{}, minio.InvalidUploadID{UploadID: uploadID}
+		}
+		return storj.Object{}, convertError(err, bucket, object)
+	}
 
-// complete completes the upload
-func (upload *MultipartUpload) complete(info minio.ObjectInfo) {
-	upload.Done <- &MultipartUploadResult{Info: info}
-	close(upload.Done)
-}
+	info = upload.Info()
+	if info.Metadata[uploadIDKey] != uploadID || info.Metadata[objectKeyKey] != object {
+		return storj.Object{}, minio.InvalidUploadID{UploadID: uploadID}
+	}
 
-// MultipartStream serializes multiple readers into a single reader
-type MultipartStream struct {
-	mu          sync.Mutex
-	moreParts   sync.Cond
-	err         error
-	closed      bool
-	finished    bool
-	nextID      int
-	nextNumber  int
-	currentPart *StreamPart
-	parts       []*StreamPart
+	return info, nil
 }
 
-// StreamPart is a reader waiting in MultipartStream
-type StreamPart struct {
-	Number int
-	ID     int
-	Size   int64
-	Reader *hash.Reader
-	Done   chan error
-}
+// deleteMultipartUpload deletes the parts and the pending upload
+func (layer *gatewayLayer) deleteMultipartUpload(ctx context.Context, upload storj.Object, uploadID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-// NewMultipartStream creates a new MultipartStream
-func NewMultipartStream() *MultipartStream {
-	stream := &MultipartStream{}
-	stream.moreParts.L = &stream.mu
-	stream.nextID = 1
-	return stream
-}
+	err = layer.deleteParts(ctx, upload.Bucket, uploadID)
+	if err != nil {
+		return err
+	}
 
-// Abort aborts the stream reading
-func (stream *MultipartStream) Abort(err error) {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
+	return layer.gateway.streams.DeletePending(ctx, storj.JoinPaths(upload.Bucket.Name, upload.Path), upload.Bucket.PathCipher)
+}
 
-	if stream.finished {
-		return
-	}
+// deleteParts deletes all stored parts of an upload
+func (layer *gatewayLayer) deleteParts(ctx context.Context, bucket storj.Bucket, uploadID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	if stream.err == nil {
-		stream.err = err
+	parts, err := layer.listParts(ctx, bucket, uploadID)
+	if err != nil {
+		return err
 	}
-	stream.finished = true
-	stream.closed = true
 
-	for _, part := range stream.parts {
-		part.Done <- err
-		close(part.Done)
+	for _, part := range parts {
+		err = layer.gateway.streams.DeletePending(ctx, storj.JoinPaths(bucket.Name, partPath(uploadID, part.PartNumber)), bucket.PathCipher)
+		if err != nil {
+			return err
+		}
 	}
-	stream.parts = nil
 
-	stream.moreParts.Broadcast()
+	return nil
 }
 
-// Close closes the stream, but lets it complete
-func (stream *MultipartStream) Close() {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
-
-	stream.closed = true
-	stream.moreParts.Broadcast()
-}
+// listParts returns all stored parts of an upload sorted by part number
+func (layer *gatewayLayer) listParts(ctx context.Context, bucket storj.Bucket, uploadID string) (parts []minio.PartInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-// Read implements io.Reader interface, blocking when there's no part
-func (stream *MultipartStream) Read(data []byte) (n int, err error) {
-	stream.mu.Lock()
+	cursor := ""
 	for {
-		// has an error occurred?
-		if stream.err != nil {
-			stream.mu.Unlock()
-			return 0, Error.Wrap(err)
+		list, err := layer.gateway.metainfo.ListPendingObjects(ctx, bucket.Name, storj.ListOptions{
+			Direction: storj.After,
+			Cursor:    cursor,
+			Prefix:    storj.JoinPaths(multipartPrefix, uploadID) + "/",
+			Recursive: true,
+		})
+		if err != nil {
+			return nil, convertError(err, bucket.Name, "")
 		}
-		// still uploading the current part?
-		if stream.currentPart != nil {
-			break
+
+		for _, item := range list.Items {
+			cursor = item.Path
+
+			partNumber, err := strconv.Atoi(item.Path)
+			if err != nil {
+				return nil, Error.New("invalid part %q of upload %q", item.Path, uploadID)
+			}
+
+			parts = append(parts, minio.PartInfo{
+				PartNumber:   partNumber,
+				LastModified: item.Modified,
+				ETag:         item.Metadata[partETagKey],
+				Size:         item.Size,
+			})
 		}
-		// do we have the next part?
-		if len(stream.parts) > 0 && stream.nextID == stream.parts[0].ID {
-			stream.currentPart = stream.parts[0]
-			stream.parts = stream.parts[1:]
-			stream.nextID++
+
+		if !list.More {
 			break
 		}
-		// we don't have the next part and are closed, hence we are complete
-		if stream.closed {
-			stream.finished = true
-			stream.mu.Unlock()
-			return 0, io.EOF
-		}
-
-		stream.moreParts.Wait()
 	}
-	stream.mu.Unlock()
 
-	// read as much as we can
-	n, err = stream.currentPart.Reader.Read(data)
-	atomic.AddInt64(&stream.currentPart.Size, int64(n))
+	sort.Slice(parts, func(i, k int) bool {
+		return parts[i].PartNumber < parts[k].PartNumber
+	})
 
-	if err == io.EOF {
-		// the part completed, hence advance to the next one
-		err = nil
-		close(stream.currentPart.Done)
-		stream.currentPart = nil
-	} else if err != nil {
-		// something bad happened, abort the whole thing
-		stream.Abort(err)
-		return n, Error.Wrap(err)
-	}
+	return parts, nil
+}
 
-	return n, err
+// validUploadID returns whether id has the format of the upload IDs created
+// by NewMultipartUpload
+func validUploadID(id string) bool {
+	decoded, err := hex.DecodeString(id)
+	return err == nil && len(decoded) == 16
 }
 
-// AddPart adds a new part to the stream to wait
-func (stream *MultipartStream) AddPart(partID int, data *hash.Reader) (*StreamPart, error) {
-	stream.mu.Lock()
-	defer stream.mu.Unlock()
+// uploadPath returns the pending path of an upload relative to the bucket
+func uploadPath(uploadID string) storj.Path {
+	return storj.JoinPaths(multipartPrefix, uploadID)
+}
 
-	if partID < stream.nextID {
-		return nil, Error.New("part %d already uploaded, next part ID is %d", partID, stream.nextID)
-	}
+// partPath returns the pending path of a part relative to the bucket
+func partPath(uploadID string, partNumber int) storj.Path {
+	return storj.JoinPaths(multipartPrefix, uploadID, fmt.Sprintf("%05d", partNumber))
+}
 
-	for _, p := range stream.parts {
-		if p.ID == partID {
-			// Replace the reader of this part with the new one.
-			// This could happen if the read timeout for this part has expired
-			// and the client tries to upload the part again.
-			p.Reader = data
-			return p, nil
+// userMetadata returns the metadata of an upload without the keys used by
+// the gateway
+func userMetadata(metadata map[string]string) map[string]string {
+	userDefined := make(map[string]string, len(metadata))
+	for key, value := range metadata {
+		if key != uploadIDKey && key != objectKeyKey {
+			userDefined[key] = value
 		}
 	}
-
-	stream.nextNumber++
-	part := &StreamPart{
-		Number: stream.nextNumber - 1,
-		ID:     partID,
-		Size:   0,
-		Reader: data,
-		Done:   make(chan error, 1),
-	}
-
-	stream.parts = append(stream.parts, part)
-	sort.Slice(stream.parts, func(i, k int) bool {
-		return stream.parts[i].ID < stream.parts[k].ID
-	})
-
-	stream.moreParts.Broadcast()
-
-	return part, nil
+	return userDefined
 }
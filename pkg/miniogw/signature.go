@@ -0,0 +1,140 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"io/ioutil"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/minio/minio-go/pkg/s3utils"
+	"github.com/minio/minio/pkg/auth"
+)
+
+const (
+	signV4Algorithm   = "AWS4-HMAC-SHA256"
+	iso8601Format     = "20060102T150405Z"
+	unsignedPayload   = "UNSIGNED-PAYLOAD"
+	maxSignatureSkew  = 15 * time.Minute
+	maxSignedBodySize = 1 << 20
+)
+
+// verifySignature checks the AWS signature version 4 in the Authorization
+// header of a request against the credentials of the gateway. The body of
+// the request is checked against its signed hash, if it has one.
+func verifySignature(r *http.Request, creds auth.Credentials, now time.Time) error {
+	authorization := r.Header.Get("Authorization")
+	if !strings.HasPrefix(authorization, signV4Algorithm+" ") {
+		return errAccessDenied
+	}
+
+	var credential, signedHeaders, signature string
+	for _, field := range strings.Split(strings.TrimPrefix(authorization, signV4Algorithm+" "), ",") {
+		field = strings.TrimSpace(field)
+		switch {
+		case strings.HasPrefix(field, "Credential="):
+			credential = strings.TrimPrefix(field, "Credential=")
+		case strings.HasPrefix(field, "SignedHeaders="):
+			signedHeaders = strings.TrimPrefix(field, "SignedHeaders=")
+		case strings.HasPrefix(field, "Signature="):
+			signature = strings.TrimPrefix(field, "Signature=")
+		}
+	}
+
+	// the credential is <access key>/<date>/<region>/<service>/aws4_request
+	scope := strings.SplitN(credential, "/", 2)
+	if len(scope) != 2 || signedHeaders == "" || signature == "" {
+		return errAccessDenied
+	}
+	if scope[0] != creds.AccessKey {
+		return errInvalidAccessKeyID
+	}
+	scopeParts := strings.Split(scope[1], "/")
+	if len(scopeParts) != 4 || scopeParts[3] != "aws4_request" {
+		return errAccessDenied
+	}
+
+	amzDate := r.Header.Get("X-Amz-Date")
+	signedAt, err := time.Parse(iso8601Format, amzDate)
+	if err != nil || !strings.HasPrefix(amzDate, scopeParts[0]) {
+		return errAccessDenied
+	}
+	if signedAt.Before(now.Add(-maxSignatureSkew)) || signedAt.After(now.Add(maxSignatureSkew)) {
+		return errRequestTimeTooSkewed
+	}
+
+	payloadHash := r.Header.Get("X-Amz-Content-Sha256")
+	if payloadHash == "" {
+		payloadHash = unsignedPayload
+	}
+	if payloadHash != unsignedPayload && r.Body != nil {
+		body, err := ioutil.ReadAll(http.MaxBytesReader(nil, r.Body, maxSignedBodySize))
+		if err != nil {
+			return errAccessDenied
+		}
+		sum := sha256.Sum256(body)
+		if hex.EncodeToString(sum[:]) != payloadHash {
+			return errContentSHA256Mismatch
+		}
+		r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	}
+
+	headers := strings.Split(signedHeaders, ";")
+	if !sort.StringsAreSorted(headers) {
+		return errSignatureDoesNotMatch
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, header := range headers {
+		canonicalHeaders.WriteString(header)
+		canonicalHeaders.WriteByte(':')
+		if header == "host" {
+			canonicalHeaders.WriteString(r.Host)
+		} else {
+			canonicalHeaders.WriteString(strings.Join(r.Header[http.CanonicalHeaderKey(header)], ","))
+		}
+		canonicalHeaders.WriteByte('\n')
+	}
+
+	canonicalRequest := strings.Join([]string{
+		r.Method,
+		s3utils.EncodePath(r.URL.Path),
+		strings.Replace(r.URL.Query().Encode(), "+", "%20", -1),
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+
+	stringToSign := strings.Join([]string{
+		signV4Algorithm,
+		amzDate,
+		scope[1],
+		hex.EncodeToString(canonicalHash[:]),
+	}, "\n")
+
+	key := []byte("AWS4" + creds.SecretKey)
+	for _, part := range scopeParts {
+		key = sumHMAC(key, []byte(part))
+	}
+
+	expected := hex.EncodeToString(sumHMAC(key, []byte(stringToSign)))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errSignatureDoesNotMatch
+	}
+	return nil
+}
+
+// sumHMAC returns the HMAC-SHA256 of data with key
+func sumHMAC(key, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write(data)
+	return mac.Sum(nil)
+}
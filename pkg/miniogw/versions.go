@@ -0,0 +1,141 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package miniogw
+
+import (
+	"context"
+	"encoding/hex"
+	"io"
+	"strings"
+
+	minio "github.com/minio/minio/cmd"
+
+	"storj.io/storj/pkg/storj"
+)
+
+// The object layer of the minio release used by the gateway has no calls for
+// the S3 versioning API. The methods below implement ListObjectVersions and
+// the versionId parameter of GetObject, HeadObject and DeleteObject for
+// buckets created with versioning.
+
+// ObjectVersionInfo is the information about a version of an object
+type ObjectVersionInfo struct {
+	minio.ObjectInfo
+
+	VersionID string
+	IsLatest  bool
+}
+
+// ListObjectVersionsInfo is the result of listing the versions of objects
+type ListObjectVersionsInfo struct {
+	IsTruncated bool
+
+	NextKeyMarker       string
+	NextVersionIDMarker string
+
+	Versions []ObjectVersionInfo
+}
+
+// ListObjectVersions lists the versions of the objects in a bucket, the
+// versions of an object are listed together
+func (layer *gatewayLayer) ListObjectVersions(ctx context.Context, bucket, prefix, keyMarker, versionIDMarker string, maxKeys int) (result ListObjectVersionsInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	cursor := keyMarker
+	if keyMarker != "" && versionIDMarker != "" {
+		cursor = storj.JoinPaths(keyMarker, versionIDMarker)
+	}
+
+	list, err := layer.gateway.metainfo.ListObjects(ctx, bucket, storj.ListOptions{
+		Direction: storj.After,
+		Cursor:    cursor,
+		Prefix:    prefix,
+		Limit:     maxKeys,
+		Versions:  true,
+	})
+	if err != nil {
+		return ListObjectVersionsInfo{}, convertError(err, bucket, "")
+	}
+
+	for _, item := range list.Items {
+		path := item.Path
+		if prefix != "" {
+			path = storj.JoinPaths(strings.TrimSuffix(prefix, "/"), path)
+		}
+		result.Versions = append(result.Versions, ObjectVersionInfo{
+			ObjectInfo: minio.ObjectInfo{
+				Bucket:      bucket,
+				Name:        path,
+				ModTime:     item.Modified,
+				Size:        item.Size,
+				ETag:        hex.EncodeToString(item.Checksum),
+				ContentType: item.ContentType,
+				UserDefined: item.Metadata,
+			},
+			VersionID: item.VersionID,
+			IsLatest:  item.IsLatest,
+		})
+	}
+
+	result.IsTruncated = list.More
+	if list.More && len(list.Items) > 0 {
+		last := list.Items[len(list.Items)-1]
+		result.NextKeyMarker = last.Path
+		result.NextVersionIDMarker = last.VersionID
+	}
+
+	return result, nil
+}
+
+// GetObjectVersion reads a version of an object like GetObject
+func (layer *gatewayLayer) GetObjectVersion(ctx context.Context, bucket, object, versionID string, startOffset int64, length int64, writer io.Writer) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versionID == "" {
+		return minio.ObjectNotFound{Bucket: bucket, Object: object}
+	}
+
+	return layer.getObject(ctx, bucket, object, versionID, startOffset, length, writer)
+}
+
+// GetObjectVersionInfo returns the information about a version of an object
+func (layer *gatewayLayer) GetObjectVersionInfo(ctx context.Context, bucket, object, versionID string) (objInfo ObjectVersionInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versionID == "" {
+		return ObjectVersionInfo{}, minio.ObjectNotFound{Bucket: bucket, Object: object}
+	}
+
+	obj, err := layer.gateway.metainfo.GetObject(ctx, bucket, object, versionID)
+	if err != nil {
+		return ObjectVersionInfo{}, convertError(err, bucket, object)
+	}
+
+	return ObjectVersionInfo{
+		ObjectInfo: minio.ObjectInfo{
+			Name:        object,
+			Bucket:      bucket,
+			ModTime:     obj.Modified,
+			Size:        obj.Size,
+			ETag:        hex.EncodeToString(obj.Checksum),
+			ContentType: obj.ContentType,
+			UserDefined: obj.Metadata,
+		},
+		VersionID: obj.VersionID,
+		IsLatest:  obj.IsLatest,
+	}, nil
+}
+
+// DeleteObjectVersion permanently deletes a version of an object
+func (layer *gatewayLayer) DeleteObjectVersion(ctx context.Context, bucket, object, versionID string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if versionID == "" {
+		return minio.ObjectNotFound{Bucket: bucket, Object: object}
+	}
+
+	err = layer.gateway.metainfo.DeleteObjectVersion(ctx, bucket, object, versionID)
+
+	return convertError(err, bucket, object)
+}
@@ -6,6 +6,7 @@ package overlay
 import (
 	"context"
 	"errors"
+	"time"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -27,6 +28,21 @@ var ErrEmptyNode = errs.New("empty node ID")
 // ErrNodeNotFound is returned if a node does not exist in database
 var ErrNodeNotFound = errs.New("Node not found")
 
+// ErrExitNotFound is returned if a node didn't initiate a graceful exit
+var ErrExitNotFound = errs.New("graceful exit not found")
+
+// ErrExitTransferNotFound is returned if a piece wasn't assigned to a target
+// node for the graceful exit
+var ErrExitTransferNotFound = errs.New("graceful exit transfer not found")
+
+// ErrExitTransferFinished is returned if the result of a transfer was
+// reported already
+var ErrExitTransferFinished = errs.New("graceful exit transfer already finished")
+
+// ErrExitIncomplete is returned if an exiting node still has pieces, which
+// weren't transferred or reported as failed
+var ErrExitIncomplete = errs.New("graceful exit incomplete")
+
 // ErrBucketNotFound is returned if a bucket is unable to be found in the routing table
 var ErrBucketNotFound = errs.New("Bucket not found")
 
@@ -41,23 +57,128 @@ type DB interface {
 	GetAll(ctx context.Context, nodeIDs storj.NodeIDList) ([]*pb.Node, error)
 	// List lists nodes starting from cursor
 	List(ctx context.Context, cursor storj.NodeID, limit int) ([]*pb.Node, error)
+	// SelectStorageNodes randomly selects up to count storage nodes meeting
+	// the criteria, the nodes leaving the network aren't selected
+	SelectStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*pb.Node, error)
 	// Update updates node information
 	Update(ctx context.Context, value *pb.Node) error
 	// Delete deletes node based on id
 	Delete(ctx context.Context, id storj.NodeID) error
 	//GetWalletAddress gets the node's wallet address
 	GetWalletAddress(ctx context.Context, id storj.NodeID) (string, error)
+
+	// CreateExitStatus marks the node as exiting, the progress of an exit initiated earlier is kept
+	CreateExitStatus(ctx context.Context, id storj.NodeID) error
+	// GetExitStatus returns the graceful exit progress of the node
+	GetExitStatus(ctx context.Context, id storj.NodeID) (*ExitStatus, error)
+	// GetExitingNodes returns the nodes which initiated a graceful exit, including the exited ones
+	GetExitingNodes(ctx context.Context) (storj.NodeIDList, error)
+	// CompleteExit marks the node as exited, it fails with ErrExitIncomplete unless all segments were listed and no transfer is pending
+	CompleteExit(ctx context.Context, id storj.NodeID) error
+
+	// AddExitTransfers records the transfers of the pieces of the node in the segments up to cursor, listed is set once all segments were listed
+	AddExitTransfers(ctx context.Context, id storj.NodeID, transfers []*ExitTransfer, cursor string, listed bool) error
+	// GetExitTransfer returns the transfer of a piece of the node
+	GetExitTransfer(ctx context.Context, id storj.NodeID, path string, pieceNum int32) (*ExitTransfer, error)
+	// GetPendingExitTransfers returns up to limit transfers of the node, which weren't finished
+	GetPendingExitTransfers(ctx context.Context, id storj.NodeID, limit int) ([]*ExitTransfer, error)
+	// CountPendingExitTransfers counts the transfers of the node, which weren't finished
+	CountPendingExitTransfers(ctx context.Context, id storj.NodeID) (int64, error)
+	// FinishExitTransfer sets the state of a pending transfer and counts it in the progress of the exit, it fails with ErrExitTransferFinished if the transfer isn't pending
+	FinishExitTransfer(ctx context.Context, id storj.NodeID, path string, pieceNum int32, state ExitTransferState) error
+}
+
+// NodeCriteria are the requirements of the storage nodes selected for new
+// pieces. The restrictions of nodes which didn't report them are only checked
+// against positive requirements.
+type NodeCriteria struct {
+	FreeBandwidth int64
+	FreeDisk      int64
+
+	AuditCount        int64
+	AuditSuccessRatio float64
+	UptimeCount       int64
+	UptimeRatio       float64
+
+	// AuditReputation and UptimeReputation are the minimum decaying
+	// reputations of statdb
+	AuditReputation  float64
+	UptimeReputation float64
+
+	// MaxAuditCount selects only the nodes audited less often, when positive
+	MaxAuditCount int64
+
+	Excluded storj.NodeIDList
+	// ExcludedAddresses, ExcludedNetworks and ExcludedWallets exclude the
+	// nodes with the addresses, on the networks and of the operator wallets
+	ExcludedAddresses []string
+	ExcludedNetworks  []string
+	ExcludedWallets   []string
+	// RequireNetwork excludes the nodes, which network wasn't resolved
+	RequireNetwork bool
+
+	// Weighted makes nodes with more free disk space and a better
+	// reputation more likely to be selected
+	Weighted bool
+}
+
+// ExitStatus is the progress of the graceful exit of a storage node. Exiting
+// nodes aren't selected for new pieces.
+type ExitStatus struct {
+	NodeID            storj.NodeID
+	PiecesTransferred int64
+	PiecesFailed      int64
+	// Exited is set once the node transferred all of its pieces
+	Exited bool
+	// TransfersCursor is the path of the last segment, whose pieces on the
+	// node were assigned to target nodes. TransfersListed is set once the
+	// pieces of all segments were.
+	TransfersCursor string
+	TransfersListed bool
+	InitiatedAt     time.Time
+	UpdatedAt       time.Time
+}
+
+// ExitTransferState is the state of the transfer of a piece of an exiting
+// node
+type ExitTransferState int
+
+const (
+	// ExitTransferPending is a transfer, which result wasn't reported yet
+	ExitTransferPending ExitTransferState = iota
+	// ExitTransferSucceeded is a piece moved to its target node
+	ExitTransferSucceeded
+	// ExitTransferFailed is a piece, which couldn't be transferred or
+	// verified, it's left to the repair
+	ExitTransferFailed
+	// ExitTransferObsolete is a piece, which doesn't have to be transferred
+	// anymore, because its segment was deleted or the piece was moved
+	ExitTransferObsolete
+)
+
+// ExitTransfer is a piece of an exiting node together with the target node
+// assigned to it. Only the assigned target node is accepted for the piece.
+type ExitTransfer struct {
+	NodeID   storj.NodeID
+	Path     string
+	PieceNum int32
+	TargetID storj.NodeID
+	State    ExitTransferState
 }
 
 // Cache is used to store overlay data in Redis
 type Cache struct {
 	db     DB
 	statDB statdb.DB
+	config NodeSelectionConfig
+	uptime statdb.ReputationParams
 }
 
-// NewCache returns a new Cache
-func NewCache(db DB, sdb statdb.DB) *Cache {
-	return &Cache{db: db, statDB: sdb}
+// NewCache returns a new Cache, the networks of the nodes are stored with
+// the prefix lengths of the config and the uptime reputations are updated
+// with the uptime params
+func NewCache(db DB, sdb statdb.DB, config NodeSelectionConfig, uptime statdb.ReputationParams) *Cache {
+	return &Cache{db: db, statDB: sdb, config: config, uptime: uptime}
 }
 
 // Inspect lists limited number of items in the cache
@@ -110,9 +231,62 @@ func (cache *Cache) Put(ctx context.Context, nodeID storj.NodeID, value pb.Node)
 		UptimeCount:        stats.UptimeCount,
 	}
 
+	// the network is resolved by the satellite, the one sent by the node
+	// isn't trusted. It's only resolved again when the address changed or
+	// the network couldn't be resolved before.
+	value.LastNet = ""
+	if cache.config.DistinctNetworkBits > 0 || cache.config.DistinctNetworkBitsV6 > 0 {
+		existing, err := cache.db.Get(ctx, nodeID)
+		if err != nil && err != ErrNodeNotFound {
+			return err
+		}
+
+		if existing != nil && existing.LastNet != "" && existing.Address.GetAddress() == value.Address.GetAddress() {
+			value.LastNet = existing.LastNet
+		} else {
+			value.LastNet, err = ResolveNetwork(ctx, value.Address.GetAddress(), cache.config.DistinctNetworkBits, cache.config.DistinctNetworkBitsV6)
+			if err != nil {
+				zap.L().Debug("error resolving the network of node", zap.String("nodeID", nodeID.String()), zap.Error(err))
+			}
+		}
+	}
+
 	return cache.db.Update(ctx, &value)
 }
 
+// SelectStorageNodes randomly selects up to count storage nodes meeting the
+// criteria from the overlay cache
+func (cache *Cache) SelectStorageNodes(ctx context.Context, count int, criteria *NodeCriteria) ([]*pb.Node, error) {
+	return cache.db.SelectStorageNodes(ctx, count, criteria)
+}
+
+// IsVetted returns whether the node was audited often enough to be vetted,
+// the unvetted nodes only get a fraction of the new pieces
+func (cache *Cache) IsVetted(node *pb.Node) bool {
+	return node.GetReputation().GetAuditCount() >= cache.config.NewNodeAuditThreshold
+}
+
+// Unvetted returns the ids of the unvetted nodes, the nodes missing in the
+// cache are skipped
+func (cache *Cache) Unvetted(ctx context.Context, ids storj.NodeIDList) (unvetted storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if cache.config.NewNodeAuditThreshold <= 0 || len(ids) == 0 {
+		return nil, nil
+	}
+
+	nodes, err := cache.db.GetAll(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, node := range nodes {
+		if node != nil && !cache.IsVetted(node) {
+			unvetted = append(unvetted, node.Id)
+		}
+	}
+	return unvetted, nil
+}
+
 // Delete will remove the node from the cache. Used when a node hard disconnects or fails
 // to pass a PING multiple times.
 func (cache *Cache) Delete(ctx context.Context, id storj.NodeID) error {
@@ -127,7 +301,7 @@ func (cache *Cache) ConnFailure(ctx context.Context, node *pb.Node, failureError
 	// TODO: Kademlia paper specifies 5 unsuccessful PINGs before removing the node
 	// from our routing table, but this is the cache so maybe we want to treat
 	// it differently.
-	_, err := cache.statDB.UpdateUptime(ctx, node.Id, false)
+	_, err := cache.statDB.UpdateUptime(ctx, node.Id, false, cache.uptime)
 	if err != nil {
 		zap.L().Debug("error updating uptime for node in statDB", zap.Error(err))
 	}
@@ -139,8 +313,98 @@ func (cache *Cache) ConnSuccess(ctx context.Context, node *pb.Node) {
 	if err != nil {
 		zap.L().Debug("error updating uptime for node in statDB", zap.Error(err))
 	}
-	_, err = cache.statDB.UpdateUptime(ctx, node.Id, true)
+	_, err = cache.statDB.UpdateUptime(ctx, node.Id, true, cache.uptime)
 	if err != nil {
 		zap.L().Debug("error updating statdDB with node connection info", zap.Error(err))
 	}
 }
+
+// InitiateExit marks the node as exiting and returns its exit progress, the
+// progress of an exit initiated earlier is kept
+func (cache *Cache) InitiateExit(ctx context.Context, id storj.NodeID) (status *ExitStatus, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if id.IsZero() {
+		return nil, ErrEmptyNode
+	}
+
+	if err = cache.db.CreateExitStatus(ctx, id); err != nil {
+		return nil, err
+	}
+
+	return cache.db.GetExitStatus(ctx, id)
+}
+
+// ExitStatus returns the graceful exit progress of the node
+func (cache *Cache) ExitStatus(ctx context.Context, id storj.NodeID) (*ExitStatus, error) {
+	if id.IsZero() {
+		return nil, ErrEmptyNode
+	}
+
+	return cache.db.GetExitStatus(ctx, id)
+}
+
+// CompleteExit marks the node as exited once all of its pieces were
+// transferred or reported as failed
+func (cache *Cache) CompleteExit(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if id.IsZero() {
+		return ErrEmptyNode
+	}
+
+	return cache.db.CompleteExit(ctx, id)
+}
+
+// AddExitTransfers records the transfers of the pieces of the node in the
+// segments up to cursor and advances the cursor of the exit
+func (cache *Cache) AddExitTransfers(ctx context.Context, id storj.NodeID, transfers []*ExitTransfer, cursor string, listed bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if id.IsZero() {
+		return ErrEmptyNode
+	}
+
+	return cache.db.AddExitTransfers(ctx, id, transfers, cursor, listed)
+}
+
+// ExitTransfer returns the transfer of a piece of the node
+func (cache *Cache) ExitTransfer(ctx context.Context, id storj.NodeID, path string, pieceNum int32) (*ExitTransfer, error) {
+	if id.IsZero() {
+		return nil, ErrEmptyNode
+	}
+
+	return cache.db.GetExitTransfer(ctx, id, path, pieceNum)
+}
+
+// PendingExitTransfers returns up to limit transfers of the node, which
+// results weren't reported yet
+func (cache *Cache) PendingExitTransfers(ctx context.Context, id storj.NodeID, limit int) ([]*ExitTransfer, error) {
+	if id.IsZero() {
+		return nil, ErrEmptyNode
+	}
+
+	return cache.db.GetPendingExitTransfers(ctx, id, limit)
+}
+
+// CountPendingExitTransfers counts the transfers of the node, which results
+// weren't reported yet
+func (cache *Cache) CountPendingExitTransfers(ctx context.Context, id storj.NodeID) (int64, error) {
+	if id.IsZero() {
+		return 0, ErrEmptyNode
+	}
+
+	return cache.db.CountPendingExitTransfers(ctx, id)
+}
+
+// FinishExitTransfer sets the state of a pending transfer of the node and
+// counts it in the progress of the exit
+func (cache *Cache) FinishExitTransfer(ctx context.Context, id storj.NodeID, path string, pieceNum int32, state ExitTransferState) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if id.IsZero() {
+		return ErrEmptyNode
+	}
+
+	return cache.db.FinishExitTransfer(ctx, id, path, pieceNum, state)
+}
@@ -5,6 +5,7 @@ package overlay_test
 
 import (
 	"context"
+	"fmt"
 	"math/rand"
 	"testing"
 
@@ -37,7 +38,7 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB, sdb statdb.D
 	_, _ = rand.Read(valid2ID[:])
 	_, _ = rand.Read(missingID[:])
 
-	cache := overlay.NewCache(store, sdb)
+	cache := overlay.NewCache(store, sdb, overlay.NodeSelectionConfig{DistinctNetworkBits: 24}, statdb.ReputationParams{})
 
 	{ // Put
 		err := cache.Put(ctx, valid1ID, pb.Node{Id: valid1ID})
@@ -97,6 +98,168 @@ func testCache(ctx context.Context, t *testing.T, store overlay.DB, sdb statdb.D
 		// TODO: add erroring database test
 	}
 
+	{ // GracefulExit
+		_, err := cache.ExitStatus(ctx, valid2ID)
+		assert.True(t, err == overlay.ErrExitNotFound)
+
+		exiting, err := store.GetExitingNodes(ctx)
+		assert.NoError(t, err)
+		assert.Empty(t, exiting)
+
+		status, err := cache.InitiateExit(ctx, valid2ID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, valid2ID, status.NodeID)
+			assert.False(t, status.Exited)
+			assert.False(t, status.TransfersListed)
+		}
+
+		// the transfers, which failed when they were listed, are counted
+		err = cache.AddExitTransfers(ctx, valid2ID, []*overlay.ExitTransfer{
+			{NodeID: valid2ID, Path: "a", PieceNum: 1, TargetID: valid1ID},
+			{NodeID: valid2ID, Path: "b", PieceNum: 2, TargetID: valid1ID},
+			{NodeID: valid2ID, Path: "c", PieceNum: 3, State: overlay.ExitTransferFailed},
+		}, "c", false)
+		assert.NoError(t, err)
+
+		pending, err := cache.PendingExitTransfers(ctx, valid2ID, 10)
+		if assert.NoError(t, err) && assert.Len(t, pending, 2) {
+			assert.Equal(t, "a", pending[0].Path)
+			assert.Equal(t, int32(1), pending[0].PieceNum)
+			assert.Equal(t, valid1ID, pending[0].TargetID)
+		}
+
+		// a transfer is finished once
+		assert.NoError(t, cache.FinishExitTransfer(ctx, valid2ID, "a", 1, overlay.ExitTransferSucceeded))
+		err = cache.FinishExitTransfer(ctx, valid2ID, "a", 1, overlay.ExitTransferFailed)
+		assert.True(t, err == overlay.ErrExitTransferFinished)
+
+		transfer, err := cache.ExitTransfer(ctx, valid2ID, "a", 1)
+		if assert.NoError(t, err) {
+			assert.Equal(t, overlay.ExitTransferSucceeded, transfer.State)
+		}
+		_, err = cache.ExitTransfer(ctx, valid2ID, "a", 2)
+		assert.True(t, err == overlay.ErrExitTransferNotFound)
+
+		// initiating again keeps the progress
+		status, err = cache.InitiateExit(ctx, valid2ID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, int64(1), status.PiecesTransferred)
+			assert.Equal(t, int64(1), status.PiecesFailed)
+			assert.Equal(t, "c", status.TransfersCursor)
+		}
+
+		exiting, err = store.GetExitingNodes(ctx)
+		assert.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{valid2ID}, exiting)
+
+		// the node exits once all segments were listed and no transfer is pending
+		assert.True(t, cache.CompleteExit(ctx, valid2ID) == overlay.ErrExitIncomplete)
+		assert.NoError(t, cache.AddExitTransfers(ctx, valid2ID, nil, "c", true))
+		assert.True(t, cache.CompleteExit(ctx, valid2ID) == overlay.ErrExitIncomplete)
+
+		assert.NoError(t, cache.FinishExitTransfer(ctx, valid2ID, "b", 2, overlay.ExitTransferObsolete))
+		count, err := cache.CountPendingExitTransfers(ctx, valid2ID)
+		assert.NoError(t, err)
+		assert.Equal(t, int64(0), count)
+		assert.NoError(t, cache.CompleteExit(ctx, valid2ID))
+
+		status, err = cache.ExitStatus(ctx, valid2ID)
+		if assert.NoError(t, err) {
+			assert.True(t, status.Exited)
+			assert.True(t, status.TransfersListed)
+			assert.Equal(t, int64(1), status.PiecesTransferred)
+			assert.Equal(t, int64(1), status.PiecesFailed)
+		}
+		assert.True(t, cache.CompleteExit(ctx, valid2ID) == overlay.ErrExitIncomplete)
+	}
+
+	{ // SelectStorageNodes
+		storage1ID, storage2ID, exitingID := storj.NodeID{}, storj.NodeID{}, storj.NodeID{}
+		_, _ = rand.Read(storage1ID[:])
+		_, _ = rand.Read(storage2ID[:])
+		_, _ = rand.Read(exitingID[:])
+
+		for address, node := range map[string]pb.Node{
+			"127.0.0.1:7777": {Id: storage1ID, Restrictions: &pb.NodeRestrictions{FreeDisk: 100, FreeBandwidth: 100}, Metadata: &pb.NodeMetadata{Wallet: "0x1"}},
+			"127.0.1.1:7777": {Id: storage2ID, Restrictions: &pb.NodeRestrictions{FreeDisk: 10, FreeBandwidth: 10}, LastNet: "10.0.0.0/24"},
+			"127.0.0.2:7777": {Id: exitingID, Restrictions: &pb.NodeRestrictions{FreeDisk: 100, FreeBandwidth: 100}},
+		} {
+			node.Type = pb.NodeType_STORAGE
+			node.Address = &pb.NodeAddress{Address: address}
+			assert.NoError(t, cache.Put(ctx, node.Id, node))
+		}
+
+		// the satellite resolves the networks of the nodes
+		storage2, err := cache.Get(ctx, storage2ID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "127.0.1.0/24", storage2.LastNet)
+		}
+
+		_, err = cache.InitiateExit(ctx, exitingID)
+		assert.NoError(t, err)
+
+		selectIDs := func(count int, criteria *overlay.NodeCriteria) map[storj.NodeID]bool {
+			nodes, err := store.SelectStorageNodes(ctx, count, criteria)
+			assert.NoError(t, err)
+			ids := map[storj.NodeID]bool{}
+			for _, node := range nodes {
+				ids[node.Id] = true
+			}
+			return ids
+		}
+
+		both := map[storj.NodeID]bool{storage1ID: true, storage2ID: true}
+		assert.Equal(t, both, selectIDs(10, &overlay.NodeCriteria{}))
+		assert.Equal(t, both, selectIDs(10, &overlay.NodeCriteria{Weighted: true}))
+		assert.Equal(t, map[storj.NodeID]bool{storage1ID: true}, selectIDs(10, &overlay.NodeCriteria{FreeDisk: 50}))
+		assert.Equal(t, map[storj.NodeID]bool{storage2ID: true}, selectIDs(10, &overlay.NodeCriteria{Excluded: storj.NodeIDList{storage1ID}}))
+		assert.Equal(t, map[storj.NodeID]bool{storage2ID: true}, selectIDs(10, &overlay.NodeCriteria{ExcludedAddresses: []string{"127.0.0.1:7777"}}))
+		assert.Equal(t, map[storj.NodeID]bool{storage2ID: true}, selectIDs(10, &overlay.NodeCriteria{ExcludedNetworks: []string{"127.0.0.0/24"}}))
+		assert.Equal(t, map[storj.NodeID]bool{storage2ID: true}, selectIDs(10, &overlay.NodeCriteria{ExcludedWallets: []string{"0x1"}}))
+		assert.Empty(t, selectIDs(10, &overlay.NodeCriteria{AuditCount: 1}))
+		assert.Equal(t, both, selectIDs(10, &overlay.NodeCriteria{MaxAuditCount: 1}))
+		assert.Len(t, selectIDs(1, &overlay.NodeCriteria{}), 1)
+		assert.Len(t, selectIDs(1, &overlay.NodeCriteria{Weighted: true}), 1)
+
+		// the exclusions over the argument limit of SQLite are filtered
+		// from the results
+		many := &overlay.NodeCriteria{}
+		for i := 0; i < 1000; i++ {
+			id := storj.NodeID{}
+			_, _ = rand.Read(id[:])
+			many.Excluded = append(many.Excluded, id)
+			many.ExcludedNetworks = append(many.ExcludedNetworks, fmt.Sprintf("10.%d.%d.0/24", i/256, i%256))
+		}
+		many.Excluded = append(many.Excluded, storage1ID)
+		assert.Equal(t, map[storj.NodeID]bool{storage2ID: true}, selectIDs(1, many))
+		many.ExcludedNetworks = append(many.ExcludedNetworks, "127.0.1.0/24")
+		assert.Empty(t, selectIDs(10, many))
+
+		// the nodes, which network wasn't resolved, aren't selected when
+		// the networks are distinct
+		unresolvedID := storj.NodeID{}
+		_, _ = rand.Read(unresolvedID[:])
+		unresolved := pb.Node{Id: unresolvedID, Type: pb.NodeType_STORAGE, Address: &pb.NodeAddress{Address: "unresolvable.invalid:7777"}}
+		assert.NoError(t, cache.Put(ctx, unresolvedID, unresolved))
+
+		stored, err := cache.Get(ctx, unresolvedID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "", stored.LastNet)
+		}
+		assert.True(t, selectIDs(10, &overlay.NodeCriteria{})[unresolvedID])
+		assert.Equal(t, both, selectIDs(10, &overlay.NodeCriteria{RequireNetwork: true}))
+
+		// the network is resolved again, when the address changed
+		unresolved.Address = &pb.NodeAddress{Address: "127.0.3.1:7777"}
+		assert.NoError(t, cache.Put(ctx, unresolvedID, unresolved))
+
+		stored, err = cache.Get(ctx, unresolvedID)
+		if assert.NoError(t, err) {
+			assert.Equal(t, "127.0.3.0/24", stored.LastNet)
+		}
+		assert.True(t, selectIDs(10, &overlay.NodeCriteria{RequireNetwork: true})[unresolvedID])
+	}
+
 	{ // Delete
 		// Test standard delete
 		err := cache.Delete(ctx, valid1ID)
@@ -78,6 +78,12 @@ func (client *client) Choose(ctx context.Context, op Options) ([]*pb.Node, error
 			Amount:        int64(op.Amount),
 			Restrictions:  &pb.NodeRestrictions{FreeDisk: op.Space, FreeBandwidth: op.Bandwidth},
 			ExcludedNodes: exIDs,
+			MinStats: &pb.NodeStats{
+				UptimeRatio:       op.Uptime,
+				UptimeCount:       op.UptimeCount,
+				AuditSuccessRatio: op.AuditSuccess,
+				AuditCount:        op.AuditCount,
+			},
 		},
 	})
 	if err != nil {
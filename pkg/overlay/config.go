@@ -45,6 +45,16 @@ type NodeSelectionConfig struct {
 	UptimeCount       int64   `help:"the number of times a node's uptime has been checked" default:"0"`
 	AuditSuccessRatio float64 `help:"a node's ratio of successful audits" default:"0"`
 	AuditCount        int64   `help:"the number of times a node has been audited" default:"0"`
+	AuditReputation   float64 `help:"a node's decaying reputation of successful audits" default:"0"`
+	UptimeReputation  float64 `help:"a node's decaying reputation of being up/online" default:"0"`
+	Weighted          bool    `help:"prefer nodes with more free disk space and a better reputation when selecting nodes" default:"false"`
+
+	DistinctNetworkBits   int  `help:"the prefix length of the IPv4 networks of which at most one node is selected for a segment, 0 disables the restriction" default:"24"`
+	DistinctNetworkBitsV6 int  `help:"the prefix length of the IPv6 networks of which at most one node is selected for a segment, 0 disables the restriction" default:"64"`
+	DistinctWallet        bool `help:"select at most one node per operator wallet for a segment" default:"false"`
+
+	NewNodeAuditThreshold int64   `help:"the number of times a node has to be audited to be vetted, 0 vets every node" default:"0"`
+	NewNodePercentage     float64 `help:"the fraction of the nodes of a segment selected from the unvetted nodes" default:"0.05"`
 }
 
 // CtxKey used for assigning cache and server
@@ -69,16 +79,9 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (
 		return Error.Wrap(errs.New("unable to get master db instance"))
 	}
 
-	cache := NewCache(sdb.OverlayCache(), sdb.StatDB())
-
-	ns := &pb.NodeStats{
-		UptimeCount:       c.Node.UptimeCount,
-		UptimeRatio:       c.Node.UptimeRatio,
-		AuditSuccessRatio: c.Node.AuditSuccessRatio,
-		AuditCount:        c.Node.AuditCount,
-	}
+	cache := NewCache(sdb.OverlayCache(), sdb.StatDB(), c.Node, statdb.LoadReputationFromContext(ctx).Uptime)
 
-	srv := NewServer(zap.L(), cache, ns)
+	srv := NewServer(zap.L(), cache, c.Node)
 	pb.RegisterOverlayServer(server.GRPC(), srv)
 
 	zap.S().Warn("Once the Peer refactor is done, the overlay inspector needs to be registered on a " +
@@ -0,0 +1,46 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay
+
+import (
+	"context"
+	"net"
+)
+
+// ResolveNetwork resolves the host of the address and returns the network
+// of the first resolved IP address with the prefix length of its IP version.
+// The network is the IP address itself, when the prefix length of the IP
+// version is 0.
+func ResolveNetwork(ctx context.Context, address string, bits, bitsV6 int) (_ string, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return "", OverlayError.Wrap(err)
+	}
+
+	addrs, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+	if err != nil {
+		return "", OverlayError.Wrap(err)
+	}
+	if len(addrs) == 0 {
+		return "", OverlayError.New("no IP address for %q", host)
+	}
+
+	ip, size := addrs[0].IP.To4(), 8*net.IPv4len
+	if ip == nil {
+		ip, size, bits = addrs[0].IP.To16(), 8*net.IPv6len, bitsV6
+	}
+	if bits <= 0 {
+		bits = size
+	}
+
+	mask := net.CIDRMask(bits, size)
+	if mask == nil {
+		return "", OverlayError.New("invalid prefix length %d", bits)
+	}
+
+	network := &net.IPNet{IP: ip.Mask(mask), Mask: mask}
+	return network.String(), nil
+}
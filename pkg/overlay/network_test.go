@@ -0,0 +1,43 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package overlay_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/overlay"
+)
+
+func TestResolveNetwork(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	for _, tt := range []struct {
+		address string
+		bits    int
+		bitsV6  int
+		network string
+		err     bool
+	}{
+		{address: "127.0.0.1:7777", bits: 24, bitsV6: 64, network: "127.0.0.0/24"},
+		{address: "127.1.2.3:7777", bits: 16, bitsV6: 64, network: "127.1.0.0/16"},
+		{address: "[2001:db8:1:2:3::1]:7777", bits: 24, bitsV6: 48, network: "2001:db8:1::/48"},
+		{address: "127.0.0.1:7777", bits: 0, bitsV6: 64, network: "127.0.0.1/32"},
+		{address: "[::1]:7777", bits: 24, bitsV6: 0, network: "::1/128"},
+		{address: "127.0.0.1:7777", bits: 33, bitsV6: 64, err: true},
+		{address: "127.0.0.1", bits: 24, bitsV6: 64, err: true},
+	} {
+		network, err := overlay.ResolveNetwork(ctx, tt.address, tt.bits, tt.bitsV6)
+		if tt.err {
+			assert.Error(t, err, tt.address)
+			continue
+		}
+		if assert.NoError(t, err, tt.address) {
+			assert.Equal(t, tt.network, network, tt.address)
+		}
+	}
+}
@@ -4,9 +4,9 @@
 package overlay
 
 import (
-	"bytes"
 	"context"
-	"fmt"
+	"math"
+	"math/rand"
 
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
@@ -23,19 +23,19 @@ var ServerError = errs.Class("Server Error")
 
 // Server implements our overlay RPC service
 type Server struct {
-	log       *zap.Logger
-	cache     *Cache
-	metrics   *monkit.Registry
-	nodeStats *pb.NodeStats
+	log     *zap.Logger
+	cache   *Cache
+	metrics *monkit.Registry
+	config  NodeSelectionConfig
 }
 
 // NewServer creates a new Overlay Server
-func NewServer(log *zap.Logger, cache *Cache, nodeStats *pb.NodeStats) *Server {
+func NewServer(log *zap.Logger, cache *Cache, config NodeSelectionConfig) *Server {
 	return &Server{
-		cache:     cache,
-		log:       log,
-		metrics:   monkit.Default,
-		nodeStats: nodeStats,
+		cache:   cache,
+		log:     log,
+		metrics: monkit.Default,
+		config:  config,
 	}
 }
 
@@ -62,55 +62,89 @@ func (server *Server) BulkLookup(ctx context.Context, reqs *pb.LookupRequests) (
 	return nodesToLookupResponses(ns), nil
 }
 
-// FindStorageNodes searches the overlay network for nodes that meet the provided requirements
+// FindStorageNodes randomly selects storage nodes that meet the provided
+// requirements, every selected node has a different address. When enabled,
+// at most one node per network and operator wallet is selected, and the
+// networks and wallets of the excluded nodes aren't selected either. A
+// fraction of the nodes is selected from the unvetted nodes, so they can
+// be audited and vetted.
 func (server *Server) FindStorageNodes(ctx context.Context, req *pb.FindStorageNodesRequest) (resp *pb.FindStorageNodesResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
 	opts := req.GetOpts()
 	maxNodes := req.GetMaxNodes()
 	if maxNodes <= 0 {
 		maxNodes = opts.GetAmount()
 	}
 
-	excluded := opts.ExcludedNodes
 	restrictions := opts.GetRestrictions()
-	reputation := server.nodeStats
+	minStats := opts.GetMinStats()
 
-	var startID storj.NodeID
-	result := []*pb.Node{}
-	for {
-		var nodes []*pb.Node
-		nodes, startID, err = server.populate(ctx, req.Start, maxNodes, restrictions, reputation, excluded)
+	selection := newNodeSelection(server.config)
+	if len(opts.ExcludedNodes) > 0 {
+		excluded, err := server.cache.GetAll(ctx, opts.ExcludedNodes)
 		if err != nil {
+			server.log.Error("Error looking up excluded nodes", zap.Error(err))
 			return nil, Error.Wrap(err)
 		}
-
-		resultNodes := []*pb.Node{}
-		usedAddrs := make(map[string]bool)
-		for _, n := range nodes {
-			addr := n.Address.GetAddress()
-			excluded = append(excluded, n.Id) // exclude all nodes on next iteration
-			if !usedAddrs[addr] {
-				resultNodes = append(resultNodes, n)
-				usedAddrs[addr] = true
+		selection.excluded = append(selection.excluded, opts.ExcludedNodes...)
+		for _, n := range excluded {
+			if n != nil {
+				selection.exclude(n)
 			}
 		}
-		if len(resultNodes) <= 0 {
-			break
-		}
+	}
 
-		result = append(result, resultNodes...)
+	result := []*pb.Node{}
 
-		if len(result) >= int(maxNodes) || startID == (storj.NodeID{}) {
-			break
+	// the unvetted nodes have no reputation to require yet
+	threshold := server.config.NewNodeAuditThreshold
+	unvetted := &NodeCriteria{
+		FreeBandwidth: restrictions.GetFreeBandwidth(),
+		FreeDisk:      restrictions.GetFreeDisk(),
+		MaxAuditCount: threshold,
+	}
+	if threshold > 0 {
+		nodes, err := server.selectNodes(ctx, newNodeCount(int(maxNodes), server.config.NewNodePercentage), unvetted, selection)
+		if err != nil {
+			return nil, err
 		}
+		result = append(result, nodes...)
+	}
 
+	// the requested reputation can't be lower than the configured one
+	vetted := &NodeCriteria{
+		FreeBandwidth:     restrictions.GetFreeBandwidth(),
+		FreeDisk:          restrictions.GetFreeDisk(),
+		AuditCount:        maxInt64(server.config.AuditCount, minStats.GetAuditCount()),
+		AuditSuccessRatio: math.Max(server.config.AuditSuccessRatio, minStats.GetAuditSuccessRatio()),
+		UptimeCount:       maxInt64(server.config.UptimeCount, minStats.GetUptimeCount()),
+		UptimeRatio:       math.Max(server.config.UptimeRatio, minStats.GetUptimeRatio()),
+		AuditReputation:   server.config.AuditReputation,
+		UptimeReputation:  server.config.UptimeReputation,
+		Weighted:          server.config.Weighted,
 	}
+	// the vetted nodes were audited at least the threshold times
+	vetted.AuditCount = maxInt64(vetted.AuditCount, server.config.NewNodeAuditThreshold)
 
-	if len(result) < int(maxNodes) {
-		return nil, status.Errorf(codes.ResourceExhausted, fmt.Sprintf("requested %d nodes, only %d nodes matched the criteria requested", maxNodes, len(result)))
+	// the vetted nodes fill up the share of the missing unvetted nodes
+	nodes, err := server.selectNodes(ctx, int(maxNodes)-len(result), vetted, selection)
+	if err != nil {
+		return nil, err
 	}
+	result = append(result, nodes...)
 
-	if len(result) > int(maxNodes) {
-		result = result[:maxNodes]
+	// and the unvetted nodes the share of the missing vetted nodes
+	if threshold > 0 && len(result) < int(maxNodes) {
+		nodes, err := server.selectNodes(ctx, int(maxNodes)-len(result), unvetted, selection)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, nodes...)
+	}
+
+	if len(result) < int(maxNodes) {
+		return nil, status.Errorf(codes.ResourceExhausted, "requested %d nodes, only %d nodes matched the criteria requested", maxNodes, len(result))
 	}
 
 	return &pb.FindStorageNodesResponse{
@@ -118,63 +152,123 @@ func (server *Server) FindStorageNodes(ctx context.Context, req *pb.FindStorageN
 	}, nil
 }
 
-// TODO: nicer method arguments
-func (server *Server) populate(ctx context.Context,
-	startID storj.NodeID, maxNodes int64,
-	minRestrictions *pb.NodeRestrictions,
-	minReputation *pb.NodeStats,
-	excluded storj.NodeIDList) ([]*pb.Node, storj.NodeID, error) {
+// selectNodes selects up to count nodes meeting the criteria, which weren't
+// excluded by the selection
+func (server *Server) selectNodes(ctx context.Context, count int, criteria *NodeCriteria, selection *nodeSelection) (result []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
 
-	// TODO: move the query into db
-	limit := int(maxNodes * 2)
-	nodes, err := server.cache.db.List(ctx, startID, limit)
-	if err != nil {
-		server.log.Error("Error listing nodes", zap.Error(err))
-		return nil, storj.NodeID{}, Error.Wrap(err)
-	}
+	for len(result) < count {
+		selection.apply(criteria)
 
-	var nextStart storj.NodeID
-	result := []*pb.Node{}
-	for _, v := range nodes {
-		if v == nil {
-			continue
+		nodes, err := server.cache.SelectStorageNodes(ctx, count-len(result), criteria)
+		if err != nil {
+			server.log.Error("Error selecting nodes", zap.Error(err))
+			return nil, Error.Wrap(err)
 		}
-
-		nextStart = v.Id
-		if v.Type != pb.NodeType_STORAGE {
-			server.log.Debug("not storage node = " + v.Id.String() + " was " + v.Type.String())
-			continue
+		if len(nodes) == 0 {
+			break
 		}
 
-		restrictions := v.GetRestrictions()
-		reputation := v.GetReputation()
-
-		if restrictions.GetFreeBandwidth() < minRestrictions.GetFreeBandwidth() ||
-			restrictions.GetFreeDisk() < minRestrictions.GetFreeDisk() ||
-			reputation.GetUptimeRatio() < minReputation.GetUptimeRatio() ||
-			reputation.GetUptimeCount() < minReputation.GetUptimeCount() ||
-			reputation.GetAuditSuccessRatio() < minReputation.GetAuditSuccessRatio() ||
-			reputation.GetAuditCount() < minReputation.GetAuditCount() ||
-			contains(excluded, v.Id) {
-			server.log.Debug("excluded = " + v.Id.String())
-			continue
+		// the nodes of a query only collide with each other, the colliding
+		// nodes are excluded by their address, network or wallet in the
+		// next query
+		for _, n := range nodes {
+			if selection.contains(n) {
+				continue
+			}
+			selection.add(n)
+			result = append(result, n)
 		}
+	}
+	return result, nil
+}
 
-		server.log.Debug("append " + v.Id.String() + " - " + v.Type.String())
-		result = append(result, v)
+// newNodeCount returns the number of unvetted nodes of a segment of count
+// nodes. The fraction of a node is rounded up randomly, so the unvetted
+// nodes get their share of the pieces of small segments too.
+func newNodeCount(count int, percentage float64) int {
+	expected := float64(count) * percentage
+	newNodes := int(expected)
+	if rand.Float64() < expected-float64(newNodes) {
+		newNodes++
+	}
+	if newNodes > count {
+		return count
 	}
+	return newNodes
+}
 
-	return result, nextStart, nil
+// nodeSelection tracks the nodes of a segment. Every selected node has a
+// different address, and, when enabled, a different network and operator
+// wallet. The nodes without a resolved network aren't selected, when the
+// networks are distinct, the nodes without a wallet are always distinct.
+type nodeSelection struct {
+	excluded  storj.NodeIDList
+	addresses map[string]bool
+	networks  map[string]bool
+	wallets   map[string]bool
 }
 
-// contains checks if item exists in list
-func contains(nodeIDs storj.NodeIDList, searchID storj.NodeID) bool {
-	for _, id := range nodeIDs {
-		if bytes.Equal(id.Bytes(), searchID.Bytes()) {
-			return true
-		}
+func newNodeSelection(config NodeSelectionConfig) *nodeSelection {
+	selection := &nodeSelection{addresses: make(map[string]bool)}
+	if config.DistinctNetworkBits > 0 || config.DistinctNetworkBitsV6 > 0 {
+		selection.networks = make(map[string]bool)
+	}
+	if config.DistinctWallet {
+		selection.wallets = make(map[string]bool)
+	}
+	return selection
+}
+
+// contains returns whether the node shares the address, network or wallet
+// of a selected node
+func (selection *nodeSelection) contains(node *pb.Node) bool {
+	return selection.addresses[node.Address.GetAddress()] ||
+		selection.networks[node.LastNet] ||
+		selection.wallets[node.GetMetadata().GetWallet()]
+}
+
+// add adds the node to the selection
+func (selection *nodeSelection) add(node *pb.Node) {
+	selection.addresses[node.Address.GetAddress()] = true
+	selection.excluded = append(selection.excluded, node.Id)
+	selection.exclude(node)
+}
+
+// exclude excludes the network and wallet of the node
+func (selection *nodeSelection) exclude(node *pb.Node) {
+	if network := node.LastNet; selection.networks != nil && network != "" {
+		selection.networks[network] = true
+	}
+	if wallet := node.GetMetadata().GetWallet(); selection.wallets != nil && wallet != "" {
+		selection.wallets[wallet] = true
+	}
+}
+
+// apply excludes the selected and excluded nodes, addresses, networks and
+// wallets in the criteria
+func (selection *nodeSelection) apply(criteria *NodeCriteria) {
+	criteria.Excluded = selection.excluded
+	criteria.RequireNetwork = selection.networks != nil
+	criteria.ExcludedAddresses = criteria.ExcludedAddresses[:0]
+	for address := range selection.addresses {
+		criteria.ExcludedAddresses = append(criteria.ExcludedAddresses, address)
+	}
+	criteria.ExcludedNetworks = criteria.ExcludedNetworks[:0]
+	for network := range selection.networks {
+		criteria.ExcludedNetworks = append(criteria.ExcludedNetworks, network)
+	}
+	criteria.ExcludedWallets = criteria.ExcludedWallets[:0]
+	for wallet := range selection.wallets {
+		criteria.ExcludedWallets = append(criteria.ExcludedWallets, wallet)
+	}
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
 	}
-	return false
+	return b
 }
 
 // lookupRequestsToNodeIDs returns the nodeIDs from the LookupRequests
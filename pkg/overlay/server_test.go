@@ -4,16 +4,26 @@
 package overlay_test
 
 import (
+	"context"
+	"fmt"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/internal/teststorj"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
 )
 
 func TestServer(t *testing.T) {
@@ -31,7 +41,7 @@ func TestServer(t *testing.T) {
 	time.Sleep(2 * time.Second)
 
 	satellite := planet.Satellites[0]
-	server := overlay.NewServer(satellite.Log.Named("overlay"), satellite.Overlay, &pb.NodeStats{})
+	server := overlay.NewServer(satellite.Log.Named("overlay"), satellite.Overlay, overlay.NodeSelectionConfig{})
 	// TODO: handle cleanup
 
 	{ // FindStorageNodes
@@ -72,3 +82,228 @@ func TestServer(t *testing.T) {
 		}
 	}
 }
+
+func TestFindStorageNodesDistinct(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		config := overlay.NodeSelectionConfig{DistinctNetworkBits: 24, DistinctWallet: true}
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB(), config, statdb.ReputationParams{})
+		server := overlay.NewServer(zap.NewNop(), cache, config)
+
+		ids := make(storj.NodeIDList, 4)
+		for i, node := range []struct {
+			address string
+			wallet  string
+		}{
+			{"127.0.0.1:7777", "0x1"},
+			{"127.0.0.2:7777", "0x2"},
+			{"127.0.1.1:7777", "0x3"},
+			{"127.0.2.1:7777", "0x3"},
+		} {
+			ids[i] = teststorj.NodeIDFromString(node.address)
+			require.NoError(t, cache.Put(ctx, ids[i], pb.Node{
+				Id:       ids[i],
+				Type:     pb.NodeType_STORAGE,
+				Address:  &pb.NodeAddress{Address: node.address},
+				Metadata: &pb.NodeMetadata{Wallet: node.wallet},
+			}))
+		}
+
+		find := func(amount int64, excluded storj.NodeIDList) ([]*pb.Node, error) {
+			result, err := server.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+				Opts: &pb.OverlayOptions{Amount: amount, ExcludedNodes: excluded},
+			})
+			return result.GetNodes(), err
+		}
+
+		// at most one node of a network and of a wallet is selected
+		nodes, err := find(2, nil)
+		require.NoError(t, err)
+		require.Len(t, nodes, 2)
+		assert.NotEqual(t, nodes[0].LastNet, nodes[1].LastNet)
+		assert.NotEqual(t, nodes[0].GetMetadata().GetWallet(), nodes[1].GetMetadata().GetWallet())
+
+		_, err = find(3, nil)
+		assert.Error(t, err)
+
+		// the networks and wallets of the excluded nodes aren't selected
+		_, err = find(1, storj.NodeIDList{ids[0], ids[2]})
+		assert.Error(t, err)
+
+		nodes, err = find(1, storj.NodeIDList{ids[0]})
+		require.NoError(t, err)
+		require.Len(t, nodes, 1)
+		assert.NotEqual(t, ids[1], nodes[0].Id)
+	})
+}
+
+func TestFindStorageNodesAddresses(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		counting := &countingOverlayDB{DB: db.OverlayCache()}
+		config := overlay.NodeSelectionConfig{}
+		cache := overlay.NewCache(counting, db.StatDB(), config, statdb.ReputationParams{})
+		server := overlay.NewServer(zap.NewNop(), cache, config)
+
+		// three nodes share an address
+		for i, address := range []string{"127.0.0.1:7777", "127.0.0.1:7777", "127.0.0.1:7777", "127.0.0.2:7777"} {
+			id := teststorj.NodeIDFromString(fmt.Sprintf("node%d", i))
+			require.NoError(t, cache.Put(ctx, id, pb.Node{
+				Id:      id,
+				Type:    pb.NodeType_STORAGE,
+				Address: &pb.NodeAddress{Address: address},
+			}))
+		}
+
+		find := func(amount int64) ([]*pb.Node, error) {
+			result, err := server.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+				Opts: &pb.OverlayOptions{Amount: amount},
+			})
+			return result.GetNodes(), err
+		}
+
+		// the colliding nodes of a query are excluded together by their
+		// address in the next one
+		for i := 0; i < 10; i++ {
+			counting.selections = 0
+			nodes, err := find(2)
+			require.NoError(t, err)
+			require.Len(t, nodes, 2)
+			assert.NotEqual(t, nodes[0].Address.Address, nodes[1].Address.Address)
+			assert.True(t, counting.selections <= 2)
+		}
+
+		// the selection is exhausted once only colliding nodes are left
+		counting.selections = 0
+		_, err := find(3)
+		assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+		assert.True(t, counting.selections <= 3)
+	})
+}
+
+// countingOverlayDB counts the selections of storage nodes
+type countingOverlayDB struct {
+	overlay.DB
+	selections int
+}
+
+// SelectStorageNodes counts the selection and selects the nodes from the
+// overlay database
+func (db *countingOverlayDB) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
+	db.selections++
+	return db.DB.SelectStorageNodes(ctx, count, criteria)
+}
+
+func TestFindStorageNodesVetting(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		config := overlay.NodeSelectionConfig{NewNodeAuditThreshold: 5, NewNodePercentage: 0.5}
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB(), config, statdb.ReputationParams{})
+		server := overlay.NewServer(zap.NewNop(), cache, config)
+
+		for i := 0; i < 4; i++ {
+			address := fmt.Sprintf("127.0.0.%d:7777", i+1)
+			id := teststorj.NodeIDFromString(address)
+
+			// half of the nodes are vetted
+			if i%2 == 0 {
+				_, err := db.StatDB().Create(ctx, id, &statdb.NodeStats{AuditCount: 5, AuditSuccessCount: 5, AuditSuccessRatio: 1})
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, cache.Put(ctx, id, pb.Node{
+				Id:      id,
+				Type:    pb.NodeType_STORAGE,
+				Address: &pb.NodeAddress{Address: address},
+			}))
+		}
+
+		for _, amount := range []int64{2, 4} {
+			result, err := server.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+				Opts: &pb.OverlayOptions{Amount: amount},
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Nodes, int(amount))
+
+			var unvetted int64
+			for _, node := range result.Nodes {
+				if !cache.IsVetted(node) {
+					unvetted++
+				}
+			}
+			assert.Equal(t, amount/2, unvetted)
+		}
+
+		// the nodes of one pool fill up the nodes missing in the other
+		for vetted, excluded := range map[bool]storj.NodeIDList{
+			false: {teststorj.NodeIDFromString("127.0.0.1:7777"), teststorj.NodeIDFromString("127.0.0.3:7777")},
+			true:  {teststorj.NodeIDFromString("127.0.0.2:7777"), teststorj.NodeIDFromString("127.0.0.4:7777")},
+		} {
+			result, err := server.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+				Opts: &pb.OverlayOptions{Amount: 2, ExcludedNodes: excluded},
+			})
+			require.NoError(t, err)
+			require.Len(t, result.Nodes, 2)
+			for _, node := range result.Nodes {
+				assert.Equal(t, vetted, cache.IsVetted(node))
+			}
+		}
+
+		_, err := server.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+			Opts: &pb.OverlayOptions{Amount: 5},
+		})
+		assert.Error(t, err)
+	})
+}
+
+func TestFindStorageNodesReputation(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		config := overlay.NodeSelectionConfig{AuditReputation: 0.6}
+		cache := overlay.NewCache(db.OverlayCache(), db.StatDB(), config, statdb.ReputationParams{})
+		server := overlay.NewServer(zap.NewNop(), cache, config)
+
+		var ids storj.NodeIDList
+		for i := 0; i < 2; i++ {
+			address := fmt.Sprintf("127.0.0.%d:7777", i+1)
+			id := teststorj.NodeIDFromString(address)
+			ids = append(ids, id)
+
+			// both nodes have a lifetime audit success ratio above 0.9
+			_, err := db.StatDB().Create(ctx, id, &statdb.NodeStats{AuditCount: 100, AuditSuccessCount: 100, AuditSuccessRatio: 1})
+			require.NoError(t, err)
+
+			require.NoError(t, cache.Put(ctx, id, pb.Node{
+				Id:      id,
+				Type:    pb.NodeType_STORAGE,
+				Address: &pb.NodeAddress{Address: address},
+			}))
+		}
+
+		// the reputation of the second node forgets its successful audits
+		for i := 0; i < 10; i++ {
+			_, err := db.StatDB().UpdateAuditSuccess(ctx, ids[1], false, statdb.ReputationParams{Lambda: 0.5, Weight: 1})
+			require.NoError(t, err)
+		}
+
+		result, err := server.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+			Opts: &pb.OverlayOptions{Amount: 1},
+		})
+		require.NoError(t, err)
+		require.Len(t, result.Nodes, 1)
+		assert.Equal(t, ids[0], result.Nodes[0].Id)
+
+		_, err = server.FindStorageNodes(ctx, &pb.FindStorageNodesRequest{
+			Opts: &pb.OverlayOptions{Amount: 2},
+		})
+		assert.Error(t, err)
+	})
+}
@@ -46,7 +46,7 @@ func (x AgreementsSummary_Status) String() string {
 	return proto.EnumName(AgreementsSummary_Status_name, int32(x))
 }
 func (AgreementsSummary_Status) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_bandwidth_1560d6d92a271e58, []int{0, 0}
+	return fileDescriptor_bandwidth_86abd1d01269e60d, []int{0, 0}
 }
 
 type AgreementsSummary struct {
@@ -60,7 +60,7 @@ func (m *AgreementsSummary) Reset()         { *m = AgreementsSummary{} }
 func (m *AgreementsSummary) String() string { return proto.CompactTextString(m) }
 func (*AgreementsSummary) ProtoMessage()    {}
 func (*AgreementsSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_bandwidth_1560d6d92a271e58, []int{0}
+	return fileDescriptor_bandwidth_86abd1d01269e60d, []int{0}
 }
 func (m *AgreementsSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_AgreementsSummary.Unmarshal(m, b)
@@ -87,8 +87,89 @@ func (m *AgreementsSummary) GetStatus() AgreementsSummary_Status {
 	return AgreementsSummary_FAIL
 }
 
+// SettlementRequest is a batch of bandwidth agreements of a storage node
+type SettlementRequest struct {
+	Allocations          []*RenterBandwidthAllocation `protobuf:"bytes,1,rep,name=allocations" json:"allocations,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
+	XXX_unrecognized     []byte                       `json:"-"`
+	XXX_sizecache        int32                        `json:"-"`
+}
+
+func (m *SettlementRequest) Reset()         { *m = SettlementRequest{} }
+func (m *SettlementRequest) String() string { return proto.CompactTextString(m) }
+func (*SettlementRequest) ProtoMessage()    {}
+func (*SettlementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bandwidth_86abd1d01269e60d, []int{1}
+}
+func (m *SettlementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettlementRequest.Unmarshal(m, b)
+}
+func (m *SettlementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettlementRequest.Marshal(b, m, deterministic)
+}
+func (dst *SettlementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementRequest.Merge(dst, src)
+}
+func (m *SettlementRequest) XXX_Size() int {
+	return xxx_messageInfo_SettlementRequest.Size(m)
+}
+func (m *SettlementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementRequest proto.InternalMessageInfo
+
+func (m *SettlementRequest) GetAllocations() []*RenterBandwidthAllocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
+// SettlementResponse has the status of every agreement of a batch, in the
+// order of the request
+type SettlementResponse struct {
+	Statuses             []AgreementsSummary_Status `protobuf:"varint,1,rep,packed,name=statuses,enum=bandwidth.AgreementsSummary_Status" json:"statuses,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
+}
+
+func (m *SettlementResponse) Reset()         { *m = SettlementResponse{} }
+func (m *SettlementResponse) String() string { return proto.CompactTextString(m) }
+func (*SettlementResponse) ProtoMessage()    {}
+func (*SettlementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bandwidth_86abd1d01269e60d, []int{2}
+}
+func (m *SettlementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SettlementResponse.Unmarshal(m, b)
+}
+func (m *SettlementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SettlementResponse.Marshal(b, m, deterministic)
+}
+func (dst *SettlementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SettlementResponse.Merge(dst, src)
+}
+func (m *SettlementResponse) XXX_Size() int {
+	return xxx_messageInfo_SettlementResponse.Size(m)
+}
+func (m *SettlementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SettlementResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SettlementResponse proto.InternalMessageInfo
+
+func (m *SettlementResponse) GetStatuses() []AgreementsSummary_Status {
+	if m != nil {
+		return m.Statuses
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*AgreementsSummary)(nil), "bandwidth.AgreementsSummary")
+	proto.RegisterType((*SettlementRequest)(nil), "bandwidth.SettlementRequest")
+	proto.RegisterType((*SettlementResponse)(nil), "bandwidth.SettlementResponse")
 	proto.RegisterEnum("bandwidth.AgreementsSummary_Status", AgreementsSummary_Status_name, AgreementsSummary_Status_value)
 }
 
@@ -105,6 +186,7 @@ const _ = grpc.SupportPackageIsVersion4
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type BandwidthClient interface {
 	BandwidthAgreements(ctx context.Context, in *RenterBandwidthAllocation, opts ...grpc.CallOption) (*AgreementsSummary, error)
+	Settlement(ctx context.Context, opts ...grpc.CallOption) (Bandwidth_SettlementClient, error)
 }
 
 type bandwidthClient struct {
@@ -124,9 +206,41 @@ func (c *bandwidthClient) BandwidthAgreements(ctx context.Context, in *RenterBan
 	return out, nil
 }
 
+func (c *bandwidthClient) Settlement(ctx context.Context, opts ...grpc.CallOption) (Bandwidth_SettlementClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Bandwidth_serviceDesc.Streams[0], "/bandwidth.Bandwidth/Settlement", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bandwidthSettlementClient{stream}
+	return x, nil
+}
+
+type Bandwidth_SettlementClient interface {
+	Send(*SettlementRequest) error
+	Recv() (*SettlementResponse, error)
+	grpc.ClientStream
+}
+
+type bandwidthSettlementClient struct {
+	grpc.ClientStream
+}
+
+func (x *bandwidthSettlementClient) Send(m *SettlementRequest) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *bandwidthSettlementClient) Recv() (*SettlementResponse, error) {
+	m := new(SettlementResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // BandwidthServer is the server API for Bandwidth service.
 type BandwidthServer interface {
 	BandwidthAgreements(context.Context, *RenterBandwidthAllocation) (*AgreementsSummary, error)
+	Settlement(Bandwidth_SettlementServer) error
 }
 
 func RegisterBandwidthServer(s *grpc.Server, srv BandwidthServer) {
@@ -151,6 +265,32 @@ func _Bandwidth_BandwidthAgreements_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bandwidth_Settlement_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(BandwidthServer).Settlement(&bandwidthSettlementServer{stream})
+}
+
+type Bandwidth_SettlementServer interface {
+	Send(*SettlementResponse) error
+	Recv() (*SettlementRequest, error)
+	grpc.ServerStream
+}
+
+type bandwidthSettlementServer struct {
+	grpc.ServerStream
+}
+
+func (x *bandwidthSettlementServer) Send(m *SettlementResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *bandwidthSettlementServer) Recv() (*SettlementRequest, error) {
+	m := new(SettlementRequest)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 var _Bandwidth_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "bandwidth.Bandwidth",
 	HandlerType: (*BandwidthServer)(nil),
@@ -160,26 +300,38 @@ var _Bandwidth_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Bandwidth_BandwidthAgreements_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Settlement",
+			Handler:       _Bandwidth_Settlement_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "bandwidth.proto",
 }
 
-func init() { proto.RegisterFile("bandwidth.proto", fileDescriptor_bandwidth_1560d6d92a271e58) }
-
-var fileDescriptor_bandwidth_1560d6d92a271e58 = []byte{
-	// 210 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0x4f, 0x4a, 0xcc, 0x4b,
-	0x29, 0xcf, 0x4c, 0x29, 0xc9, 0xd0, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x84, 0x0b, 0x48,
-	0x09, 0x14, 0x64, 0xa6, 0x26, 0xa7, 0x16, 0x97, 0xe4, 0x17, 0xa5, 0x42, 0x24, 0x95, 0xaa, 0xb8,
-	0x04, 0x1d, 0xd3, 0x8b, 0x52, 0x53, 0x73, 0x53, 0xf3, 0x4a, 0x8a, 0x83, 0x4b, 0x73, 0x73, 0x13,
-	0x8b, 0x2a, 0x85, 0xac, 0xb9, 0xd8, 0x8a, 0x4b, 0x12, 0x4b, 0x4a, 0x8b, 0x25, 0x18, 0x15, 0x18,
-	0x35, 0xf8, 0x8c, 0x94, 0xf5, 0x10, 0x66, 0x62, 0xa8, 0xd6, 0x0b, 0x06, 0x2b, 0x0d, 0x82, 0x6a,
-	0x51, 0xd2, 0xe0, 0x62, 0x83, 0x88, 0x08, 0x71, 0x70, 0xb1, 0xb8, 0x39, 0x7a, 0xfa, 0x08, 0x30,
-	0x08, 0xb1, 0x71, 0x31, 0xf9, 0x7b, 0x0b, 0x30, 0x0a, 0xf1, 0x70, 0x71, 0x04, 0xb9, 0x7a, 0xb9,
-	0x3a, 0x87, 0xb8, 0xba, 0x08, 0x30, 0x19, 0xe5, 0x73, 0x71, 0x3a, 0xc1, 0xcc, 0x15, 0x4a, 0xe2,
-	0x12, 0x86, 0x73, 0x10, 0x76, 0x08, 0x69, 0xeb, 0x21, 0x9c, 0x5c, 0x94, 0x5f, 0x5a, 0x92, 0x5a,
-	0xac, 0x17, 0x94, 0x9a, 0x57, 0x92, 0x5a, 0x84, 0x50, 0x9c, 0x93, 0x93, 0x9f, 0x9c, 0x58, 0x92,
-	0x99, 0x9f, 0x27, 0x25, 0x83, 0xcf, 0x9d, 0x4a, 0x0c, 0x4e, 0x2c, 0x51, 0x4c, 0x05, 0x49, 0x49,
-	0x6c, 0x60, 0x9f, 0x1b, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x2a, 0x6a, 0xd9, 0xfd, 0x29, 0x01,
-	0x00, 0x00,
+func init() { proto.RegisterFile("bandwidth.proto", fileDescriptor_bandwidth_86abd1d01269e60d) }
+
+var fileDescriptor_bandwidth_86abd1d01269e60d = []byte{
+	// 291 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xcf, 0x4b, 0xfb, 0x40,
+	0x10, 0xc5, 0xbb, 0xfd, 0x96, 0xd0, 0x4e, 0xbf, 0x68, 0x3a, 0x5e, 0xa4, 0x28, 0xc8, 0x7a, 0x09,
+	0x08, 0x41, 0xea, 0xd1, 0x83, 0xa4, 0x1a, 0xc1, 0x5f, 0x14, 0x12, 0xbd, 0x78, 0x4b, 0xda, 0x41,
+	0x03, 0x49, 0x36, 0xee, 0x4e, 0x10, 0xfd, 0xeb, 0xfc, 0xd3, 0xc4, 0x44, 0x93, 0x40, 0x51, 0xf4,
+	0x3a, 0xf3, 0xde, 0xbc, 0xcf, 0x5b, 0x16, 0x36, 0xe3, 0x28, 0x5f, 0x3d, 0x27, 0x2b, 0x7e, 0x74,
+	0x0b, 0xad, 0x58, 0xe1, 0xa8, 0x19, 0x4c, 0xed, 0x22, 0xa1, 0x25, 0x19, 0x56, 0x9a, 0xea, 0xa5,
+	0x7c, 0x85, 0x89, 0xf7, 0xa0, 0x89, 0x32, 0xca, 0xd9, 0x84, 0x65, 0x96, 0x45, 0xfa, 0x05, 0x8f,
+	0xc1, 0x32, 0x1c, 0x71, 0x69, 0xb6, 0xc5, 0x9e, 0x70, 0x36, 0x66, 0xfb, 0x6e, 0x7b, 0x73, 0x4d,
+	0xed, 0x86, 0x95, 0x34, 0xf8, 0xb4, 0x48, 0x07, 0xac, 0x7a, 0x82, 0x43, 0x18, 0x9c, 0x7b, 0x17,
+	0xd7, 0x76, 0x0f, 0x2d, 0xe8, 0x2f, 0xae, 0x6c, 0x81, 0xff, 0x61, 0x18, 0xf8, 0x97, 0xfe, 0xe9,
+	0xad, 0x7f, 0x66, 0xf7, 0x65, 0x0c, 0x93, 0x90, 0x98, 0xd3, 0xea, 0x5c, 0x40, 0x4f, 0x25, 0x19,
+	0xc6, 0x1b, 0x18, 0x47, 0x69, 0xaa, 0x96, 0x11, 0x27, 0x2a, 0xff, 0x00, 0xf8, 0xe7, 0x8c, 0x67,
+	0x07, 0x6e, 0x0b, 0xae, 0x55, 0xc9, 0x64, 0xdc, 0x80, 0x72, 0x26, 0x3d, 0xff, 0xe2, 0xf2, 0x1a,
+	0x4f, 0xd0, 0xf5, 0xcb, 0x3b, 0xc0, 0x6e, 0x86, 0x29, 0x54, 0x6e, 0x08, 0x4f, 0x60, 0x58, 0xd3,
+	0x52, 0x9d, 0xf0, 0xcb, 0x8a, 0x8d, 0x69, 0xf6, 0x26, 0x60, 0xd4, 0x64, 0x63, 0x0c, 0x5b, 0x2d,
+	0x48, 0x63, 0xc6, 0xbf, 0x50, 0x4f, 0x77, 0x7e, 0x02, 0x90, 0x3d, 0x5c, 0x00, 0xb4, 0x45, 0xb0,
+	0xab, 0x5e, 0x7b, 0xc3, 0xe9, 0xee, 0x37, 0xdb, 0xba, 0xbd, 0xec, 0x39, 0xe2, 0x50, 0xcc, 0x07,
+	0xf7, 0xfd, 0x22, 0x8e, 0xad, 0xea, 0x1b, 0x1c, 0xbd, 0x0f, 0x00, 0x6b, 0x85, 0x7a, 0x6b, 0x36,
+	0x02, 0x00, 0x00,
 }
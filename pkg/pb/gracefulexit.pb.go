@@ -0,0 +1,742 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gracefulexit.proto
+
+package pb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+import _ "github.com/gogo/protobuf/gogoproto"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type InitiateRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *InitiateRequest) Reset()         { *m = InitiateRequest{} }
+func (m *InitiateRequest) String() string { return proto.CompactTextString(m) }
+func (*InitiateRequest) ProtoMessage()    {}
+func (*InitiateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gracefulexit_bcded3db3946606b, []int{0}
+}
+func (m *InitiateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_InitiateRequest.Unmarshal(m, b)
+}
+func (m *InitiateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_InitiateRequest.Marshal(b, m, deterministic)
+}
+func (dst *InitiateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_InitiateRequest.Merge(dst, src)
+}
+func (m *InitiateRequest) XXX_Size() int {
+	return xxx_messageInfo_InitiateRequest.Size(m)
+}
+func (m *InitiateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_InitiateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_InitiateRequest proto.InternalMessageInfo
+
+type CompleteRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CompleteRequest) Reset()         { *m = CompleteRequest{} }
+func (m *CompleteRequest) String() string { return proto.CompactTextString(m) }
+func (*CompleteRequest) ProtoMessage()    {}
+func (*CompleteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gracefulexit_bcded3db3946606b, []int{1}
+}
+func (m *CompleteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CompleteRequest.Unmarshal(m, b)
+}
+func (m *CompleteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CompleteRequest.Marshal(b, m, deterministic)
+}
+func (dst *CompleteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CompleteRequest.Merge(dst, src)
+}
+func (m *CompleteRequest) XXX_Size() int {
+	return xxx_messageInfo_CompleteRequest.Size(m)
+}
+func (m *CompleteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CompleteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CompleteRequest proto.InternalMessageInfo
+
+type ProgressRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ProgressRequest) Reset()         { *m = ProgressRequest{} }
+func (m *ProgressRequest) String() string { return proto.CompactTextString(m) }
+func (*ProgressRequest) ProtoMessage()    {}
+func (*ProgressRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gracefulexit_bcded3db3946606b, []int{2}
+}
+func (m *ProgressRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ProgressRequest.Unmarshal(m, b)
+}
+func (m *ProgressRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ProgressRequest.Marshal(b, m, deterministic)
+}
+func (dst *ProgressRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ProgressRequest.Merge(dst, src)
+}
+func (m *ProgressRequest) XXX_Size() int {
+	return xxx_messageInfo_ProgressRequest.Size(m)
+}
+func (m *ProgressRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ProgressRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ProgressRequest proto.InternalMessageInfo
+
+type ExitProgress struct {
+	PiecesTransferred    int64                `protobuf:"varint,1,opt,name=pieces_transferred,json=piecesTransferred,proto3" json:"pieces_transferred,omitempty"`
+	PiecesFailed         int64                `protobuf:"varint,2,opt,name=pieces_failed,json=piecesFailed,proto3" json:"pieces_failed,omitempty"`
+	Exited               bool                 `protobuf:"varint,3,opt,name=exited,proto3" json:"exited,omitempty"`
+	InitiatedAt          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=initiated_at,json=initiatedAt" json:"initiated_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ExitProgress) Reset()         { *m = ExitProgress{} }
+func (m *ExitProgress) String() string { return proto.CompactTextString(m) }
+func (*ExitProgress) ProtoMessage()    {}
+func (*ExitProgress) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gracefulexit_bcded3db3946606b, []int{3}
+}
+func (m *ExitProgress) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExitProgress.Unmarshal(m, b)
+}
+func (m *ExitProgress) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExitProgress.Marshal(b, m, deterministic)
+}
+func (dst *ExitProgress) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExitProgress.Merge(dst, src)
+}
+func (m *ExitProgress) XXX_Size() int {
+	return xxx_messageInfo_ExitProgress.Size(m)
+}
+func (m *ExitProgress) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExitProgress.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExitProgress proto.InternalMessageInfo
+
+func (m *ExitProgress) GetPiecesTransferred() int64 {
+	if m != nil {
+		return m.PiecesTransferred
+	}
+	return 0
+}
+
+func (m *ExitProgress) GetPiecesFailed() int64 {
+	if m != nil {
+		return m.PiecesFailed
+	}
+	return 0
+}
+
+func (m *ExitProgress) GetExited() bool {
+	if m != nil {
+		return m.Exited
+	}
+	return false
+}
+
+func (m *ExitProgress) GetInitiatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.InitiatedAt
+	}
+	return nil
+}
+
+type TransfersRequest struct {
+	Limit                int32    `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransfersRequest) Reset()         { *m = TransfersRequest{} }
+func (m *TransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*TransfersRequest) ProtoMessage()    {}
+func (*TransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gracefulexit_bcded3db3946606b, []int{4}
+}
+func (m *TransfersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransfersRequest.Unmarshal(m, b)
+}
+func (m *TransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransfersRequest.Marshal(b, m, deterministic)
+}
+func (dst *TransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransfersRequest.Merge(dst, src)
+}
+func (m *TransfersRequest) XXX_Size() int {
+	return xxx_messageInfo_TransfersRequest.Size(m)
+}
+func (m *TransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransfersRequest proto.InternalMessageInfo
+
+func (m *TransfersRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type TransfersResponse struct {
+	Transfers            []*PieceTransfer `protobuf:"bytes,1,rep,name=transfers" json:"transfers,omitempty"`
+	More                 bool             `protobuf:"varint,2,opt,name=more,proto3" json:"more,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *TransfersResponse) Reset()         { *m = TransfersResponse{} }
+func (m *TransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*TransfersResponse) ProtoMessage()    {}
+func (*TransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gracefulexit_bcded3db3946606b, []int{5}
+}
+func (m *TransfersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransfersResponse.Unmarshal(m, b)
+}
+func (m *TransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransfersResponse.Marshal(b, m, deterministic)
+}
+func (dst *TransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransfersResponse.Merge(dst, src)
+}
+func (m *TransfersResponse) XXX_Size() int {
+	return xxx_messageInfo_TransfersResponse.Size(m)
+}
+func (m *TransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransfersResponse proto.InternalMessageInfo
+
+func (m *TransfersResponse) GetTransfers() []*PieceTransfer {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+func (m *TransfersResponse) GetMore() bool {
+	if m != nil {
+		return m.More
+	}
+	return false
+}
+
+// PieceTransfer is a piece to upload to the target node. The piece ids are
+// derived from the root piece id for the exiting and the target node.
+type PieceTransfer struct {
+	Path                 string                    `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	PieceId              string                    `protobuf:"bytes,2,opt,name=piece_id,json=pieceId,proto3" json:"piece_id,omitempty"`
+	PieceNum             int32                     `protobuf:"varint,3,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	Target               *Node                     `protobuf:"bytes,4,opt,name=target" json:"target,omitempty"`
+	ExpirationDate       *timestamp.Timestamp      `protobuf:"bytes,5,opt,name=expiration_date,json=expirationDate" json:"expiration_date,omitempty"`
+	PayerAllocation      *PayerBandwidthAllocation `protobuf:"bytes,6,opt,name=payer_allocation,json=payerAllocation" json:"payer_allocation,omitempty"`
+	Authorization        *SignedMessage            `protobuf:"bytes,7,opt,name=authorization" json:"authorization,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *PieceTransfer) Reset()         { *m = PieceTransfer{} }
+func (m *PieceTransfer) String() string { return proto.CompactTextString(m) }
+func (*PieceTransfer) ProtoMessage()    {}
+func (*PieceTransfer) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gracefulexit_bcded3db3946606b, []int{6}
+}
+func (m *PieceTransfer) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceTransfer.Unmarshal(m, b)
+}
+func (m *PieceTransfer) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceTransfer.Marshal(b, m, deterministic)
+}
+func (dst *PieceTransfer) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceTransfer.Merge(dst, src)
+}
+func (m *PieceTransfer) XXX_Size() int {
+	return xxx_messageInfo_PieceTransfer.Size(m)
+}
+func (m *PieceTransfer) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceTransfer.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceTransfer proto.InternalMessageInfo
+
+func (m *PieceTransfer) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *PieceTransfer) GetPieceId() string {
+	if m != nil {
+		return m.PieceId
+	}
+	return ""
+}
+
+func (m *PieceTransfer) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
+}
+
+func (m *PieceTransfer) GetTarget() *Node {
+	if m != nil {
+		return m.Target
+	}
+	return nil
+}
+
+func (m *PieceTransfer) GetExpirationDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.ExpirationDate
+	}
+	return nil
+}
+
+func (m *PieceTransfer) GetPayerAllocation() *PayerBandwidthAllocation {
+	if m != nil {
+		return m.PayerAllocation
+	}
+	return nil
+}
+
+func (m *PieceTransfer) GetAuthorization() *SignedMessage {
+	if m != nil {
+		return m.Authorization
+	}
+	return nil
+}
+
+type TransferResult struct {
+	Path     string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	PieceNum int32  `protobuf:"varint,2,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
+	TargetId NodeID `protobuf:"bytes,3,opt,name=target_id,json=targetId,proto3,customtype=NodeID" json:"target_id"`
+	// hash of the piece verified by the target node, it has to match the
+	// hash of the piece in the pointer
+	Hash []byte `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
+	// failed is set when the piece couldn't be transferred
+	Failed               bool     `protobuf:"varint,5,opt,name=failed,proto3" json:"failed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferResult) Reset()         { *m = TransferResult{} }
+func (m *TransferResult) String() string { return proto.CompactTextString(m) }
+func (*TransferResult) ProtoMessage()    {}
+func (*TransferResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gracefulexit_bcded3db3946606b, []int{7}
+}
+func (m *TransferResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferResult.Unmarshal(m, b)
+}
+func (m *TransferResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferResult.Marshal(b, m, deterministic)
+}
+func (dst *TransferResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferResult.Merge(dst, src)
+}
+func (m *TransferResult) XXX_Size() int {
+	return xxx_messageInfo_TransferResult.Size(m)
+}
+func (m *TransferResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferResult.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferResult proto.InternalMessageInfo
+
+func (m *TransferResult) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *TransferResult) GetPieceNum() int32 {
+	if m != nil {
+		return m.PieceNum
+	}
+	return 0
+}
+
+func (m *TransferResult) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
+func (m *TransferResult) GetFailed() bool {
+	if m != nil {
+		return m.Failed
+	}
+	return false
+}
+
+type TransferredResponse struct {
+	Progress *ExitProgress `protobuf:"bytes,1,opt,name=progress" json:"progress,omitempty"`
+	// verified is set when the satellite moved the piece to the target node,
+	// only then the exiting node deletes it
+	Verified             bool     `protobuf:"varint,2,opt,name=verified,proto3" json:"verified,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *TransferredResponse) Reset()         { *m = TransferredResponse{} }
+func (m *TransferredResponse) String() string { return proto.CompactTextString(m) }
+func (*TransferredResponse) ProtoMessage()    {}
+func (*TransferredResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_gracefulexit_bcded3db3946606b, []int{8}
+}
+func (m *TransferredResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferredResponse.Unmarshal(m, b)
+}
+func (m *TransferredResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferredResponse.Marshal(b, m, deterministic)
+}
+func (dst *TransferredResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferredResponse.Merge(dst, src)
+}
+func (m *TransferredResponse) XXX_Size() int {
+	return xxx_messageInfo_TransferredResponse.Size(m)
+}
+func (m *TransferredResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferredResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TransferredResponse proto.InternalMessageInfo
+
+func (m *TransferredResponse) GetProgress() *ExitProgress {
+	if m != nil {
+		return m.Progress
+	}
+	return nil
+}
+
+func (m *TransferredResponse) GetVerified() bool {
+	if m != nil {
+		return m.Verified
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*InitiateRequest)(nil), "gracefulexit.InitiateRequest")
+	proto.RegisterType((*CompleteRequest)(nil), "gracefulexit.CompleteRequest")
+	proto.RegisterType((*ProgressRequest)(nil), "gracefulexit.ProgressRequest")
+	proto.RegisterType((*ExitProgress)(nil), "gracefulexit.ExitProgress")
+	proto.RegisterType((*TransfersRequest)(nil), "gracefulexit.TransfersRequest")
+	proto.RegisterType((*TransfersResponse)(nil), "gracefulexit.TransfersResponse")
+	proto.RegisterType((*PieceTransfer)(nil), "gracefulexit.PieceTransfer")
+	proto.RegisterType((*TransferResult)(nil), "gracefulexit.TransferResult")
+	proto.RegisterType((*TransferredResponse)(nil), "gracefulexit.TransferredResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GracefulExitClient is the client API for GracefulExit service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GracefulExitClient interface {
+	// Initiate marks the calling node as exiting, it isn't selected for new pieces anymore
+	Initiate(ctx context.Context, in *InitiateRequest, opts ...grpc.CallOption) (*ExitProgress, error)
+	// Transfers lists the pieces the calling node has to transfer to other
+	// nodes, starting with the ones which results weren't reported yet
+	Transfers(ctx context.Context, in *TransfersRequest, opts ...grpc.CallOption) (*TransfersResponse, error)
+	// Transferred reports the result of the transfer of a piece
+	Transferred(ctx context.Context, in *TransferResult, opts ...grpc.CallOption) (*TransferredResponse, error)
+	// Complete marks the calling node as exited once it has no pieces left
+	Complete(ctx context.Context, in *CompleteRequest, opts ...grpc.CallOption) (*ExitProgress, error)
+	// Progress returns the progress of the exit of the calling node
+	Progress(ctx context.Context, in *ProgressRequest, opts ...grpc.CallOption) (*ExitProgress, error)
+}
+
+type gracefulExitClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGracefulExitClient(cc *grpc.ClientConn) GracefulExitClient {
+	return &gracefulExitClient{cc}
+}
+
+func (c *gracefulExitClient) Initiate(ctx context.Context, in *InitiateRequest, opts ...grpc.CallOption) (*ExitProgress, error) {
+	out := new(ExitProgress)
+	err := c.cc.Invoke(ctx, "/gracefulexit.GracefulExit/Initiate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gracefulExitClient) Transfers(ctx context.Context, in *TransfersRequest, opts ...grpc.CallOption) (*TransfersResponse, error) {
+	out := new(TransfersResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.GracefulExit/Transfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gracefulExitClient) Transferred(ctx context.Context, in *TransferResult, opts ...grpc.CallOption) (*TransferredResponse, error) {
+	out := new(TransferredResponse)
+	err := c.cc.Invoke(ctx, "/gracefulexit.GracefulExit/Transferred", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gracefulExitClient) Complete(ctx context.Context, in *CompleteRequest, opts ...grpc.CallOption) (*ExitProgress, error) {
+	out := new(ExitProgress)
+	err := c.cc.Invoke(ctx, "/gracefulexit.GracefulExit/Complete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *gracefulExitClient) Progress(ctx context.Context, in *ProgressRequest, opts ...grpc.CallOption) (*ExitProgress, error) {
+	out := new(ExitProgress)
+	err := c.cc.Invoke(ctx, "/gracefulexit.GracefulExit/Progress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GracefulExitServer is the server API for GracefulExit service.
+type GracefulExitServer interface {
+	// Initiate marks the calling node as exiting, it isn't selected for new pieces anymore
+	Initiate(context.Context, *InitiateRequest) (*ExitProgress, error)
+	// Transfers lists the pieces the calling node has to transfer to other
+	// nodes, starting with the ones which results weren't reported yet
+	Transfers(context.Context, *TransfersRequest) (*TransfersResponse, error)
+	// Transferred reports the result of the transfer of a piece
+	Transferred(context.Context, *TransferResult) (*TransferredResponse, error)
+	// Complete marks the calling node as exited once it has no pieces left
+	Complete(context.Context, *CompleteRequest) (*ExitProgress, error)
+	// Progress returns the progress of the exit of the calling node
+	Progress(context.Context, *ProgressRequest) (*ExitProgress, error)
+}
+
+func RegisterGracefulExitServer(s *grpc.Server, srv GracefulExitServer) {
+	s.RegisterService(&_GracefulExit_serviceDesc, srv)
+}
+
+func _GracefulExit_Initiate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(InitiateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GracefulExitServer).Initiate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.GracefulExit/Initiate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GracefulExitServer).Initiate(ctx, req.(*InitiateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GracefulExit_Transfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransfersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GracefulExitServer).Transfers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.GracefulExit/Transfers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GracefulExitServer).Transfers(ctx, req.(*TransfersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GracefulExit_Transferred_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferResult)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GracefulExitServer).Transferred(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.GracefulExit/Transferred",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GracefulExitServer).Transferred(ctx, req.(*TransferResult))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GracefulExit_Complete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CompleteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GracefulExitServer).Complete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.GracefulExit/Complete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GracefulExitServer).Complete(ctx, req.(*CompleteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GracefulExit_Progress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ProgressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GracefulExitServer).Progress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gracefulexit.GracefulExit/Progress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GracefulExitServer).Progress(ctx, req.(*ProgressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _GracefulExit_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gracefulexit.GracefulExit",
+	HandlerType: (*GracefulExitServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Initiate",
+			Handler:    _GracefulExit_Initiate_Handler,
+		},
+		{
+			MethodName: "Transfers",
+			Handler:    _GracefulExit_Transfers_Handler,
+		},
+		{
+			MethodName: "Transferred",
+			Handler:    _GracefulExit_Transferred_Handler,
+		},
+		{
+			MethodName: "Complete",
+			Handler:    _GracefulExit_Complete_Handler,
+		},
+		{
+			MethodName: "Progress",
+			Handler:    _GracefulExit_Progress_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gracefulexit.proto",
+}
+
+func init() { proto.RegisterFile("gracefulexit.proto", fileDescriptor_gracefulexit_bcded3db3946606b) }
+
+var fileDescriptor_gracefulexit_bcded3db3946606b = []byte{
+	// 689 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0xf3, 0x57, 0x67, 0x92, 0xb6, 0xe9, 0x82, 0x90, 0x49, 0x81, 0x04, 0x73, 0x89, 0x40,
+	0xb8, 0x52, 0x91, 0x90, 0x38, 0x70, 0xe8, 0x1f, 0x55, 0x90, 0xa8, 0xa2, 0xa5, 0x5c, 0xb8, 0x44,
+	0x9b, 0x7a, 0xe2, 0xac, 0xe4, 0x78, 0xcd, 0x7a, 0x0d, 0x85, 0x57, 0xe0, 0x0d, 0x78, 0x13, 0xde,
+	0x80, 0x67, 0xe0, 0xd0, 0x03, 0x4f, 0x82, 0xbc, 0x6b, 0xe7, 0x8f, 0xd2, 0xde, 0x76, 0x66, 0xbe,
+	0x99, 0xdd, 0xef, 0xdb, 0x99, 0x01, 0x12, 0x48, 0x76, 0x81, 0x93, 0x34, 0xc4, 0x4b, 0xae, 0xbc,
+	0x58, 0x0a, 0x25, 0x48, 0x6b, 0xd9, 0xd7, 0x81, 0x40, 0x04, 0xc2, 0x44, 0x3a, 0x10, 0x09, 0x1f,
+	0xf3, 0x73, 0x3b, 0xe6, 0x78, 0x81, 0x89, 0x12, 0xb2, 0xf0, 0x74, 0x03, 0x21, 0x82, 0x10, 0xf7,
+	0xb4, 0x35, 0x4e, 0x27, 0x7b, 0x8a, 0xcf, 0x30, 0x51, 0x6c, 0x16, 0x1b, 0x80, 0xbb, 0x03, 0xdb,
+	0x83, 0x88, 0x2b, 0xce, 0x14, 0x52, 0xfc, 0x94, 0x62, 0xa2, 0x32, 0xd7, 0x91, 0x98, 0xc5, 0x21,
+	0xae, 0xb8, 0x86, 0x52, 0x04, 0x12, 0x93, 0xa4, 0x70, 0xfd, 0xb4, 0xa0, 0x75, 0x72, 0xc9, 0x55,
+	0xe1, 0x27, 0xcf, 0x81, 0x98, 0xeb, 0x47, 0x4a, 0xb2, 0x28, 0x99, 0xa0, 0x94, 0xe8, 0x3b, 0x56,
+	0xcf, 0xea, 0x57, 0xe8, 0x8e, 0x89, 0x9c, 0x2f, 0x02, 0xe4, 0x09, 0x6c, 0xe6, 0xf0, 0x09, 0xe3,
+	0x21, 0xfa, 0x4e, 0x59, 0x23, 0x5b, 0xc6, 0xf9, 0x46, 0xfb, 0xc8, 0x3d, 0xa8, 0x67, 0x84, 0xd1,
+	0x77, 0x2a, 0x3d, 0xab, 0x6f, 0xd3, 0xdc, 0x22, 0xaf, 0xa1, 0xc5, 0xf3, 0x57, 0xfb, 0x23, 0xa6,
+	0x9c, 0x6a, 0xcf, 0xea, 0x37, 0xf7, 0x3b, 0x9e, 0x61, 0xeb, 0x15, 0x6c, 0xbd, 0xf3, 0x82, 0x2d,
+	0x6d, 0xce, 0xf1, 0x07, 0xca, 0xf5, 0xa0, 0x5d, 0x3c, 0xa5, 0xe0, 0x43, 0xee, 0x42, 0x2d, 0xe4,
+	0x33, 0xae, 0xf4, 0x3b, 0x6a, 0xd4, 0x18, 0x6f, 0xab, 0xb6, 0xd5, 0x2e, 0xbb, 0x63, 0xd8, 0x59,
+	0xc2, 0x27, 0xb1, 0x88, 0x12, 0x24, 0xaf, 0xa0, 0x51, 0x10, 0x4d, 0x1c, 0xab, 0x57, 0xe9, 0x37,
+	0xf7, 0x77, 0xbd, 0x95, 0xaf, 0x1b, 0x66, 0x54, 0x8a, 0x44, 0xba, 0x40, 0x13, 0x02, 0xd5, 0x99,
+	0x90, 0xa8, 0xaf, 0xb2, 0xa9, 0x3e, 0xbb, 0x7f, 0xca, 0xb0, 0xb9, 0x92, 0x90, 0xa1, 0x62, 0xa6,
+	0xa6, 0x5a, 0xc2, 0x06, 0xd5, 0x67, 0x72, 0x1f, 0x6c, 0x2d, 0xd0, 0x88, 0x1b, 0xc1, 0x1a, 0x74,
+	0x43, 0xdb, 0x03, 0x9f, 0xec, 0x42, 0xc3, 0x84, 0xa2, 0x74, 0xa6, 0xe5, 0xaa, 0x51, 0x83, 0x3d,
+	0x4b, 0x67, 0xc4, 0x85, 0xba, 0x62, 0x32, 0xc0, 0x42, 0x2a, 0xf0, 0x74, 0xdb, 0x9c, 0x09, 0x1f,
+	0x69, 0x1e, 0x21, 0x47, 0xb0, 0x8d, 0x97, 0x31, 0x97, 0x4c, 0x71, 0x11, 0x8d, 0x7c, 0xa6, 0xd0,
+	0xa9, 0xdd, 0xaa, 0xeb, 0xd6, 0x22, 0xe5, 0x98, 0x29, 0x24, 0x1f, 0xa0, 0x1d, 0xb3, 0xaf, 0x28,
+	0x47, 0x2c, 0x0c, 0xc5, 0x85, 0xf6, 0x3b, 0x75, 0x5d, 0xe5, 0xa9, 0xb7, 0xe8, 0x4e, 0x29, 0x52,
+	0x85, 0x89, 0x37, 0xcc, 0x90, 0x87, 0x2c, 0xf2, 0xbf, 0x70, 0x5f, 0x4d, 0x0f, 0xe6, 0x19, 0x74,
+	0x5b, 0xd7, 0x58, 0x38, 0xc8, 0x09, 0x6c, 0xb2, 0x54, 0x4d, 0x85, 0xe4, 0xdf, 0x4c, 0xcd, 0x0d,
+	0x5d, 0xb3, 0xfb, 0x6f, 0xcd, 0xf7, 0x3c, 0x88, 0xd0, 0x7f, 0x87, 0x49, 0xc2, 0x02, 0xa4, 0xab,
+	0x59, 0xee, 0x0f, 0x0b, 0xb6, 0xe6, 0x1f, 0x82, 0x49, 0x1a, 0xaa, 0x6b, 0x55, 0x5e, 0x91, 0xb2,
+	0xbc, 0x26, 0xe5, 0x33, 0x68, 0x18, 0xc1, 0xb2, 0x3f, 0xc8, 0x74, 0x6e, 0x1d, 0x6e, 0xfd, 0xba,
+	0xea, 0x96, 0x7e, 0x5f, 0x75, 0xeb, 0x99, 0x9e, 0x83, 0x63, 0x6a, 0x1b, 0xc0, 0xc0, 0xcf, 0xaa,
+	0x4f, 0x59, 0x32, 0xd5, 0xaa, 0xb7, 0xa8, 0x3e, 0x67, 0x4d, 0x9d, 0xb7, 0x7c, 0xcd, 0x34, 0xb5,
+	0xb1, 0x5c, 0x0e, 0x77, 0x96, 0x06, 0x64, 0xde, 0x67, 0x2f, 0xc1, 0x8e, 0xf3, 0x19, 0x73, 0xac,
+	0xe2, 0x3f, 0x96, 0xdb, 0x6c, 0x79, 0x0a, 0xe9, 0x1c, 0x4b, 0x3a, 0x60, 0x7f, 0x46, 0xc9, 0x27,
+	0x3c, 0x9f, 0x2d, 0x9b, 0xce, 0xed, 0xfd, 0xef, 0x15, 0x68, 0x9d, 0xe6, 0x35, 0xb2, 0x74, 0x72,
+	0x0a, 0x76, 0xb1, 0x06, 0xc8, 0xc3, 0xd5, 0xf2, 0x6b, 0xeb, 0xa1, 0x73, 0xc3, 0xed, 0x6e, 0x89,
+	0x9c, 0x41, 0x63, 0x3e, 0x2a, 0xe4, 0xd1, 0x2a, 0x74, 0x7d, 0xe6, 0x3a, 0xdd, 0xff, 0xc6, 0x0d,
+	0x77, 0xb7, 0x44, 0x86, 0xd0, 0x5c, 0xde, 0x1a, 0x0f, 0xae, 0xcf, 0x30, 0x7f, 0xd9, 0x79, 0x7c,
+	0x7d, 0x74, 0x49, 0x4d, 0xb7, 0x94, 0x51, 0x2d, 0xd6, 0xdb, 0x3a, 0xd5, 0xb5, 0xb5, 0x77, 0x0b,
+	0xd5, 0x53, 0xb0, 0x0b, 0x6b, 0xbd, 0xd0, 0xda, 0xb2, 0xbc, 0xb9, 0xd0, 0x61, 0xf5, 0x63, 0x39,
+	0x1e, 0x8f, 0xeb, 0x7a, 0xba, 0x5e, 0xfc, 0x1d, 0x00, 0x97, 0x46, 0x82, 0x08, 0xff, 0x05, 0x00,
+	0x00,
+}
@@ -7,6 +7,7 @@ import proto "github.com/gogo/protobuf/proto"
 import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 import (
 	context "golang.org/x/net/context"
@@ -24,6 +25,33 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
+// NodeState is the lifecycle state of a node
+type NodeState int32
+
+const (
+	NodeState_ACTIVE       NodeState = 0
+	NodeState_SUSPENDED    NodeState = 1
+	NodeState_DISQUALIFIED NodeState = 2
+)
+
+var NodeState_name = map[int32]string{
+	0: "ACTIVE",
+	1: "SUSPENDED",
+	2: "DISQUALIFIED",
+}
+var NodeState_value = map[string]int32{
+	"ACTIVE":       0,
+	"SUSPENDED":    1,
+	"DISQUALIFIED": 2,
+}
+
+func (x NodeState) String() string {
+	return proto.EnumName(NodeState_name, int32(x))
+}
+func (NodeState) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{0}
+}
+
 // GetStats
 type GetStatsRequest struct {
 	NodeId               NodeID   `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
@@ -36,7 +64,7 @@ func (m *GetStatsRequest) Reset()         { *m = GetStatsRequest{} }
 func (m *GetStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetStatsRequest) ProtoMessage()    {}
 func (*GetStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{0}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{0}
 }
 func (m *GetStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsRequest.Unmarshal(m, b)
@@ -70,7 +98,7 @@ func (m *GetStatsResponse) Reset()         { *m = GetStatsResponse{} }
 func (m *GetStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetStatsResponse) ProtoMessage()    {}
 func (*GetStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{1}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{1}
 }
 func (m *GetStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetStatsResponse.Unmarshal(m, b)
@@ -134,7 +162,7 @@ func (m *CreateStatsRequest) Reset()         { *m = CreateStatsRequest{} }
 func (m *CreateStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*CreateStatsRequest) ProtoMessage()    {}
 func (*CreateStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{2}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{2}
 }
 func (m *CreateStatsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStatsRequest.Unmarshal(m, b)
@@ -192,7 +220,7 @@ func (m *CreateStatsResponse) Reset()         { *m = CreateStatsResponse{} }
 func (m *CreateStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*CreateStatsResponse) ProtoMessage()    {}
 func (*CreateStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{3}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{3}
 }
 func (m *CreateStatsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CreateStatsResponse.Unmarshal(m, b)
@@ -212,6 +240,224 @@ func (m *CreateStatsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_CreateStatsResponse proto.InternalMessageInfo
 
+type NodeStateInfo struct {
+	NodeId               NodeID               `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	State                NodeState            `protobuf:"varint,2,opt,name=state,proto3,enum=inspector.NodeState" json:"state,omitempty"`
+	Reason               string               `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=changed_at,json=changedAt" json:"changed_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *NodeStateInfo) Reset()         { *m = NodeStateInfo{} }
+func (m *NodeStateInfo) String() string { return proto.CompactTextString(m) }
+func (*NodeStateInfo) ProtoMessage()    {}
+func (*NodeStateInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{4}
+}
+func (m *NodeStateInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NodeStateInfo.Unmarshal(m, b)
+}
+func (m *NodeStateInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NodeStateInfo.Marshal(b, m, deterministic)
+}
+func (dst *NodeStateInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NodeStateInfo.Merge(dst, src)
+}
+func (m *NodeStateInfo) XXX_Size() int {
+	return xxx_messageInfo_NodeStateInfo.Size(m)
+}
+func (m *NodeStateInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_NodeStateInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NodeStateInfo proto.InternalMessageInfo
+
+func (m *NodeStateInfo) GetState() NodeState {
+	if m != nil {
+		return m.State
+	}
+	return NodeState_ACTIVE
+}
+
+func (m *NodeStateInfo) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *NodeStateInfo) GetChangedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.ChangedAt
+	}
+	return nil
+}
+
+// ListNodeStates
+type ListNodeStatesRequest struct {
+	State                NodeState `protobuf:"varint,1,opt,name=state,proto3,enum=inspector.NodeState" json:"state,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *ListNodeStatesRequest) Reset()         { *m = ListNodeStatesRequest{} }
+func (m *ListNodeStatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNodeStatesRequest) ProtoMessage()    {}
+func (*ListNodeStatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{5}
+}
+func (m *ListNodeStatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNodeStatesRequest.Unmarshal(m, b)
+}
+func (m *ListNodeStatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNodeStatesRequest.Marshal(b, m, deterministic)
+}
+func (dst *ListNodeStatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNodeStatesRequest.Merge(dst, src)
+}
+func (m *ListNodeStatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNodeStatesRequest.Size(m)
+}
+func (m *ListNodeStatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNodeStatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNodeStatesRequest proto.InternalMessageInfo
+
+func (m *ListNodeStatesRequest) GetState() NodeState {
+	if m != nil {
+		return m.State
+	}
+	return NodeState_ACTIVE
+}
+
+type ListNodeStatesResponse struct {
+	Nodes                []*NodeStateInfo `protobuf:"bytes,1,rep,name=nodes" json:"nodes,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *ListNodeStatesResponse) Reset()         { *m = ListNodeStatesResponse{} }
+func (m *ListNodeStatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNodeStatesResponse) ProtoMessage()    {}
+func (*ListNodeStatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{6}
+}
+func (m *ListNodeStatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNodeStatesResponse.Unmarshal(m, b)
+}
+func (m *ListNodeStatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNodeStatesResponse.Marshal(b, m, deterministic)
+}
+func (dst *ListNodeStatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNodeStatesResponse.Merge(dst, src)
+}
+func (m *ListNodeStatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListNodeStatesResponse.Size(m)
+}
+func (m *ListNodeStatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNodeStatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNodeStatesResponse proto.InternalMessageInfo
+
+func (m *ListNodeStatesResponse) GetNodes() []*NodeStateInfo {
+	if m != nil {
+		return m.Nodes
+	}
+	return nil
+}
+
+// SetNodeState
+type SetNodeStateRequest struct {
+	NodeId               NodeID    `protobuf:"bytes,1,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	State                NodeState `protobuf:"varint,2,opt,name=state,proto3,enum=inspector.NodeState" json:"state,omitempty"`
+	Reason               string    `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *SetNodeStateRequest) Reset()         { *m = SetNodeStateRequest{} }
+func (m *SetNodeStateRequest) String() string { return proto.CompactTextString(m) }
+func (*SetNodeStateRequest) ProtoMessage()    {}
+func (*SetNodeStateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{7}
+}
+func (m *SetNodeStateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetNodeStateRequest.Unmarshal(m, b)
+}
+func (m *SetNodeStateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetNodeStateRequest.Marshal(b, m, deterministic)
+}
+func (dst *SetNodeStateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNodeStateRequest.Merge(dst, src)
+}
+func (m *SetNodeStateRequest) XXX_Size() int {
+	return xxx_messageInfo_SetNodeStateRequest.Size(m)
+}
+func (m *SetNodeStateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNodeStateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetNodeStateRequest proto.InternalMessageInfo
+
+func (m *SetNodeStateRequest) GetState() NodeState {
+	if m != nil {
+		return m.State
+	}
+	return NodeState_ACTIVE
+}
+
+func (m *SetNodeStateRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type SetNodeStateResponse struct {
+	Node                 *NodeStateInfo `protobuf:"bytes,1,opt,name=node" json:"node,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *SetNodeStateResponse) Reset()         { *m = SetNodeStateResponse{} }
+func (m *SetNodeStateResponse) String() string { return proto.CompactTextString(m) }
+func (*SetNodeStateResponse) ProtoMessage()    {}
+func (*SetNodeStateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{8}
+}
+func (m *SetNodeStateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetNodeStateResponse.Unmarshal(m, b)
+}
+func (m *SetNodeStateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetNodeStateResponse.Marshal(b, m, deterministic)
+}
+func (dst *SetNodeStateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetNodeStateResponse.Merge(dst, src)
+}
+func (m *SetNodeStateResponse) XXX_Size() int {
+	return xxx_messageInfo_SetNodeStateResponse.Size(m)
+}
+func (m *SetNodeStateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetNodeStateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetNodeStateResponse proto.InternalMessageInfo
+
+func (m *SetNodeStateResponse) GetNode() *NodeStateInfo {
+	if m != nil {
+		return m.Node
+	}
+	return nil
+}
+
 // CountNodes
 type CountNodesResponse struct {
 	Count                int64    `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
@@ -224,7 +470,7 @@ func (m *CountNodesResponse) Reset()         { *m = CountNodesResponse{} }
 func (m *CountNodesResponse) String() string { return proto.CompactTextString(m) }
 func (*CountNodesResponse) ProtoMessage()    {}
 func (*CountNodesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{4}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{9}
 }
 func (m *CountNodesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesResponse.Unmarshal(m, b)
@@ -261,7 +507,7 @@ func (m *CountNodesRequest) Reset()         { *m = CountNodesRequest{} }
 func (m *CountNodesRequest) String() string { return proto.CompactTextString(m) }
 func (*CountNodesRequest) ProtoMessage()    {}
 func (*CountNodesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{5}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{10}
 }
 func (m *CountNodesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_CountNodesRequest.Unmarshal(m, b)
@@ -292,7 +538,7 @@ func (m *GetBucketsRequest) Reset()         { *m = GetBucketsRequest{} }
 func (m *GetBucketsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsRequest) ProtoMessage()    {}
 func (*GetBucketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{6}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{11}
 }
 func (m *GetBucketsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsRequest.Unmarshal(m, b)
@@ -324,7 +570,7 @@ func (m *GetBucketsResponse) Reset()         { *m = GetBucketsResponse{} }
 func (m *GetBucketsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketsResponse) ProtoMessage()    {}
 func (*GetBucketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{7}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{12}
 }
 func (m *GetBucketsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketsResponse.Unmarshal(m, b)
@@ -363,7 +609,7 @@ func (m *GetBucketRequest) Reset()         { *m = GetBucketRequest{} }
 func (m *GetBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GetBucketRequest) ProtoMessage()    {}
 func (*GetBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{8}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{13}
 }
 func (m *GetBucketRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketRequest.Unmarshal(m, b)
@@ -395,7 +641,7 @@ func (m *GetBucketResponse) Reset()         { *m = GetBucketResponse{} }
 func (m *GetBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GetBucketResponse) ProtoMessage()    {}
 func (*GetBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{9}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{14}
 }
 func (m *GetBucketResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetBucketResponse.Unmarshal(m, b)
@@ -433,7 +679,7 @@ func (m *Bucket) Reset()         { *m = Bucket{} }
 func (m *Bucket) String() string { return proto.CompactTextString(m) }
 func (*Bucket) ProtoMessage()    {}
 func (*Bucket) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{10}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{15}
 }
 func (m *Bucket) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Bucket.Unmarshal(m, b)
@@ -471,7 +717,7 @@ func (m *BucketList) Reset()         { *m = BucketList{} }
 func (m *BucketList) String() string { return proto.CompactTextString(m) }
 func (*BucketList) ProtoMessage()    {}
 func (*BucketList) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{11}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{16}
 }
 func (m *BucketList) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_BucketList.Unmarshal(m, b)
@@ -511,7 +757,7 @@ func (m *PingNodeRequest) Reset()         { *m = PingNodeRequest{} }
 func (m *PingNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*PingNodeRequest) ProtoMessage()    {}
 func (*PingNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{12}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{17}
 }
 func (m *PingNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeRequest.Unmarshal(m, b)
@@ -549,7 +795,7 @@ func (m *PingNodeResponse) Reset()         { *m = PingNodeResponse{} }
 func (m *PingNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*PingNodeResponse) ProtoMessage()    {}
 func (*PingNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{13}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{18}
 }
 func (m *PingNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PingNodeResponse.Unmarshal(m, b)
@@ -588,7 +834,7 @@ func (m *LookupNodeRequest) Reset()         { *m = LookupNodeRequest{} }
 func (m *LookupNodeRequest) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeRequest) ProtoMessage()    {}
 func (*LookupNodeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{14}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{19}
 }
 func (m *LookupNodeRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeRequest.Unmarshal(m, b)
@@ -634,7 +880,7 @@ func (m *LookupNodeResponse) Reset()         { *m = LookupNodeResponse{} }
 func (m *LookupNodeResponse) String() string { return proto.CompactTextString(m) }
 func (*LookupNodeResponse) ProtoMessage()    {}
 func (*LookupNodeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_inspector_27853f276f81267a, []int{15}
+	return fileDescriptor_inspector_137e3a61d3cb3245, []int{20}
 }
 func (m *LookupNodeResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LookupNodeResponse.Unmarshal(m, b)
@@ -673,6 +919,11 @@ func init() {
 	proto.RegisterType((*GetStatsResponse)(nil), "inspector.GetStatsResponse")
 	proto.RegisterType((*CreateStatsRequest)(nil), "inspector.CreateStatsRequest")
 	proto.RegisterType((*CreateStatsResponse)(nil), "inspector.CreateStatsResponse")
+	proto.RegisterType((*NodeStateInfo)(nil), "inspector.NodeStateInfo")
+	proto.RegisterType((*ListNodeStatesRequest)(nil), "inspector.ListNodeStatesRequest")
+	proto.RegisterType((*ListNodeStatesResponse)(nil), "inspector.ListNodeStatesResponse")
+	proto.RegisterType((*SetNodeStateRequest)(nil), "inspector.SetNodeStateRequest")
+	proto.RegisterType((*SetNodeStateResponse)(nil), "inspector.SetNodeStateResponse")
 	proto.RegisterType((*CountNodesResponse)(nil), "inspector.CountNodesResponse")
 	proto.RegisterType((*CountNodesRequest)(nil), "inspector.CountNodesRequest")
 	proto.RegisterType((*GetBucketsRequest)(nil), "inspector.GetBucketsRequest")
@@ -685,6 +936,7 @@ func init() {
 	proto.RegisterType((*PingNodeResponse)(nil), "inspector.PingNodeResponse")
 	proto.RegisterType((*LookupNodeRequest)(nil), "inspector.LookupNodeRequest")
 	proto.RegisterType((*LookupNodeResponse)(nil), "inspector.LookupNodeResponse")
+	proto.RegisterEnum("inspector.NodeState", NodeState_name, NodeState_value)
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -975,6 +1227,10 @@ type StatDBInspectorClient interface {
 	GetStats(ctx context.Context, in *GetStatsRequest, opts ...grpc.CallOption) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(ctx context.Context, in *CreateStatsRequest, opts ...grpc.CallOption) (*CreateStatsResponse, error)
+	// ListNodeStates lists the nodes in a state
+	ListNodeStates(ctx context.Context, in *ListNodeStatesRequest, opts ...grpc.CallOption) (*ListNodeStatesResponse, error)
+	// SetNodeState changes the state of a node
+	SetNodeState(ctx context.Context, in *SetNodeStateRequest, opts ...grpc.CallOption) (*SetNodeStateResponse, error)
 }
 
 type statDBInspectorClient struct {
@@ -1003,12 +1259,34 @@ func (c *statDBInspectorClient) CreateStats(ctx context.Context, in *CreateStats
 	return out, nil
 }
 
+func (c *statDBInspectorClient) ListNodeStates(ctx context.Context, in *ListNodeStatesRequest, opts ...grpc.CallOption) (*ListNodeStatesResponse, error) {
+	out := new(ListNodeStatesResponse)
+	err := c.cc.Invoke(ctx, "/inspector.StatDBInspector/ListNodeStates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *statDBInspectorClient) SetNodeState(ctx context.Context, in *SetNodeStateRequest, opts ...grpc.CallOption) (*SetNodeStateResponse, error) {
+	out := new(SetNodeStateResponse)
+	err := c.cc.Invoke(ctx, "/inspector.StatDBInspector/SetNodeState", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // StatDBInspectorServer is the server API for StatDBInspector service.
 type StatDBInspectorServer interface {
 	// GetStats returns the stats for a particular node ID
 	GetStats(context.Context, *GetStatsRequest) (*GetStatsResponse, error)
 	// CreateStats creates a node with specified stats
 	CreateStats(context.Context, *CreateStatsRequest) (*CreateStatsResponse, error)
+	// ListNodeStates lists the nodes in a state
+	ListNodeStates(context.Context, *ListNodeStatesRequest) (*ListNodeStatesResponse, error)
+	// SetNodeState changes the state of a node
+	SetNodeState(context.Context, *SetNodeStateRequest) (*SetNodeStateResponse, error)
 }
 
 func RegisterStatDBInspectorServer(s *grpc.Server, srv StatDBInspectorServer) {
@@ -1051,6 +1329,42 @@ func _StatDBInspector_CreateStats_Handler(srv interface{}, ctx context.Context,
 	return interceptor(ctx, in, info, handler)
 }
 
+func _StatDBInspector_ListNodeStates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNodeStatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatDBInspectorServer).ListNodeStates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.StatDBInspector/ListNodeStates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatDBInspectorServer).ListNodeStates(ctx, req.(*ListNodeStatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _StatDBInspector_SetNodeState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetNodeStateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(StatDBInspectorServer).SetNodeState(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/inspector.StatDBInspector/SetNodeState",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(StatDBInspectorServer).SetNodeState(ctx, req.(*SetNodeStateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _StatDBInspector_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "inspector.StatDBInspector",
 	HandlerType: (*StatDBInspectorServer)(nil),
@@ -1063,54 +1377,77 @@ var _StatDBInspector_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateStats",
 			Handler:    _StatDBInspector_CreateStats_Handler,
 		},
+		{
+			MethodName: "ListNodeStates",
+			Handler:    _StatDBInspector_ListNodeStates_Handler,
+		},
+		{
+			MethodName: "SetNodeState",
+			Handler:    _StatDBInspector_SetNodeState_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "inspector.proto",
 }
 
-func init() { proto.RegisterFile("inspector.proto", fileDescriptor_inspector_27853f276f81267a) }
-
-var fileDescriptor_inspector_27853f276f81267a = []byte{
-	// 644 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x55, 0xcb, 0x6e, 0xd3, 0x40,
-	0x14, 0xc5, 0x4e, 0x9a, 0x26, 0x37, 0x51, 0x1e, 0x93, 0x22, 0x45, 0x4e, 0x68, 0xc2, 0x2c, 0x20,
-	0xea, 0x22, 0x42, 0x66, 0x87, 0xc4, 0x26, 0xa9, 0x28, 0x51, 0xc3, 0x43, 0xae, 0xd8, 0x20, 0x50,
-	0x35, 0x8d, 0x47, 0x91, 0x95, 0x36, 0x63, 0x3c, 0x63, 0x24, 0x7e, 0x85, 0x35, 0x1f, 0xc3, 0x37,
-	0xb0, 0xe8, 0x86, 0xdf, 0x60, 0x81, 0x3c, 0x63, 0x7b, 0xec, 0x38, 0x51, 0x2b, 0x24, 0x76, 0xf6,
-	0x39, 0xc7, 0x67, 0xee, 0xb9, 0xf7, 0x6a, 0x0c, 0x2d, 0x6f, 0xc3, 0x7d, 0xba, 0x14, 0x2c, 0x98,
-	0xf8, 0x01, 0x13, 0x0c, 0xd5, 0x52, 0xc0, 0x82, 0x15, 0x5b, 0x31, 0x05, 0x5b, 0xb0, 0x61, 0x2e,
-	0x55, 0xcf, 0xf8, 0x05, 0xb4, 0xce, 0xa8, 0xb8, 0x10, 0x44, 0x70, 0x87, 0x7e, 0x09, 0x29, 0x17,
-	0xe8, 0x29, 0x1c, 0x46, 0x82, 0x4b, 0xcf, 0xed, 0x19, 0x23, 0x63, 0xdc, 0x98, 0x36, 0x7f, 0xde,
-	0x0e, 0x1f, 0xfc, 0xba, 0x1d, 0x56, 0xde, 0x32, 0x97, 0xce, 0x4f, 0x9d, 0x4a, 0x44, 0xcf, 0x5d,
-	0xfc, 0xdd, 0x80, 0xb6, 0xfe, 0x98, 0xfb, 0x6c, 0xc3, 0x29, 0x1a, 0x42, 0x9d, 0x84, 0xae, 0x27,
-	0x2e, 0x97, 0x2c, 0xdc, 0x08, 0xe9, 0x50, 0x72, 0x40, 0x42, 0xb3, 0x08, 0xd1, 0x82, 0x80, 0x08,
-	0x8f, 0xf5, 0xcc, 0x91, 0x31, 0x36, 0x62, 0x81, 0x13, 0x21, 0xe8, 0x31, 0x34, 0x42, 0x5f, 0x78,
-	0x37, 0x34, 0xb6, 0x28, 0x49, 0x8b, 0xba, 0xc2, 0x94, 0x87, 0x96, 0x28, 0x93, 0xb2, 0x34, 0x89,
-	0x25, 0xd2, 0x05, 0xff, 0x36, 0x00, 0xcd, 0x02, 0x4a, 0x04, 0xfd, 0xa7, 0x70, 0xdb, 0x39, 0xcc,
-	0x42, 0x8e, 0x09, 0x74, 0x95, 0x80, 0x87, 0xcb, 0x25, 0xe5, 0x3c, 0x57, 0x6d, 0x47, 0x52, 0x17,
-	0x8a, 0xd9, 0xae, 0x59, 0x09, 0xcb, 0xc5, 0x58, 0xcf, 0xe0, 0x28, 0x96, 0xe4, 0x3d, 0x0f, 0xa4,
-	0x14, 0x29, 0x2e, 0x6b, 0x8a, 0x1f, 0x42, 0x37, 0x17, 0x52, 0x0d, 0x01, 0x9f, 0x00, 0x92, 0x7c,
-	0x94, 0x49, 0x8f, 0xe6, 0x08, 0x0e, 0xb2, 0x43, 0x51, 0x2f, 0xb8, 0x0b, 0x9d, 0xac, 0x56, 0xb6,
-	0x29, 0x02, 0xcf, 0xa8, 0x98, 0x86, 0xcb, 0x35, 0x4d, 0x7b, 0x87, 0x5f, 0x03, 0xca, 0x82, 0xda,
-	0x55, 0x30, 0x41, 0xae, 0x13, 0x57, 0xf9, 0x82, 0x06, 0x50, 0xf2, 0x5c, 0xde, 0x33, 0x47, 0xa5,
-	0x71, 0x63, 0x0a, 0x99, 0xfe, 0x46, 0x30, 0xb6, 0xe5, 0xe2, 0x28, 0xa7, 0x64, 0x32, 0xc7, 0x60,
-	0xee, 0x1d, 0x8a, 0xe9, 0xb9, 0xf8, 0x43, 0xa6, 0xa4, 0xf4, 0xf0, 0x3b, 0x3e, 0x42, 0x23, 0x38,
-	0x88, 0xe6, 0xa9, 0x0a, 0xa9, 0xdb, 0x30, 0x91, 0xab, 0x1f, 0x09, 0x1c, 0x45, 0xe0, 0x13, 0xa8,
-	0x28, 0xcf, 0x7b, 0x68, 0x27, 0x00, 0x4a, 0xbb, 0xf0, 0x78, 0x46, 0x6f, 0xec, 0xd3, 0x9f, 0x43,
-	0xeb, 0xbd, 0xb7, 0x59, 0x49, 0xe8, 0x7e, 0x29, 0x51, 0x0f, 0x0e, 0x89, 0xeb, 0x06, 0x94, 0x73,
-	0xb9, 0x72, 0x35, 0x27, 0x79, 0xc5, 0x18, 0xda, 0xda, 0x2c, 0x8e, 0xdf, 0x04, 0x93, 0xad, 0xa5,
-	0x5b, 0xd5, 0x31, 0xd9, 0x1a, 0xbf, 0x84, 0xce, 0x82, 0xb1, 0x75, 0xe8, 0x67, 0x8f, 0x6c, 0xa6,
-	0x47, 0xd6, 0xee, 0x38, 0xe2, 0x13, 0xa0, 0xec, 0xe7, 0x69, 0x8f, 0xcb, 0x51, 0x1c, 0xe9, 0x90,
-	0x8f, 0x29, 0x71, 0xf4, 0x04, 0xca, 0x37, 0x54, 0x10, 0x69, 0x56, 0xb7, 0x91, 0xe6, 0xdf, 0x50,
-	0x41, 0x5c, 0x22, 0x88, 0x23, 0x79, 0xfb, 0x8f, 0x09, 0x8d, 0x73, 0xe2, 0xce, 0x93, 0x3b, 0x09,
-	0xcd, 0x01, 0xf4, 0xe6, 0xa1, 0xc1, 0x44, 0x5f, 0x5f, 0x85, 0x85, 0xb4, 0x1e, 0xed, 0x61, 0xe3,
-	0x1a, 0xe7, 0x00, 0x7a, 0x35, 0x73, 0x56, 0x85, 0x35, 0xce, 0x59, 0xed, 0xd8, 0xe7, 0x57, 0x50,
-	0x4b, 0x51, 0xd4, 0xdf, 0xa5, 0x4d, 0x8c, 0x06, 0xbb, 0xc9, 0xd8, 0x67, 0x06, 0xd5, 0x64, 0x5e,
-	0xc8, 0xca, 0x28, 0xb7, 0x36, 0xc2, 0xea, 0xef, 0xe4, 0x74, 0x2e, 0x3d, 0x91, 0x5c, 0xae, 0xc2,
-	0x9c, 0x73, 0xb9, 0x8a, 0x63, 0xb4, 0x3f, 0x43, 0xfb, 0xdd, 0x57, 0x1a, 0x5c, 0x93, 0x6f, 0xff,
-	0x63, 0x02, 0xf6, 0x0f, 0x03, 0x5a, 0xd1, 0x25, 0x74, 0x3a, 0xd5, 0xf6, 0x33, 0xa8, 0x26, 0xff,
-	0x87, 0x5c, 0x0b, 0xb6, 0xfe, 0x38, 0x56, 0x7f, 0x27, 0x17, 0xb7, 0x60, 0x01, 0xf5, 0xcc, 0x15,
-	0x87, 0x72, 0x65, 0x14, 0xee, 0x77, 0xeb, 0x78, 0x1f, 0xad, 0xdc, 0xa6, 0xe5, 0x8f, 0xa6, 0x7f,
-	0x75, 0x55, 0x91, 0x3f, 0xbf, 0xe7, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x78, 0xf1, 0xf1, 0x7a,
-	0x32, 0x07, 0x00, 0x00,
+func init() { proto.RegisterFile("inspector.proto", fileDescriptor_inspector_137e3a61d3cb3245) }
+
+var fileDescriptor_inspector_137e3a61d3cb3245 = []byte{
+	// 894 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x72, 0xdb, 0x44,
+	0x1c, 0xae, 0x64, 0xc7, 0x8d, 0x7f, 0x76, 0x1d, 0x67, 0x93, 0x76, 0x3c, 0x4a, 0x52, 0xbb, 0x7b,
+	0x80, 0x4c, 0x86, 0x51, 0x19, 0x73, 0x01, 0x66, 0x38, 0xc4, 0x76, 0xda, 0x6a, 0x1a, 0xda, 0x22,
+	0x37, 0x1c, 0x18, 0x98, 0xcc, 0xc6, 0xda, 0x1a, 0x4d, 0x12, 0xaf, 0xf1, 0xae, 0x98, 0xe1, 0xca,
+	0x63, 0xf0, 0x22, 0xbc, 0x02, 0x07, 0x9e, 0x80, 0x43, 0x2f, 0xbc, 0x06, 0x07, 0x66, 0xff, 0x48,
+	0x5a, 0xd9, 0x72, 0x12, 0x18, 0xb8, 0x79, 0x7f, 0xdf, 0xa7, 0x6f, 0x7f, 0xdf, 0x6f, 0x3f, 0x79,
+	0x05, 0x5b, 0xf1, 0x8c, 0xcf, 0xe9, 0x44, 0xb0, 0x85, 0x3f, 0x5f, 0x30, 0xc1, 0x50, 0x3d, 0x2b,
+	0x78, 0x30, 0x65, 0x53, 0xa6, 0xcb, 0x1e, 0xcc, 0x58, 0x44, 0xcd, 0xef, 0xee, 0x94, 0xb1, 0xe9,
+	0x15, 0x7d, 0xaa, 0x56, 0x17, 0xc9, 0xbb, 0xa7, 0x22, 0xbe, 0xa6, 0x5c, 0x90, 0xeb, 0xb9, 0x26,
+	0xe0, 0xcf, 0x61, 0xeb, 0x39, 0x15, 0x63, 0x41, 0x04, 0x0f, 0xe9, 0x0f, 0x09, 0xe5, 0x02, 0x7d,
+	0x08, 0xf7, 0xa5, 0xc2, 0x79, 0x1c, 0x75, 0x9c, 0x9e, 0x73, 0xd8, 0x1c, 0xb4, 0x7e, 0x7b, 0xdf,
+	0xbd, 0xf7, 0xc7, 0xfb, 0x6e, 0xed, 0x15, 0x8b, 0x68, 0x30, 0x0a, 0x6b, 0x12, 0x0e, 0x22, 0xfc,
+	0x8b, 0x03, 0xed, 0xfc, 0x61, 0x3e, 0x67, 0x33, 0x4e, 0x51, 0x17, 0x1a, 0x24, 0x89, 0x62, 0x71,
+	0x3e, 0x61, 0xc9, 0x4c, 0x28, 0x85, 0x4a, 0x08, 0xaa, 0x34, 0x94, 0x95, 0x9c, 0xb0, 0x20, 0x22,
+	0x66, 0x1d, 0xb7, 0xe7, 0x1c, 0x3a, 0x86, 0x10, 0xca, 0x0a, 0x7a, 0x02, 0xcd, 0x64, 0x2e, 0xfb,
+	0x34, 0x12, 0x15, 0x25, 0xd1, 0xd0, 0x35, 0xad, 0x91, 0x53, 0xb4, 0x48, 0x55, 0x89, 0x18, 0x8a,
+	0x52, 0xc1, 0x7f, 0x3a, 0x80, 0x86, 0x0b, 0x4a, 0x04, 0xfd, 0x57, 0xe6, 0x96, 0x7d, 0xb8, 0x2b,
+	0x3e, 0x7c, 0xd8, 0xd1, 0x04, 0x9e, 0x4c, 0x26, 0x94, 0xf3, 0x42, 0xb7, 0xdb, 0x0a, 0x1a, 0x6b,
+	0x64, 0xb9, 0x67, 0x4d, 0xac, 0xae, 0xda, 0xfa, 0x18, 0x76, 0x0d, 0xa5, 0xa8, 0xb9, 0xa1, 0xa8,
+	0x48, 0x63, 0xb6, 0x28, 0x7e, 0x08, 0x3b, 0x05, 0x93, 0xfa, 0x10, 0xf0, 0xaf, 0x0e, 0x3c, 0x90,
+	0x7e, 0x64, 0x95, 0x06, 0xb3, 0x77, 0xec, 0xee, 0xbe, 0x8f, 0x60, 0x83, 0xcb, 0xa7, 0x94, 0xe3,
+	0x56, 0x7f, 0xd7, 0xcf, 0x53, 0x97, 0x29, 0x86, 0x9a, 0x82, 0x1e, 0x41, 0x6d, 0x41, 0x09, 0x67,
+	0x33, 0xe5, 0xba, 0x1e, 0x9a, 0x15, 0xfa, 0x0c, 0x60, 0xf2, 0x3d, 0x99, 0x4d, 0x69, 0x74, 0x4e,
+	0xb4, 0xd1, 0x46, 0xdf, 0xf3, 0x75, 0x14, 0xfd, 0x34, 0x8a, 0xfe, 0xdb, 0x34, 0x8a, 0x61, 0xdd,
+	0xb0, 0x8f, 0x05, 0x1e, 0xc2, 0xc3, 0xd3, 0x98, 0x8b, 0x6c, 0xab, 0xec, 0xe0, 0xb2, 0xbe, 0x9c,
+	0x5b, 0xfb, 0xc2, 0x2f, 0xe0, 0xd1, 0xb2, 0x88, 0x49, 0xa7, 0x0f, 0x1b, 0xd2, 0x27, 0xef, 0x38,
+	0xbd, 0xca, 0x61, 0xa3, 0xdf, 0x29, 0x53, 0x91, 0xf3, 0x0a, 0x35, 0x0d, 0xff, 0xec, 0xc0, 0xce,
+	0x98, 0xe6, 0x4a, 0xff, 0x38, 0x46, 0xff, 0xc1, 0x38, 0xf1, 0x08, 0x76, 0x8b, 0x3d, 0x18, 0x33,
+	0x1f, 0x41, 0x55, 0xee, 0xa2, 0x3a, 0xb8, 0xc9, 0x8b, 0x62, 0xe1, 0x23, 0x40, 0x2a, 0x33, 0x12,
+	0xcb, 0x07, 0xb2, 0x0b, 0x1b, 0xf6, 0x8b, 0xaa, 0x17, 0x78, 0x07, 0xb6, 0x6d, 0xae, 0xf2, 0x2c,
+	0x8b, 0xcf, 0xa9, 0x18, 0x24, 0x93, 0x4b, 0x9a, 0xbd, 0x4f, 0xf8, 0x05, 0x20, 0xbb, 0x98, 0xab,
+	0x0a, 0x26, 0xc8, 0x55, 0xaa, 0xaa, 0x16, 0x68, 0x1f, 0x2a, 0x71, 0xc4, 0x3b, 0x6e, 0xaf, 0x72,
+	0xd8, 0x1c, 0x80, 0x35, 0x2c, 0x59, 0xc6, 0x7d, 0x68, 0x67, 0x4a, 0xe9, 0x98, 0x1f, 0x83, 0xbb,
+	0x76, 0xc2, 0x6e, 0x1c, 0xe1, 0x33, 0xab, 0xa5, 0x6c, 0xf3, 0x5b, 0x1e, 0x42, 0xbd, 0x34, 0x03,
+	0xae, 0xca, 0x00, 0xf8, 0x72, 0xa5, 0x46, 0x96, 0x9e, 0xfa, 0x11, 0xd4, 0xb4, 0xe6, 0x1d, 0xb8,
+	0x3e, 0x80, 0xe6, 0xca, 0xc4, 0xa1, 0x5e, 0x31, 0x5f, 0x25, 0xfc, 0x97, 0xb0, 0xf5, 0x26, 0x9e,
+	0x4d, 0x55, 0xe9, 0x6e, 0x2e, 0x51, 0x07, 0xee, 0x93, 0x28, 0x5a, 0x50, 0xce, 0x55, 0x8a, 0xea,
+	0x61, 0xba, 0xc4, 0x18, 0xda, 0xb9, 0x98, 0xb1, 0xdf, 0x02, 0x97, 0x5d, 0x2a, 0xb5, 0xcd, 0xd0,
+	0x65, 0x97, 0xf8, 0x0b, 0xd8, 0x3e, 0x65, 0xec, 0x32, 0x99, 0xdb, 0x5b, 0xb6, 0xb2, 0x2d, 0xeb,
+	0xb7, 0x6c, 0xf1, 0x2d, 0x20, 0xfb, 0xf1, 0x6c, 0xc6, 0x76, 0xf4, 0x6c, 0x9b, 0xaa, 0x8e, 0x3e,
+	0x80, 0xea, 0x35, 0x15, 0x44, 0x89, 0x35, 0xfa, 0x28, 0xc7, 0xbf, 0xa4, 0x82, 0x44, 0x44, 0x90,
+	0x50, 0xe1, 0x47, 0x9f, 0x42, 0x3d, 0xcb, 0x2a, 0x02, 0xa8, 0x1d, 0x0f, 0xdf, 0x06, 0x5f, 0x9f,
+	0xb4, 0xef, 0xa1, 0x07, 0x50, 0x1f, 0x9f, 0x8d, 0xdf, 0x9c, 0xbc, 0x1a, 0x9d, 0x8c, 0xda, 0x0e,
+	0x6a, 0x43, 0x73, 0x14, 0x8c, 0xbf, 0x3a, 0x3b, 0x3e, 0x0d, 0x9e, 0x05, 0x27, 0xa3, 0xb6, 0xdb,
+	0xff, 0xcb, 0x85, 0xe6, 0x4b, 0x12, 0x05, 0x69, 0xe6, 0x51, 0x00, 0x90, 0x67, 0x16, 0xed, 0x5b,
+	0x6f, 0xc3, 0x4a, 0x94, 0xbd, 0x83, 0x35, 0xa8, 0x71, 0x17, 0x00, 0xe4, 0xa1, 0x2e, 0x48, 0xad,
+	0xbc, 0x00, 0xde, 0xc1, 0x1a, 0xd4, 0x48, 0x3d, 0x83, 0x7a, 0x56, 0x45, 0x7b, 0x65, 0xdc, 0x54,
+	0x68, 0xbf, 0x1c, 0x34, 0x3a, 0x43, 0xd8, 0x4c, 0x4f, 0x1a, 0x79, 0x16, 0x73, 0x29, 0x4b, 0xde,
+	0x5e, 0x29, 0x96, 0xfb, 0xca, 0xcf, 0xb2, 0xe0, 0x6b, 0x25, 0x21, 0xde, 0xc1, 0x1a, 0x54, 0x4b,
+	0xf5, 0xbf, 0x83, 0xf6, 0xeb, 0x1f, 0xe9, 0xe2, 0x8a, 0xfc, 0xf4, 0x7f, 0x9c, 0x40, 0xff, 0x77,
+	0x17, 0xb6, 0x64, 0x28, 0x46, 0x83, 0x5c, 0x7e, 0x08, 0x9b, 0xe9, 0xd7, 0x46, 0x61, 0x04, 0x4b,
+	0xdf, 0x2f, 0xde, 0x5e, 0x29, 0x66, 0x46, 0x70, 0x0a, 0x0d, 0xeb, 0xc2, 0x44, 0x85, 0x36, 0x56,
+	0xbe, 0x16, 0xbc, 0xc7, 0xeb, 0x60, 0xa3, 0x76, 0x06, 0xad, 0xe2, 0x45, 0x83, 0x7a, 0xf6, 0xd8,
+	0xca, 0x2e, 0x32, 0xef, 0xc9, 0x0d, 0x0c, 0x23, 0xfb, 0x1a, 0x9a, 0xf6, 0x1f, 0x3e, 0xb2, 0xdb,
+	0x28, 0xb9, 0x8d, 0xbc, 0xee, 0x5a, 0x5c, 0x0b, 0x0e, 0xaa, 0xdf, 0xb8, 0xf3, 0x8b, 0x8b, 0x9a,
+	0xba, 0x7a, 0x3f, 0xf9, 0x7b, 0x00, 0x02, 0x86, 0x4e, 0x1f, 0x49, 0x0a, 0x00, 0x00,
 }
@@ -20,18 +20,20 @@ const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
 
 // SerializableMeta is the object metadata that will be stored serialized
 type SerializableMeta struct {
-	ContentType          string            `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
-	UserDefined          map[string]string `protobuf:"bytes,2,rep,name=user_defined,json=userDefined" json:"user_defined,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	ContentType string            `protobuf:"bytes,1,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	UserDefined map[string]string `protobuf:"bytes,2,rep,name=user_defined,json=userDefined" json:"user_defined,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	// version_id identifies the version of objects in buckets with versioning
+	VersionId            string   `protobuf:"bytes,3,opt,name=version_id,json=versionId,proto3" json:"version_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *SerializableMeta) Reset()         { *m = SerializableMeta{} }
 func (m *SerializableMeta) String() string { return proto.CompactTextString(m) }
 func (*SerializableMeta) ProtoMessage()    {}
 func (*SerializableMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_meta_12afffbb4ed1a0bb, []int{0}
+	return fileDescriptor_meta_89dfb10c8c1b8d22, []int{0}
 }
 func (m *SerializableMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SerializableMeta.Unmarshal(m, b)
@@ -65,25 +67,34 @@ func (m *SerializableMeta) GetUserDefined() map[string]string {
 	return nil
 }
 
+func (m *SerializableMeta) GetVersionId() string {
+	if m != nil {
+		return m.VersionId
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*SerializableMeta)(nil), "objects.SerializableMeta")
 	proto.RegisterMapType((map[string]string)(nil), "objects.SerializableMeta.UserDefinedEntry")
 }
 
-func init() { proto.RegisterFile("meta.proto", fileDescriptor_meta_12afffbb4ed1a0bb) }
+func init() { proto.RegisterFile("meta.proto", fileDescriptor_meta_89dfb10c8c1b8d22) }
 
-var fileDescriptor_meta_12afffbb4ed1a0bb = []byte{
-	// 191 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_meta_89dfb10c8c1b8d22 = []byte{
+	// 211 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xca, 0x4d, 0x2d, 0x49,
 	0xd4, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x62, 0xcf, 0x4f, 0xca, 0x4a, 0x4d, 0x2e, 0x29, 0x56,
-	0x3a, 0xc2, 0xc8, 0x25, 0x10, 0x9c, 0x5a, 0x94, 0x99, 0x98, 0x93, 0x59, 0x95, 0x98, 0x94, 0x93,
+	0x7a, 0xcc, 0xc8, 0x25, 0x10, 0x9c, 0x5a, 0x94, 0x99, 0x98, 0x93, 0x59, 0x95, 0x98, 0x94, 0x93,
 	0xea, 0x9b, 0x5a, 0x92, 0x28, 0xa4, 0xc8, 0xc5, 0x93, 0x9c, 0x9f, 0x57, 0x92, 0x9a, 0x57, 0x12,
 	0x5f, 0x52, 0x59, 0x90, 0x2a, 0xc1, 0xa8, 0xc0, 0xa8, 0xc1, 0x19, 0xc4, 0x0d, 0x15, 0x0b, 0xa9,
 	0x2c, 0x48, 0x15, 0xf2, 0xe5, 0xe2, 0x29, 0x2d, 0x4e, 0x2d, 0x8a, 0x4f, 0x49, 0x4d, 0xcb, 0xcc,
 	0x4b, 0x4d, 0x91, 0x60, 0x52, 0x60, 0xd6, 0xe0, 0x36, 0xd2, 0xd2, 0x83, 0x9a, 0xab, 0x87, 0x6e,
 	0xa6, 0x5e, 0x68, 0x71, 0x6a, 0x91, 0x0b, 0x44, 0xb1, 0x6b, 0x5e, 0x49, 0x51, 0x65, 0x10, 0x77,
-	0x29, 0x42, 0x44, 0xca, 0x8e, 0x4b, 0x00, 0x5d, 0x81, 0x90, 0x00, 0x17, 0x73, 0x76, 0x6a, 0x25,
-	0xd4, 0x72, 0x10, 0x53, 0x48, 0x84, 0x8b, 0xb5, 0x2c, 0x31, 0xa7, 0x34, 0x55, 0x82, 0x09, 0x2c,
-	0x06, 0xe1, 0x58, 0x31, 0x59, 0x30, 0x3a, 0xb1, 0x44, 0x31, 0x15, 0x24, 0x25, 0xb1, 0x81, 0x3d,
-	0x67, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff, 0x4f, 0x25, 0xc0, 0xd0, 0xea, 0x00, 0x00, 0x00,
+	0x29, 0x42, 0x44, 0x48, 0x96, 0x8b, 0xab, 0x2c, 0xb5, 0xa8, 0x38, 0x33, 0x3f, 0x2f, 0x3e, 0x33,
+	0x45, 0x82, 0x19, 0x6c, 0x1f, 0x27, 0x54, 0xc4, 0x33, 0x45, 0xca, 0x8e, 0x4b, 0x00, 0x5d, 0xbf,
+	0x90, 0x00, 0x17, 0x73, 0x76, 0x6a, 0x25, 0xd4, 0x6d, 0x20, 0xa6, 0x90, 0x08, 0x17, 0x6b, 0x59,
+	0x62, 0x4e, 0x69, 0xaa, 0x04, 0x13, 0x58, 0x0c, 0xc2, 0xb1, 0x62, 0xb2, 0x60, 0x74, 0x62, 0x89,
+	0x62, 0x2a, 0x48, 0x4a, 0x62, 0x03, 0xfb, 0xdd, 0x18, 0x30, 0x00, 0x70, 0x39, 0x99, 0xef, 0x09,
+	0x01, 0x00, 0x00,
 }
@@ -49,7 +49,7 @@ func (x NodeType) String() string {
 	return proto.EnumName(NodeType_name, int32(x))
 }
 func (NodeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_node_67af40c3abfaa4ce, []int{0}
+	return fileDescriptor_node_5ef2b4f8119a35b3, []int{0}
 }
 
 // NodeTransport is an enum of possible transports for the overlay network
@@ -70,10 +70,10 @@ func (x NodeTransport) String() string {
 	return proto.EnumName(NodeTransport_name, int32(x))
 }
 func (NodeTransport) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_node_67af40c3abfaa4ce, []int{1}
+	return fileDescriptor_node_5ef2b4f8119a35b3, []int{1}
 }
 
-//  NodeRestrictions contains all relevant data about a nodes ability to store data
+// NodeRestrictions contains all relevant data about a nodes ability to store data
 type NodeRestrictions struct {
 	FreeBandwidth        int64    `protobuf:"varint,1,opt,name=free_bandwidth,json=freeBandwidth,proto3" json:"free_bandwidth,omitempty"`
 	FreeDisk             int64    `protobuf:"varint,2,opt,name=free_disk,json=freeDisk,proto3" json:"free_disk,omitempty"`
@@ -86,7 +86,7 @@ func (m *NodeRestrictions) Reset()         { *m = NodeRestrictions{} }
 func (m *NodeRestrictions) String() string { return proto.CompactTextString(m) }
 func (*NodeRestrictions) ProtoMessage()    {}
 func (*NodeRestrictions) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_67af40c3abfaa4ce, []int{0}
+	return fileDescriptor_node_5ef2b4f8119a35b3, []int{0}
 }
 func (m *NodeRestrictions) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeRestrictions.Unmarshal(m, b)
@@ -124,28 +124,31 @@ func (m *NodeRestrictions) GetFreeDisk() int64 {
 // Node represents a node in the overlay network
 // Node is info for a updating a single storagenode, used in the Update rpc calls
 type Node struct {
-	Id                   NodeID            `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
-	Address              *NodeAddress      `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
-	Type                 NodeType          `protobuf:"varint,3,opt,name=type,proto3,enum=node.NodeType" json:"type,omitempty"`
-	Restrictions         *NodeRestrictions `protobuf:"bytes,4,opt,name=restrictions" json:"restrictions,omitempty"`
-	Reputation           *NodeStats        `protobuf:"bytes,5,opt,name=reputation" json:"reputation,omitempty"`
-	Metadata             *NodeMetadata     `protobuf:"bytes,6,opt,name=metadata" json:"metadata,omitempty"`
-	LatencyList          []int64           `protobuf:"varint,7,rep,packed,name=latency_list,json=latencyList" json:"latency_list,omitempty"`
-	AuditSuccess         bool              `protobuf:"varint,8,opt,name=audit_success,json=auditSuccess,proto3" json:"audit_success,omitempty"`
-	IsUp                 bool              `protobuf:"varint,9,opt,name=is_up,json=isUp,proto3" json:"is_up,omitempty"`
-	UpdateLatency        bool              `protobuf:"varint,10,opt,name=update_latency,json=updateLatency,proto3" json:"update_latency,omitempty"`
-	UpdateAuditSuccess   bool              `protobuf:"varint,11,opt,name=update_audit_success,json=updateAuditSuccess,proto3" json:"update_audit_success,omitempty"`
-	UpdateUptime         bool              `protobuf:"varint,12,opt,name=update_uptime,json=updateUptime,proto3" json:"update_uptime,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+	Id                 NodeID            `protobuf:"bytes,1,opt,name=id,proto3,customtype=NodeID" json:"id"`
+	Address            *NodeAddress      `protobuf:"bytes,2,opt,name=address" json:"address,omitempty"`
+	Type               NodeType          `protobuf:"varint,3,opt,name=type,proto3,enum=node.NodeType" json:"type,omitempty"`
+	Restrictions       *NodeRestrictions `protobuf:"bytes,4,opt,name=restrictions" json:"restrictions,omitempty"`
+	Reputation         *NodeStats        `protobuf:"bytes,5,opt,name=reputation" json:"reputation,omitempty"`
+	Metadata           *NodeMetadata     `protobuf:"bytes,6,opt,name=metadata" json:"metadata,omitempty"`
+	LatencyList        []int64           `protobuf:"varint,7,rep,packed,name=latency_list,json=latencyList" json:"latency_list,omitempty"`
+	AuditSuccess       bool              `protobuf:"varint,8,opt,name=audit_success,json=auditSuccess,proto3" json:"audit_success,omitempty"`
+	IsUp               bool              `protobuf:"varint,9,opt,name=is_up,json=isUp,proto3" json:"is_up,omitempty"`
+	UpdateLatency      bool              `protobuf:"varint,10,opt,name=update_latency,json=updateLatency,proto3" json:"update_latency,omitempty"`
+	UpdateAuditSuccess bool              `protobuf:"varint,11,opt,name=update_audit_success,json=updateAuditSuccess,proto3" json:"update_audit_success,omitempty"`
+	UpdateUptime       bool              `protobuf:"varint,12,opt,name=update_uptime,json=updateUptime,proto3" json:"update_uptime,omitempty"`
+	// last_net is the network of the resolved address of the node, the
+	// satellite sets it when it stores the node in the overlay cache
+	LastNet              string   `protobuf:"bytes,13,opt,name=last_net,json=lastNet,proto3" json:"last_net,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Node) Reset()         { *m = Node{} }
 func (m *Node) String() string { return proto.CompactTextString(m) }
 func (*Node) ProtoMessage()    {}
 func (*Node) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_67af40c3abfaa4ce, []int{1}
+	return fileDescriptor_node_5ef2b4f8119a35b3, []int{1}
 }
 func (m *Node) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Node.Unmarshal(m, b)
@@ -242,6 +245,13 @@ func (m *Node) GetUpdateUptime() bool {
 	return false
 }
 
+func (m *Node) GetLastNet() string {
+	if m != nil {
+		return m.LastNet
+	}
+	return ""
+}
+
 // NodeAddress contains the information needed to communicate with a node on the network
 type NodeAddress struct {
 	Transport            NodeTransport `protobuf:"varint,1,opt,name=transport,proto3,enum=node.NodeTransport" json:"transport,omitempty"`
@@ -255,7 +265,7 @@ func (m *NodeAddress) Reset()         { *m = NodeAddress{} }
 func (m *NodeAddress) String() string { return proto.CompactTextString(m) }
 func (*NodeAddress) ProtoMessage()    {}
 func (*NodeAddress) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_67af40c3abfaa4ce, []int{2}
+	return fileDescriptor_node_5ef2b4f8119a35b3, []int{2}
 }
 func (m *NodeAddress) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeAddress.Unmarshal(m, b)
@@ -308,7 +318,7 @@ func (m *NodeStats) Reset()         { *m = NodeStats{} }
 func (m *NodeStats) String() string { return proto.CompactTextString(m) }
 func (*NodeStats) ProtoMessage()    {}
 func (*NodeStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_67af40c3abfaa4ce, []int{3}
+	return fileDescriptor_node_5ef2b4f8119a35b3, []int{3}
 }
 func (m *NodeStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeStats.Unmarshal(m, b)
@@ -389,7 +399,7 @@ func (m *NodeMetadata) Reset()         { *m = NodeMetadata{} }
 func (m *NodeMetadata) String() string { return proto.CompactTextString(m) }
 func (*NodeMetadata) ProtoMessage()    {}
 func (*NodeMetadata) Descriptor() ([]byte, []int) {
-	return fileDescriptor_node_67af40c3abfaa4ce, []int{4}
+	return fileDescriptor_node_5ef2b4f8119a35b3, []int{4}
 }
 func (m *NodeMetadata) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_NodeMetadata.Unmarshal(m, b)
@@ -433,49 +443,50 @@ func init() {
 	proto.RegisterEnum("node.NodeTransport", NodeTransport_name, NodeTransport_value)
 }
 
-func init() { proto.RegisterFile("node.proto", fileDescriptor_node_67af40c3abfaa4ce) }
-
-var fileDescriptor_node_67af40c3abfaa4ce = []byte{
-	// 652 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xc1, 0x4e, 0xdb, 0x40,
-	0x10, 0x86, 0x49, 0x6c, 0x9c, 0x78, 0xec, 0xa4, 0x66, 0x40, 0xc8, 0x6a, 0xd5, 0x12, 0x82, 0xaa,
-	0x46, 0x54, 0x4a, 0x29, 0x3d, 0x51, 0xf5, 0x92, 0x00, 0x42, 0x51, 0xdd, 0x10, 0x6d, 0x0c, 0x07,
-	0x2e, 0x96, 0x89, 0xb7, 0x74, 0x45, 0x88, 0x2d, 0x7b, 0x2d, 0xc4, 0x1b, 0xf6, 0xd0, 0x27, 0xe8,
-	0x81, 0x57, 0xe8, 0x2b, 0x54, 0xbb, 0xeb, 0x24, 0xb6, 0xaa, 0xde, 0xb2, 0xff, 0xff, 0x79, 0xc6,
-	0x3b, 0xff, 0xc4, 0x00, 0x8b, 0x38, 0xa2, 0xfd, 0x24, 0x8d, 0x79, 0x8c, 0xba, 0xf8, 0xfd, 0x12,
-	0xee, 0xe2, 0xbb, 0x58, 0x29, 0xdd, 0x6b, 0x70, 0xc6, 0x71, 0x44, 0x09, 0xcd, 0x78, 0xca, 0x66,
-	0x9c, 0xc5, 0x8b, 0x0c, 0xdf, 0x42, 0xfb, 0x7b, 0x4a, 0x69, 0x70, 0x1b, 0x2e, 0xa2, 0x47, 0x16,
-	0xf1, 0x1f, 0x6e, 0xad, 0x53, 0xeb, 0x69, 0xa4, 0x25, 0xd4, 0xe1, 0x52, 0xc4, 0x57, 0x60, 0x4a,
-	0x2c, 0x62, 0xd9, 0xbd, 0x5b, 0x97, 0x44, 0x53, 0x08, 0x67, 0x2c, 0xbb, 0xef, 0xfe, 0xd1, 0x40,
-	0x17, 0x85, 0xf1, 0x0d, 0xd4, 0x59, 0x24, 0x0b, 0xd8, 0xc3, 0xf6, 0xcf, 0xe7, 0xbd, 0x8d, 0xdf,
-	0xcf, 0x7b, 0x86, 0x70, 0x46, 0x67, 0xa4, 0xce, 0x22, 0x7c, 0x0f, 0x8d, 0x30, 0x8a, 0x52, 0x9a,
-	0x65, 0xb2, 0x86, 0x75, 0xbc, 0xd5, 0x97, 0x2f, 0x2c, 0x90, 0x81, 0x32, 0xc8, 0x92, 0xc0, 0x2e,
-	0xe8, 0xfc, 0x29, 0xa1, 0xae, 0xd6, 0xa9, 0xf5, 0xda, 0xc7, 0xed, 0x35, 0xe9, 0x3f, 0x25, 0x94,
-	0x48, 0x0f, 0x3f, 0x83, 0x9d, 0x96, 0x6e, 0xe3, 0xea, 0xb2, 0xea, 0xee, 0x9a, 0x2d, 0xdf, 0x95,
-	0x54, 0x58, 0xfc, 0x00, 0x90, 0xd2, 0x24, 0xe7, 0xa1, 0x38, 0xba, 0x9b, 0xf2, 0xc9, 0x17, 0xeb,
-	0x27, 0xa7, 0x3c, 0xe4, 0x19, 0x29, 0x21, 0xd8, 0x87, 0xe6, 0x03, 0xe5, 0x61, 0x14, 0xf2, 0xd0,
-	0x35, 0x24, 0x8e, 0x6b, 0xfc, 0x5b, 0xe1, 0x90, 0x15, 0x83, 0xfb, 0x60, 0xcf, 0x43, 0x4e, 0x17,
-	0xb3, 0xa7, 0x60, 0xce, 0x32, 0xee, 0x36, 0x3a, 0x5a, 0x4f, 0x23, 0x56, 0xa1, 0x79, 0x2c, 0xe3,
-	0x78, 0x00, 0xad, 0x30, 0x8f, 0x18, 0x0f, 0xb2, 0x7c, 0x36, 0x13, 0x63, 0x69, 0x76, 0x6a, 0xbd,
-	0x26, 0xb1, 0xa5, 0x38, 0x55, 0x1a, 0x6e, 0xc3, 0x26, 0xcb, 0x82, 0x3c, 0x71, 0x4d, 0x69, 0xea,
-	0x2c, 0xbb, 0x4a, 0x44, 0x6e, 0x79, 0x12, 0x85, 0x9c, 0x06, 0x45, 0x3d, 0x17, 0xa4, 0xdb, 0x52,
-	0xaa, 0xa7, 0x44, 0x3c, 0x82, 0x9d, 0x02, 0xab, 0xf6, 0xb1, 0x24, 0x8c, 0xca, 0x1b, 0x94, 0xbb,
-	0x1d, 0x40, 0x51, 0x22, 0xc8, 0x13, 0xce, 0x1e, 0xa8, 0x6b, 0xab, 0x57, 0x52, 0xe2, 0x95, 0xd4,
-	0xba, 0x37, 0x60, 0x95, 0x32, 0xc3, 0x8f, 0x60, 0xf2, 0x34, 0x5c, 0x64, 0x49, 0x9c, 0x72, 0x19,
-	0x7f, 0xfb, 0x78, 0xbb, 0x94, 0xd7, 0xd2, 0x22, 0x6b, 0x0a, 0xdd, 0xea, 0x2a, 0x98, 0xab, 0xdc,
-	0xbb, 0xbf, 0xea, 0x60, 0xae, 0x02, 0xc0, 0x77, 0xd0, 0x10, 0x85, 0x82, 0xff, 0xee, 0x95, 0x21,
-	0xec, 0x51, 0x84, 0xaf, 0x01, 0x96, 0xd3, 0x3e, 0x39, 0x2a, 0x56, 0xd4, 0x2c, 0x94, 0x93, 0x23,
-	0xec, 0xc3, 0x76, 0x65, 0x02, 0x41, 0x2a, 0x42, 0x95, 0xcb, 0x55, 0x23, 0x5b, 0xe5, 0x79, 0x13,
-	0x61, 0x88, 0xf0, 0xd4, 0xfd, 0x0b, 0x50, 0x97, 0xa0, 0xa5, 0x34, 0x85, 0xec, 0x81, 0xa5, 0x4a,
-	0xce, 0xe2, 0x7c, 0xc1, 0xe5, 0x06, 0x69, 0x04, 0xa4, 0x74, 0x2a, 0x94, 0x7f, 0x7b, 0x2a, 0xd0,
-	0x90, 0x60, 0xa5, 0xa7, 0xe2, 0xd7, 0x3d, 0x15, 0xd8, 0x90, 0x60, 0xd1, 0x53, 0x21, 0x32, 0x4f,
-	0x89, 0x54, 0x6b, 0x36, 0x25, 0x8a, 0xca, 0x2b, 0x17, 0xed, 0x7e, 0x01, 0xbb, 0xbc, 0x9f, 0xb8,
-	0x03, 0x9b, 0xf4, 0x21, 0x64, 0x73, 0x39, 0x4e, 0x93, 0xa8, 0x03, 0xee, 0x82, 0xf1, 0x18, 0xce,
-	0xe7, 0x94, 0x17, 0x69, 0x14, 0xa7, 0xc3, 0x31, 0x34, 0x97, 0x7f, 0x39, 0xb4, 0xa0, 0x31, 0x1a,
-	0x5f, 0x0f, 0xbc, 0xd1, 0x99, 0xb3, 0x81, 0x2d, 0x30, 0xa7, 0x03, 0xff, 0xdc, 0xf3, 0x46, 0xfe,
-	0xb9, 0x53, 0x13, 0xde, 0xd4, 0xbf, 0x24, 0x83, 0x8b, 0x73, 0xa7, 0x8e, 0x00, 0xc6, 0xd5, 0xc4,
-	0x1b, 0x8d, 0xbf, 0x3a, 0x9a, 0xe0, 0x86, 0x97, 0x97, 0xfe, 0xd4, 0x27, 0x83, 0x89, 0xa3, 0x1f,
-	0xee, 0x43, 0xab, 0xb2, 0x12, 0xe8, 0x80, 0xed, 0x9f, 0x4e, 0x02, 0xdf, 0x9b, 0x06, 0x17, 0x64,
-	0x72, 0xea, 0x6c, 0x0c, 0xf5, 0x9b, 0x7a, 0x72, 0x7b, 0x6b, 0xc8, 0x4f, 0xd6, 0xa7, 0xbf, 0x01,
-	0x00, 0x00, 0xff, 0xff, 0xc0, 0x8a, 0x68, 0xa5, 0xd2, 0x04, 0x00, 0x00,
+func init() { proto.RegisterFile("node.proto", fileDescriptor_node_5ef2b4f8119a35b3) }
+
+var fileDescriptor_node_5ef2b4f8119a35b3 = []byte{
+	// 670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0xcf, 0x4e, 0xdb, 0x4a,
+	0x14, 0xc6, 0x49, 0x62, 0x92, 0xf8, 0xe4, 0xcf, 0x35, 0x07, 0x84, 0x7c, 0xef, 0xd5, 0xbd, 0x84,
+	0xa0, 0xaa, 0x11, 0x95, 0x52, 0x4a, 0x57, 0x54, 0xdd, 0x24, 0x80, 0x50, 0x54, 0x37, 0x44, 0x13,
+	0xc3, 0x82, 0x8d, 0x35, 0xc4, 0x53, 0x3a, 0x22, 0xd8, 0x96, 0x67, 0x2c, 0xc4, 0x2b, 0xf4, 0xc9,
+	0xba, 0xe8, 0x13, 0x74, 0xc1, 0xb3, 0x54, 0x33, 0xe3, 0x10, 0x5b, 0x55, 0x77, 0xcc, 0xf7, 0xfd,
+	0xfc, 0x1d, 0x7b, 0xbe, 0x43, 0x00, 0xa2, 0x38, 0x64, 0xc3, 0x24, 0x8d, 0x65, 0x8c, 0x96, 0xfa,
+	0xfb, 0x1f, 0xb8, 0x8b, 0xef, 0x62, 0xa3, 0xf4, 0xaf, 0xc1, 0x99, 0xc6, 0x21, 0x23, 0x4c, 0xc8,
+	0x94, 0x2f, 0x24, 0x8f, 0x23, 0x81, 0xaf, 0xa0, 0xfb, 0x25, 0x65, 0x2c, 0xb8, 0xa5, 0x51, 0xf8,
+	0xc8, 0x43, 0xf9, 0xd5, 0xad, 0xf4, 0x2a, 0x83, 0x1a, 0xe9, 0x28, 0x75, 0xbc, 0x12, 0xf1, 0x5f,
+	0xb0, 0x35, 0x16, 0x72, 0x71, 0xef, 0x56, 0x35, 0xd1, 0x54, 0xc2, 0x19, 0x17, 0xf7, 0xfd, 0x6f,
+	0x16, 0x58, 0x2a, 0x18, 0xff, 0x87, 0x2a, 0x0f, 0x75, 0x40, 0x7b, 0xdc, 0xfd, 0xfe, 0xbc, 0xb7,
+	0xf1, 0xf3, 0x79, 0xaf, 0xae, 0x9c, 0xc9, 0x19, 0xa9, 0xf2, 0x10, 0xdf, 0x40, 0x83, 0x86, 0x61,
+	0xca, 0x84, 0xd0, 0x19, 0xad, 0xe3, 0xad, 0xa1, 0x7e, 0x61, 0x85, 0x8c, 0x8c, 0x41, 0x56, 0x04,
+	0xf6, 0xc1, 0x92, 0x4f, 0x09, 0x73, 0x6b, 0xbd, 0xca, 0xa0, 0x7b, 0xdc, 0x5d, 0x93, 0xfe, 0x53,
+	0xc2, 0x88, 0xf6, 0xf0, 0x03, 0xb4, 0xd3, 0xc2, 0xd7, 0xb8, 0x96, 0x4e, 0xdd, 0x5d, 0xb3, 0xc5,
+	0x6f, 0x25, 0x25, 0x16, 0xdf, 0x02, 0xa4, 0x2c, 0xc9, 0x24, 0x55, 0x47, 0x77, 0x53, 0x3f, 0xf9,
+	0xd7, 0xfa, 0xc9, 0xb9, 0xa4, 0x52, 0x90, 0x02, 0x82, 0x43, 0x68, 0x3e, 0x30, 0x49, 0x43, 0x2a,
+	0xa9, 0x5b, 0xd7, 0x38, 0xae, 0xf1, 0xcf, 0xb9, 0x43, 0x5e, 0x18, 0xdc, 0x87, 0xf6, 0x92, 0x4a,
+	0x16, 0x2d, 0x9e, 0x82, 0x25, 0x17, 0xd2, 0x6d, 0xf4, 0x6a, 0x83, 0x1a, 0x69, 0xe5, 0x9a, 0xc7,
+	0x85, 0xc4, 0x03, 0xe8, 0xd0, 0x2c, 0xe4, 0x32, 0x10, 0xd9, 0x62, 0xa1, 0xae, 0xa5, 0xd9, 0xab,
+	0x0c, 0x9a, 0xa4, 0xad, 0xc5, 0xb9, 0xd1, 0x70, 0x1b, 0x36, 0xb9, 0x08, 0xb2, 0xc4, 0xb5, 0xb5,
+	0x69, 0x71, 0x71, 0x95, 0xa8, 0xde, 0xb2, 0x24, 0xa4, 0x92, 0x05, 0x79, 0x9e, 0x0b, 0xda, 0xed,
+	0x18, 0xd5, 0x33, 0x22, 0x1e, 0xc1, 0x4e, 0x8e, 0x95, 0xe7, 0xb4, 0x34, 0x8c, 0xc6, 0x1b, 0x15,
+	0xa7, 0x1d, 0x40, 0x1e, 0x11, 0x64, 0x89, 0xe4, 0x0f, 0xcc, 0x6d, 0x9b, 0x57, 0x32, 0xe2, 0x95,
+	0xd6, 0xf0, 0x6f, 0x68, 0x2e, 0xa9, 0x90, 0x41, 0xc4, 0xa4, 0xdb, 0xe9, 0x55, 0x06, 0x36, 0x69,
+	0xa8, 0xf3, 0x94, 0xc9, 0xfe, 0x0d, 0xb4, 0x0a, 0x75, 0xe2, 0x3b, 0xb0, 0x65, 0x4a, 0x23, 0x91,
+	0xc4, 0xa9, 0xd4, 0x9b, 0xd1, 0x3d, 0xde, 0x2e, 0x54, 0xb9, 0xb2, 0xc8, 0x9a, 0x42, 0xb7, 0xbc,
+	0x25, 0xf6, 0xcb, 0x4a, 0xf4, 0x7f, 0x54, 0xc1, 0x7e, 0xe9, 0x06, 0x5f, 0x43, 0x43, 0x05, 0x05,
+	0x7f, 0x5c, 0xb9, 0xba, 0xb2, 0x27, 0x21, 0xfe, 0x07, 0xb0, 0x2a, 0xe2, 0xe4, 0x28, 0xdf, 0x5e,
+	0x3b, 0x57, 0x4e, 0x8e, 0x70, 0x08, 0xdb, 0xa5, 0xcb, 0x09, 0x52, 0xd5, 0xb7, 0xde, 0xbb, 0x0a,
+	0xd9, 0x2a, 0x56, 0x41, 0x94, 0xa1, 0x7a, 0x35, 0x57, 0x93, 0x83, 0x96, 0x06, 0x5b, 0x46, 0x33,
+	0xc8, 0x1e, 0xb4, 0x4c, 0xe4, 0x22, 0xce, 0x22, 0xa9, 0x97, 0xab, 0x46, 0x40, 0x4b, 0xa7, 0x4a,
+	0xf9, 0x7d, 0xa6, 0x01, 0xeb, 0x1a, 0x2c, 0xcd, 0x34, 0xfc, 0x7a, 0xa6, 0x01, 0x1b, 0x1a, 0xcc,
+	0x67, 0x1a, 0x44, 0x57, 0xad, 0x91, 0x72, 0x66, 0x53, 0xa3, 0x68, 0xbc, 0x62, 0x68, 0xff, 0x23,
+	0xb4, 0x8b, 0xab, 0x8b, 0x3b, 0xb0, 0xc9, 0x1e, 0x28, 0x5f, 0xea, 0xeb, 0xb4, 0x89, 0x39, 0xe0,
+	0x2e, 0xd4, 0x1f, 0xe9, 0x72, 0xc9, 0x64, 0xde, 0x46, 0x7e, 0x3a, 0x9c, 0x42, 0x73, 0xf5, 0xdf,
+	0x88, 0x2d, 0x68, 0x4c, 0xa6, 0xd7, 0x23, 0x6f, 0x72, 0xe6, 0x6c, 0x60, 0x07, 0xec, 0xf9, 0xc8,
+	0x3f, 0xf7, 0xbc, 0x89, 0x7f, 0xee, 0x54, 0x94, 0x37, 0xf7, 0x2f, 0xc9, 0xe8, 0xe2, 0xdc, 0xa9,
+	0x22, 0x40, 0xfd, 0x6a, 0xe6, 0x4d, 0xa6, 0x9f, 0x9c, 0x9a, 0xe2, 0xc6, 0x97, 0x97, 0xfe, 0xdc,
+	0x27, 0xa3, 0x99, 0x63, 0x1d, 0xee, 0x43, 0xa7, 0xb4, 0x12, 0xe8, 0x40, 0xdb, 0x3f, 0x9d, 0x05,
+	0xbe, 0x37, 0x0f, 0x2e, 0xc8, 0xec, 0xd4, 0xd9, 0x18, 0x5b, 0x37, 0xd5, 0xe4, 0xf6, 0xb6, 0xae,
+	0x7f, 0xcd, 0xde, 0xff, 0x1a, 0x00, 0xda, 0x13, 0x0e, 0x03, 0xed, 0x04, 0x00, 0x00,
 }
@@ -0,0 +1,214 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: nodestats.proto
+
+package pb
+
+import proto "github.com/gogo/protobuf/proto"
+import fmt "fmt"
+import math "math"
+
+import (
+	context "golang.org/x/net/context"
+	grpc "google.golang.org/grpc"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion2 // please upgrade the proto package
+
+type ReputationRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReputationRequest) Reset()         { *m = ReputationRequest{} }
+func (m *ReputationRequest) String() string { return proto.CompactTextString(m) }
+func (*ReputationRequest) ProtoMessage()    {}
+func (*ReputationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_nodestats_de9dd7b3bb2f29bf, []int{0}
+}
+func (m *ReputationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReputationRequest.Unmarshal(m, b)
+}
+func (m *ReputationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReputationRequest.Marshal(b, m, deterministic)
+}
+func (dst *ReputationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputationRequest.Merge(dst, src)
+}
+func (m *ReputationRequest) XXX_Size() int {
+	return xxx_messageInfo_ReputationRequest.Size(m)
+}
+func (m *ReputationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputationRequest proto.InternalMessageInfo
+
+type ReputationResponse struct {
+	AuditCount           int64    `protobuf:"varint,1,opt,name=audit_count,json=auditCount,proto3" json:"audit_count,omitempty"`
+	AuditRatio           float64  `protobuf:"fixed64,2,opt,name=audit_ratio,json=auditRatio,proto3" json:"audit_ratio,omitempty"`
+	UptimeCount          int64    `protobuf:"varint,3,opt,name=uptime_count,json=uptimeCount,proto3" json:"uptime_count,omitempty"`
+	UptimeRatio          float64  `protobuf:"fixed64,4,opt,name=uptime_ratio,json=uptimeRatio,proto3" json:"uptime_ratio,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReputationResponse) Reset()         { *m = ReputationResponse{} }
+func (m *ReputationResponse) String() string { return proto.CompactTextString(m) }
+func (*ReputationResponse) ProtoMessage()    {}
+func (*ReputationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_nodestats_de9dd7b3bb2f29bf, []int{1}
+}
+func (m *ReputationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReputationResponse.Unmarshal(m, b)
+}
+func (m *ReputationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReputationResponse.Marshal(b, m, deterministic)
+}
+func (dst *ReputationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReputationResponse.Merge(dst, src)
+}
+func (m *ReputationResponse) XXX_Size() int {
+	return xxx_messageInfo_ReputationResponse.Size(m)
+}
+func (m *ReputationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReputationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReputationResponse proto.InternalMessageInfo
+
+func (m *ReputationResponse) GetAuditCount() int64 {
+	if m != nil {
+		return m.AuditCount
+	}
+	return 0
+}
+
+func (m *ReputationResponse) GetAuditRatio() float64 {
+	if m != nil {
+		return m.AuditRatio
+	}
+	return 0
+}
+
+func (m *ReputationResponse) GetUptimeCount() int64 {
+	if m != nil {
+		return m.UptimeCount
+	}
+	return 0
+}
+
+func (m *ReputationResponse) GetUptimeRatio() float64 {
+	if m != nil {
+		return m.UptimeRatio
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*ReputationRequest)(nil), "nodestats.ReputationRequest")
+	proto.RegisterType((*ReputationResponse)(nil), "nodestats.ReputationResponse")
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// NodeStatsClient is the client API for NodeStats service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NodeStatsClient interface {
+	// Reputation returns the audit and uptime stats of the calling node
+	Reputation(ctx context.Context, in *ReputationRequest, opts ...grpc.CallOption) (*ReputationResponse, error)
+}
+
+type nodeStatsClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewNodeStatsClient(cc *grpc.ClientConn) NodeStatsClient {
+	return &nodeStatsClient{cc}
+}
+
+func (c *nodeStatsClient) Reputation(ctx context.Context, in *ReputationRequest, opts ...grpc.CallOption) (*ReputationResponse, error) {
+	out := new(ReputationResponse)
+	err := c.cc.Invoke(ctx, "/nodestats.NodeStats/Reputation", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NodeStatsServer is the server API for NodeStats service.
+type NodeStatsServer interface {
+	// Reputation returns the audit and uptime stats of the calling node
+	Reputation(context.Context, *ReputationRequest) (*ReputationResponse, error)
+}
+
+func RegisterNodeStatsServer(s *grpc.Server, srv NodeStatsServer) {
+	s.RegisterService(&_NodeStats_serviceDesc, srv)
+}
+
+func _NodeStats_Reputation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReputationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NodeStatsServer).Reputation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/nodestats.NodeStats/Reputation",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NodeStatsServer).Reputation(ctx, req.(*ReputationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NodeStats_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "nodestats.NodeStats",
+	HandlerType: (*NodeStatsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Reputation",
+			Handler:    _NodeStats_Reputation_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "nodestats.proto",
+}
+
+func init() { proto.RegisterFile("nodestats.proto", fileDescriptor_nodestats_de9dd7b3bb2f29bf) }
+
+var fileDescriptor_nodestats_de9dd7b3bb2f29bf = []byte{
+	// 196 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xe2, 0xcf, 0xcb, 0x4f, 0x49,
+	0x2d, 0x2e, 0x49, 0x2c, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x84, 0x0b, 0x28,
+	0x09, 0x73, 0x09, 0x06, 0xa5, 0x16, 0x94, 0x96, 0x24, 0x96, 0x64, 0xe6, 0xe7, 0x05, 0xa5, 0x16,
+	0x96, 0xa6, 0x16, 0x97, 0x28, 0xcd, 0x61, 0xe4, 0x12, 0x42, 0x16, 0x2d, 0x2e, 0xc8, 0xcf, 0x2b,
+	0x4e, 0x15, 0x92, 0xe7, 0xe2, 0x4e, 0x2c, 0x4d, 0xc9, 0x2c, 0x89, 0x4f, 0xce, 0x2f, 0xcd, 0x2b,
+	0x91, 0x60, 0x54, 0x60, 0xd4, 0x60, 0x0e, 0xe2, 0x02, 0x0b, 0x39, 0x83, 0x44, 0x10, 0x0a, 0x8a,
+	0x40, 0x1a, 0x25, 0x98, 0x14, 0x18, 0x35, 0x18, 0xa1, 0x0a, 0x82, 0x40, 0x22, 0x42, 0x8a, 0x5c,
+	0x3c, 0xa5, 0x05, 0x25, 0x99, 0xb9, 0xa9, 0x50, 0x23, 0x98, 0xc1, 0x46, 0x70, 0x43, 0xc4, 0x20,
+	0x66, 0x20, 0x94, 0x40, 0x0c, 0x61, 0x01, 0x1b, 0x02, 0x55, 0x02, 0x36, 0xc5, 0x28, 0x82, 0x8b,
+	0xd3, 0x2f, 0x3f, 0x25, 0x35, 0x18, 0xe4, 0x01, 0x21, 0x6f, 0x2e, 0x2e, 0x84, 0x53, 0x85, 0x64,
+	0xf4, 0x10, 0x7e, 0xc5, 0xf0, 0x97, 0x94, 0x2c, 0x0e, 0x59, 0x88, 0xff, 0x94, 0x18, 0x9c, 0x58,
+	0xa2, 0x98, 0x0a, 0x92, 0x92, 0xd8, 0xc0, 0xa1, 0x64, 0x0c, 0x18, 0x00, 0x03, 0x6c, 0x6f, 0x35,
+	0x38, 0x01, 0x00, 0x00,
+}
@@ -8,6 +8,7 @@ import fmt "fmt"
 import math "math"
 import _ "github.com/gogo/protobuf/gogoproto"
 import duration "github.com/golang/protobuf/ptypes/duration"
+import timestamp "github.com/golang/protobuf/ptypes/timestamp"
 
 import (
 	context "golang.org/x/net/context"
@@ -54,7 +55,7 @@ func (x PayerBandwidthAllocation_Action) String() string {
 	return proto.EnumName(PayerBandwidthAllocation_Action_name, int32(x))
 }
 func (PayerBandwidthAllocation_Action) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{0, 0}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{0, 0}
 }
 
 type PayerBandwidthAllocation struct {
@@ -69,7 +70,7 @@ func (m *PayerBandwidthAllocation) Reset()         { *m = PayerBandwidthAllocati
 func (m *PayerBandwidthAllocation) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocation) ProtoMessage()    {}
 func (*PayerBandwidthAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{0}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{0}
 }
 func (m *PayerBandwidthAllocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocation.Unmarshal(m, b)
@@ -112,6 +113,8 @@ type PayerBandwidthAllocation_Data struct {
 	Action               PayerBandwidthAllocation_Action `protobuf:"varint,6,opt,name=action,proto3,enum=piecestoreroutes.PayerBandwidthAllocation_Action" json:"action,omitempty"`
 	CreatedUnixSec       int64                           `protobuf:"varint,7,opt,name=created_unix_sec,json=createdUnixSec,proto3" json:"created_unix_sec,omitempty"`
 	PubKey               []byte                          `protobuf:"bytes,8,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	ProjectId            string                          `protobuf:"bytes,9,opt,name=project_id,json=projectId,proto3" json:"project_id,omitempty"`
+	Bucket               string                          `protobuf:"bytes,10,opt,name=bucket,proto3" json:"bucket,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                        `json:"-"`
 	XXX_unrecognized     []byte                          `json:"-"`
 	XXX_sizecache        int32                           `json:"-"`
@@ -121,7 +124,7 @@ func (m *PayerBandwidthAllocation_Data) Reset()         { *m = PayerBandwidthAll
 func (m *PayerBandwidthAllocation_Data) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocation_Data) ProtoMessage()    {}
 func (*PayerBandwidthAllocation_Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{0, 0}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{0, 0}
 }
 func (m *PayerBandwidthAllocation_Data) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocation_Data.Unmarshal(m, b)
@@ -183,6 +186,20 @@ func (m *PayerBandwidthAllocation_Data) GetPubKey() []byte {
 	return nil
 }
 
+func (m *PayerBandwidthAllocation_Data) GetProjectId() string {
+	if m != nil {
+		return m.ProjectId
+	}
+	return ""
+}
+
+func (m *PayerBandwidthAllocation_Data) GetBucket() string {
+	if m != nil {
+		return m.Bucket
+	}
+	return ""
+}
+
 type RenterBandwidthAllocation struct {
 	Signature            []byte   `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
 	Data                 []byte   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
@@ -195,7 +212,7 @@ func (m *RenterBandwidthAllocation) Reset()         { *m = RenterBandwidthAlloca
 func (m *RenterBandwidthAllocation) String() string { return proto.CompactTextString(m) }
 func (*RenterBandwidthAllocation) ProtoMessage()    {}
 func (*RenterBandwidthAllocation) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{1}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{1}
 }
 func (m *RenterBandwidthAllocation) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenterBandwidthAllocation.Unmarshal(m, b)
@@ -242,7 +259,7 @@ func (m *RenterBandwidthAllocation_Data) Reset()         { *m = RenterBandwidthA
 func (m *RenterBandwidthAllocation_Data) String() string { return proto.CompactTextString(m) }
 func (*RenterBandwidthAllocation_Data) ProtoMessage()    {}
 func (*RenterBandwidthAllocation_Data) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{1, 0}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{1, 0}
 }
 func (m *RenterBandwidthAllocation_Data) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RenterBandwidthAllocation_Data.Unmarshal(m, b)
@@ -289,7 +306,7 @@ func (m *PieceStore) Reset()         { *m = PieceStore{} }
 func (m *PieceStore) String() string { return proto.CompactTextString(m) }
 func (*PieceStore) ProtoMessage()    {}
 func (*PieceStore) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{2}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{2}
 }
 func (m *PieceStore) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStore.Unmarshal(m, b)
@@ -335,6 +352,7 @@ type PieceStore_PieceData struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	ExpirationUnixSec    int64    `protobuf:"varint,2,opt,name=expiration_unix_sec,json=expirationUnixSec,proto3" json:"expiration_unix_sec,omitempty"`
 	Content              []byte   `protobuf:"bytes,3,opt,name=content,proto3" json:"content,omitempty"`
+	Hash                 []byte   `protobuf:"bytes,4,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -344,7 +362,7 @@ func (m *PieceStore_PieceData) Reset()         { *m = PieceStore_PieceData{} }
 func (m *PieceStore_PieceData) String() string { return proto.CompactTextString(m) }
 func (*PieceStore_PieceData) ProtoMessage()    {}
 func (*PieceStore_PieceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{2, 0}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{2, 0}
 }
 func (m *PieceStore_PieceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStore_PieceData.Unmarshal(m, b)
@@ -385,6 +403,13 @@ func (m *PieceStore_PieceData) GetContent() []byte {
 	return nil
 }
 
+func (m *PieceStore_PieceData) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type PieceId struct {
 	// TODO: may want to use customtype and fixed-length byte slice
 	Id                   string         `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
@@ -398,7 +423,7 @@ func (m *PieceId) Reset()         { *m = PieceId{} }
 func (m *PieceId) String() string { return proto.CompactTextString(m) }
 func (*PieceId) ProtoMessage()    {}
 func (*PieceId) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{3}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{3}
 }
 func (m *PieceId) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceId.Unmarshal(m, b)
@@ -445,7 +470,7 @@ func (m *PieceSummary) Reset()         { *m = PieceSummary{} }
 func (m *PieceSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceSummary) ProtoMessage()    {}
 func (*PieceSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{4}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{4}
 }
 func (m *PieceSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceSummary.Unmarshal(m, b)
@@ -499,7 +524,7 @@ func (m *PieceRetrieval) Reset()         { *m = PieceRetrieval{} }
 func (m *PieceRetrieval) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrieval) ProtoMessage()    {}
 func (*PieceRetrieval) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{5}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{5}
 }
 func (m *PieceRetrieval) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrieval.Unmarshal(m, b)
@@ -554,7 +579,7 @@ func (m *PieceRetrieval_PieceData) Reset()         { *m = PieceRetrieval_PieceDa
 func (m *PieceRetrieval_PieceData) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrieval_PieceData) ProtoMessage()    {}
 func (*PieceRetrieval_PieceData) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{5, 0}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{5, 0}
 }
 func (m *PieceRetrieval_PieceData) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrieval_PieceData.Unmarshal(m, b)
@@ -607,7 +632,7 @@ func (m *PieceRetrievalStream) Reset()         { *m = PieceRetrievalStream{} }
 func (m *PieceRetrievalStream) String() string { return proto.CompactTextString(m) }
 func (*PieceRetrievalStream) ProtoMessage()    {}
 func (*PieceRetrievalStream) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{6}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{6}
 }
 func (m *PieceRetrievalStream) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceRetrievalStream.Unmarshal(m, b)
@@ -654,7 +679,7 @@ func (m *PieceDelete) Reset()         { *m = PieceDelete{} }
 func (m *PieceDelete) String() string { return proto.CompactTextString(m) }
 func (*PieceDelete) ProtoMessage()    {}
 func (*PieceDelete) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{7}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{7}
 }
 func (m *PieceDelete) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceDelete.Unmarshal(m, b)
@@ -699,7 +724,7 @@ func (m *PieceDeleteSummary) Reset()         { *m = PieceDeleteSummary{} }
 func (m *PieceDeleteSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceDeleteSummary) ProtoMessage()    {}
 func (*PieceDeleteSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{8}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{8}
 }
 func (m *PieceDeleteSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceDeleteSummary.Unmarshal(m, b)
@@ -738,7 +763,7 @@ func (m *PieceStoreSummary) Reset()         { *m = PieceStoreSummary{} }
 func (m *PieceStoreSummary) String() string { return proto.CompactTextString(m) }
 func (*PieceStoreSummary) ProtoMessage()    {}
 func (*PieceStoreSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{9}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{9}
 }
 func (m *PieceStoreSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PieceStoreSummary.Unmarshal(m, b)
@@ -782,7 +807,7 @@ func (m *StatsReq) Reset()         { *m = StatsReq{} }
 func (m *StatsReq) String() string { return proto.CompactTextString(m) }
 func (*StatsReq) ProtoMessage()    {}
 func (*StatsReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{10}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{10}
 }
 func (m *StatsReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatsReq.Unmarshal(m, b)
@@ -803,20 +828,21 @@ func (m *StatsReq) XXX_DiscardUnknown() {
 var xxx_messageInfo_StatsReq proto.InternalMessageInfo
 
 type StatSummary struct {
-	UsedSpace            int64    `protobuf:"varint,1,opt,name=used_space,json=usedSpace,proto3" json:"used_space,omitempty"`
-	AvailableSpace       int64    `protobuf:"varint,2,opt,name=available_space,json=availableSpace,proto3" json:"available_space,omitempty"`
-	UsedBandwidth        int64    `protobuf:"varint,3,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
-	AvailableBandwidth   int64    `protobuf:"varint,4,opt,name=available_bandwidth,json=availableBandwidth,proto3" json:"available_bandwidth,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	UsedSpace            int64             `protobuf:"varint,1,opt,name=used_space,json=usedSpace,proto3" json:"used_space,omitempty"`
+	AvailableSpace       int64             `protobuf:"varint,2,opt,name=available_space,json=availableSpace,proto3" json:"available_space,omitempty"`
+	UsedBandwidth        int64             `protobuf:"varint,3,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
+	AvailableBandwidth   int64             `protobuf:"varint,4,opt,name=available_bandwidth,json=availableBandwidth,proto3" json:"available_bandwidth,omitempty"`
+	Satellites           []*SatelliteStats `protobuf:"bytes,5,rep,name=satellites" json:"satellites,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
 func (m *StatSummary) Reset()         { *m = StatSummary{} }
 func (m *StatSummary) String() string { return proto.CompactTextString(m) }
 func (*StatSummary) ProtoMessage()    {}
 func (*StatSummary) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{11}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{11}
 }
 func (m *StatSummary) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StatSummary.Unmarshal(m, b)
@@ -864,6 +890,71 @@ func (m *StatSummary) GetAvailableBandwidth() int64 {
 	return 0
 }
 
+func (m *StatSummary) GetSatellites() []*SatelliteStats {
+	if m != nil {
+		return m.Satellites
+	}
+	return nil
+}
+
+// SatelliteStats is the space and the bandwidth of the current month used
+// for a satellite
+type SatelliteStats struct {
+	SatelliteId NodeID `protobuf:"bytes,1,opt,name=satellite_id,json=satelliteId,proto3,customtype=NodeID" json:"satellite_id"`
+	UsedSpace   int64  `protobuf:"varint,2,opt,name=used_space,json=usedSpace,proto3" json:"used_space,omitempty"`
+	// allocated_space is the storage cap of the satellite, zero without a cap
+	AllocatedSpace       int64    `protobuf:"varint,3,opt,name=allocated_space,json=allocatedSpace,proto3" json:"allocated_space,omitempty"`
+	UsedBandwidth        int64    `protobuf:"varint,4,opt,name=used_bandwidth,json=usedBandwidth,proto3" json:"used_bandwidth,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SatelliteStats) Reset()         { *m = SatelliteStats{} }
+func (m *SatelliteStats) String() string { return proto.CompactTextString(m) }
+func (*SatelliteStats) ProtoMessage()    {}
+func (*SatelliteStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{12}
+}
+func (m *SatelliteStats) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SatelliteStats.Unmarshal(m, b)
+}
+func (m *SatelliteStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SatelliteStats.Marshal(b, m, deterministic)
+}
+func (dst *SatelliteStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SatelliteStats.Merge(dst, src)
+}
+func (m *SatelliteStats) XXX_Size() int {
+	return xxx_messageInfo_SatelliteStats.Size(m)
+}
+func (m *SatelliteStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_SatelliteStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SatelliteStats proto.InternalMessageInfo
+
+func (m *SatelliteStats) GetUsedSpace() int64 {
+	if m != nil {
+		return m.UsedSpace
+	}
+	return 0
+}
+
+func (m *SatelliteStats) GetAllocatedSpace() int64 {
+	if m != nil {
+		return m.AllocatedSpace
+	}
+	return 0
+}
+
+func (m *SatelliteStats) GetUsedBandwidth() int64 {
+	if m != nil {
+		return m.UsedBandwidth
+	}
+	return 0
+}
+
 type SignedMessage struct {
 	Data                 []byte   `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
 	Signature            []byte   `protobuf:"bytes,2,opt,name=signature,proto3" json:"signature,omitempty"`
@@ -877,7 +968,7 @@ func (m *SignedMessage) Reset()         { *m = SignedMessage{} }
 func (m *SignedMessage) String() string { return proto.CompactTextString(m) }
 func (*SignedMessage) ProtoMessage()    {}
 func (*SignedMessage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{12}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{13}
 }
 func (m *SignedMessage) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SignedMessage.Unmarshal(m, b)
@@ -928,7 +1019,7 @@ func (m *DashboardReq) Reset()         { *m = DashboardReq{} }
 func (m *DashboardReq) String() string { return proto.CompactTextString(m) }
 func (*DashboardReq) ProtoMessage()    {}
 func (*DashboardReq) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{13}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{14}
 }
 func (m *DashboardReq) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardReq.Unmarshal(m, b)
@@ -964,7 +1055,7 @@ func (m *DashboardStats) Reset()         { *m = DashboardStats{} }
 func (m *DashboardStats) String() string { return proto.CompactTextString(m) }
 func (*DashboardStats) ProtoMessage()    {}
 func (*DashboardStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_piecestore_664948018be76fe5, []int{14}
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{15}
 }
 func (m *DashboardStats) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DashboardStats.Unmarshal(m, b)
@@ -1026,6 +1117,94 @@ func (m *DashboardStats) GetUptime() *duration.Duration {
 	return nil
 }
 
+// RetainRequest is sent by satellites to delete the pieces of the node, which
+// the satellite doesn't reference anymore
+type RetainRequest struct {
+	// filter is a bloom filter of the ids of the pieces the satellite references
+	Filter []byte `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
+	// creation_date is the time before the satellite started to build the filter
+	CreationDate         *timestamp.Timestamp `protobuf:"bytes,2,opt,name=creation_date,json=creationDate" json:"creation_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *RetainRequest) Reset()         { *m = RetainRequest{} }
+func (m *RetainRequest) String() string { return proto.CompactTextString(m) }
+func (*RetainRequest) ProtoMessage()    {}
+func (*RetainRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{16}
+}
+func (m *RetainRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainRequest.Unmarshal(m, b)
+}
+func (m *RetainRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainRequest.Marshal(b, m, deterministic)
+}
+func (dst *RetainRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainRequest.Merge(dst, src)
+}
+func (m *RetainRequest) XXX_Size() int {
+	return xxx_messageInfo_RetainRequest.Size(m)
+}
+func (m *RetainRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainRequest proto.InternalMessageInfo
+
+func (m *RetainRequest) GetFilter() []byte {
+	if m != nil {
+		return m.Filter
+	}
+	return nil
+}
+
+func (m *RetainRequest) GetCreationDate() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreationDate
+	}
+	return nil
+}
+
+type RetainResponse struct {
+	Deleted              int64    `protobuf:"varint,1,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RetainResponse) Reset()         { *m = RetainResponse{} }
+func (m *RetainResponse) String() string { return proto.CompactTextString(m) }
+func (*RetainResponse) ProtoMessage()    {}
+func (*RetainResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_piecestore_d116fcf4ae912873, []int{17}
+}
+func (m *RetainResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RetainResponse.Unmarshal(m, b)
+}
+func (m *RetainResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RetainResponse.Marshal(b, m, deterministic)
+}
+func (dst *RetainResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RetainResponse.Merge(dst, src)
+}
+func (m *RetainResponse) XXX_Size() int {
+	return xxx_messageInfo_RetainResponse.Size(m)
+}
+func (m *RetainResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RetainResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RetainResponse proto.InternalMessageInfo
+
+func (m *RetainResponse) GetDeleted() int64 {
+	if m != nil {
+		return m.Deleted
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*PayerBandwidthAllocation)(nil), "piecestoreroutes.PayerBandwidthAllocation")
 	proto.RegisterType((*PayerBandwidthAllocation_Data)(nil), "piecestoreroutes.PayerBandwidthAllocation.Data")
@@ -1043,9 +1222,12 @@ func init() {
 	proto.RegisterType((*PieceStoreSummary)(nil), "piecestoreroutes.PieceStoreSummary")
 	proto.RegisterType((*StatsReq)(nil), "piecestoreroutes.StatsReq")
 	proto.RegisterType((*StatSummary)(nil), "piecestoreroutes.StatSummary")
+	proto.RegisterType((*SatelliteStats)(nil), "piecestoreroutes.SatelliteStats")
 	proto.RegisterType((*SignedMessage)(nil), "piecestoreroutes.SignedMessage")
 	proto.RegisterType((*DashboardReq)(nil), "piecestoreroutes.DashboardReq")
 	proto.RegisterType((*DashboardStats)(nil), "piecestoreroutes.DashboardStats")
+	proto.RegisterType((*RetainRequest)(nil), "piecestoreroutes.RetainRequest")
+	proto.RegisterType((*RetainResponse)(nil), "piecestoreroutes.RetainResponse")
 	proto.RegisterEnum("piecestoreroutes.PayerBandwidthAllocation_Action", PayerBandwidthAllocation_Action_name, PayerBandwidthAllocation_Action_value)
 }
 
@@ -1067,6 +1249,7 @@ type PieceStoreRoutesClient interface {
 	Delete(ctx context.Context, in *PieceDelete, opts ...grpc.CallOption) (*PieceDeleteSummary, error)
 	Stats(ctx context.Context, in *StatsReq, opts ...grpc.CallOption) (*StatSummary, error)
 	Dashboard(ctx context.Context, in *DashboardReq, opts ...grpc.CallOption) (PieceStoreRoutes_DashboardClient, error)
+	Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error)
 }
 
 type pieceStoreRoutesClient struct {
@@ -1201,6 +1384,15 @@ func (x *pieceStoreRoutesDashboardClient) Recv() (*DashboardStats, error) {
 	return m, nil
 }
 
+func (c *pieceStoreRoutesClient) Retain(ctx context.Context, in *RetainRequest, opts ...grpc.CallOption) (*RetainResponse, error) {
+	out := new(RetainResponse)
+	err := c.cc.Invoke(ctx, "/piecestoreroutes.PieceStoreRoutes/Retain", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // PieceStoreRoutesServer is the server API for PieceStoreRoutes service.
 type PieceStoreRoutesServer interface {
 	Piece(context.Context, *PieceId) (*PieceSummary, error)
@@ -1209,6 +1401,7 @@ type PieceStoreRoutesServer interface {
 	Delete(context.Context, *PieceDelete) (*PieceDeleteSummary, error)
 	Stats(context.Context, *StatsReq) (*StatSummary, error)
 	Dashboard(*DashboardReq, PieceStoreRoutes_DashboardServer) error
+	Retain(context.Context, *RetainRequest) (*RetainResponse, error)
 }
 
 func RegisterPieceStoreRoutesServer(s *grpc.Server, srv PieceStoreRoutesServer) {
@@ -1342,6 +1535,24 @@ func (x *pieceStoreRoutesDashboardServer) Send(m *DashboardStats) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _PieceStoreRoutes_Retain_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RetainRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PieceStoreRoutesServer).Retain(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/piecestoreroutes.PieceStoreRoutes/Retain",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PieceStoreRoutesServer).Retain(ctx, req.(*RetainRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "piecestoreroutes.PieceStoreRoutes",
 	HandlerType: (*PieceStoreRoutesServer)(nil),
@@ -1358,6 +1569,10 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Stats",
 			Handler:    _PieceStoreRoutes_Stats_Handler,
 		},
+		{
+			MethodName: "Retain",
+			Handler:    _PieceStoreRoutes_Retain_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1380,79 +1595,91 @@ var _PieceStoreRoutes_serviceDesc = grpc.ServiceDesc{
 	Metadata: "piecestore.proto",
 }
 
-func init() { proto.RegisterFile("piecestore.proto", fileDescriptor_piecestore_664948018be76fe5) }
-
-var fileDescriptor_piecestore_664948018be76fe5 = []byte{
-	// 1130 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4b, 0x6f, 0xdb, 0xc6,
-	0x13, 0x17, 0x29, 0x5b, 0x8f, 0x91, 0x25, 0x2b, 0x9b, 0xe0, 0xff, 0x97, 0x85, 0xd8, 0x16, 0x98,
-	0x26, 0x55, 0x13, 0x40, 0x8e, 0x15, 0xa0, 0x77, 0xbb, 0x32, 0x02, 0xa1, 0xa8, 0xe3, 0xae, 0xec,
-	0x4b, 0x0e, 0x65, 0x56, 0xe4, 0x58, 0x26, 0x42, 0x91, 0x2c, 0xb9, 0x74, 0x6d, 0x7f, 0xa5, 0xa2,
-	0xdf, 0xa3, 0x9f, 0xa0, 0x87, 0x1e, 0x02, 0x14, 0xe8, 0xb9, 0xc7, 0x5e, 0x0a, 0x14, 0xc5, 0xee,
-	0xf2, 0x21, 0x5b, 0x0f, 0x17, 0x41, 0x73, 0xdb, 0x79, 0xec, 0xcc, 0xec, 0x6f, 0x7f, 0xb3, 0xb3,
-	0xd0, 0x0c, 0x1c, 0xb4, 0x30, 0xe2, 0x7e, 0x88, 0xbd, 0x20, 0xf4, 0xb9, 0x4f, 0x66, 0x34, 0xa1,
-	0x1f, 0x73, 0x8c, 0xda, 0xe0, 0xf9, 0x76, 0x62, 0x6d, 0xc3, 0xc4, 0x9f, 0xf8, 0xc9, 0x7a, 0x67,
-	0xe2, 0xfb, 0x13, 0x17, 0xf7, 0xa4, 0x34, 0x8e, 0xcf, 0xf7, 0xec, 0x38, 0x64, 0xdc, 0xf1, 0x3d,
-	0x65, 0x37, 0xfe, 0x2e, 0x42, 0xeb, 0x84, 0x5d, 0x63, 0x78, 0xc8, 0x3c, 0xfb, 0x07, 0xc7, 0xe6,
-	0x17, 0x07, 0xae, 0xeb, 0x5b, 0xd2, 0x85, 0x3c, 0x86, 0x6a, 0xe4, 0x4c, 0x3c, 0xc6, 0xe3, 0x10,
-	0x5b, 0x5a, 0x47, 0xeb, 0x6e, 0xd0, 0x5c, 0x41, 0x08, 0xac, 0xd9, 0x8c, 0xb3, 0x96, 0x2e, 0x0d,
-	0x72, 0xdd, 0xfe, 0x5d, 0x87, 0xb5, 0x01, 0xe3, 0x8c, 0xec, 0xc3, 0x46, 0xc4, 0x38, 0xba, 0xae,
-	0xc3, 0xd1, 0x74, 0x6c, 0xb5, 0xfb, 0xb0, 0xf1, 0xf3, 0x87, 0xdd, 0xc2, 0xaf, 0x1f, 0x76, 0x4b,
-	0xc7, 0xbe, 0x8d, 0xc3, 0x01, 0xad, 0x65, 0x3e, 0x43, 0x9b, 0xbc, 0x80, 0x6a, 0x1c, 0xb8, 0x8e,
-	0xf7, 0x5e, 0xf8, 0xeb, 0x0b, 0xfd, 0x2b, 0xca, 0x61, 0x68, 0x93, 0x2d, 0xa8, 0x4c, 0xd9, 0x95,
-	0x19, 0x39, 0x37, 0xd8, 0x2a, 0x76, 0xb4, 0x6e, 0x91, 0x96, 0xa7, 0xec, 0x6a, 0xe4, 0xdc, 0x20,
-	0xe9, 0xc1, 0x43, 0xbc, 0x0a, 0x1c, 0x75, 0x4c, 0x33, 0xf6, 0x9c, 0x2b, 0x33, 0x42, 0xab, 0xb5,
-	0x26, 0xbd, 0x1e, 0xe4, 0xa6, 0x33, 0xcf, 0xb9, 0x1a, 0xa1, 0x45, 0x9e, 0x40, 0x3d, 0xc2, 0xd0,
-	0x61, 0xae, 0xe9, 0xc5, 0xd3, 0x31, 0x86, 0xad, 0xf5, 0x8e, 0xd6, 0xad, 0xd2, 0x0d, 0xa5, 0x3c,
-	0x96, 0x3a, 0x32, 0x84, 0x12, 0xb3, 0xc4, 0xae, 0x56, 0xa9, 0xa3, 0x75, 0x1b, 0xfd, 0xfd, 0xde,
-	0xdd, 0x2b, 0xe8, 0x2d, 0x83, 0xb1, 0x77, 0x20, 0x37, 0xd2, 0x24, 0x00, 0xe9, 0x42, 0xd3, 0x0a,
-	0x91, 0x71, 0xb4, 0xf3, 0xe2, 0xca, 0xb2, 0xb8, 0x46, 0xa2, 0x4f, 0x2b, 0xfb, 0x3f, 0x94, 0x83,
-	0x78, 0x6c, 0xbe, 0xc7, 0xeb, 0x56, 0x45, 0x82, 0x5c, 0x0a, 0xe2, 0xf1, 0xd7, 0x78, 0x6d, 0x0c,
-	0xa1, 0xa4, 0x82, 0x92, 0x32, 0x14, 0x4f, 0xce, 0x4e, 0x9b, 0x05, 0xb1, 0x78, 0x7d, 0x74, 0xda,
-	0xd4, 0x48, 0x1d, 0xaa, 0xaf, 0x8f, 0x4e, 0xcd, 0x83, 0xb3, 0xc1, 0xf0, 0xb4, 0xa9, 0x93, 0x06,
-	0x80, 0x10, 0xe9, 0xd1, 0xc9, 0xc1, 0x90, 0x36, 0x8b, 0x42, 0x3e, 0x39, 0xcb, 0xe4, 0x35, 0xe3,
-	0x2f, 0x0d, 0xb6, 0x28, 0x7a, 0xfc, 0xbf, 0x62, 0xc0, 0x8f, 0x5a, 0xc2, 0x80, 0x33, 0x68, 0x06,
-	0x02, 0x11, 0x93, 0x65, 0xe1, 0x64, 0x84, 0x5a, 0xff, 0xf9, 0xbf, 0xc7, 0x8e, 0x6e, 0xca, 0x18,
-	0x33, 0x15, 0x3d, 0x82, 0x75, 0xee, 0x73, 0xe6, 0xca, 0xa4, 0x45, 0xaa, 0x04, 0xf2, 0x25, 0x6c,
-	0x8a, 0x70, 0x6c, 0x82, 0xa6, 0x68, 0x04, 0xc1, 0xa0, 0xe2, 0x42, 0x06, 0xd5, 0x13, 0x37, 0x29,
-	0xda, 0xc6, 0x6f, 0x3a, 0xc0, 0x89, 0x28, 0x66, 0x24, 0x8a, 0x21, 0xdf, 0xc1, 0xa3, 0x71, 0x5a,
-	0xc4, 0x7c, 0xdd, 0x2f, 0xe6, 0xeb, 0x5e, 0x8a, 0x1c, 0x7d, 0x38, 0x5e, 0x00, 0xe7, 0x11, 0x80,
-	0x0c, 0x61, 0x66, 0xb0, 0xd5, 0xfa, 0xcf, 0x16, 0xa0, 0x91, 0x55, 0xa4, 0x96, 0x02, 0x4f, 0x5a,
-	0x0d, 0xd2, 0x25, 0x39, 0x82, 0x3a, 0x8b, 0xf9, 0x85, 0x1f, 0x3a, 0x37, 0xaa, 0xbe, 0xa2, 0x8c,
-	0xb4, 0x3b, 0x1f, 0x69, 0xe4, 0x4c, 0x3c, 0xb4, 0xbf, 0xc1, 0x28, 0x62, 0x13, 0xa4, 0xb7, 0x77,
-	0xb5, 0x11, 0xaa, 0x59, 0x78, 0xd2, 0x00, 0x3d, 0x69, 0xd3, 0x2a, 0xd5, 0x1d, 0x7b, 0x59, 0x17,
-	0xe9, 0xcb, 0xba, 0xa8, 0x05, 0x65, 0xcb, 0xf7, 0x38, 0x7a, 0x5c, 0x21, 0x4f, 0x53, 0xd1, 0x78,
-	0x07, 0x65, 0x99, 0x66, 0x68, 0xcf, 0x25, 0x99, 0x3b, 0x88, 0xfe, 0x31, 0x07, 0x31, 0xa6, 0xb0,
-	0xa1, 0x20, 0x8b, 0xa7, 0x53, 0x16, 0x5e, 0xcf, 0xa5, 0xd9, 0x4e, 0x61, 0x97, 0xcf, 0x85, 0x3a,
-	0x82, 0x82, 0x73, 0xd5, 0x83, 0x51, 0x5c, 0x72, 0x54, 0xe3, 0x17, 0x1d, 0x1a, 0x32, 0x1f, 0x45,
-	0x1e, 0x3a, 0x78, 0xc9, 0xdc, 0x4f, 0x4e, 0x9c, 0xe1, 0x02, 0xe2, 0x3c, 0x5f, 0x42, 0x9c, 0xac,
-	0xaa, 0x4f, 0x4a, 0x1e, 0xba, 0x8a, 0x3c, 0xf7, 0x00, 0xfe, 0x3f, 0x28, 0xf9, 0xe7, 0xe7, 0x11,
-	0xf2, 0x04, 0xe3, 0x44, 0x32, 0xde, 0xc0, 0xa3, 0xdb, 0x27, 0x18, 0xf1, 0x10, 0xd9, 0xf4, 0x4e,
-	0x38, 0xed, 0x6e, 0xb8, 0x19, 0xea, 0xe9, 0xb7, 0xa9, 0x67, 0x43, 0x4d, 0x15, 0x89, 0x2e, 0x72,
-	0xbc, 0x9f, 0x7e, 0x1f, 0x05, 0x85, 0xd1, 0x03, 0x32, 0x93, 0x25, 0x25, 0x61, 0x0b, 0xca, 0x53,
-	0xe5, 0x9f, 0x64, 0x4c, 0x45, 0xe3, 0x14, 0x1e, 0xe4, 0x1d, 0x7e, 0xaf, 0x3b, 0x79, 0x0a, 0x0d,
-	0xf9, 0xc8, 0x99, 0x21, 0x5a, 0xe8, 0x5c, 0xa2, 0x9d, 0x00, 0x5a, 0x97, 0x5a, 0x9a, 0x28, 0x0d,
-	0x80, 0xca, 0x88, 0x33, 0x1e, 0x51, 0xfc, 0xde, 0xf8, 0x49, 0x83, 0x9a, 0x10, 0xd2, 0xe0, 0xdb,
-	0x00, 0x71, 0x84, 0xb6, 0x19, 0x05, 0xcc, 0xca, 0x00, 0x14, 0x9a, 0x91, 0x50, 0x90, 0xcf, 0x61,
-	0x93, 0x5d, 0x32, 0xc7, 0x65, 0x63, 0x17, 0x13, 0x1f, 0x95, 0xa2, 0x91, 0xa9, 0x95, 0xe3, 0x53,
-	0x68, 0xc8, 0x38, 0x19, 0x45, 0x93, 0x0b, 0xac, 0x0b, 0x6d, 0x46, 0x66, 0xb2, 0x07, 0x0f, 0xf3,
-	0x78, 0xb9, 0xaf, 0x9a, 0xc0, 0x24, 0x33, 0x65, 0x1b, 0x8c, 0x77, 0x50, 0xbf, 0x85, 0x70, 0x36,
-	0x59, 0xb4, 0x7c, 0xb2, 0xdc, 0x9e, 0x45, 0xfa, 0xdd, 0x59, 0x24, 0x38, 0x12, 0x8f, 0x5d, 0xc7,
-	0x92, 0xe3, 0x52, 0x3d, 0x41, 0x55, 0xa5, 0x11, 0x13, 0xb3, 0x01, 0x1b, 0x03, 0x16, 0x5d, 0x8c,
-	0x7d, 0x16, 0xda, 0x02, 0xa1, 0x3f, 0x35, 0x68, 0x64, 0x0a, 0x89, 0x9b, 0x98, 0xb6, 0xe9, 0xec,
-	0x50, 0x37, 0x50, 0xf2, 0xe4, 0x90, 0x20, 0x5f, 0x40, 0x53, 0x1a, 0x2c, 0xdf, 0xf3, 0x50, 0x8e,
-	0xdd, 0x28, 0xc1, 0x67, 0x53, 0xe8, 0xbf, 0xca, 0xd5, 0xe2, 0x16, 0x99, 0x6d, 0x87, 0x18, 0x45,
-	0xb2, 0x84, 0x2a, 0x4d, 0x45, 0xf2, 0x0a, 0xd6, 0x23, 0x91, 0x46, 0xa2, 0x50, 0xeb, 0x6f, 0x2f,
-	0xe0, 0x58, 0x7e, 0x61, 0x54, 0xf9, 0x92, 0x1d, 0x80, 0x3c, 0xa9, 0xfc, 0x97, 0x54, 0xe8, 0x8c,
-	0x86, 0xec, 0x43, 0x29, 0x0e, 0xb8, 0x33, 0x45, 0xf9, 0x2b, 0xa9, 0xf5, 0xb7, 0x7a, 0xea, 0xbb,
-	0xd7, 0x4b, 0xbf, 0x7b, 0xbd, 0x41, 0xf2, 0xdd, 0xa3, 0x89, 0x63, 0xff, 0x8f, 0x22, 0x34, 0x73,
-	0xf6, 0x51, 0x99, 0x9a, 0x0c, 0x60, 0x5d, 0xea, 0xc8, 0xd6, 0x92, 0x37, 0x65, 0x68, 0xb7, 0x77,
-	0x96, 0xcd, 0x29, 0x55, 0xb2, 0x51, 0x20, 0x6f, 0xa1, 0x92, 0x74, 0x2e, 0x92, 0xce, 0x7d, 0x8f,
-	0x53, 0xfb, 0xd9, 0x7d, 0x1e, 0xaa, 0xf9, 0x8d, 0x42, 0x57, 0x7b, 0xa9, 0x91, 0x63, 0x58, 0x57,
-	0x23, 0xfa, 0xf1, 0xaa, 0x71, 0xd9, 0x7e, 0xb2, 0xca, 0x9a, 0x55, 0xda, 0xd5, 0xc8, 0x1b, 0x28,
-	0x25, 0x8f, 0xc2, 0xf6, 0x92, 0x2d, 0xca, 0xdc, 0xfe, 0x6c, 0xa5, 0x39, 0x3f, 0xfc, 0x40, 0x14,
-	0x28, 0xee, 0xac, 0xbd, 0xf8, 0x66, 0x45, 0x5f, 0xb6, 0x57, 0xdf, 0xba, 0x51, 0x20, 0xdf, 0x42,
-	0x35, 0x63, 0x25, 0x59, 0x80, 0xf8, 0x2c, 0x87, 0xdb, 0x9d, 0x15, 0x76, 0x99, 0xd2, 0x28, 0xbc,
-	0xd4, 0x0e, 0xd7, 0xde, 0xea, 0xc1, 0x78, 0x5c, 0x92, 0x8c, 0x78, 0xf5, 0x4f, 0x00, 0x00, 0x00,
-	0xff, 0xff, 0x14, 0xe3, 0x9c, 0x65, 0x4c, 0x0c, 0x00, 0x00,
+func init() { proto.RegisterFile("piecestore.proto", fileDescriptor_piecestore_d116fcf4ae912873) }
+
+var fileDescriptor_piecestore_d116fcf4ae912873 = []byte{
+	// 1319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x10, 0x36, 0x25, 0x59, 0xb2, 0x46, 0x3f, 0x56, 0x36, 0x41, 0x22, 0x0b, 0x71, 0x2c, 0x30, 0x4d,
+	0xaa, 0x26, 0x80, 0x92, 0x28, 0x40, 0xaf, 0xad, 0x53, 0x19, 0x81, 0x10, 0x24, 0x71, 0x57, 0xf6,
+	0x25, 0x87, 0x2a, 0x2b, 0x72, 0x2c, 0xb3, 0xa1, 0x48, 0x86, 0x5c, 0xa6, 0x76, 0x5e, 0xa5, 0x8f,
+	0xd0, 0x5b, 0x81, 0x1e, 0x7b, 0xef, 0x13, 0xf4, 0xd0, 0x43, 0x5e, 0xa1, 0xa7, 0xf6, 0xd2, 0x4b,
+	0xb1, 0x3f, 0x24, 0x25, 0xeb, 0xc7, 0x45, 0xd0, 0xdc, 0x38, 0x3f, 0x3b, 0x33, 0xfb, 0xf1, 0x9b,
+	0xd9, 0x81, 0x46, 0xe0, 0xa0, 0x85, 0x11, 0xf7, 0x43, 0xec, 0x06, 0xa1, 0xcf, 0x7d, 0x32, 0xa3,
+	0x09, 0xfd, 0x98, 0x63, 0xd4, 0x02, 0xcf, 0xb7, 0xb5, 0xb5, 0x05, 0x13, 0x7f, 0xe2, 0xeb, 0xef,
+	0x5b, 0x13, 0xdf, 0x9f, 0xb8, 0xf8, 0x40, 0x4a, 0xe3, 0xf8, 0xe4, 0x81, 0x1d, 0x87, 0x8c, 0x3b,
+	0xbe, 0xa7, 0xed, 0x7b, 0x17, 0xed, 0xdc, 0x99, 0x62, 0xc4, 0xd9, 0x34, 0x50, 0x0e, 0xe6, 0x2f,
+	0x05, 0x68, 0x1e, 0xb2, 0x73, 0x0c, 0x9f, 0x30, 0xcf, 0xfe, 0xc1, 0xb1, 0xf9, 0xe9, 0xbe, 0xeb,
+	0xfa, 0x96, 0x8c, 0x41, 0x6e, 0x42, 0x39, 0x72, 0x26, 0x1e, 0xe3, 0x71, 0x88, 0x4d, 0xa3, 0x6d,
+	0x74, 0xaa, 0x34, 0x53, 0x10, 0x02, 0x05, 0x9b, 0x71, 0xd6, 0xcc, 0x49, 0x83, 0xfc, 0x6e, 0xfd,
+	0x98, 0x87, 0x42, 0x9f, 0x71, 0x46, 0x1e, 0x41, 0x35, 0x62, 0x1c, 0x5d, 0xd7, 0xe1, 0x38, 0x72,
+	0x6c, 0x75, 0xfa, 0x49, 0xfd, 0xb7, 0x0f, 0x7b, 0x1b, 0x7f, 0x7c, 0xd8, 0x2b, 0xbe, 0xf0, 0x6d,
+	0x1c, 0xf4, 0x69, 0x25, 0xf5, 0x19, 0xd8, 0xe4, 0x3e, 0x94, 0xe3, 0xc0, 0x75, 0xbc, 0x37, 0xc2,
+	0x3f, 0xb7, 0xd4, 0x7f, 0x4b, 0x39, 0x0c, 0x6c, 0xb2, 0x03, 0x5b, 0x53, 0x76, 0x36, 0x8a, 0x9c,
+	0xf7, 0xd8, 0xcc, 0xb7, 0x8d, 0x4e, 0x9e, 0x96, 0xa6, 0xec, 0x6c, 0xe8, 0xbc, 0x47, 0xd2, 0x85,
+	0xab, 0x78, 0x16, 0x38, 0x0a, 0x87, 0x51, 0xec, 0x39, 0x67, 0xa3, 0x08, 0xad, 0x66, 0x41, 0x7a,
+	0x5d, 0xc9, 0x4c, 0xc7, 0x9e, 0x73, 0x36, 0x44, 0x8b, 0xdc, 0x86, 0x5a, 0x84, 0xa1, 0xc3, 0xdc,
+	0x91, 0x17, 0x4f, 0xc7, 0x18, 0x36, 0x37, 0xdb, 0x46, 0xa7, 0x4c, 0xab, 0x4a, 0xf9, 0x42, 0xea,
+	0xc8, 0x00, 0x8a, 0xcc, 0x12, 0xa7, 0x9a, 0xc5, 0xb6, 0xd1, 0xa9, 0xf7, 0x1e, 0x75, 0x2f, 0xfe,
+	0xa3, 0xee, 0x2a, 0x18, 0xbb, 0xfb, 0xf2, 0x20, 0xd5, 0x01, 0x48, 0x07, 0x1a, 0x56, 0x88, 0x8c,
+	0xa3, 0x9d, 0x15, 0x57, 0x92, 0xc5, 0xd5, 0xb5, 0x3e, 0xa9, 0xec, 0x06, 0x94, 0x82, 0x78, 0x3c,
+	0x7a, 0x83, 0xe7, 0xcd, 0x2d, 0x09, 0x72, 0x31, 0x88, 0xc7, 0xcf, 0xf0, 0x9c, 0xec, 0x02, 0x04,
+	0xa1, 0xff, 0x3d, 0x5a, 0x5c, 0x60, 0x55, 0x96, 0xf5, 0x96, 0xb5, 0x66, 0x60, 0x93, 0xeb, 0x50,
+	0x1c, 0xc7, 0xd6, 0x1b, 0xe4, 0x4d, 0x90, 0x26, 0x2d, 0x99, 0x03, 0x28, 0xaa, 0x5a, 0x48, 0x09,
+	0xf2, 0x87, 0xc7, 0x47, 0x8d, 0x0d, 0xf1, 0xf1, 0xf4, 0xe0, 0xa8, 0x61, 0x90, 0x1a, 0x94, 0x9f,
+	0x1e, 0x1c, 0x8d, 0xf6, 0x8f, 0xfb, 0x83, 0xa3, 0x46, 0x8e, 0xd4, 0x01, 0x84, 0x48, 0x0f, 0x0e,
+	0xf7, 0x07, 0xb4, 0x91, 0x17, 0xf2, 0xe1, 0x71, 0x2a, 0x17, 0xcc, 0x7f, 0x0c, 0xd8, 0xa1, 0xe8,
+	0xf1, 0xff, 0x8b, 0x38, 0x3f, 0x19, 0x9a, 0x38, 0xc7, 0xd0, 0x08, 0x04, 0x90, 0x23, 0x96, 0x86,
+	0x93, 0x11, 0x2a, 0xbd, 0x7b, 0xff, 0x1d, 0x72, 0xba, 0x2d, 0x63, 0xcc, 0x54, 0x74, 0x0d, 0x36,
+	0xb9, 0xcf, 0x99, 0x2b, 0x93, 0xe6, 0xa9, 0x12, 0xc8, 0x97, 0xb0, 0x2d, 0xc2, 0xb1, 0x09, 0x8e,
+	0x44, 0x83, 0x09, 0x30, 0xf3, 0x4b, 0x89, 0x57, 0xd3, 0x6e, 0x52, 0xb4, 0xcd, 0xbf, 0x72, 0x00,
+	0x87, 0xa2, 0x98, 0xa1, 0x28, 0x86, 0x7c, 0x07, 0xd7, 0xc6, 0x49, 0x11, 0x8b, 0x75, 0xdf, 0x5f,
+	0xac, 0x7b, 0x25, 0x72, 0xf4, 0xea, 0x78, 0x51, 0x49, 0x0e, 0x00, 0x64, 0x88, 0x51, 0x0a, 0x5b,
+	0xa5, 0x77, 0x77, 0x09, 0x1a, 0x69, 0x45, 0xea, 0x53, 0xe0, 0x49, 0xcb, 0x41, 0xf2, 0x49, 0x0e,
+	0xa0, 0xc6, 0x62, 0x7e, 0xea, 0x87, 0xce, 0x7b, 0x55, 0x5f, 0x5e, 0x46, 0xda, 0x5b, 0x8c, 0x34,
+	0x74, 0x26, 0x1e, 0xda, 0xcf, 0x31, 0x8a, 0xd8, 0x04, 0xe9, 0xfc, 0xa9, 0xd6, 0x39, 0x94, 0xd3,
+	0xf0, 0xa4, 0x0e, 0x39, 0xdd, 0xdd, 0x65, 0x9a, 0x73, 0xec, 0x55, 0xcd, 0x97, 0x5b, 0xd5, 0x7c,
+	0x4d, 0x28, 0x59, 0xbe, 0xc7, 0xd1, 0xe3, 0x0a, 0x79, 0x9a, 0x88, 0x82, 0x25, 0xa7, 0x2c, 0x3a,
+	0x95, 0x7d, 0x5b, 0xa5, 0xf2, 0xdb, 0x7c, 0x0d, 0x25, 0x99, 0x7a, 0x60, 0x2f, 0x24, 0x5e, 0xb8,
+	0x5c, 0xee, 0x63, 0x2e, 0x67, 0x4e, 0xa1, 0xaa, 0x60, 0x8c, 0xa7, 0x53, 0x16, 0x9e, 0x2f, 0xa4,
+	0xd9, 0x4d, 0x7e, 0x85, 0x9c, 0x3c, 0xea, 0x5a, 0x0a, 0xe2, 0x75, 0xb3, 0x27, 0xbf, 0xe2, 0xfa,
+	0xe6, 0xef, 0x39, 0xa8, 0xcb, 0x7c, 0x14, 0x79, 0xe8, 0xe0, 0x3b, 0xe6, 0x7e, 0x72, 0x32, 0x0d,
+	0x96, 0x90, 0xe9, 0xde, 0x0a, 0x32, 0xa5, 0x55, 0x7d, 0x52, 0x42, 0xd1, 0x75, 0x84, 0xba, 0x04,
+	0xf0, 0xeb, 0x50, 0xf4, 0x4f, 0x4e, 0x22, 0xe4, 0x1a, 0x63, 0x2d, 0x99, 0x2f, 0xe1, 0xda, 0xfc,
+	0x0d, 0x86, 0x3c, 0x44, 0x36, 0xbd, 0x10, 0xce, 0xb8, 0x18, 0x6e, 0x86, 0x8e, 0xb9, 0x39, 0x3a,
+	0x9a, 0x36, 0x54, 0x54, 0x91, 0xe8, 0x22, 0xc7, 0xcb, 0xe9, 0xf7, 0x51, 0x50, 0x98, 0x5d, 0x20,
+	0x33, 0x59, 0x12, 0x12, 0x36, 0xa1, 0x34, 0x55, 0xfe, 0x3a, 0x63, 0x22, 0x9a, 0x47, 0x70, 0x25,
+	0xeb, 0xfa, 0x4b, 0xdd, 0xc9, 0x1d, 0xa8, 0xcb, 0xc1, 0x37, 0x0a, 0xd1, 0x42, 0xe7, 0x1d, 0xda,
+	0x1a, 0xd0, 0x9a, 0xd4, 0x52, 0xad, 0x34, 0x01, 0xb6, 0x86, 0x9c, 0xf1, 0x88, 0xe2, 0x5b, 0xf3,
+	0x4f, 0x03, 0x2a, 0x42, 0x48, 0x82, 0xef, 0x02, 0xc4, 0x11, 0xda, 0xa3, 0x28, 0x60, 0x56, 0x0a,
+	0xa0, 0xd0, 0x0c, 0x85, 0x82, 0x7c, 0x0e, 0xdb, 0xec, 0x1d, 0x73, 0x5c, 0x36, 0x76, 0x51, 0xfb,
+	0xa8, 0x14, 0xf5, 0x54, 0xad, 0x1c, 0xef, 0x40, 0x5d, 0xc6, 0x49, 0x29, 0xaa, 0x7f, 0x60, 0x4d,
+	0x68, 0x53, 0x32, 0x93, 0x07, 0x70, 0x35, 0x8b, 0x97, 0xf9, 0xaa, 0xc7, 0x9c, 0xa4, 0xa6, 0xec,
+	0xc0, 0xd7, 0x00, 0xe9, 0x52, 0x11, 0x35, 0x37, 0xdb, 0xf9, 0x4e, 0xa5, 0xd7, 0x5e, 0xf2, 0x17,
+	0x12, 0x1f, 0x75, 0xd1, 0x99, 0x33, 0xe6, 0xcf, 0x06, 0xd4, 0xe7, 0xcd, 0x1f, 0xb3, 0xcd, 0xcc,
+	0xe3, 0x94, 0x5b, 0x86, 0x93, 0xea, 0xc9, 0xd4, 0x27, 0xaf, 0x71, 0x4a, 0xd4, 0xab, 0x70, 0x2a,
+	0x2c, 0xc1, 0xc9, 0x7c, 0x0d, 0xb5, 0x39, 0x62, 0xa5, 0x8f, 0xac, 0x91, 0x3d, 0xb2, 0xf3, 0xcf,
+	0x72, 0xee, 0xe2, 0xb3, 0x2c, 0x5a, 0x23, 0x1e, 0xbb, 0x8e, 0x25, 0x17, 0x0e, 0x35, 0x8d, 0xcb,
+	0x4a, 0xf3, 0x0c, 0xcf, 0xcd, 0x3a, 0x54, 0xfb, 0x2c, 0x3a, 0x1d, 0xfb, 0x2c, 0xb4, 0x05, 0x31,
+	0xfe, 0x36, 0xa0, 0x9e, 0x2a, 0x14, 0x4c, 0x37, 0xa0, 0x94, 0x3c, 0xa3, 0x8a, 0x78, 0x45, 0x4f,
+	0xbe, 0x97, 0xe4, 0x0b, 0x68, 0x48, 0x83, 0xe5, 0x7b, 0x1e, 0xca, 0x0d, 0x24, 0xd2, 0x90, 0x6c,
+	0x0b, 0xfd, 0x37, 0x99, 0x5a, 0x90, 0x97, 0xd9, 0x76, 0x88, 0x51, 0x24, 0x4b, 0x28, 0xd3, 0x44,
+	0x24, 0x8f, 0x61, 0x33, 0x12, 0x69, 0x24, 0x00, 0x95, 0xde, 0xee, 0x92, 0x9f, 0x9a, 0xf1, 0x94,
+	0x2a, 0x5f, 0x72, 0x0b, 0x20, 0x4b, 0x2a, 0x37, 0xbb, 0x2d, 0x3a, 0xa3, 0x21, 0x8f, 0xa0, 0x18,
+	0x07, 0x62, 0x29, 0x96, 0x7b, 0x5d, 0xa5, 0xb7, 0xd3, 0x55, 0x1b, 0x73, 0x37, 0xd9, 0x98, 0xbb,
+	0x7d, 0xbd, 0x51, 0x53, 0xed, 0x68, 0x9e, 0x42, 0x8d, 0x22, 0x67, 0x8e, 0x47, 0xf1, 0x6d, 0x8c,
+	0x11, 0x17, 0x33, 0xe8, 0xc4, 0x71, 0x39, 0x86, 0x1a, 0x6c, 0x2d, 0x91, 0xaf, 0xa0, 0x26, 0x17,
+	0x3a, 0xf1, 0x14, 0xd8, 0x8c, 0xa3, 0x1e, 0xb6, 0xad, 0x85, 0x14, 0x47, 0xc9, 0x52, 0x4e, 0xab,
+	0xc9, 0x81, 0x3e, 0xe3, 0x68, 0xde, 0x83, 0x7a, 0x92, 0x29, 0x0a, 0x7c, 0x2f, 0x92, 0xf3, 0xc9,
+	0x96, 0xa3, 0xc1, 0xd6, 0xad, 0x97, 0x88, 0xbd, 0x5f, 0x0b, 0xd0, 0xc8, 0x46, 0x01, 0x95, 0x80,
+	0x90, 0x3e, 0x6c, 0x4a, 0x1d, 0xd9, 0x59, 0x31, 0xe0, 0x07, 0x76, 0xeb, 0xd6, 0x0a, 0x93, 0x06,
+	0xd2, 0xdc, 0x20, 0xaf, 0x60, 0x4b, 0x8f, 0x51, 0x24, 0xed, 0xcb, 0x5e, 0x8a, 0xd6, 0xdd, 0xcb,
+	0x3c, 0xd4, 0x24, 0x36, 0x37, 0x3a, 0xc6, 0x43, 0x83, 0xbc, 0x80, 0x4d, 0xb5, 0x43, 0xdd, 0x5c,
+	0xb7, 0xcf, 0xb4, 0x6e, 0xaf, 0xb3, 0xa6, 0x95, 0x76, 0x0c, 0xf2, 0x12, 0x8a, 0x7a, 0x42, 0xef,
+	0xae, 0x38, 0xa2, 0xcc, 0xad, 0xcf, 0xd6, 0x9a, 0xb3, 0xcb, 0xf7, 0x45, 0x81, 0x82, 0x49, 0xad,
+	0xe5, 0x7c, 0x13, 0x43, 0xb2, 0xb5, 0x9e, 0x8b, 0xe6, 0x06, 0xf9, 0x16, 0xca, 0x69, 0xaf, 0x90,
+	0x25, 0x88, 0xcf, 0x76, 0x56, 0xab, 0xbd, 0xc6, 0x2e, 0x53, 0x9a, 0x1b, 0x0f, 0x0d, 0xf2, 0x1c,
+	0x8a, 0x8a, 0x1c, 0x64, 0x6f, 0xd9, 0x4e, 0x30, 0x43, 0xd0, 0x56, 0x7b, 0xb5, 0x83, 0xe2, 0x95,
+	0xb9, 0xf1, 0xa4, 0xf0, 0x2a, 0x17, 0x8c, 0xc7, 0x45, 0xc9, 0xc9, 0xc7, 0xff, 0x0e, 0x00, 0x83,
+	0xdc, 0x8e, 0xab, 0x94, 0x0e, 0x00, 0x00,
 }
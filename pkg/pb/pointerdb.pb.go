@@ -42,7 +42,7 @@ func (x RedundancyScheme_SchemeType) String() string {
 	return proto.EnumName(RedundancyScheme_SchemeType_name, int32(x))
 }
 func (RedundancyScheme_SchemeType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{0, 0}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{0, 0}
 }
 
 type Pointer_DataType int32
@@ -65,7 +65,7 @@ func (x Pointer_DataType) String() string {
 	return proto.EnumName(Pointer_DataType_name, int32(x))
 }
 func (Pointer_DataType) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{3, 0}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{3, 0}
 }
 
 type RedundancyScheme struct {
@@ -85,7 +85,7 @@ func (m *RedundancyScheme) Reset()         { *m = RedundancyScheme{} }
 func (m *RedundancyScheme) String() string { return proto.CompactTextString(m) }
 func (*RedundancyScheme) ProtoMessage()    {}
 func (*RedundancyScheme) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{0}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{0}
 }
 func (m *RedundancyScheme) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RedundancyScheme.Unmarshal(m, b)
@@ -150,6 +150,7 @@ func (m *RedundancyScheme) GetErasureShareSize() int32 {
 type RemotePiece struct {
 	PieceNum             int32    `protobuf:"varint,1,opt,name=piece_num,json=pieceNum,proto3" json:"piece_num,omitempty"`
 	NodeId               NodeID   `protobuf:"bytes,2,opt,name=node_id,json=nodeId,proto3,customtype=NodeID" json:"node_id"`
+	Hash                 []byte   `protobuf:"bytes,3,opt,name=hash,proto3" json:"hash,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -159,7 +160,7 @@ func (m *RemotePiece) Reset()         { *m = RemotePiece{} }
 func (m *RemotePiece) String() string { return proto.CompactTextString(m) }
 func (*RemotePiece) ProtoMessage()    {}
 func (*RemotePiece) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{1}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{1}
 }
 func (m *RemotePiece) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemotePiece.Unmarshal(m, b)
@@ -186,6 +187,13 @@ func (m *RemotePiece) GetPieceNum() int32 {
 	return 0
 }
 
+func (m *RemotePiece) GetHash() []byte {
+	if m != nil {
+		return m.Hash
+	}
+	return nil
+}
+
 type RemoteSegment struct {
 	Redundancy *RedundancyScheme `protobuf:"bytes,1,opt,name=redundancy" json:"redundancy,omitempty"`
 	// TODO: may want to use customtype and fixed-length byte slice
@@ -201,7 +209,7 @@ func (m *RemoteSegment) Reset()         { *m = RemoteSegment{} }
 func (m *RemoteSegment) String() string { return proto.CompactTextString(m) }
 func (*RemoteSegment) ProtoMessage()    {}
 func (*RemoteSegment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{2}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{2}
 }
 func (m *RemoteSegment) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_RemoteSegment.Unmarshal(m, b)
@@ -266,7 +274,7 @@ func (m *Pointer) Reset()         { *m = Pointer{} }
 func (m *Pointer) String() string { return proto.CompactTextString(m) }
 func (*Pointer) ProtoMessage()    {}
 func (*Pointer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{3}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{3}
 }
 func (m *Pointer) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Pointer.Unmarshal(m, b)
@@ -348,7 +356,7 @@ func (m *PutRequest) Reset()         { *m = PutRequest{} }
 func (m *PutRequest) String() string { return proto.CompactTextString(m) }
 func (*PutRequest) ProtoMessage()    {}
 func (*PutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{4}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{4}
 }
 func (m *PutRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutRequest.Unmarshal(m, b)
@@ -394,7 +402,7 @@ func (m *GetRequest) Reset()         { *m = GetRequest{} }
 func (m *GetRequest) String() string { return proto.CompactTextString(m) }
 func (*GetRequest) ProtoMessage()    {}
 func (*GetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{5}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{5}
 }
 func (m *GetRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetRequest.Unmarshal(m, b)
@@ -438,7 +446,7 @@ func (m *ListRequest) Reset()         { *m = ListRequest{} }
 func (m *ListRequest) String() string { return proto.CompactTextString(m) }
 func (*ListRequest) ProtoMessage()    {}
 func (*ListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{6}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{6}
 }
 func (m *ListRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListRequest.Unmarshal(m, b)
@@ -511,7 +519,7 @@ func (m *PutResponse) Reset()         { *m = PutResponse{} }
 func (m *PutResponse) String() string { return proto.CompactTextString(m) }
 func (*PutResponse) ProtoMessage()    {}
 func (*PutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{7}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{7}
 }
 func (m *PutResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PutResponse.Unmarshal(m, b)
@@ -546,7 +554,7 @@ func (m *GetResponse) Reset()         { *m = GetResponse{} }
 func (m *GetResponse) String() string { return proto.CompactTextString(m) }
 func (*GetResponse) ProtoMessage()    {}
 func (*GetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{8}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{8}
 }
 func (m *GetResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetResponse.Unmarshal(m, b)
@@ -607,7 +615,7 @@ func (m *ListResponse) Reset()         { *m = ListResponse{} }
 func (m *ListResponse) String() string { return proto.CompactTextString(m) }
 func (*ListResponse) ProtoMessage()    {}
 func (*ListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{9}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{9}
 }
 func (m *ListResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse.Unmarshal(m, b)
@@ -654,7 +662,7 @@ func (m *ListResponse_Item) Reset()         { *m = ListResponse_Item{} }
 func (m *ListResponse_Item) String() string { return proto.CompactTextString(m) }
 func (*ListResponse_Item) ProtoMessage()    {}
 func (*ListResponse_Item) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{9, 0}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{9, 0}
 }
 func (m *ListResponse_Item) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ListResponse_Item.Unmarshal(m, b)
@@ -706,7 +714,7 @@ func (m *DeleteRequest) Reset()         { *m = DeleteRequest{} }
 func (m *DeleteRequest) String() string { return proto.CompactTextString(m) }
 func (*DeleteRequest) ProtoMessage()    {}
 func (*DeleteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{10}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{10}
 }
 func (m *DeleteRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteRequest.Unmarshal(m, b)
@@ -735,6 +743,8 @@ func (m *DeleteRequest) GetPath() string {
 
 // DeleteResponse is a response message for the Delete rpc call
 type DeleteResponse struct {
+	// pieces_referenced is true when the pieces are still used by a copy
+	PiecesReferenced     bool     `protobuf:"varint,1,opt,name=pieces_referenced,json=piecesReferenced,proto3" json:"pieces_referenced,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -744,7 +754,7 @@ func (m *DeleteResponse) Reset()         { *m = DeleteResponse{} }
 func (m *DeleteResponse) String() string { return proto.CompactTextString(m) }
 func (*DeleteResponse) ProtoMessage()    {}
 func (*DeleteResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{11}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{11}
 }
 func (m *DeleteResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_DeleteResponse.Unmarshal(m, b)
@@ -764,6 +774,139 @@ func (m *DeleteResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_DeleteResponse proto.InternalMessageInfo
 
+func (m *DeleteResponse) GetPiecesReferenced() bool {
+	if m != nil {
+		return m.PiecesReferenced
+	}
+	return false
+}
+
+// PieceReferences lists the paths of the pointers sharing the pieces of a
+// copied segment
+type PieceReferences struct {
+	Paths                []string `protobuf:"bytes,1,rep,name=paths" json:"paths,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *PieceReferences) Reset()         { *m = PieceReferences{} }
+func (m *PieceReferences) String() string { return proto.CompactTextString(m) }
+func (*PieceReferences) ProtoMessage()    {}
+func (*PieceReferences) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{12}
+}
+func (m *PieceReferences) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PieceReferences.Unmarshal(m, b)
+}
+func (m *PieceReferences) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PieceReferences.Marshal(b, m, deterministic)
+}
+func (dst *PieceReferences) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PieceReferences.Merge(dst, src)
+}
+func (m *PieceReferences) XXX_Size() int {
+	return xxx_messageInfo_PieceReferences.Size(m)
+}
+func (m *PieceReferences) XXX_DiscardUnknown() {
+	xxx_messageInfo_PieceReferences.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PieceReferences proto.InternalMessageInfo
+
+func (m *PieceReferences) GetPaths() []string {
+	if m != nil {
+		return m.Paths
+	}
+	return nil
+}
+
+// CopyRequest is a request message for the Copy rpc call
+type CopyRequest struct {
+	SourcePath           string   `protobuf:"bytes,1,opt,name=source_path,json=sourcePath,proto3" json:"source_path,omitempty"`
+	Path                 string   `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	Metadata             []byte   `protobuf:"bytes,3,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CopyRequest) Reset()         { *m = CopyRequest{} }
+func (m *CopyRequest) String() string { return proto.CompactTextString(m) }
+func (*CopyRequest) ProtoMessage()    {}
+func (*CopyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{13}
+}
+func (m *CopyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyRequest.Unmarshal(m, b)
+}
+func (m *CopyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyRequest.Marshal(b, m, deterministic)
+}
+func (dst *CopyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyRequest.Merge(dst, src)
+}
+func (m *CopyRequest) XXX_Size() int {
+	return xxx_messageInfo_CopyRequest.Size(m)
+}
+func (m *CopyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyRequest proto.InternalMessageInfo
+
+func (m *CopyRequest) GetSourcePath() string {
+	if m != nil {
+		return m.SourcePath
+	}
+	return ""
+}
+
+func (m *CopyRequest) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *CopyRequest) GetMetadata() []byte {
+	if m != nil {
+		return m.Metadata
+	}
+	return nil
+}
+
+// CopyResponse is a response message for the Copy rpc call
+type CopyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CopyResponse) Reset()         { *m = CopyResponse{} }
+func (m *CopyResponse) String() string { return proto.CompactTextString(m) }
+func (*CopyResponse) ProtoMessage()    {}
+func (*CopyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{14}
+}
+func (m *CopyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CopyResponse.Unmarshal(m, b)
+}
+func (m *CopyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CopyResponse.Marshal(b, m, deterministic)
+}
+func (dst *CopyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CopyResponse.Merge(dst, src)
+}
+func (m *CopyResponse) XXX_Size() int {
+	return xxx_messageInfo_CopyResponse.Size(m)
+}
+func (m *CopyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CopyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CopyResponse proto.InternalMessageInfo
+
 // IterateRequest is a request message for the Iterate rpc call
 type IterateRequest struct {
 	Prefix               string   `protobuf:"bytes,1,opt,name=prefix,proto3" json:"prefix,omitempty"`
@@ -779,7 +922,7 @@ func (m *IterateRequest) Reset()         { *m = IterateRequest{} }
 func (m *IterateRequest) String() string { return proto.CompactTextString(m) }
 func (*IterateRequest) ProtoMessage()    {}
 func (*IterateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{12}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{15}
 }
 func (m *IterateRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_IterateRequest.Unmarshal(m, b)
@@ -838,7 +981,7 @@ func (m *PayerBandwidthAllocationRequest) Reset()         { *m = PayerBandwidthA
 func (m *PayerBandwidthAllocationRequest) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocationRequest) ProtoMessage()    {}
 func (*PayerBandwidthAllocationRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{13}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{16}
 }
 func (m *PayerBandwidthAllocationRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocationRequest.Unmarshal(m, b)
@@ -876,7 +1019,7 @@ func (m *PayerBandwidthAllocationResponse) Reset()         { *m = PayerBandwidth
 func (m *PayerBandwidthAllocationResponse) String() string { return proto.CompactTextString(m) }
 func (*PayerBandwidthAllocationResponse) ProtoMessage()    {}
 func (*PayerBandwidthAllocationResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_pointerdb_3f3e047a48f87feb, []int{14}
+	return fileDescriptor_pointerdb_76606760f572a2e5, []int{17}
 }
 func (m *PayerBandwidthAllocationResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_PayerBandwidthAllocationResponse.Unmarshal(m, b)
@@ -917,6 +1060,9 @@ func init() {
 	proto.RegisterType((*ListResponse_Item)(nil), "pointerdb.ListResponse.Item")
 	proto.RegisterType((*DeleteRequest)(nil), "pointerdb.DeleteRequest")
 	proto.RegisterType((*DeleteResponse)(nil), "pointerdb.DeleteResponse")
+	proto.RegisterType((*PieceReferences)(nil), "pointerdb.PieceReferences")
+	proto.RegisterType((*CopyRequest)(nil), "pointerdb.CopyRequest")
+	proto.RegisterType((*CopyResponse)(nil), "pointerdb.CopyResponse")
 	proto.RegisterType((*IterateRequest)(nil), "pointerdb.IterateRequest")
 	proto.RegisterType((*PayerBandwidthAllocationRequest)(nil), "pointerdb.PayerBandwidthAllocationRequest")
 	proto.RegisterType((*PayerBandwidthAllocationResponse)(nil), "pointerdb.PayerBandwidthAllocationResponse")
@@ -944,6 +1090,8 @@ type PointerDBClient interface {
 	List(ctx context.Context, in *ListRequest, opts ...grpc.CallOption) (*ListResponse, error)
 	// Delete formats and hands off a file path to delete from boltdb
 	Delete(ctx context.Context, in *DeleteRequest, opts ...grpc.CallOption) (*DeleteResponse, error)
+	// Copy duplicates the pointer at source path to path, sharing its pieces
+	Copy(ctx context.Context, in *CopyRequest, opts ...grpc.CallOption) (*CopyResponse, error)
 	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
 	PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error)
 }
@@ -992,6 +1140,15 @@ func (c *pointerDBClient) Delete(ctx context.Context, in *DeleteRequest, opts ..
 	return out, nil
 }
 
+func (c *pointerDBClient) Copy(ctx context.Context, in *CopyRequest, opts ...grpc.CallOption) (*CopyResponse, error) {
+	out := new(CopyResponse)
+	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/Copy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *pointerDBClient) PayerBandwidthAllocation(ctx context.Context, in *PayerBandwidthAllocationRequest, opts ...grpc.CallOption) (*PayerBandwidthAllocationResponse, error) {
 	out := new(PayerBandwidthAllocationResponse)
 	err := c.cc.Invoke(ctx, "/pointerdb.PointerDB/PayerBandwidthAllocation", in, out, opts...)
@@ -1011,6 +1168,8 @@ type PointerDBServer interface {
 	List(context.Context, *ListRequest) (*ListResponse, error)
 	// Delete formats and hands off a file path to delete from boltdb
 	Delete(context.Context, *DeleteRequest) (*DeleteResponse, error)
+	// Copy duplicates the pointer at source path to path, sharing its pieces
+	Copy(context.Context, *CopyRequest) (*CopyResponse, error)
 	// PayerBandwidthAllocation returns signed payer bandwidth allocation struct
 	PayerBandwidthAllocation(context.Context, *PayerBandwidthAllocationRequest) (*PayerBandwidthAllocationResponse, error)
 }
@@ -1091,6 +1250,24 @@ func _PointerDB_Delete_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _PointerDB_Copy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CopyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PointerDBServer).Copy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/pointerdb.PointerDB/Copy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PointerDBServer).Copy(ctx, req.(*CopyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _PointerDB_PayerBandwidthAllocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PayerBandwidthAllocationRequest)
 	if err := dec(in); err != nil {
@@ -1129,6 +1306,10 @@ var _PointerDB_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Delete",
 			Handler:    _PointerDB_Delete_Handler,
 		},
+		{
+			MethodName: "Copy",
+			Handler:    _PointerDB_Copy_Handler,
+		},
 		{
 			MethodName: "PayerBandwidthAllocation",
 			Handler:    _PointerDB_PayerBandwidthAllocation_Handler,
@@ -1138,77 +1319,83 @@ var _PointerDB_serviceDesc = grpc.ServiceDesc{
 	Metadata: "pointerdb.proto",
 }
 
-func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_pointerdb_3f3e047a48f87feb) }
+func init() { proto.RegisterFile("pointerdb.proto", fileDescriptor_pointerdb_76606760f572a2e5) }
 
-var fileDescriptor_pointerdb_3f3e047a48f87feb = []byte{
-	// 1092 bytes of a gzipped FileDescriptorProto
+var fileDescriptor_pointerdb_76606760f572a2e5 = []byte{
+	// 1186 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x5d, 0x6f, 0x1b, 0x45,
-	0x17, 0xae, 0xbf, 0xe3, 0xb3, 0x76, 0xea, 0x77, 0xd4, 0x37, 0xdd, 0xba, 0x45, 0x09, 0x8b, 0x80,
-	0xd2, 0x56, 0x5b, 0x30, 0x95, 0x90, 0x28, 0x08, 0x35, 0x24, 0x54, 0x96, 0xda, 0x10, 0x8d, 0x73,
-	0xc5, 0xcd, 0x32, 0xf1, 0x1e, 0xdb, 0x23, 0xbc, 0x1f, 0x9d, 0x99, 0x2d, 0x4d, 0xff, 0x09, 0xff,
-	0x84, 0x1b, 0x2e, 0x91, 0xf8, 0x0d, 0x5c, 0xf4, 0x02, 0xf1, 0x33, 0xb8, 0x40, 0xf3, 0xb1, 0xf6,
-	0xba, 0x21, 0x69, 0x05, 0x37, 0xc9, 0x9e, 0x73, 0x9e, 0x73, 0xe6, 0x9c, 0xf3, 0x3c, 0x33, 0x86,
-	0xab, 0x79, 0xc6, 0x53, 0x85, 0x22, 0x3e, 0x0d, 0x73, 0x91, 0xa9, 0x8c, 0x74, 0x57, 0x8e, 0xe1,
-	0xee, 0x3c, 0xcb, 0xe6, 0x4b, 0xbc, 0x6f, 0x02, 0xa7, 0xc5, 0xec, 0xbe, 0xe2, 0x09, 0x4a, 0xc5,
-	0x92, 0xdc, 0x62, 0x87, 0x30, 0xcf, 0xe6, 0x59, 0xf9, 0x9d, 0x66, 0x31, 0xba, 0xef, 0x41, 0xce,
-	0x71, 0x8a, 0x52, 0x65, 0xc2, 0x79, 0x82, 0x9f, 0xea, 0x30, 0xa0, 0x18, 0x17, 0x69, 0xcc, 0xd2,
-	0xe9, 0xd9, 0x64, 0xba, 0xc0, 0x04, 0xc9, 0xe7, 0xd0, 0x54, 0x67, 0x39, 0xfa, 0xb5, 0xbd, 0xda,
-	0xed, 0xed, 0xd1, 0x07, 0xe1, 0xba, 0x95, 0xd7, 0xa1, 0xa1, 0xfd, 0x77, 0x72, 0x96, 0x23, 0x35,
-	0x39, 0xe4, 0x3a, 0x74, 0x12, 0x9e, 0x46, 0x02, 0x9f, 0xf9, 0xf5, 0xbd, 0xda, 0xed, 0x16, 0x6d,
-	0x27, 0x3c, 0xa5, 0xf8, 0x8c, 0x5c, 0x83, 0x96, 0xca, 0x14, 0x5b, 0xfa, 0x0d, 0xe3, 0xb6, 0x06,
-	0xf9, 0x08, 0x06, 0x02, 0x73, 0xc6, 0x45, 0xa4, 0x16, 0x02, 0xe5, 0x22, 0x5b, 0xc6, 0x7e, 0xd3,
-	0x00, 0xae, 0x5a, 0xff, 0x49, 0xe9, 0x26, 0x77, 0xe1, 0x7f, 0xb2, 0x98, 0x4e, 0x51, 0xca, 0x0a,
-	0xb6, 0x65, 0xb0, 0x03, 0x17, 0x58, 0x83, 0xef, 0x01, 0x41, 0xc1, 0x64, 0x21, 0x30, 0x92, 0x0b,
-	0xa6, 0xff, 0xf2, 0x97, 0xe8, 0xb7, 0x2d, 0xda, 0x45, 0x26, 0x3a, 0x30, 0xe1, 0x2f, 0x31, 0xb8,
-	0x06, 0xb0, 0x1e, 0x84, 0xb4, 0xa1, 0x4e, 0x27, 0x83, 0x2b, 0xc1, 0x04, 0x3c, 0x8a, 0x49, 0xa6,
-	0xf0, 0x58, 0x6f, 0x8d, 0xdc, 0x84, 0xae, 0x59, 0x5f, 0x94, 0x16, 0x89, 0x59, 0x4d, 0x8b, 0x6e,
-	0x19, 0xc7, 0x51, 0x91, 0x90, 0x0f, 0xa1, 0xa3, 0xf7, 0x1c, 0xf1, 0xd8, 0x8c, 0xdd, 0xdb, 0xdf,
-	0xfe, 0xed, 0xd5, 0xee, 0x95, 0xdf, 0x5f, 0xed, 0xb6, 0x8f, 0xb2, 0x18, 0xc7, 0x07, 0xb4, 0xad,
-	0xc3, 0xe3, 0x38, 0xf8, 0xb5, 0x06, 0x7d, 0x5b, 0x75, 0x82, 0xf3, 0x04, 0x53, 0x45, 0x1e, 0x02,
-	0x88, 0xd5, 0x5a, 0x4d, 0x61, 0x6f, 0x74, 0xf3, 0x92, 0x9d, 0xd3, 0x0a, 0x9c, 0xdc, 0x00, 0xdb,
-	0x43, 0x79, 0x70, 0x97, 0x76, 0x8c, 0x3d, 0x8e, 0xc9, 0x43, 0xe8, 0x0b, 0x73, 0x50, 0x64, 0x59,
-	0xf7, 0x1b, 0x7b, 0x8d, 0xdb, 0xde, 0x68, 0x67, 0xa3, 0xf4, 0x6a, 0x3c, 0xda, 0x13, 0x6b, 0x43,
-	0x92, 0x5d, 0xf0, 0x12, 0x14, 0x3f, 0x2c, 0x31, 0x12, 0x59, 0xa6, 0x0c, 0x25, 0x3d, 0x0a, 0xd6,
-	0x45, 0xb3, 0x4c, 0x05, 0x7f, 0xd5, 0xa1, 0x73, 0x6c, 0x0b, 0x91, 0xfb, 0x1b, 0x7a, 0xa9, 0xf6,
-	0xee, 0x10, 0xe1, 0x01, 0x53, 0xac, 0x22, 0x92, 0xf7, 0x61, 0x9b, 0xa7, 0x4b, 0x9e, 0x62, 0x24,
-	0xed, 0x12, 0x8c, 0x28, 0x7a, 0xb4, 0x6f, 0xbd, 0xe5, 0x66, 0x3e, 0x86, 0xb6, 0x6d, 0xca, 0x9c,
-	0xef, 0x8d, 0xfc, 0x73, 0xad, 0x3b, 0x24, 0x75, 0x38, 0xf2, 0x2e, 0xf4, 0x5c, 0x45, 0x4b, 0xb8,
-	0x96, 0x47, 0x83, 0x7a, 0xce, 0xa7, 0xb9, 0x26, 0x5f, 0x41, 0x7f, 0x2a, 0x90, 0x29, 0x9e, 0xa5,
-	0x51, 0xcc, 0x94, 0x15, 0x85, 0x37, 0x1a, 0x86, 0xf6, 0x52, 0x85, 0xe5, 0xa5, 0x0a, 0x4f, 0xca,
-	0x4b, 0x45, 0x7b, 0x65, 0xc2, 0x01, 0x53, 0x48, 0xbe, 0x86, 0xab, 0xf8, 0x22, 0xe7, 0xa2, 0x52,
-	0xa2, 0xf3, 0xc6, 0x12, 0xdb, 0xeb, 0x14, 0x53, 0x64, 0x08, 0x5b, 0x09, 0x2a, 0x16, 0x33, 0xc5,
-	0xfc, 0x2d, 0x33, 0xfb, 0xca, 0x0e, 0x02, 0xd8, 0x2a, 0xf7, 0x45, 0x00, 0xda, 0xe3, 0xa3, 0x27,
-	0xe3, 0xa3, 0xc3, 0xc1, 0x15, 0xfd, 0x4d, 0x0f, 0x9f, 0x7e, 0x7b, 0x72, 0x38, 0xa8, 0x05, 0x47,
-	0x00, 0xc7, 0x85, 0xa2, 0xf8, 0xac, 0x40, 0xa9, 0x08, 0x81, 0x66, 0xce, 0xd4, 0xc2, 0x10, 0xd0,
-	0xa5, 0xe6, 0x9b, 0xdc, 0x83, 0x8e, 0xdb, 0x96, 0x11, 0x86, 0x37, 0x22, 0xe7, 0x79, 0xa1, 0x25,
-	0x24, 0xd8, 0x03, 0x78, 0x8c, 0x97, 0xd5, 0x0b, 0x7e, 0xae, 0x81, 0xf7, 0x84, 0xcb, 0x15, 0x66,
-	0x07, 0xda, 0xb9, 0xc0, 0x19, 0x7f, 0xe1, 0x50, 0xce, 0xd2, 0xca, 0x91, 0x8a, 0x09, 0x15, 0xb1,
-	0x59, 0x79, 0x76, 0x97, 0x82, 0x71, 0x3d, 0xd2, 0x1e, 0xf2, 0x0e, 0x00, 0xa6, 0x71, 0x74, 0x8a,
-	0xb3, 0x4c, 0xa0, 0x21, 0xbe, 0x4b, 0xbb, 0x98, 0xc6, 0xfb, 0xc6, 0x41, 0x6e, 0x41, 0x57, 0xe0,
-	0xb4, 0x10, 0x92, 0x3f, 0xb7, 0xbc, 0x6f, 0xd1, 0xb5, 0x43, 0xbf, 0x22, 0x4b, 0x9e, 0x70, 0xe5,
-	0x2e, 0xbe, 0x35, 0x74, 0x49, 0xbd, 0xbd, 0x68, 0xb6, 0x64, 0x73, 0x69, 0x08, 0xed, 0xd0, 0xae,
-	0xf6, 0x7c, 0xa3, 0x1d, 0x41, 0x1f, 0x3c, 0xb3, 0x2c, 0x99, 0x67, 0xa9, 0xc4, 0xe0, 0x8f, 0x1a,
-	0x78, 0x66, 0x58, 0x6b, 0x57, 0x37, 0x55, 0x7b, 0xe3, 0xa6, 0xc8, 0x1e, 0xb4, 0xf4, 0x55, 0x96,
-	0x7e, 0xdd, 0x5c, 0x27, 0x08, 0xcd, 0xfb, 0xaa, 0x6f, 0x39, 0xb5, 0x01, 0xf2, 0x05, 0x34, 0xf2,
-	0x53, 0x66, 0x26, 0xf3, 0x46, 0x77, 0xc2, 0xf5, 0x9b, 0x2b, 0xb2, 0x42, 0xa1, 0x0c, 0x8f, 0xd9,
-	0x19, 0x8a, 0x7d, 0x96, 0xc6, 0x3f, 0xf2, 0x58, 0x2d, 0x1e, 0x2d, 0x97, 0xd9, 0xd4, 0x08, 0x83,
-	0xea, 0x34, 0x72, 0x08, 0x7d, 0x56, 0xa8, 0x45, 0x26, 0xf8, 0x4b, 0xe3, 0x75, 0xda, 0xdf, 0x3d,
-	0x5f, 0x67, 0xc2, 0xe7, 0x29, 0xc6, 0x4f, 0x51, 0x4a, 0x36, 0x47, 0xba, 0x99, 0x15, 0xfc, 0x52,
-	0x83, 0x9e, 0xa5, 0xcb, 0x4d, 0x39, 0x82, 0x16, 0x57, 0x98, 0x48, 0xbf, 0x66, 0xfa, 0xbe, 0x55,
-	0x99, 0xb1, 0x8a, 0x0b, 0xc7, 0x0a, 0x13, 0x6a, 0xa1, 0x5a, 0x07, 0x89, 0x26, 0xa9, 0x6e, 0x68,
-	0x30, 0xdf, 0x43, 0x84, 0xa6, 0x86, 0xfc, 0x77, 0xcd, 0xe9, 0x07, 0x95, 0xcb, 0xc8, 0x89, 0xa8,
-	0x61, 0x8e, 0xd8, 0xe2, 0xf2, 0xd8, 0xd8, 0xc1, 0x7b, 0xd0, 0x3f, 0xc0, 0x25, 0x2a, 0xbc, 0x4c,
-	0x93, 0x03, 0xd8, 0x2e, 0x41, 0x8e, 0x5b, 0x01, 0xdb, 0x63, 0x85, 0x82, 0xad, 0xf3, 0x2e, 0xd2,
-	0xe9, 0x35, 0x68, 0xcd, 0xb8, 0x90, 0xca, 0x29, 0xd4, 0x1a, 0xc4, 0x87, 0x8e, 0x15, 0x1b, 0xba,
-	0x8e, 0x4a, 0xd3, 0x46, 0x9e, 0xa3, 0x8e, 0x34, 0xcb, 0x88, 0x31, 0x83, 0x25, 0xec, 0x5e, 0x48,
-	0xa9, 0x6b, 0x62, 0x0c, 0x6d, 0x36, 0x35, 0x6c, 0xda, 0x37, 0xf2, 0x93, 0xb7, 0x57, 0x45, 0xf8,
-	0xc8, 0x24, 0x52, 0x57, 0x20, 0xf8, 0x1e, 0xf6, 0x2e, 0x3e, 0xcd, 0x71, 0xed, 0x14, 0x58, 0xfb,
-	0x57, 0x0a, 0x1c, 0xfd, 0x59, 0x87, 0xae, 0x23, 0xeb, 0x60, 0x9f, 0x3c, 0x80, 0xc6, 0x71, 0xa1,
-	0xc8, 0xff, 0xab, 0x4c, 0xae, 0x5e, 0x9e, 0xe1, 0xce, 0xeb, 0x6e, 0xd7, 0xc1, 0x03, 0x68, 0x3c,
-	0xc6, 0xcd, 0xac, 0xf5, 0xfb, 0xb2, 0x91, 0x55, 0xbd, 0x89, 0x9f, 0x41, 0x53, 0x6b, 0x91, 0xec,
-	0x9c, 0x13, 0xa7, 0xcd, 0xbb, 0x7e, 0x81, 0x68, 0xc9, 0x97, 0xd0, 0xb6, 0x42, 0x20, 0xd5, 0xdf,
-	0x88, 0x0d, 0x01, 0x0d, 0x6f, 0xfc, 0x43, 0xc4, 0xa5, 0x4b, 0xf0, 0x2f, 0x5a, 0x09, 0xb9, 0x53,
-	0x9d, 0xf0, 0x72, 0x9a, 0x87, 0x77, 0xdf, 0x0a, 0x6b, 0x0f, 0xdd, 0x6f, 0x7e, 0x57, 0xcf, 0x4f,
-	0x4f, 0xdb, 0xe6, 0xc7, 0xe2, 0xd3, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0xfa, 0x56, 0x48, 0xf9,
-	0xf0, 0x09, 0x00, 0x00,
+	0x17, 0xae, 0xbf, 0xed, 0xe3, 0x8f, 0xb8, 0xa3, 0xbe, 0xa9, 0xeb, 0xf6, 0x55, 0xc2, 0x22, 0x68,
+	0x69, 0xab, 0x2d, 0x98, 0x4a, 0x48, 0x94, 0x0a, 0x35, 0x4d, 0x88, 0x2c, 0xb5, 0xc1, 0x9a, 0xe4,
+	0x8a, 0x0b, 0x96, 0x89, 0xf7, 0xd8, 0x5e, 0xe1, 0xdd, 0xd9, 0xce, 0xcc, 0x96, 0xa6, 0xff, 0x84,
+	0x0b, 0xfe, 0x07, 0x37, 0x5c, 0x22, 0xf1, 0x1b, 0xb8, 0xe8, 0x05, 0xbf, 0x83, 0x0b, 0x34, 0x1f,
+	0x6b, 0x6f, 0x9a, 0x26, 0xa9, 0xe0, 0x26, 0xd9, 0xf3, 0xcc, 0xf9, 0x9a, 0xe7, 0x3c, 0x67, 0x0c,
+	0x1b, 0x29, 0x8f, 0x12, 0x85, 0x22, 0x3c, 0xf6, 0x53, 0xc1, 0x15, 0x27, 0xad, 0x15, 0x30, 0xdc,
+	0x9a, 0x73, 0x3e, 0x5f, 0xe2, 0x03, 0x73, 0x70, 0x9c, 0xcd, 0x1e, 0xa8, 0x28, 0x46, 0xa9, 0x58,
+	0x9c, 0x5a, 0xdf, 0x21, 0xcc, 0xf9, 0x9c, 0xe7, 0xdf, 0x09, 0x0f, 0xd1, 0x7d, 0xf7, 0xd3, 0x08,
+	0xa7, 0x28, 0x15, 0x17, 0x0e, 0xf1, 0x7e, 0x2e, 0x43, 0x9f, 0x62, 0x98, 0x25, 0x21, 0x4b, 0xa6,
+	0x27, 0x87, 0xd3, 0x05, 0xc6, 0x48, 0xbe, 0x84, 0xaa, 0x3a, 0x49, 0x71, 0x50, 0xda, 0x2e, 0xdd,
+	0xe9, 0x8d, 0x3e, 0xf6, 0xd7, 0xad, 0xbc, 0xed, 0xea, 0xdb, 0x7f, 0x47, 0x27, 0x29, 0x52, 0x13,
+	0x43, 0xae, 0x43, 0x23, 0x8e, 0x92, 0x40, 0xe0, 0x8b, 0x41, 0x79, 0xbb, 0x74, 0xa7, 0x46, 0xeb,
+	0x71, 0x94, 0x50, 0x7c, 0x41, 0xae, 0x41, 0x4d, 0x71, 0xc5, 0x96, 0x83, 0x8a, 0x81, 0xad, 0x41,
+	0x3e, 0x81, 0xbe, 0xc0, 0x94, 0x45, 0x22, 0x50, 0x0b, 0x81, 0x72, 0xc1, 0x97, 0xe1, 0xa0, 0x6a,
+	0x1c, 0x36, 0x2c, 0x7e, 0x94, 0xc3, 0xe4, 0x1e, 0x5c, 0x95, 0xd9, 0x74, 0x8a, 0x52, 0x16, 0x7c,
+	0x6b, 0xc6, 0xb7, 0xef, 0x0e, 0xd6, 0xce, 0xf7, 0x81, 0xa0, 0x60, 0x32, 0x13, 0x18, 0xc8, 0x05,
+	0xd3, 0x7f, 0xa3, 0xd7, 0x38, 0xa8, 0x5b, 0x6f, 0x77, 0x72, 0xa8, 0x0f, 0x0e, 0xa3, 0xd7, 0xe8,
+	0x5d, 0x03, 0x58, 0x5f, 0x84, 0xd4, 0xa1, 0x4c, 0x0f, 0xfb, 0x57, 0xbc, 0x39, 0xb4, 0x29, 0xc6,
+	0x5c, 0xe1, 0x44, 0xb3, 0x46, 0x6e, 0x42, 0xcb, 0xd0, 0x17, 0x24, 0x59, 0x6c, 0xa8, 0xa9, 0xd1,
+	0xa6, 0x01, 0x0e, 0xb2, 0x98, 0xdc, 0x86, 0x86, 0xe6, 0x39, 0x88, 0x42, 0x73, 0xed, 0xce, 0x4e,
+	0xef, 0x8f, 0x37, 0x5b, 0x57, 0xfe, 0x7c, 0xb3, 0x55, 0x3f, 0xe0, 0x21, 0x8e, 0x77, 0x69, 0x5d,
+	0x1f, 0x8f, 0x43, 0x42, 0xa0, 0xba, 0x60, 0x72, 0x61, 0x58, 0xe8, 0x50, 0xf3, 0xed, 0xfd, 0x5e,
+	0x82, 0xae, 0xad, 0x74, 0x88, 0xf3, 0x18, 0x13, 0x45, 0x1e, 0x01, 0x88, 0x15, 0xd5, 0xa6, 0x58,
+	0x7b, 0x74, 0xf3, 0x82, 0x39, 0xd0, 0x82, 0x3b, 0xb9, 0x01, 0xb6, 0xaf, 0xbc, 0x99, 0x16, 0x6d,
+	0x18, 0x7b, 0x1c, 0x92, 0x47, 0xd0, 0x15, 0xa6, 0x50, 0x60, 0x10, 0x39, 0xa8, 0x6c, 0x57, 0xee,
+	0xb4, 0x47, 0x9b, 0xa7, 0x52, 0xaf, 0xae, 0x4c, 0x3b, 0x62, 0x6d, 0x48, 0xb2, 0x05, 0xed, 0x18,
+	0xc5, 0x8f, 0x4b, 0x0c, 0x04, 0xe7, 0xca, 0x8c, 0xa9, 0x43, 0xc1, 0x42, 0x94, 0x73, 0xe5, 0xfd,
+	0x5d, 0x86, 0xc6, 0xc4, 0x26, 0x22, 0x0f, 0x4e, 0x69, 0xa8, 0xd8, 0xbb, 0xf3, 0xf0, 0x77, 0x99,
+	0x62, 0x05, 0xe1, 0x7c, 0x04, 0xbd, 0x28, 0x59, 0x46, 0x09, 0x06, 0xd2, 0x92, 0xe0, 0x28, 0xea,
+	0x5a, 0x34, 0x67, 0xe6, 0x53, 0xa8, 0xdb, 0xa6, 0x4c, 0xfd, 0xf6, 0x68, 0x70, 0xa6, 0x75, 0xe7,
+	0x49, 0x9d, 0x1f, 0xf9, 0x00, 0x3a, 0x2e, 0xa3, 0x15, 0x81, 0x96, 0x4c, 0x85, 0xb6, 0x1d, 0xa6,
+	0xe7, 0x4f, 0xbe, 0x86, 0xee, 0x54, 0x20, 0x53, 0x11, 0x4f, 0x82, 0x90, 0x29, 0x2b, 0x94, 0xf6,
+	0x68, 0xe8, 0xdb, 0x45, 0xf3, 0xf3, 0x45, 0xf3, 0x8f, 0xf2, 0x45, 0xa3, 0x9d, 0x3c, 0x60, 0x97,
+	0x29, 0x24, 0x4f, 0x61, 0x03, 0x5f, 0xa5, 0x91, 0x28, 0xa4, 0x68, 0x5c, 0x9a, 0xa2, 0xb7, 0x0e,
+	0x31, 0x49, 0x86, 0xd0, 0x8c, 0x51, 0xb1, 0x90, 0x29, 0x36, 0x68, 0x9a, 0xbb, 0xaf, 0x6c, 0xcf,
+	0x83, 0x66, 0xce, 0x17, 0x01, 0xa8, 0x8f, 0x0f, 0x9e, 0x8d, 0x0f, 0xf6, 0xfa, 0x57, 0xf4, 0x37,
+	0xdd, 0x7b, 0xfe, 0xed, 0xd1, 0x5e, 0xbf, 0xe4, 0x1d, 0x00, 0x4c, 0x32, 0x45, 0xf1, 0x45, 0x86,
+	0x52, 0x69, 0xa1, 0xa5, 0x4c, 0x2d, 0xcc, 0x00, 0x5a, 0xd4, 0x7c, 0x93, 0xfb, 0xd0, 0x70, 0x6c,
+	0x19, 0x61, 0xb4, 0x47, 0xe4, 0xec, 0x5c, 0x68, 0xee, 0xe2, 0x6d, 0x03, 0xec, 0xe3, 0x45, 0xf9,
+	0xbc, 0x5f, 0x4b, 0xd0, 0x7e, 0x16, 0xc9, 0x95, 0xcf, 0x26, 0xd4, 0x53, 0x81, 0xb3, 0xe8, 0x95,
+	0xf3, 0x72, 0x96, 0x56, 0x8e, 0x54, 0x4c, 0xa8, 0x80, 0xcd, 0xf2, 0xda, 0x2d, 0x0a, 0x06, 0x7a,
+	0xa2, 0x11, 0xf2, 0x7f, 0x00, 0x4c, 0xc2, 0xe0, 0x18, 0x67, 0x5c, 0xa0, 0x19, 0x7c, 0x8b, 0xb6,
+	0x30, 0x09, 0x77, 0x0c, 0x40, 0x6e, 0x41, 0x4b, 0xe0, 0x34, 0x13, 0x32, 0x7a, 0x69, 0xe7, 0xde,
+	0xa4, 0x6b, 0x40, 0xbf, 0x2c, 0xcb, 0x28, 0x8e, 0x94, 0x7b, 0x0c, 0xac, 0xa1, 0x53, 0x6a, 0xf6,
+	0x82, 0xd9, 0x92, 0xcd, 0xa5, 0x19, 0x68, 0x83, 0xb6, 0x34, 0xf2, 0x8d, 0x06, 0xbc, 0x2e, 0xb4,
+	0x0d, 0x59, 0x32, 0xe5, 0x89, 0x44, 0xef, 0xaf, 0x12, 0xb4, 0xf7, 0x71, 0x65, 0x17, 0x99, 0x2a,
+	0x5d, 0xca, 0x14, 0xd9, 0x86, 0x9a, 0x5e, 0x6f, 0x39, 0x28, 0x9b, 0x75, 0x02, 0x5f, 0x5b, 0xbe,
+	0xde, 0x7c, 0x6a, 0x0f, 0xc8, 0x57, 0x50, 0x49, 0x8f, 0x99, 0xb9, 0x59, 0x7b, 0x74, 0xd7, 0x5f,
+	0xbf, 0xc3, 0x82, 0x67, 0x0a, 0xa5, 0x3f, 0x61, 0x27, 0x28, 0x76, 0x58, 0x12, 0xfe, 0x14, 0x85,
+	0x6a, 0xf1, 0x64, 0xb9, 0xe4, 0x53, 0x23, 0x0c, 0xaa, 0xc3, 0xc8, 0x1e, 0x74, 0x59, 0xa6, 0x16,
+	0x5c, 0x44, 0xaf, 0x0d, 0xea, 0xb4, 0xbf, 0x75, 0x36, 0xcf, 0x61, 0x34, 0x4f, 0x30, 0x7c, 0x8e,
+	0x52, 0xb2, 0x39, 0xd2, 0xd3, 0x51, 0xde, 0x6f, 0x25, 0xe8, 0xd8, 0x71, 0xb9, 0x5b, 0x8e, 0xa0,
+	0x16, 0x29, 0x8c, 0xe5, 0xa0, 0x64, 0xfa, 0xbe, 0x55, 0xb8, 0x63, 0xd1, 0xcf, 0x1f, 0x2b, 0x8c,
+	0xa9, 0x75, 0xd5, 0x3a, 0x88, 0xf5, 0x90, 0xca, 0x66, 0x0c, 0xe6, 0x7b, 0x88, 0x50, 0xd5, 0x2e,
+	0xff, 0x5d, 0x73, 0xfa, 0x91, 0x8d, 0x64, 0xe0, 0x44, 0x54, 0x31, 0x25, 0x9a, 0x91, 0x9c, 0x18,
+	0xdb, 0xfb, 0x10, 0xba, 0xbb, 0xb8, 0x44, 0x85, 0x17, 0x69, 0xf2, 0x31, 0xf4, 0x72, 0x27, 0x77,
+	0xcb, 0x7b, 0x70, 0xd5, 0xf2, 0x14, 0x08, 0x9c, 0xa1, 0xc0, 0x64, 0x8a, 0xa1, 0x09, 0x69, 0x52,
+	0xf7, 0x83, 0x48, 0x57, 0xb8, 0x77, 0x1b, 0x36, 0xec, 0xdb, 0x97, 0x43, 0x52, 0xeb, 0x4b, 0x67,
+	0xb6, 0x2c, 0xb5, 0xa8, 0x35, 0xbc, 0xef, 0xa1, 0xfd, 0x94, 0xa7, 0x27, 0x79, 0x2b, 0x5a, 0xe2,
+	0x3c, 0x13, 0x53, 0x0c, 0x0a, 0x1d, 0x81, 0x85, 0x26, 0x9a, 0x87, 0xbc, 0xd7, 0x72, 0x81, 0x9b,
+	0xe2, 0xc6, 0x57, 0xde, 0xda, 0xf8, 0x1e, 0x74, 0x6c, 0x7e, 0xa7, 0x50, 0x01, 0xbd, 0xb1, 0x42,
+	0xc1, 0x14, 0x5e, 0xb6, 0x6d, 0xd7, 0xa0, 0x36, 0x8b, 0x84, 0x54, 0xae, 0x94, 0x35, 0xc8, 0x00,
+	0x1a, 0x76, 0x65, 0xd0, 0xf1, 0x9a, 0x9b, 0xf6, 0xe4, 0x25, 0xea, 0x93, 0x6a, 0x7e, 0x62, 0x4c,
+	0x6f, 0x09, 0x5b, 0xe7, 0x0a, 0xd3, 0x35, 0x31, 0x86, 0x3a, 0x9b, 0x1a, 0x4d, 0xda, 0x97, 0xfe,
+	0xb3, 0xf7, 0xd7, 0xb6, 0xff, 0xc4, 0x04, 0x52, 0x97, 0xc0, 0xfb, 0x01, 0xb6, 0xcf, 0xaf, 0xe6,
+	0x66, 0xe9, 0xf6, 0xa8, 0xf4, 0xaf, 0xf6, 0x68, 0xf4, 0x4b, 0x05, 0x5a, 0x4e, 0x72, 0xbb, 0x3b,
+	0xe4, 0x21, 0x54, 0x26, 0x99, 0x22, 0xff, 0x2b, 0xea, 0x71, 0xf5, 0x7e, 0x0e, 0x37, 0xdf, 0x86,
+	0x5d, 0x07, 0x0f, 0xa1, 0xb2, 0x8f, 0xa7, 0xa3, 0xf6, 0xf1, 0x9d, 0x51, 0xc5, 0xf7, 0xe4, 0x0b,
+	0xa8, 0xea, 0x8d, 0x22, 0x9b, 0x67, 0x56, 0xcc, 0xc6, 0x5d, 0x3f, 0x67, 0xf5, 0xc8, 0x63, 0xa8,
+	0x5b, 0x39, 0x93, 0xe2, 0x2f, 0xdd, 0xa9, 0x35, 0x18, 0xde, 0x78, 0xc7, 0xc9, 0xba, 0xae, 0x56,
+	0xd1, 0xa9, 0xba, 0x05, 0xd9, 0x0e, 0xaf, 0x9f, 0xc1, 0x5d, 0xa0, 0x84, 0xc1, 0x79, 0x5c, 0x92,
+	0xbb, 0x45, 0x6a, 0x2e, 0xd6, 0xc7, 0xf0, 0xde, 0x7b, 0xf9, 0xda, 0xa2, 0x3b, 0xd5, 0xef, 0xca,
+	0xe9, 0xf1, 0x71, 0xdd, 0xfc, 0x56, 0x7e, 0xfe, 0xcf, 0x00, 0xfe, 0x0d, 0x6f, 0x03, 0x03, 0x0b,
+	0x00, 0x00,
 }
@@ -30,7 +30,7 @@ func (m *SegmentMeta) Reset()         { *m = SegmentMeta{} }
 func (m *SegmentMeta) String() string { return proto.CompactTextString(m) }
 func (*SegmentMeta) ProtoMessage()    {}
 func (*SegmentMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streams_2b972859339b7bc3, []int{0}
+	return fileDescriptor_streams_a1bef1412cb4fe07, []int{0}
 }
 func (m *SegmentMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_SegmentMeta.Unmarshal(m, b)
@@ -65,10 +65,16 @@ func (m *SegmentMeta) GetKeyNonce() []byte {
 }
 
 type StreamInfo struct {
-	NumberOfSegments     int64    `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
-	SegmentsSize         int64    `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
-	LastSegmentSize      int64    `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
-	Metadata             []byte   `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	NumberOfSegments int64  `protobuf:"varint,1,opt,name=number_of_segments,json=numberOfSegments,proto3" json:"number_of_segments,omitempty"`
+	SegmentsSize     int64  `protobuf:"varint,2,opt,name=segments_size,json=segmentsSize,proto3" json:"segments_size,omitempty"`
+	LastSegmentSize  int64  `protobuf:"varint,3,opt,name=last_segment_size,json=lastSegmentSize,proto3" json:"last_segment_size,omitempty"`
+	Metadata         []byte `protobuf:"bytes,4,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	// sizes of all segments, set when they differ from segments_size like
+	// in streams concatenated from other streams
+	SegmentSizes []int64 `protobuf:"varint,5,rep,packed,name=segment_sizes,json=segmentSizes" json:"segment_sizes,omitempty"`
+	// increments of the zero nonce the content of each segment was encrypted
+	// with, set when they differ from the position of the segment plus one
+	ContentNonces        []int64  `protobuf:"varint,6,rep,packed,name=content_nonces,json=contentNonces" json:"content_nonces,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -78,7 +84,7 @@ func (m *StreamInfo) Reset()         { *m = StreamInfo{} }
 func (m *StreamInfo) String() string { return proto.CompactTextString(m) }
 func (*StreamInfo) ProtoMessage()    {}
 func (*StreamInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streams_2b972859339b7bc3, []int{1}
+	return fileDescriptor_streams_a1bef1412cb4fe07, []int{1}
 }
 func (m *StreamInfo) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamInfo.Unmarshal(m, b)
@@ -126,6 +132,20 @@ func (m *StreamInfo) GetMetadata() []byte {
 	return nil
 }
 
+func (m *StreamInfo) GetSegmentSizes() []int64 {
+	if m != nil {
+		return m.SegmentSizes
+	}
+	return nil
+}
+
+func (m *StreamInfo) GetContentNonces() []int64 {
+	if m != nil {
+		return m.ContentNonces
+	}
+	return nil
+}
+
 type StreamMeta struct {
 	EncryptedStreamInfo  []byte       `protobuf:"bytes,1,opt,name=encrypted_stream_info,json=encryptedStreamInfo,proto3" json:"encrypted_stream_info,omitempty"`
 	EncryptionType       int32        `protobuf:"varint,2,opt,name=encryption_type,json=encryptionType,proto3" json:"encryption_type,omitempty"`
@@ -140,7 +160,7 @@ func (m *StreamMeta) Reset()         { *m = StreamMeta{} }
 func (m *StreamMeta) String() string { return proto.CompactTextString(m) }
 func (*StreamMeta) ProtoMessage()    {}
 func (*StreamMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_streams_2b972859339b7bc3, []int{2}
+	return fileDescriptor_streams_a1bef1412cb4fe07, []int{2}
 }
 func (m *StreamMeta) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_StreamMeta.Unmarshal(m, b)
@@ -194,27 +214,29 @@ func init() {
 	proto.RegisterType((*StreamMeta)(nil), "streams.StreamMeta")
 }
 
-func init() { proto.RegisterFile("streams.proto", fileDescriptor_streams_2b972859339b7bc3) }
-
-var fileDescriptor_streams_2b972859339b7bc3 = []byte{
-	// 304 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x51, 0xcb, 0x4e, 0xc3, 0x30,
-	0x10, 0x54, 0x5f, 0x50, 0xb6, 0x29, 0x05, 0x03, 0x52, 0x04, 0x17, 0x14, 0x0e, 0x20, 0x84, 0x7a,
-	0x28, 0x3f, 0x80, 0x7a, 0x43, 0x08, 0x2a, 0x25, 0x9c, 0xb8, 0x58, 0x4e, 0xba, 0x41, 0x51, 0x1a,
-	0x3b, 0x8a, 0xcd, 0xc1, 0xfd, 0x21, 0x3e, 0x8b, 0x5f, 0x41, 0xb6, 0xf3, 0x82, 0xe3, 0xce, 0x8c,
-	0x66, 0x67, 0x76, 0x61, 0x2e, 0x55, 0x85, 0xac, 0x90, 0xcb, 0xb2, 0x12, 0x4a, 0x90, 0xc3, 0x7a,
-	0x0c, 0x36, 0x30, 0x8b, 0xf0, 0xb3, 0x40, 0xae, 0x5e, 0x51, 0x31, 0x72, 0x03, 0x73, 0xe4, 0x49,
-	0xa5, 0x4b, 0x85, 0x5b, 0x9a, 0xa3, 0xf6, 0x07, 0xd7, 0x83, 0x3b, 0x2f, 0xf4, 0x5a, 0xf0, 0x05,
-	0x35, 0xb9, 0x82, 0xa3, 0x1c, 0x35, 0xe5, 0x82, 0x27, 0xe8, 0x0f, 0xad, 0x60, 0x9a, 0xa3, 0x7e,
-	0x33, 0x73, 0xf0, 0x3d, 0x00, 0x88, 0xac, 0xf9, 0x33, 0x4f, 0x05, 0x79, 0x00, 0xc2, 0xbf, 0x8a,
-	0x18, 0x2b, 0x2a, 0x52, 0x2a, 0xdd, 0x26, 0x69, 0x5d, 0x47, 0xe1, 0x89, 0x63, 0x36, 0x69, 0x9d,
-	0x40, 0x9a, 0xf5, 0x8d, 0x86, 0xca, 0x6c, 0xef, 0xdc, 0x47, 0xa1, 0xd7, 0x80, 0x51, 0xb6, 0x47,
-	0x72, 0x0f, 0xa7, 0x3b, 0x26, 0x55, 0xe3, 0xe6, 0x84, 0x23, 0x2b, 0x5c, 0x18, 0xa2, 0x76, 0xb3,
-	0xda, 0x4b, 0x98, 0x16, 0xa8, 0xd8, 0x96, 0x29, 0xe6, 0x8f, 0x5d, 0xd2, 0x66, 0x0e, 0x7e, 0xda,
-	0xa4, 0xb6, 0xfa, 0x0a, 0x2e, 0xba, 0xea, 0xee, 0x3c, 0x34, 0xe3, 0xa9, 0xa8, 0x4f, 0x70, 0xd6,
-	0x92, 0xbd, 0x76, 0xb7, 0xb0, 0xa8, 0xe1, 0x4c, 0x70, 0xaa, 0x74, 0xe9, 0x12, 0x4f, 0xc2, 0xe3,
-	0x0e, 0x7e, 0xd7, 0x25, 0xf6, 0xcc, 0x8d, 0x30, 0xde, 0x89, 0x24, 0xef, 0x72, 0x4f, 0x5a, 0xf3,
-	0x4c, 0xf0, 0xb5, 0xe1, 0x6c, 0xf6, 0xa7, 0x7f, 0x3d, 0x4d, 0x70, 0x5b, 0x62, 0xb6, 0x3a, 0x5f,
-	0x36, 0xef, 0xec, 0x3d, 0xef, 0x4f, 0x7b, 0x03, 0xac, 0xc7, 0x1f, 0xc3, 0x32, 0x8e, 0x0f, 0xec,
-	0xcb, 0x1f, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff, 0xdf, 0x5e, 0x32, 0x31, 0x03, 0x02, 0x00, 0x00,
+func init() { proto.RegisterFile("streams.proto", fileDescriptor_streams_a1bef1412cb4fe07) }
+
+var fileDescriptor_streams_a1bef1412cb4fe07 = []byte{
+	// 334 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x4d, 0x4e, 0xc3, 0x30,
+	0x10, 0x85, 0xd5, 0xa6, 0x2d, 0xc5, 0xfd, 0x03, 0x03, 0x52, 0x04, 0x9b, 0xaa, 0x08, 0x81, 0x10,
+	0xea, 0xa2, 0x5c, 0x00, 0x75, 0x87, 0x10, 0x54, 0x4a, 0x59, 0xb1, 0xb1, 0x92, 0x74, 0x82, 0xa2,
+	0x34, 0x76, 0x14, 0x9b, 0x85, 0x7b, 0x59, 0x0e, 0xc1, 0x05, 0x90, 0xc7, 0xce, 0x0f, 0x2c, 0xe7,
+	0xcd, 0xf3, 0x1b, 0xcf, 0xa7, 0x21, 0x13, 0xa9, 0x4a, 0x08, 0x73, 0xb9, 0x2c, 0x4a, 0xa1, 0x04,
+	0x3d, 0x72, 0xe5, 0x62, 0x43, 0x46, 0x5b, 0xf8, 0xcc, 0x81, 0xab, 0x57, 0x50, 0x21, 0xbd, 0x26,
+	0x13, 0xe0, 0x71, 0xa9, 0x0b, 0x05, 0x3b, 0x96, 0x81, 0xf6, 0x3b, 0xf3, 0xce, 0xdd, 0x38, 0x18,
+	0xd7, 0xe2, 0x0b, 0x68, 0x7a, 0x45, 0x8e, 0x33, 0xd0, 0x8c, 0x0b, 0x1e, 0x83, 0xdf, 0x45, 0xc3,
+	0x30, 0x03, 0xfd, 0x66, 0xea, 0xc5, 0x4f, 0x87, 0x90, 0x2d, 0x86, 0x3f, 0xf3, 0x44, 0xd0, 0x07,
+	0x42, 0xf9, 0x57, 0x1e, 0x41, 0xc9, 0x44, 0xc2, 0xa4, 0x9d, 0x24, 0x31, 0xd5, 0x0b, 0x4e, 0x6c,
+	0x67, 0x93, 0xb8, 0x1f, 0x48, 0x33, 0xbe, 0xf2, 0x30, 0x99, 0x1e, 0x6c, 0xba, 0x17, 0x8c, 0x2b,
+	0x71, 0x9b, 0x1e, 0x80, 0xde, 0x93, 0xd3, 0x7d, 0x28, 0x55, 0x95, 0x66, 0x8d, 0x1e, 0x1a, 0x67,
+	0xa6, 0xe1, 0xd2, 0xd0, 0x7b, 0x49, 0x86, 0x39, 0xa8, 0x70, 0x17, 0xaa, 0xd0, 0xef, 0xd9, 0x9f,
+	0x56, 0x75, 0x6b, 0x18, 0x46, 0x48, 0xbf, 0x3f, 0xf7, 0x5a, 0xc3, 0xcc, 0x7b, 0x49, 0x6f, 0xc8,
+	0x34, 0x16, 0x5c, 0x19, 0x13, 0xee, 0x2b, 0xfd, 0x01, 0xba, 0x26, 0x4e, 0xc5, 0xa5, 0xe5, 0xe2,
+	0xbb, 0xde, 0x1a, 0x31, 0xae, 0xc8, 0x45, 0x83, 0xd1, 0xa2, 0x66, 0x29, 0x4f, 0x84, 0xc3, 0x79,
+	0x56, 0x37, 0x5b, 0xa4, 0x6e, 0xc9, 0xcc, 0xc9, 0xa9, 0xe0, 0x4c, 0xe9, 0xc2, 0x6e, 0xdf, 0x0f,
+	0xa6, 0x8d, 0xfc, 0xae, 0x0b, 0x68, 0x85, 0x1b, 0x63, 0xb4, 0x17, 0x71, 0xd6, 0x30, 0xe8, 0xd7,
+	0xe1, 0xa9, 0xe0, 0x6b, 0xd3, 0x43, 0x0e, 0x4f, 0xff, 0x98, 0xe5, 0xe0, 0x80, 0x8c, 0x56, 0xe7,
+	0xcb, 0xea, 0x34, 0x5a, 0x87, 0xf0, 0x87, 0xa4, 0x11, 0xd6, 0xbd, 0x8f, 0x6e, 0x11, 0x45, 0x03,
+	0x3c, 0x9f, 0xc7, 0xdf, 0x01, 0x00, 0x5d, 0x78, 0x14, 0x58, 0x4f, 0x02, 0x00, 0x00,
 }
@@ -4,6 +4,9 @@
 package pb
 
 import (
+	"crypto/sha256"
+	"sort"
+
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/storj"
@@ -40,6 +43,22 @@ func NodesToIDs(nodes []*Node) storj.NodeIDList {
 	return ids
 }
 
+// PieceHashesRoot returns the root hash of the hashes of the pieces, which is
+// the SHA-256 hash of the piece hashes ordered by piece number
+func PieceHashesRoot(pieces []*RemotePiece) []byte {
+	sorted := make([]*RemotePiece, len(pieces))
+	copy(sorted, pieces)
+	sort.Slice(sorted, func(i, k int) bool {
+		return sorted[i].PieceNum < sorted[k].PieceNum
+	})
+
+	hash := sha256.New()
+	for _, piece := range sorted {
+		_, _ = hash.Write(piece.GetHash())
+	}
+	return hash.Sum(nil)
+}
+
 // CopyNode returns a deep copy of a node
 // It would be better to use `proto.Clone` but it is curently incompatible
 // with gogo's customtype extension.
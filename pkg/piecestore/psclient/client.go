@@ -7,6 +7,7 @@ import (
 	"bufio"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/sha256"
 	"flag"
 	"fmt"
 	"io"
@@ -45,7 +46,7 @@ func init() {
 // Client is an interface describing the functions for interacting with piecestore nodes
 type Client interface {
 	Meta(ctx context.Context, id PieceID) (*pb.PieceSummary, error)
-	Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error
+	Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (hash []byte, err error)
 	Get(ctx context.Context, id PieceID, size int64, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (ranger.Ranger, error)
 	Delete(ctx context.Context, pieceID PieceID, authorization *pb.SignedMessage) error
 	io.Closer
@@ -118,11 +119,12 @@ func (ps *PieceStore) Meta(ctx context.Context, id PieceID) (*pb.PieceSummary, e
 	return ps.client.Piece(ctx, &pb.PieceId{Id: id.String()})
 }
 
-// Put uploads a Piece to a piece store Server
-func (ps *PieceStore) Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) error {
+// Put uploads a Piece to a piece store Server and returns the SHA-256 hash
+// of the piece, the server verifies the piece against it
+func (ps *PieceStore) Put(ctx context.Context, id PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) (hash []byte, err error) {
 	stream, err := ps.client.Store(ctx)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	msg := &pb.PieceStore{
@@ -134,33 +136,53 @@ func (ps *PieceStore) Put(ctx context.Context, id PieceID, data io.Reader, ttl t
 			zap.S().Errorf("error closing stream %s :: %v.Send() = %v", closeErr, stream, closeErr)
 		}
 
-		return fmt.Errorf("%v.Send() = %v", stream, err)
+		return nil, fmt.Errorf("%v.Send() = %v", stream, err)
 	}
 
 	writer := &StreamWriter{signer: ps, stream: stream, pba: ba}
 
+	closed := false
 	defer func() {
+		if closed {
+			return
+		}
 		if err := writer.Close(); err != nil && err != io.EOF {
 			log.Printf("failed to close writer: %s\n", err)
 		}
 	}()
 
+	hasher := sha256.New()
 	bufw := bufio.NewWriterSize(writer, 32*1024)
 
-	_, err = io.Copy(bufw, data)
+	_, err = io.Copy(bufw, io.TeeReader(data, hasher))
 	if err == io.ErrUnexpectedEOF {
 		_ = writer.Close()
 		zap.S().Infof("Node cut from upload due to slow connection. Deleting piece %s...", id)
 		deleteErr := ps.Delete(ctx, id, authorization)
 		if deleteErr != nil {
-			return deleteErr
+			return nil, deleteErr
 		}
 	}
 	if err != nil {
-		return err
+		return nil, err
+	}
+
+	if err = bufw.Flush(); err != nil {
+		return nil, err
+	}
+
+	hash = hasher.Sum(nil)
+	if err = writer.sendHash(hash); err != nil {
+		return nil, err
+	}
+
+	// the piece is stored only when the server accepts its hash
+	closed = true
+	if err = writer.Close(); err != nil {
+		return nil, err
 	}
 
-	return bufw.Flush()
+	return hash, nil
 }
 
 // Get begins downloading a Piece from a piece store Server
@@ -58,6 +58,19 @@ func (s *StreamWriter) Write(b []byte) (int, error) {
 	return len(b), nil
 }
 
+// sendHash sends the hash of the written piece data
+func (s *StreamWriter) sendHash(hash []byte) error {
+	msg := &pb.PieceStore{
+		PieceData: &pb.PieceStore_PieceData{Hash: hash},
+	}
+
+	if err := s.stream.Send(msg); err != nil {
+		return fmt.Errorf("%v.Send() = %v", s.stream, err)
+	}
+
+	return nil
+}
+
 // Close the piece store Write Stream
 func (s *StreamWriter) Close() error {
 	reply, err := s.stream.CloseAndRecv()
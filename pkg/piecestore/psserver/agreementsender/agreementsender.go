@@ -30,11 +30,15 @@ type AgreementSender struct {
 	transport     transport.Client
 	kad           *kademlia.Kademlia
 	checkInterval time.Duration
+	batchSize     int
 }
 
 // New creates an Agreement Sender
-func New(log *zap.Logger, DB *psdb.DB, identity *provider.FullIdentity, kad *kademlia.Kademlia, checkInterval time.Duration) *AgreementSender {
-	return &AgreementSender{DB: DB, log: log, transport: transport.NewClient(identity), kad: kad, checkInterval: checkInterval}
+func New(log *zap.Logger, DB *psdb.DB, identity *provider.FullIdentity, kad *kademlia.Kademlia, checkInterval time.Duration, batchSize int) *AgreementSender {
+	if batchSize <= 0 {
+		batchSize = 1
+	}
+	return &AgreementSender{DB: DB, log: log, transport: transport.NewClient(identity), kad: kad, checkInterval: checkInterval, batchSize: batchSize}
 }
 
 // Run the agreement sender with a context to check for cancel
@@ -84,24 +88,73 @@ func (as *AgreementSender) sendAgreementsToSatellite(ctx context.Context, satID
 		}
 	}()
 
-	//todo:  stop sending these one-by-one, send all at once
-	for _, agreement := range agreements {
-		msg := &pb.RenterBandwidthAllocation{
+	stream, err := client.Settlement(ctx)
+	if err != nil {
+		as.log.Warn("Agreementsender could not start settlement with satellite", zap.Error(err))
+		return
+	}
+	defer func() {
+		if err := stream.CloseSend(); err != nil {
+			as.log.Warn("Agreementsender failed to close settlement", zap.Error(err))
+		}
+	}()
+
+	for len(agreements) > 0 {
+		batch := agreements
+		if len(batch) > as.batchSize {
+			batch = batch[:as.batchSize]
+		}
+		agreements = agreements[len(batch):]
+
+		if err := as.settleBatch(stream, batch); err != nil {
+			as.log.Warn("Agreementsender failed to send agreements to satellite : will retry", zap.Error(err))
+			return
+		}
+	}
+}
+
+// settleBatch sends the batch of agreements to the satellite and deletes the
+// agreements the satellite acknowledged
+func (as *AgreementSender) settleBatch(stream pb.Bandwidth_SettlementClient, batch []*psdb.Agreement) error {
+	req := &pb.SettlementRequest{}
+	for _, agreement := range batch {
+		req.Allocations = append(req.Allocations, &pb.RenterBandwidthAllocation{
 			Data:      agreement.Agreement,
 			Signature: agreement.Signature,
-		}
-		// Send agreement to satellite
-		r, err := client.BandwidthAgreements(ctx, msg)
-		if err != nil || r.GetStatus() == pb.AgreementsSummary_FAIL {
-			as.log.Warn("Agreementsender failed to send agreement to satellite : will retry", zap.Error(err))
-			continue
-		} else if r.GetStatus() == pb.AgreementsSummary_REJECTED {
+		})
+	}
+
+	if err := stream.Send(req); err != nil {
+		return ASError.Wrap(err)
+	}
+
+	resp, err := stream.Recv()
+	if err != nil {
+		return ASError.Wrap(err)
+	}
+
+	statuses := resp.GetStatuses()
+	if len(statuses) != len(batch) {
+		return ASError.New("expected %d statuses, got %d", len(batch), len(statuses))
+	}
+
+	var settled [][]byte
+	for i, status := range statuses {
+		switch status {
+		case pb.AgreementsSummary_OK:
+			settled = append(settled, batch[i].Signature)
+		case pb.AgreementsSummary_REJECTED:
 			//todo: something better than a delete here?
-			as.log.Error("Agreementsender had agreement explicitly rejected by satellite : will delete", zap.Error(err))
-		}
-		// Delete from PSDB by signature
-		if err = as.DB.DeleteBandwidthAllocationBySignature(agreement.Signature); err != nil {
-			as.log.Error("Agreementsender failed to delete bandwidth allocation", zap.Error(err))
+			as.log.Error("Agreementsender had agreement explicitly rejected by satellite : will delete")
+			settled = append(settled, batch[i].Signature)
+		default:
+			// failed agreements are sent again
 		}
 	}
+
+	// Delete from PSDB by signature
+	if err := as.DB.DeleteBandwidthAllocationsBySignature(settled); err != nil {
+		as.log.Error("Agreementsender failed to delete bandwidth allocations", zap.Error(err))
+	}
+	return nil
 }
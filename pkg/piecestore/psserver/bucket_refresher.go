@@ -19,16 +19,18 @@ var (
 	Error = errs.Class("kademlia bucket refresher error")
 )
 
-// refreshService contains the information needed to run the bucket refresher service
-type refreshService struct {
+// RefreshService contains the information needed to run the bucket refresher service
+type RefreshService struct {
 	log    *zap.Logger
 	ticker *time.Ticker
 	rt     *kademlia.RoutingTable
 	server *Server
 }
 
-func newService(log *zap.Logger, interval time.Duration, rt *kademlia.RoutingTable, server *Server) *refreshService {
-	return &refreshService{
+// NewRefreshService creates the service advertising the free disk space and
+// bandwidth of the server in the routing table every interval
+func NewRefreshService(log *zap.Logger, interval time.Duration, rt *kademlia.RoutingTable, server *Server) *RefreshService {
+	return &RefreshService{
 		log:    log,
 		ticker: time.NewTicker(interval),
 		rt:     rt,
@@ -37,7 +39,7 @@ func newService(log *zap.Logger, interval time.Duration, rt *kademlia.RoutingTab
 }
 
 // Run runs the bucket refresher service
-func (service *refreshService) Run(ctx context.Context) {
+func (service *RefreshService) Run(ctx context.Context) {
 	for {
 		err := service.process(ctx)
 		if err != nil {
@@ -53,7 +55,7 @@ func (service *refreshService) Run(ctx context.Context) {
 }
 
 // process will attempt to update the kademlia bucket with the latest information about the storage node
-func (service *refreshService) process(ctx context.Context) error {
+func (service *RefreshService) process(ctx context.Context) error {
 	stats, err := service.server.Stats(ctx, nil)
 	if err != nil {
 		return Error.Wrap(err)
@@ -61,9 +63,10 @@ func (service *refreshService) process(ctx context.Context) error {
 
 	self := service.rt.Local()
 
+	// nodes which used more than allocated have nothing left
 	self.Restrictions = &pb.NodeRestrictions{
-		FreeBandwidth: stats.AvailableBandwidth,
-		FreeDisk:      stats.AvailableSpace,
+		FreeBandwidth: nonNegative(stats.AvailableBandwidth),
+		FreeDisk:      nonNegative(stats.AvailableSpace),
 	}
 
 	// Update the routing table with latest restrictions
@@ -73,3 +76,10 @@ func (service *refreshService) process(ctx context.Context) error {
 
 	return nil
 }
+
+func nonNegative(value int64) int64 {
+	if value < 0 {
+		return 0
+	}
+	return value
+}
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log"
 	"path/filepath"
+	"strings"
 	"time"
 
 	"go.uber.org/zap"
@@ -19,7 +20,10 @@ import (
 	pstore "storj.io/storj/pkg/piecestore"
 	"storj.io/storj/pkg/piecestore/psserver/agreementsender"
 	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/piecestore/psserver/webdashboard"
 	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
 )
 
 var (
@@ -33,6 +37,13 @@ type Config struct {
 	AllocatedBandwidth           memory.Size   `user:"true" help:"total allocated bandwidth in bytes" default:"500GiB"`
 	KBucketRefreshInterval       time.Duration `help:"how frequently Kademlia bucket should be refreshed with node stats" default:"1h0m0s"`
 	AgreementSenderCheckInterval time.Duration `help:"duration between agreement checks" default:"1h0m0s"`
+	AgreementSenderBatchSize     int           `help:"number of agreements sent to a satellite at once" default:"1000"`
+	TrustedSatellites            string        `help:"comma separated list of trusted satellites as <id>@<address>, satellites without an address are looked up with kademlia" default:""`
+	TrustUnknownSatellites       bool          `help:"accept the bandwidth allocations of satellites which aren't trusted" default:"false"`
+	RetainTimeBuffer             time.Duration `help:"how long before the creation of a garbage collection filter pieces must have been stored to be deleted" default:"48h0m0s"`
+	SatelliteAllocations         string        `user:"true" help:"comma separated list of storage caps of satellites as <id>:<size>, satellites without a cap can use all of the allocated disk space" default:""`
+
+	Dashboard webdashboard.Config
 }
 
 // Run implements provider.Responsibility
@@ -40,7 +51,10 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 	defer mon.Task()(&ctx)(&err)
 
 	// piecestore Storage Driver
-	storage := pstore.NewStorage(filepath.Join(c.Path, "piece-store-data"))
+	storage, err := pstore.NewStorage(filepath.Join(c.Path, "piece-store-data"))
+	if err != nil {
+		return ServerError.Wrap(err)
+	}
 
 	db, err := psdb.Open(ctx, storage, filepath.Join(c.Path, "piecestore.db"))
 	if err != nil {
@@ -53,8 +67,14 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 		return ServerError.New("Failed to load Kademlia from context")
 	}
 
+	transportClient := transport.NewClient(server.Identity())
+	trust, err := NewTrustedSatellites(zap.L(), c.TrustedSatellites, c.TrustUnknownSatellites, kad, transportClient)
+	if err != nil {
+		return err
+	}
+
 	// Initialize piecestore server struct
-	s, err := NewEndpoint(zap.L(), c, storage, db, server.Identity().Key, kad)
+	s, err := NewEndpoint(zap.L(), c, storage, db, server.Identity().Key, kad, trust)
 	if err != nil {
 		return err
 	}
@@ -77,13 +97,54 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) (err error)
 	}
 
 	// Initialize Refresh process for updating storage node meta in kademlia
-	refreshProcess := newService(zap.L(), c.KBucketRefreshInterval, krt, s)
+	refreshProcess := NewRefreshService(zap.L(), c.KBucketRefreshInterval, krt, s)
 	go refreshProcess.Run(ctx)
 
 	// Initialize agreementsender process for sending received bandwidth agreements to satellites
-	agreementSender := agreementsender.New(zap.L(), s.DB, server.Identity(), kad, c.AgreementSenderCheckInterval)
+	agreementSender := agreementsender.New(zap.L(), s.DB, server.Identity(), kad, c.AgreementSenderCheckInterval, c.AgreementSenderBatchSize)
 	go agreementSender.Run(ctx)
 
+	if c.Dashboard.Address != "" {
+		dashboard := webdashboard.NewServer(zap.L().Named("webdashboard"), c.Dashboard, s.DB, trust, transportClient, webdashboard.Allocation{
+			Space:     s.totalAllocated,
+			Bandwidth: s.totalBwAllocated,
+		})
+		go func() {
+			if err := dashboard.Run(ctx); err != nil {
+				zap.L().Error("web dashboard failed", zap.Error(err))
+			}
+		}()
+	}
+
 	s.log.Info("Started Node", zap.String("ID", fmt.Sprint(server.Identity().ID)))
 	return server.Run(ctx)
 }
+
+// parseSatelliteAllocations parses a comma separated list of <id>:<size>
+// storage caps of satellites
+func parseSatelliteAllocations(list string) (map[storj.NodeID]int64, error) {
+	allocations := map[storj.NodeID]int64{}
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		i := strings.LastIndex(entry, ":")
+		if i < 0 {
+			return nil, ServerError.New("invalid satellite allocation %q: missing size", entry)
+		}
+
+		id, err := storj.NodeIDFromString(entry[:i])
+		if err != nil {
+			return nil, ServerError.New("invalid satellite allocation %q: %v", entry, err)
+		}
+
+		var size memory.Size
+		if err := size.Set(entry[i+1:]); err != nil {
+			return nil, ServerError.New("invalid satellite allocation %q: %v", entry, err)
+		}
+		allocations[id] = size.Int64()
+	}
+	return allocations, nil
+}
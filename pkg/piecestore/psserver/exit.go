@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"crypto/sha256"
+	"io"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psclient"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/pkg/utils"
+)
+
+// ExitError is the error class of graceful exits
+var ExitError = errs.Class("graceful exit error")
+
+// Exit leaves the satellite gracefully: the node fetches the pieces it stores
+// for the satellite together with their new nodes, uploads the pieces to them
+// and deletes the pieces the satellite verified. An interrupted exit is
+// continued by calling Exit again. progress is called with the progress of
+// the exit reported by the satellite after every piece.
+func (s *Server) Exit(ctx context.Context, satellite *pb.Node, tc transport.Client, progress func(*pb.ExitProgress)) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	conn, err := tc.DialNode(ctx, satellite)
+	if err != nil {
+		return ExitError.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	client := pb.NewGracefulExitClient(conn)
+
+	status, err := client.Initiate(ctx, &pb.InitiateRequest{})
+	if err != nil {
+		return ExitError.Wrap(err)
+	}
+	progress(status)
+
+	for {
+		// the satellite returns the transfers, which results weren't
+		// reported, first and continues with the pieces it didn't list yet
+		resp, err := client.Transfers(ctx, &pb.TransfersRequest{})
+		if err != nil {
+			return ExitError.Wrap(err)
+		}
+
+		for _, transfer := range resp.GetTransfers() {
+			localID, hash, transferErr := s.transferPiece(ctx, tc, transfer)
+			if transferErr != nil {
+				s.log.Warn("failed to transfer piece",
+					zap.String("path", transfer.GetPath()), zap.Int32("piece", transfer.GetPieceNum()), zap.Error(transferErr))
+			}
+
+			transferred, err := client.Transferred(ctx, &pb.TransferResult{
+				Path:     transfer.GetPath(),
+				PieceNum: transfer.GetPieceNum(),
+				TargetId: transfer.GetTarget().Id,
+				Hash:     hash,
+				Failed:   transferErr != nil,
+			})
+			if err != nil {
+				return ExitError.Wrap(err)
+			}
+			progress(transferred.GetProgress())
+
+			// the piece is kept until the satellite moved it to the new node
+			if transferred.GetVerified() {
+				if err := s.deleteByID(localID); err != nil {
+					s.log.Error("failed to delete transferred piece", zap.String("Piece ID", localID), zap.Error(err))
+				}
+			}
+		}
+
+		if !resp.GetMore() {
+			break
+		}
+	}
+
+	status, err = client.Complete(ctx, &pb.CompleteRequest{})
+	if err != nil {
+		return ExitError.Wrap(err)
+	}
+	progress(status)
+
+	return nil
+}
+
+// transferPiece uploads the local piece of the transfer to its target node
+// and returns the id of the local piece and the hash the target verified
+func (s *Server) transferPiece(ctx context.Context, tc transport.Client, transfer *pb.PieceTransfer) (localID string, hash []byte, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pieceID := psclient.PieceID(transfer.GetPieceId())
+	target := transfer.GetTarget()
+
+	derivedID, err := pieceID.Derive(tc.Identity().ID.Bytes())
+	if err != nil {
+		return "", nil, ExitError.Wrap(err)
+	}
+
+	localID, err = getNamespacedPieceID([]byte(derivedID.String()), getNamespace(transfer.GetAuthorization()))
+	if err != nil {
+		return "", nil, ExitError.Wrap(err)
+	}
+
+	targetID, err := pieceID.Derive(target.Id.Bytes())
+	if err != nil {
+		return "", nil, ExitError.Wrap(err)
+	}
+
+	size, err := s.storage.Size(localID)
+	if err != nil {
+		return "", nil, ExitError.Wrap(err)
+	}
+
+	reader, err := s.storage.Reader(ctx, localID, 0, -1)
+	if err != nil {
+		return "", nil, ExitError.Wrap(err)
+	}
+	defer utils.LogClose(reader)
+
+	// corrupted pieces aren't spread to other nodes
+	var data io.Reader = reader
+	pieceHash, err := s.DB.GetPieceHash(localID)
+	if err != nil {
+		return "", nil, ExitError.Wrap(err)
+	}
+	if pieceHash != nil {
+		data = &verifyingReader{
+			reader:    reader,
+			remaining: size,
+			hasher:    sha256.New(),
+			expected:  pieceHash,
+		}
+	}
+
+	ps, err := psclient.NewPSClient(ctx, tc, target, 0)
+	if err != nil {
+		return "", nil, ExitError.Wrap(err)
+	}
+	defer utils.LogClose(ps)
+
+	var expiration time.Time
+	if transfer.GetExpirationDate() != nil {
+		expiration, err = ptypes.Timestamp(transfer.GetExpirationDate())
+		if err != nil {
+			return "", nil, ExitError.Wrap(err)
+		}
+	}
+
+	hash, err = ps.Put(ctx, targetID, data, expiration, transfer.GetPayerAllocation(), transfer.GetAuthorization())
+	if err != nil {
+		return "", nil, ExitError.Wrap(err)
+	}
+
+	return localID, hash, nil
+}
@@ -0,0 +1,165 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/vivint/infectious"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/internal/testplanet"
+	"storj.io/storj/pkg/eestream"
+	"storj.io/storj/pkg/pb"
+	ecclient "storj.io/storj/pkg/storage/ec"
+	"storj.io/storj/pkg/storage/segments"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+	"storj.io/storj/storagenode"
+)
+
+// TestAPIKey is the api key of the satellite pointerdb
+const TestAPIKey = "test-api-key"
+
+func TestExit(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	planet, err := testplanet.New(t, 1, 6, 1)
+	require.NoError(t, err)
+	defer ctx.Check(planet.Shutdown)
+
+	planet.Start(ctx)
+
+	// we wait for the satellite to discover the storage nodes
+	time.Sleep(2 * time.Second)
+
+	// TODO: use a better way for configuring the api key of the satellite
+	require.NoError(t, flag.Set("pointer-db.auth.api-key", TestAPIKey))
+
+	satellite := planet.Satellites[0]
+	reportRestrictions(ctx, t, planet)
+
+	pdb, err := planet.Uplinks[0].DialPointerDB(satellite, TestAPIKey)
+	require.NoError(t, err)
+
+	path := uploadSegment(ctx, t, planet)
+
+	pointer, _, _, err := pdb.Get(ctx, path)
+	require.NoError(t, err)
+	piece := pointer.GetRemote().GetRemotePieces()[0]
+	exiting := storageNode(t, planet, piece.NodeId)
+
+	var last *pb.ExitProgress
+	err = exiting.Piecestore.Exit(ctx, &satellite.Info, transport.NewClient(exiting.Identity), func(progress *pb.ExitProgress) {
+		last = progress
+	})
+	require.NoError(t, err)
+
+	require.NotNil(t, last)
+	assert.True(t, last.GetExited())
+	assert.Equal(t, int64(1), last.GetPiecesTransferred())
+	assert.Equal(t, int64(0), last.GetPiecesFailed())
+
+	// the piece was moved to its new node
+	pointer, _, _, err = pdb.Get(ctx, path)
+	require.NoError(t, err)
+
+	var target storj.NodeID
+	for _, p := range pointer.GetRemote().GetRemotePieces() {
+		assert.NotEqual(t, exiting.Identity.ID, p.NodeId)
+		if p.PieceNum == piece.PieceNum {
+			target = p.NodeId
+		}
+	}
+	require.False(t, target.IsZero())
+
+	stats, err := storageNode(t, planet, target).Piecestore.Stats(ctx, nil)
+	require.NoError(t, err)
+	assert.NotZero(t, stats.GetUsedSpace())
+
+	// and deleted from the exiting node
+	stats, err = exiting.Piecestore.Stats(ctx, nil)
+	require.NoError(t, err)
+	assert.Zero(t, stats.GetUsedSpace())
+
+	// the exit can't be repeated
+	err = exiting.Piecestore.Exit(ctx, &satellite.Info, transport.NewClient(exiting.Identity), func(*pb.ExitProgress) {})
+	assert.Error(t, err)
+}
+
+// uploadSegment uploads a remote segment with random data through the
+// uplink of the planet and returns its path
+func uploadSegment(ctx context.Context, t *testing.T, planet *testplanet.Planet) storj.Path {
+	uplink, satellite := planet.Uplinks[0], planet.Satellites[0]
+
+	oc, err := uplink.DialOverlay(satellite)
+	require.NoError(t, err)
+
+	pdb, err := uplink.DialPointerDB(satellite, TestAPIKey)
+	require.NoError(t, err)
+
+	fc, err := infectious.NewFEC(2, 4)
+	require.NoError(t, err)
+
+	rs, err := eestream.NewRedundancyStrategy(eestream.NewRSScheme(fc, int(1*memory.KB)), 3, 4)
+	require.NoError(t, err)
+
+	store := segments.NewSegmentStore(oc, ecclient.NewClient(uplink.Identity, 0), pdb, rs, int(1*memory.KB))
+
+	data := make([]byte, 10*memory.KB)
+	_, err = rand.Read(data)
+	require.NoError(t, err)
+
+	// the pointerdb client gets the authorization of the uploads from the
+	// first pointer it gets
+	inline := storj.Path("l/bucket/inline")
+	_, err = store.Put(ctx, bytes.NewReader([]byte("inline")), time.Time{}, func() (storj.Path, []byte, error) {
+		return inline, nil, nil
+	})
+	require.NoError(t, err)
+	_, _, _, err = pdb.Get(ctx, inline)
+	require.NoError(t, err)
+
+	path := storj.Path("l/bucket/object")
+	_, err = store.Put(ctx, bytes.NewReader(data), time.Time{}, func() (storj.Path, []byte, error) {
+		return path, nil, nil
+	})
+	require.NoError(t, err)
+
+	return path
+}
+
+// reportRestrictions updates the free space of the storage nodes in the
+// overlay of the satellite, which they didn't report before their first
+// refresh
+func reportRestrictions(ctx context.Context, t *testing.T, planet *testplanet.Planet) {
+	for _, node := range planet.StorageNodes {
+		local := node.Local()
+		local.Restrictions = &pb.NodeRestrictions{
+			FreeBandwidth: memory.TB.Int64(),
+			FreeDisk:      memory.TB.Int64(),
+		}
+		require.NoError(t, planet.Satellites[0].Overlay.Put(ctx, local.Id, local))
+	}
+}
+
+// storageNode returns the storage node of the planet with the id
+func storageNode(t *testing.T, planet *testplanet.Planet, id storj.NodeID) *storagenode.Peer {
+	for _, node := range planet.StorageNodes {
+		if node.Identity.ID == id {
+			return node
+		}
+	}
+	t.Fatalf("storage node %s not found", id)
+	return nil
+}
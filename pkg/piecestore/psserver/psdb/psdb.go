@@ -11,6 +11,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"sort"
 	"sync"
 	"time"
 
@@ -126,6 +127,56 @@ func (db *DB) init() (err error) {
 		return err
 	}
 
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `used_serials` (`satellite` BLOB, `serial_number` TEXT, `expires` INT(10), PRIMARY KEY (`satellite`, `serial_number`));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE INDEX IF NOT EXISTS idx_used_serials_expires ON used_serials (expires);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `piece_hashes` (`id` BLOB UNIQUE, `hash` BLOB);")
+	if err != nil {
+		return err
+	}
+
+	// the pieces stored before the satellites were recorded aren't garbage
+	// collected, the node can't tell which satellite they belong to
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_pieces` (`id` BLOB UNIQUE, `satellite` BLOB, `piece_id` TEXT);")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE INDEX IF NOT EXISTS idx_satellite_pieces_satellite ON satellite_pieces (satellite);")
+	if err != nil {
+		return err
+	}
+
+	// the bandwidth used before the satellites were recorded is only in
+	// bwusagetbl
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `satellite_bandwidth` (`satellite` BLOB, `daystartdate` INT(10), `size` INT(10), PRIMARY KEY (`satellite`, `daystartdate`));")
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec("CREATE TABLE IF NOT EXISTS `usage_counters` (`id` INTEGER PRIMARY KEY, `used_space` INT(10) NOT NULL, `used_bandwidth` INT(10) NOT NULL, `bandwidth_month` INT(10) NOT NULL);")
+	if err != nil {
+		return err
+	}
+
+	// databases of earlier versions start counting from the recorded pieces
+	// and bandwidth usage
+	month := beginningOfMonth(time.Now())
+	_, err = tx.Exec(`INSERT OR IGNORE INTO usage_counters (id, used_space, used_bandwidth, bandwidth_month) VALUES (1,
+		(SELECT COALESCE(SUM(size), 0) FROM ttl),
+		(SELECT COALESCE(SUM(size), 0) FROM bwusagetbl WHERE ? <= daystartdate),
+		?)`, month, month)
+	if err != nil {
+		return err
+	}
+
 	err = tx.Commit()
 	if err != nil {
 		return err
@@ -151,66 +202,96 @@ func (db *DB) locked() func() {
 func (db *DB) DeleteExpired(ctx context.Context) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var expired []string
-	err = func() error {
+	expired, err := func() (expired []string, err error) {
 		defer db.locked()()
 
-		tx, err := db.DB.BeginTx(ctx, nil)
-		if err != nil {
-			return err
-		}
-		defer func() { _ = tx.Rollback() }()
-
-		now := time.Now().Unix()
-
-		rows, err := tx.Query("SELECT id FROM ttl WHERE 0 < expires AND ? < expires", now)
+		rows, err := db.DB.QueryContext(ctx, "SELECT id FROM ttl WHERE 0 < expires AND expires < ?", time.Now().Unix())
 		if err != nil {
-			return err
+			return nil, err
 		}
+		defer func() { err = errs.Combine(err, rows.Close()) }()
 
 		for rows.Next() {
 			var id string
 			if err := rows.Scan(&id); err != nil {
-				return err
+				return nil, err
 			}
 			expired = append(expired, id)
 		}
-		if err := rows.Close(); err != nil {
-			return err
-		}
-
-		_, err = tx.Exec(`DELETE FROM ttl WHERE 0 < expires AND ? < expires`, now)
-		if err != nil {
-			return err
-		}
-
-		return tx.Commit()
+		return expired, rows.Err()
 	}()
-
-	if db.storage != nil {
-		var errlist errs.Group
-		for _, id := range expired {
-			errlist.Add(db.storage.Delete(id))
-		}
-
-		if len(errlist) > 0 {
-			return errlist.Err()
-		}
+	if err != nil {
+		return err
 	}
 
-	return nil
+	var errlist errs.Group
+	for _, id := range expired {
+		id := id
+		errlist.Add(db.DeletePiece(id, func() error {
+			if db.storage == nil {
+				return nil
+			}
+			return db.storage.Delete(id)
+		}))
+	}
+	return errlist.Err()
 }
 
-// garbageCollect will periodically run DeleteExpired
+// garbageCollect will periodically run DeleteExpired, DeleteExpiredSerialNumbers
+// and collect the pieces whose deletion failed
 func (db *DB) garbageCollect(ctx context.Context) {
 	for range db.check.C {
 		err := db.DeleteExpired(ctx)
 		if err != nil {
 			zap.S().Errorf("failed checking entries: %+v", err)
 		}
+
+		err = db.DeleteExpiredSerialNumbers(ctx, time.Now())
+		if err != nil {
+			zap.S().Errorf("failed deleting expired serial numbers: %+v", err)
+		}
+
+		if db.storage != nil {
+			err = db.storage.GarbageCollect(ctx)
+			if err != nil {
+				zap.S().Errorf("failed collecting deleted pieces: %+v", err)
+			}
+		}
 	}
 }
 
+// AddSerialNumber records the serial number of a bandwidth allocation of
+// the satellite until the allocation expires, it fails when the serial
+// number was already used
+func (db *DB) AddSerialNumber(satelliteID storj.NodeID, serialNumber string, expiration int64) error {
+	defer db.locked()()
+
+	result, err := db.DB.Exec(`INSERT OR IGNORE INTO used_serials (satellite, serial_number, expires) VALUES (?, ?, ?)`, satelliteID.Bytes(), serialNumber, expiration)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	added, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if added == 0 {
+		return Error.New("serial number %q of satellite %s already used", serialNumber, satelliteID)
+	}
+
+	return nil
+}
+
+// DeleteExpiredSerialNumbers forgets the serial numbers of the allocations
+// which expired before now, they can't be used anymore anyway
+func (db *DB) DeleteExpiredSerialNumbers(ctx context.Context, now time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer db.locked()()
+
+	_, err = db.DB.Exec(`DELETE FROM used_serials WHERE expires < ?`, now.Unix())
+	return err
+}
+
 // WriteBandwidthAllocToDB -- Insert bandwidth agreement into DB
 func (db *DB) WriteBandwidthAllocToDB(ba *pb.RenterBandwidthAllocation) error {
 	defer db.locked()()
@@ -244,6 +325,27 @@ func (db *DB) DeleteBandwidthAllocationBySignature(signature []byte) error {
 	return err
 }
 
+// DeleteBandwidthAllocationsBySignature deletes the allocations by signature
+// in one transaction
+func (db *DB) DeleteBandwidthAllocationsBySignature(signatures [][]byte) error {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	for _, signature := range signatures {
+		_, err = tx.Exec(`DELETE FROM bandwidth_agreements WHERE signature=?`, signature)
+		if err != nil {
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
+
 // GetBandwidthAllocationBySignature finds allocation info by signature
 func (db *DB) GetBandwidthAllocationBySignature(signature []byte) ([][]byte, error) {
 	defer db.locked()()
@@ -305,12 +407,38 @@ func (db *DB) GetBandwidthAllocations() (map[storj.NodeID][]*Agreement, error) {
 	return agreements, nil
 }
 
-// AddTTL adds TTL into database by id
+// AddTTL adds TTL into database by id and counts the size of the piece as
+// used space
 func (db *DB) AddTTL(id string, expiration, size int64) error {
 	defer db.locked()()
 
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err = addTTL(tx, id, expiration, size); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func addTTL(tx *sql.Tx, id string, expiration, size int64) error {
+	var previous int64
+	err := tx.QueryRow(`SELECT COALESCE(size, 0) FROM ttl WHERE id=?`, id).Scan(&previous)
+	if err != nil && err != sql.ErrNoRows {
+		return err
+	}
+
 	created := time.Now().Unix()
-	_, err := db.DB.Exec("INSERT OR REPLACE INTO ttl (id, created, expires, size) VALUES (?, ?, ?, ?)", id, created, expiration, size)
+	_, err = tx.Exec("INSERT OR REPLACE INTO ttl (id, created, expires, size) VALUES (?, ?, ?, ?)", id, created, expiration, size)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`UPDATE usage_counters SET used_space = used_space + ? WHERE id = 1`, size-previous)
 	return err
 }
 
@@ -341,41 +469,365 @@ func (db *DB) SumTTLSizes() (sum int64, err error) {
 	return sum, err
 }
 
-// DeleteTTLByID finds the TTL in the database by id and delete it
+// DeleteTTLByID finds the TTL in the database by id and delete it, the size
+// of the piece isn't counted as used space anymore
 func (db *DB) DeleteTTLByID(id string) error {
 	defer db.locked()()
 
-	_, err := db.DB.Exec(`DELETE FROM ttl WHERE id=?`, id)
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err = deleteTTL(tx, id); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+func deleteTTL(tx *sql.Tx, id string) error {
+	var size int64
+	err := tx.QueryRow(`SELECT COALESCE(size, 0) FROM ttl WHERE id=?`, id).Scan(&size)
 	if err == sql.ErrNoRows {
-		err = nil
+		return nil
 	}
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM ttl WHERE id=?`, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`UPDATE usage_counters SET used_space = used_space - ? WHERE id = 1`, size)
 	return err
 }
 
-// AddBandwidthUsed adds bandwidth usage into database by date
-func (db *DB) AddBandwidthUsed(size int64) (err error) {
+// StoredPiece is a piece stored by the node
+type StoredPiece struct {
+	// ID is the id of the piece on the node
+	ID         string
+	Expiration int64
+	Size       int64
+	Hash       []byte
+	// SatelliteID is the satellite the piece is stored for, if it's known,
+	// and SatellitePieceID the id the satellite knows the piece by
+	SatelliteID      storj.NodeID
+	SatellitePieceID string
+}
+
+// AddPiece records a stored piece and counts its size as used space. The
+// data of the piece is committed by commit in the same transaction, nothing
+// is recorded when it fails.
+func (db *DB) AddPiece(piece StoredPiece, commit func() error) error {
 	defer db.locked()()
 
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err = addTTL(tx, piece.ID, piece.Expiration, piece.Size); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`INSERT OR REPLACE INTO piece_hashes (id, hash) VALUES (?, ?)`, piece.ID, piece.Hash)
+	if err != nil {
+		return err
+	}
+
+	if !piece.SatelliteID.IsZero() {
+		_, err = tx.Exec(`INSERT OR REPLACE INTO satellite_pieces (id, satellite, piece_id) VALUES (?, ?, ?)`, piece.ID, piece.SatelliteID.Bytes(), piece.SatellitePieceID)
+		if err != nil {
+			return err
+		}
+	}
+
+	if err = commit(); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// DeletePiece deletes the records of a piece, its size isn't counted as used
+// space anymore. The data of the piece is deleted by remove in the same
+// transaction, the records are kept when it fails.
+func (db *DB) DeletePiece(id string, remove func() error) error {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if err = deleteTTL(tx, id); err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM piece_hashes WHERE id=?`, id)
+	if err != nil {
+		return err
+	}
+
+	_, err = tx.Exec(`DELETE FROM satellite_pieces WHERE id=?`, id)
+	if err != nil {
+		return err
+	}
+
+	if err = remove(); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// AddPieceHash stores the hash of the piece by id
+func (db *DB) AddPieceHash(id string, hash []byte) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`INSERT OR REPLACE INTO piece_hashes (id, hash) VALUES (?, ?)`, id, hash)
+	return err
+}
+
+// GetPieceHash returns the hash of the piece by id, it's nil for the pieces
+// stored before their hashes were recorded
+func (db *DB) GetPieceHash(id string) (hash []byte, err error) {
+	defer db.locked()()
+
+	err = db.DB.QueryRow(`SELECT hash FROM piece_hashes WHERE id=?`, id).Scan(&hash)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	return hash, err
+}
+
+// DeletePieceHash deletes the hash of the piece by id
+func (db *DB) DeletePieceHash(id string) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`DELETE FROM piece_hashes WHERE id=?`, id)
+	return err
+}
+
+// SatellitePiece is a piece stored for a satellite
+type SatellitePiece struct {
+	// ID is the id of the piece on the node
+	ID string
+	// PieceID is the id of the piece the satellite knows it by
+	PieceID string
+}
+
+// AddSatellitePiece records the satellite of the piece by id and the id the
+// satellite knows the piece by
+func (db *DB) AddSatellitePiece(id string, satelliteID storj.NodeID, pieceID string) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`INSERT OR REPLACE INTO satellite_pieces (id, satellite, piece_id) VALUES (?, ?, ?)`, id, satelliteID.Bytes(), pieceID)
+	return err
+}
+
+// GetSatellitePieces returns the pieces of the satellite stored before the
+// time
+func (db *DB) GetSatellitePieces(ctx context.Context, satelliteID storj.NodeID, createdBefore time.Time) (pieces []SatellitePiece, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer db.locked()()
+
+	rows, err := db.DB.QueryContext(ctx, `
+		SELECT satellite_pieces.id, satellite_pieces.piece_id
+		FROM satellite_pieces
+		JOIN ttl ON ttl.id = satellite_pieces.id
+		WHERE satellite_pieces.satellite = ? AND ttl.created < ?`,
+		satelliteID.Bytes(), createdBefore.Unix())
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var piece SatellitePiece
+		if err := rows.Scan(&piece.ID, &piece.PieceID); err != nil {
+			return nil, err
+		}
+		pieces = append(pieces, piece)
+	}
+	return pieces, rows.Err()
+}
+
+// DeleteSatellitePiece deletes the satellite of the piece by id
+func (db *DB) DeleteSatellitePiece(id string) error {
+	defer db.locked()()
+
+	_, err := db.DB.Exec(`DELETE FROM satellite_pieces WHERE id=?`, id)
+	return err
+}
+
+// SatelliteUsage is the data stored and the bandwidth used for a satellite
+type SatelliteUsage struct {
+	SatelliteID   storj.NodeID
+	Pieces        int64
+	UsedSpace     int64
+	UsedBandwidth int64
+}
+
+// GetSatelliteUsage returns the number and the size of the pieces stored and
+// the bandwidth used in the month of now for every satellite. Pieces stored
+// and bandwidth used before their satellite was recorded aren't counted.
+func (db *DB) GetSatelliteUsage(ctx context.Context, now time.Time) (usage []SatelliteUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer db.locked()()
+
+	bySatellite := map[storj.NodeID]*SatelliteUsage{}
+	get := func(satellite []byte) (*SatelliteUsage, error) {
+		id, err := storj.NodeIDFromBytes(satellite)
+		if err != nil {
+			return nil, err
+		}
+		item, ok := bySatellite[id]
+		if !ok {
+			item = &SatelliteUsage{SatelliteID: id}
+			bySatellite[id] = item
+		}
+		return item, nil
+	}
+
+	err = func() (err error) {
+		rows, err := db.DB.QueryContext(ctx, `
+			SELECT satellite_pieces.satellite, COUNT(*), COALESCE(SUM(ttl.size), 0)
+			FROM satellite_pieces
+			JOIN ttl ON ttl.id = satellite_pieces.id
+			GROUP BY satellite_pieces.satellite`)
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, rows.Close()) }()
+
+		for rows.Next() {
+			var satellite []byte
+			var pieces, usedSpace int64
+			if err := rows.Scan(&satellite, &pieces, &usedSpace); err != nil {
+				return err
+			}
+			item, err := get(satellite)
+			if err != nil {
+				return err
+			}
+			item.Pieces, item.UsedSpace = pieces, usedSpace
+		}
+		return rows.Err()
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	err = func() (err error) {
+		rows, err := db.DB.QueryContext(ctx, `
+			SELECT satellite, COALESCE(SUM(size), 0)
+			FROM satellite_bandwidth
+			WHERE daystartdate >= ?
+			GROUP BY satellite`, beginningOfMonth(now))
+		if err != nil {
+			return err
+		}
+		defer func() { err = errs.Combine(err, rows.Close()) }()
+
+		for rows.Next() {
+			var satellite []byte
+			var usedBandwidth int64
+			if err := rows.Scan(&satellite, &usedBandwidth); err != nil {
+				return err
+			}
+			item, err := get(satellite)
+			if err != nil {
+				return err
+			}
+			item.UsedBandwidth = usedBandwidth
+		}
+		return rows.Err()
+	}()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range bySatellite {
+		usage = append(usage, *item)
+	}
+	sort.Slice(usage, func(i, k int) bool {
+		return usage[i].SatelliteID.Less(usage[k].SatelliteID)
+	})
+	return usage, nil
+}
+
+// SatelliteUsedSpace returns the size of the pieces stored for the satellite
+func (db *DB) SatelliteUsedSpace(satelliteID storj.NodeID) (usedSpace int64, err error) {
+	defer db.locked()()
+
+	err = db.DB.QueryRow(`
+		SELECT COALESCE(SUM(ttl.size), 0)
+		FROM satellite_pieces
+		JOIN ttl ON ttl.id = satellite_pieces.id
+		WHERE satellite_pieces.satellite = ?`, satelliteID.Bytes()).Scan(&usedSpace)
+	return usedSpace, err
+}
+
+// AddBandwidthUsed adds bandwidth usage into database by date and to the
+// bandwidth used in the current month. The usage is also recorded for the
+// satellite unless its id is zero.
+func (db *DB) AddBandwidthUsed(satelliteID storj.NodeID, size int64) (err error) {
+	defer db.locked()()
+
+	tx, err := db.DB.Begin()
+	if err != nil {
+		return err
+	}
+	defer func() { _ = tx.Rollback() }()
+
 	t := time.Now()
 	daystartunixtime := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location()).Unix()
 	dayendunixtime := time.Date(t.Year(), t.Month(), t.Day(), 24, 0, 0, 0, t.Location()).Unix()
 
 	var getSize int64
-	if (t.Unix() >= daystartunixtime) && (t.Unix() <= dayendunixtime) {
-		err = db.DB.QueryRow(`SELECT size FROM bwusagetbl WHERE daystartdate <= ? AND ? <= dayenddate`, t.Unix(), t.Unix()).Scan(&getSize)
-		switch {
-		case err == sql.ErrNoRows:
-			_, err = db.DB.Exec("INSERT INTO bwusagetbl (size, daystartdate, dayenddate) VALUES (?, ?, ?)", size, daystartunixtime, dayendunixtime)
-			return err
-		case err != nil:
+	err = tx.QueryRow(`SELECT size FROM bwusagetbl WHERE daystartdate <= ? AND ? <= dayenddate`, t.Unix(), t.Unix()).Scan(&getSize)
+	switch {
+	case err == sql.ErrNoRows:
+		_, err = tx.Exec("INSERT INTO bwusagetbl (size, daystartdate, dayenddate) VALUES (?, ?, ?)", size, daystartunixtime, dayendunixtime)
+	case err != nil:
+		return err
+	default:
+		getSize = size + getSize
+		_, err = tx.Exec("UPDATE bwusagetbl SET size = ? WHERE daystartdate = ?", getSize, daystartunixtime)
+	}
+	if err != nil {
+		return err
+	}
+
+	if !satelliteID.IsZero() {
+		_, err = tx.Exec(`INSERT OR IGNORE INTO satellite_bandwidth (satellite, daystartdate, size) VALUES (?, ?, 0)`, satelliteID.Bytes(), daystartunixtime)
+		if err != nil {
 			return err
-		default:
-			getSize = size + getSize
-			_, err = db.DB.Exec("UPDATE bwusagetbl SET size = ? WHERE daystartdate = ?", getSize, daystartunixtime)
+		}
+		_, err = tx.Exec(`UPDATE satellite_bandwidth SET size = size + ? WHERE satellite = ? AND daystartdate = ?`, size, satelliteID.Bytes(), daystartunixtime)
+		if err != nil {
 			return err
 		}
 	}
-	return err
+
+	// the monthly counter starts over in a new month
+	month := beginningOfMonth(t)
+	_, err = tx.Exec(`UPDATE usage_counters SET
+		used_bandwidth = CASE WHEN bandwidth_month = ? THEN used_bandwidth + ? ELSE ? END,
+		bandwidth_month = ?
+		WHERE id = 1`, month, size, size, month)
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
 }
 
 // GetBandwidthUsedByDay finds the so far bw used by day and return it
@@ -387,6 +839,37 @@ func (db *DB) GetBandwidthUsedByDay(t time.Time) (size int64, err error) {
 	return size, err
 }
 
+// DailyBandwidth is the bandwidth used on a day
+type DailyBandwidth struct {
+	Day  time.Time
+	Used int64
+}
+
+// GetBandwidthHistory returns the bandwidth used on every day since the day
+// of the time, the days without bandwidth usage are left out
+func (db *DB) GetBandwidthHistory(ctx context.Context, since time.Time) (history []DailyBandwidth, err error) {
+	defer mon.Task()(&ctx)(&err)
+	defer db.locked()()
+
+	daystarttime := time.Date(since.Year(), since.Month(), since.Day(), 0, 0, 0, 0, since.Location()).Unix()
+	rows, err := db.DB.QueryContext(ctx, `SELECT daystartdate, size FROM bwusagetbl WHERE daystartdate >= ? ORDER BY daystartdate`, daystarttime)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var day int64
+		var item DailyBandwidth
+		if err := rows.Scan(&day, &item.Used); err != nil {
+			return nil, err
+		}
+		item.Day = time.Unix(day, 0)
+		history = append(history, item)
+	}
+	return history, rows.Err()
+}
+
 // GetTotalBandwidthBetween each row in the bwusagetbl contains the total bw used per day
 func (db *DB) GetTotalBandwidthBetween(startdate time.Time, enddate time.Time) (totalbwusage int64, err error) {
 	defer db.locked()()
@@ -413,3 +896,68 @@ func (db *DB) GetTotalBandwidthBetween(startdate time.Time, enddate time.Time) (
 	err = db.DB.QueryRow(`SELECT SUM(size) FROM bwusagetbl WHERE daystartdate BETWEEN ? AND ?`, startTimeUnix, endTimeUnix).Scan(&totalbwusage)
 	return totalbwusage, err
 }
+
+// UsedSpace returns the space used by the stored pieces
+func (db *DB) UsedSpace() (usedSpace int64, err error) {
+	defer db.locked()()
+
+	err = db.DB.QueryRow(`SELECT used_space FROM usage_counters WHERE id = 1`).Scan(&usedSpace)
+	return usedSpace, err
+}
+
+// UsedBandwidth returns the bandwidth used in the month of now
+func (db *DB) UsedBandwidth(now time.Time) (usedBandwidth int64, err error) {
+	defer db.locked()()
+
+	var month int64
+	err = db.DB.QueryRow(`SELECT used_bandwidth, bandwidth_month FROM usage_counters WHERE id = 1`).Scan(&usedBandwidth, &month)
+	if err != nil {
+		return 0, err
+	}
+
+	if month != beginningOfMonth(now) {
+		return 0, nil
+	}
+	return usedBandwidth, nil
+}
+
+// RebuildUsage recounts the used space from the pieces on disk and the used
+// bandwidth from the daily bandwidth usage, for when the counters drifted
+func (db *DB) RebuildUsage(ctx context.Context) (usedSpace, usedBandwidth int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if db.storage == nil {
+		return 0, 0, Error.New("no piece storage to count the used space of")
+	}
+
+	usedSpace, err = db.storage.SpaceUsed()
+	if err != nil {
+		return 0, 0, err
+	}
+
+	defer db.locked()()
+
+	tx, err := db.DB.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	month := beginningOfMonth(time.Now())
+	err = tx.QueryRow(`SELECT COALESCE(SUM(size), 0) FROM bwusagetbl WHERE ? <= daystartdate`, month).Scan(&usedBandwidth)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	_, err = tx.Exec(`UPDATE usage_counters SET used_space = ?, used_bandwidth = ?, bandwidth_month = ? WHERE id = 1`, usedSpace, usedBandwidth, month)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return usedSpace, usedBandwidth, tx.Commit()
+}
+
+// beginningOfMonth returns the unix time of the beginning of the month of t
+func beginningOfMonth(t time.Time) int64 {
+	return time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location()).Unix()
+}
@@ -6,10 +6,12 @@ package psdb
 import (
 	"bytes"
 	"context"
+	"errors"
 	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strconv"
+	"strings"
 	"testing"
 	"time"
 
@@ -33,7 +35,10 @@ func newDB(t testing.TB) (*DB, func()) {
 	}
 	dbpath := filepath.Join(tmpdir, "psdb.db")
 
-	storage := pstore.NewStorage(tmpdir)
+	storage, err := pstore.NewStorage(tmpdir)
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	db, err := Open(ctx, storage, dbpath)
 	if err != nil {
@@ -258,7 +263,7 @@ func TestBandwidthUsage(t *testing.T) {
 			t.Run("#"+strconv.Itoa(P), func(t *testing.T) {
 				t.Parallel()
 				for _, bw := range bwtests {
-					err := db.AddBandwidthUsed(bw.size)
+					err := db.AddBandwidthUsed(storj.NodeID{}, bw.size)
 					if err != nil {
 						t.Fatal(err)
 					}
@@ -300,6 +305,59 @@ func TestBandwidthUsage(t *testing.T) {
 			})
 		}
 	})
+
+	t.Run("GetBandwidthHistory", func(t *testing.T) {
+		now := time.Now()
+		history, err := db.GetBandwidthHistory(ctx, now.AddDate(0, 0, -7))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(history) != 1 || history[0].Used != bwTotal || history[0].Day.Day() != now.Day() {
+			t.Fatalf("expected %d used today, got %v", bwTotal, history)
+		}
+
+		history, err = db.GetBandwidthHistory(ctx, now.AddDate(0, 0, 1))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(history) != 0 {
+			t.Fatalf("expected no usage since tomorrow, got %v", history)
+		}
+	})
+}
+
+func TestSerialNumbers(t *testing.T) {
+	db, cleanup := newDB(t)
+	defer cleanup()
+
+	satellite := teststorj.NodeIDFromString("satellite")
+	other := teststorj.NodeIDFromString("other")
+	now := time.Now()
+
+	if err := db.AddSerialNumber(satellite, "expired", now.Add(-time.Hour).Unix()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddSerialNumber(satellite, "serial", now.Add(time.Hour).Unix()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddSerialNumber(satellite, "serial", now.Add(time.Hour).Unix()); err == nil {
+		t.Fatal("expected error for used serial number")
+	}
+	if err := db.AddSerialNumber(other, "serial", now.Add(time.Hour).Unix()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := db.DeleteExpiredSerialNumbers(ctx, now); err != nil {
+		t.Fatal(err)
+	}
+
+	// expired serial numbers are forgotten, the others are kept
+	if err := db.AddSerialNumber(satellite, "expired", now.Add(time.Hour).Unix()); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddSerialNumber(satellite, "serial", now.Add(time.Hour).Unix()); err == nil {
+		t.Fatal("expected error for used serial number")
+	}
 }
 
 func BenchmarkWriteBandwidthAllocation(b *testing.B) {
@@ -332,3 +390,319 @@ func serialize(t testing.TB, v proto.Message) []byte {
 	}
 	return data
 }
+
+func TestPieceHashes(t *testing.T) {
+	db, cleanup := newDB(t)
+	defer cleanup()
+
+	hash, err := db.GetPieceHash("piece")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != nil {
+		t.Fatalf("expected no hash for unknown piece, got %x", hash)
+	}
+
+	if err := db.AddPieceHash("piece", []byte("hash")); err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err = db.GetPieceHash("piece")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(hash, []byte("hash")) {
+		t.Fatalf("expected hash %x, got %x", []byte("hash"), hash)
+	}
+
+	if err := db.DeletePieceHash("piece"); err != nil {
+		t.Fatal(err)
+	}
+
+	hash, err = db.GetPieceHash("piece")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hash != nil {
+		t.Fatalf("expected deleted hash, got %x", hash)
+	}
+}
+
+func TestSatellitePieces(t *testing.T) {
+	db, cleanup := newDB(t)
+	defer cleanup()
+
+	satellite, other := teststorj.NodeIDFromString("satellite"), teststorj.NodeIDFromString("other")
+
+	for _, piece := range []struct {
+		id        string
+		satellite storj.NodeID
+	}{
+		{"piece1", satellite},
+		{"piece2", satellite},
+		{"piece3", other},
+	} {
+		if err := db.AddTTL(piece.id, 0, 1); err != nil {
+			t.Fatal(err)
+		}
+		if err := db.AddSatellitePiece(piece.id, piece.satellite, "satellite-"+piece.id); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	pieces, err := db.GetSatellitePieces(ctx, satellite, time.Now().Add(-time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pieces) != 0 {
+		t.Fatalf("expected no pieces created an hour ago, got %v", pieces)
+	}
+
+	// bandwidth without pieces is counted for its satellite as well
+	bandwidthOnly := teststorj.NodeIDFromString("bandwidth")
+	for _, used := range []struct {
+		satellite storj.NodeID
+		size      int64
+	}{
+		{satellite, 10},
+		{satellite, 20},
+		{bandwidthOnly, 5},
+	} {
+		if err := db.AddBandwidthUsed(used.satellite, used.size); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	usage, err := db.GetSatelliteUsage(ctx, time.Now())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expectedUsage := map[storj.NodeID]SatelliteUsage{
+		satellite:     {SatelliteID: satellite, Pieces: 2, UsedSpace: 2, UsedBandwidth: 30},
+		other:         {SatelliteID: other, Pieces: 1, UsedSpace: 1},
+		bandwidthOnly: {SatelliteID: bandwidthOnly, UsedBandwidth: 5},
+	}
+	if len(usage) != len(expectedUsage) {
+		t.Fatalf("expected usage %v, got %v", expectedUsage, usage)
+	}
+	for _, item := range usage {
+		if expectedUsage[item.SatelliteID] != item {
+			t.Fatalf("expected usage %v, got %v", expectedUsage, usage)
+		}
+	}
+
+	usedSpace, err := db.SatelliteUsedSpace(satellite)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usedSpace != 2 {
+		t.Fatalf("expected 2 bytes used for the satellite, got %d", usedSpace)
+	}
+
+	if err := db.DeleteSatellitePiece("piece2"); err != nil {
+		t.Fatal(err)
+	}
+
+	pieces, err = db.GetSatellitePieces(ctx, satellite, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []SatellitePiece{{ID: "piece1", PieceID: "satellite-piece1"}}
+	if len(pieces) != 1 || pieces[0] != expected[0] {
+		t.Fatalf("expected pieces %v, got %v", expected, pieces)
+	}
+}
+
+func TestUsageCounters(t *testing.T) {
+	db, cleanup := newDB(t)
+	defer cleanup()
+
+	usedSpace := func() int64 {
+		used, err := db.UsedSpace()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return used
+	}
+
+	if err := db.AddTTL("piece1", 0, 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddTTL("piece2", 0, 20); err != nil {
+		t.Fatal(err)
+	}
+	if used := usedSpace(); used != 30 {
+		t.Fatalf("expected 30 bytes used, got %d", used)
+	}
+
+	// replacing a piece counts only its new size
+	if err := db.AddTTL("piece1", 0, 5); err != nil {
+		t.Fatal(err)
+	}
+	if used := usedSpace(); used != 25 {
+		t.Fatalf("expected 25 bytes used, got %d", used)
+	}
+
+	if err := db.DeleteTTLByID("piece2"); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeleteTTLByID("unknown"); err != nil {
+		t.Fatal(err)
+	}
+	if used := usedSpace(); used != 5 {
+		t.Fatalf("expected 5 bytes used, got %d", used)
+	}
+
+	if err := db.AddBandwidthUsed(storj.NodeID{}, 100); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.AddBandwidthUsed(storj.NodeID{}, 50); err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	usedBandwidth, err := db.UsedBandwidth(now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usedBandwidth != 150 {
+		t.Fatalf("expected 150 bytes of bandwidth used, got %d", usedBandwidth)
+	}
+
+	// bandwidth is counted per month
+	usedBandwidth, err = db.UsedBandwidth(now.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if usedBandwidth != 0 {
+		t.Fatalf("expected no bandwidth used next month, got %d", usedBandwidth)
+	}
+
+	// rebuilding counts the pieces on disk
+	writer, err := db.storage.Writer("0123456789abcdefghijklmnopqrstuv")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := writer.Write(make([]byte, 42)); err != nil {
+		t.Fatal(err)
+	}
+	if err := writer.Commit(); err != nil {
+		t.Fatal(err)
+	}
+
+	rebuiltSpace, rebuiltBandwidth, err := db.RebuildUsage(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rebuiltSpace != 42 || rebuiltBandwidth != 150 {
+		t.Fatalf("expected 42 bytes and 150 bytes of bandwidth used, got %d and %d", rebuiltSpace, rebuiltBandwidth)
+	}
+	if used := usedSpace(); used != 42 {
+		t.Fatalf("expected 42 bytes used, got %d", used)
+	}
+}
+
+func TestDeleteExpired(t *testing.T) {
+	db, cleanup := newDB(t)
+	defer cleanup()
+
+	satellite := teststorj.NodeIDFromString("satellite")
+	now := time.Now().Unix()
+
+	id := func(name string) string {
+		return name + strings.Repeat("_", pstore.IDLength)
+	}
+
+	for _, piece := range []StoredPiece{
+		{ID: id("expired"), Expiration: now - 60, Size: 10, Hash: []byte("expired")},
+		{ID: id("unexpired"), Expiration: now + 3600, Size: 20, Hash: []byte("unexpired")},
+		{ID: id("permanent"), Expiration: 0, Size: 40, Hash: []byte("permanent")},
+	} {
+		writer, err := db.storage.Writer(piece.ID)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := writer.Write(make([]byte, piece.Size)); err != nil {
+			t.Fatal(err)
+		}
+
+		piece.SatelliteID = satellite
+		piece.SatellitePieceID = "satellite-" + piece.ID
+		if err := db.AddPiece(piece, writer.Commit); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := db.DeleteExpired(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	for name, exists := range map[string]bool{"expired": false, "unexpired": true, "permanent": true} {
+		_, err := db.GetTTLByID(id(name))
+		if exists != (err == nil) {
+			t.Fatalf("expected ttl of %s to exist %v, got %v", name, exists, err)
+		}
+
+		hash, err := db.GetPieceHash(id(name))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if exists != (hash != nil) {
+			t.Fatalf("expected hash of %s to exist %v", name, exists)
+		}
+
+		_, err = db.storage.Size(id(name))
+		if exists != (err == nil) {
+			t.Fatalf("expected data of %s to exist %v, got %v", name, exists, err)
+		}
+	}
+
+	pieces, err := db.GetSatellitePieces(ctx, satellite, time.Now().Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pieces) != 2 {
+		t.Fatalf("expected 2 satellite pieces, got %v", pieces)
+	}
+
+	used, err := db.UsedSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used != 60 {
+		t.Fatalf("expected 60 bytes used, got %d", used)
+	}
+}
+
+func TestAddPieceCommitFails(t *testing.T) {
+	db, cleanup := newDB(t)
+	defer cleanup()
+
+	commitErr := errors.New("commit failed")
+	err := db.AddPiece(StoredPiece{ID: "piece", Size: 10, Hash: []byte("hash")}, func() error { return commitErr })
+	if err != commitErr {
+		t.Fatalf("expected the commit error, got %v", err)
+	}
+
+	if _, err := db.GetTTLByID("piece"); err == nil {
+		t.Fatal("expected no ttl for the piece")
+	}
+	used, err := db.UsedSpace()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if used != 0 {
+		t.Fatalf("expected no space used, got %d", used)
+	}
+
+	// the records are kept when the data can't be deleted
+	if err := db.AddPiece(StoredPiece{ID: "piece", Size: 10, Hash: []byte("hash")}, func() error { return nil }); err != nil {
+		t.Fatal(err)
+	}
+	if err := db.DeletePiece("piece", func() error { return commitErr }); err != commitErr {
+		t.Fatalf("expected the delete error, got %v", err)
+	}
+	if used, err := db.UsedSpace(); err != nil || used != 10 {
+		t.Fatalf("expected 10 bytes used, got %d, %v", used, err)
+	}
+}
@@ -39,6 +39,8 @@ func (s *StreamWriter) Write(b []byte) (int, error) {
 type StreamReader struct {
 	src                 *utils.ReaderSource
 	bandwidthAllocation *pb.RenterBandwidthAllocation
+	payerAllocation     *pb.PayerBandwidthAllocation
+	hash                []byte
 	currentTotal        int64
 	bandwidthRemaining  int64
 	spaceRemaining      int64
@@ -72,13 +74,14 @@ func NewStreamReader(s *Server, stream pb.PieceStoreRoutes_StoreServer, bandwidt
 				return nil, err
 			}
 
-			pbaData := &pb.PayerBandwidthAllocation_Data{}
-			if err = proto.Unmarshal(deserializedData.GetPayerAllocation().GetData(), pbaData); err != nil {
-				return nil, err
-			}
-
-			if err = s.verifyPayerAllocation(pbaData, "PUT"); err != nil {
-				return nil, err
+			// the payer allocation is repeated in every renter allocation of
+			// the transfer, it's verified and its serial number used once
+			pba := deserializedData.GetPayerAllocation()
+			if sr.payerAllocation == nil || !proto.Equal(sr.payerAllocation, pba) {
+				if err = s.verifyPayerAllocation(stream.Context(), pba, "PUT"); err != nil {
+					return nil, err
+				}
+				sr.payerAllocation = pba
 			}
 
 			// Update bandwidthallocation to be stored
@@ -88,6 +91,11 @@ func NewStreamReader(s *Server, stream pb.PieceStoreRoutes_StoreServer, bandwidt
 			}
 		}
 
+		// the uplink sends the hash of the piece after its content
+		if hash := pd.GetHash(); len(hash) > 0 {
+			sr.hash = hash
+		}
+
 		return pd.GetContent(), nil
 	})
 
@@ -0,0 +1,77 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/provider"
+)
+
+// RetainError is the error class of garbage collection requests
+var RetainError = errs.Class("retain error")
+
+// Retain deletes the pieces of the satellite of the request, which aren't in
+// the filter of the pieces the satellite references. Only the pieces stored
+// before the creation of the filter, less the retain time buffer, are
+// deleted, so pieces of uploads in progress are kept.
+func (s *Server) Retain(ctx context.Context, req *pb.RetainRequest) (resp *pb.RetainResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pi, err := provider.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+
+	satelliteID := pi.ID
+	if !s.trust.IsTrusted(satelliteID) {
+		return nil, RetainError.New("untrusted satellite %s", satelliteID)
+	}
+
+	filter, err := bloomfilter.NewFromBytes(req.GetFilter())
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+
+	createdBefore, err := ptypes.Timestamp(req.GetCreationDate())
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+	if now := time.Now(); createdBefore.After(now) {
+		createdBefore = now
+	}
+	createdBefore = createdBefore.Add(-s.retainTimeBuffer)
+
+	pieces, err := s.DB.GetSatellitePieces(ctx, satelliteID, createdBefore)
+	if err != nil {
+		return nil, RetainError.Wrap(err)
+	}
+
+	var deleted int64
+	for _, piece := range pieces {
+		if filter.Contains([]byte(piece.PieceID)) {
+			continue
+		}
+
+		if err := s.deleteByID(piece.ID); err != nil {
+			s.log.Error("failed to delete garbage piece", zap.String("Piece ID", piece.ID), zap.Error(err))
+			continue
+		}
+		deleted++
+	}
+
+	s.log.Info("collected garbage pieces",
+		zap.String("satellite", satelliteID.String()),
+		zap.Int("pieces", len(pieces)),
+		zap.Int64("deleted", deleted))
+
+	return &pb.RetainResponse{Deleted: deleted}, nil
+}
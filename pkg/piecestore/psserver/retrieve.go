@@ -4,10 +4,12 @@
 package psserver
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
+	"hash"
 	"io"
-	"os"
 	"sync/atomic"
 
 	"github.com/gogo/protobuf/proto"
@@ -16,12 +18,16 @@ import (
 
 	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/storj"
 	"storj.io/storj/pkg/utils"
 )
 
 // RetrieveError is a type of error for failures in Server.Retrieve()
 var RetrieveError = errs.Class("retrieve error")
 
+// ErrPieceCorrupted is the error for pieces whose data doesn't match their hash
+var ErrPieceCorrupted = errs.Class("piece corrupted")
+
 // Retrieve -- Retrieve data from piecestore and send to client
 func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error) {
 	ctx := stream.Context()
@@ -57,28 +63,35 @@ func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error)
 		return err
 	}
 
-	// Get path to data being retrieved
-	path, err := s.storage.PiecePath(id)
+	satelliteID, err := getSatelliteID(authorization)
 	if err != nil {
-		return err
+		return RetrieveError.Wrap(err)
 	}
 
-	// Verify that the path exists
-	fileInfo, err := os.Stat(path)
+	// Verify that the piece exists
+	fileSize, err := s.storage.Size(id)
 	if err != nil {
 		return RetrieveError.Wrap(err)
 	}
 
 	// Read the size specified
 	totalToRead := pd.GetPieceSize()
-	fileSize := fileInfo.Size()
 
 	// Read the entire file if specified -1 but make sure we do it from the correct offset
 	if pd.GetPieceSize() <= -1 || totalToRead+pd.GetOffset() > fileSize {
 		totalToRead = fileSize - pd.GetOffset()
 	}
 
-	retrieved, allocated, err := s.retrieveData(ctx, stream, id, pd.GetOffset(), totalToRead)
+	// Only reads of the whole piece can be verified against its hash
+	var pieceHash []byte
+	if pd.GetOffset() == 0 && totalToRead == fileSize {
+		pieceHash, err = s.DB.GetPieceHash(id)
+		if err != nil {
+			return RetrieveError.Wrap(err)
+		}
+	}
+
+	retrieved, allocated, err := s.retrieveData(ctx, stream, id, satelliteID, pd.GetOffset(), totalToRead, pieceHash)
 	if err != nil {
 		return err
 	}
@@ -91,7 +104,7 @@ func (s *Server) Retrieve(stream pb.PieceStoreRoutes_RetrieveServer) (err error)
 	return nil
 }
 
-func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_RetrieveServer, id string, offset, length int64) (retrieved, allocated int64, err error) {
+func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_RetrieveServer, id string, satelliteID storj.NodeID, offset, length int64, pieceHash []byte) (retrieved, allocated int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	storeFile, err := s.storage.Reader(ctx, id, offset, length)
@@ -101,6 +114,16 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 
 	defer utils.LogClose(storeFile)
 
+	var pieceReader io.Reader = storeFile
+	if pieceHash != nil {
+		pieceReader = &verifyingReader{
+			reader:    storeFile,
+			remaining: length,
+			hasher:    sha256.New(),
+			expected:  pieceHash,
+		}
+	}
+
 	writer := NewStreamWriter(s, stream)
 	allocationTracking := sync2.NewThrottle()
 	totalAllocated := int64(0)
@@ -109,6 +132,7 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 	go func() {
 		var lastTotal int64
 		var lastAllocation *pb.RenterBandwidthAllocation
+		var payerAllocation *pb.PayerBandwidthAllocation
 		defer func() {
 			if lastAllocation == nil {
 				return
@@ -144,15 +168,15 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 				return
 			}
 
-			pbaData := &pb.PayerBandwidthAllocation_Data{}
-			if err = proto.Unmarshal(allocData.GetPayerAllocation().GetData(), pbaData); err != nil {
-				allocationTracking.Fail(err)
-				return
-			}
-
-			if err = s.verifyPayerAllocation(pbaData, "GET"); err != nil {
-				allocationTracking.Fail(err)
-				return
+			// the payer allocation is repeated in every renter allocation of
+			// the transfer, it's verified and its serial number used once
+			pba := allocData.GetPayerAllocation()
+			if payerAllocation == nil || !proto.Equal(payerAllocation, pba) {
+				if err = s.verifyPayerAllocation(ctx, pba, "GET"); err != nil {
+					allocationTracking.Fail(err)
+					return
+				}
+				payerAllocation = pba
 			}
 
 			// TODO: break when lastTotal >= allocData.GetPayer_allocation().GetData().GetMax_size()
@@ -185,7 +209,7 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 		}
 
 		used += nextMessageSize
-		n, err := io.CopyN(writer, storeFile, nextMessageSize)
+		n, err := io.CopyN(writer, pieceReader, nextMessageSize)
 		// correct errors when needed
 		if n != nextMessageSize {
 			if pErr := allocationTracking.Produce(nextMessageSize - n); pErr != nil {
@@ -195,13 +219,16 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 		}
 		// break on error
 		if err != nil {
+			if ErrPieceCorrupted.Has(err) {
+				s.log.Error("Piece corrupted", zap.String("Piece ID", id))
+			}
 			allocationTracking.Fail(err)
 			break
 		}
 	}
 
 	// write to bandwidth usage table
-	if err = s.DB.AddBandwidthUsed(used); err != nil {
+	if err = s.DB.AddBandwidthUsed(satelliteID, used); err != nil {
 		return retrieved, allocated, StoreError.New("failed to write bandwidth info to database: %v", err)
 	}
 
@@ -210,3 +237,32 @@ func (s *Server) retrieveData(ctx context.Context, stream pb.PieceStoreRoutes_Re
 
 	return used, atomic.LoadInt64(&totalAllocated), allocationTracking.Err()
 }
+
+// verifyingReader reads a whole piece and compares its data with the hash
+// stored for it. The last read fails instead of returning the end of a
+// corrupted or truncated piece, so the piece is never completely sent.
+type verifyingReader struct {
+	reader    io.Reader
+	remaining int64
+	hasher    hash.Hash
+	expected  []byte
+}
+
+// Read implements io.Reader
+func (r *verifyingReader) Read(p []byte) (n int, err error) {
+	n, err = r.reader.Read(p)
+	_, _ = r.hasher.Write(p[:n])
+	r.remaining -= int64(n)
+
+	if err == io.EOF && r.remaining > 0 {
+		mon.Meter("piece_corrupted").Mark(1)
+		return 0, ErrPieceCorrupted.New("piece truncated, %d bytes missing", r.remaining)
+	}
+
+	if r.remaining <= 0 && !bytes.Equal(r.hasher.Sum(nil), r.expected) {
+		mon.Meter("piece_corrupted").Mark(1)
+		return 0, ErrPieceCorrupted.New("hash mismatch")
+	}
+
+	return n, err
+}
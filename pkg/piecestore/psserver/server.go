@@ -4,18 +4,22 @@
 package psserver
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/ecdsa"
 	"crypto/hmac"
 	"crypto/sha512"
+	"crypto/x509"
 	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
+	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/gtank/cryptopasta"
 	"github.com/mr-tron/base58/base58"
@@ -23,6 +27,8 @@ import (
 	"github.com/zeebo/errs"
 	"go.uber.org/zap"
 	"golang.org/x/net/context"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/kademlia"
@@ -31,6 +37,7 @@ import (
 	pstore "storj.io/storj/pkg/piecestore"
 	"storj.io/storj/pkg/piecestore/psserver/psdb"
 	"storj.io/storj/pkg/provider"
+	"storj.io/storj/pkg/storj"
 )
 
 var (
@@ -67,14 +74,24 @@ type Server struct {
 	totalBwAllocated int64
 	verifier         auth.SignedMessageVerifier
 	kad              *kademlia.Kademlia
+	trust            *TrustedSatellites
+	retainTimeBuffer time.Duration
+
+	// satelliteAllocated are the storage caps of the satellites
+	satelliteAllocated map[storj.NodeID]int64
 }
 
 // NewEndpoint -- initializes a new endpoint for a piecestore server
-func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *psdb.DB, pkey crypto.PrivateKey, k *kademlia.Kademlia) (*Server, error) {
+func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *psdb.DB, pkey crypto.PrivateKey, k *kademlia.Kademlia, trust *TrustedSatellites) (*Server, error) {
 	// read the allocated disk space from the config file
 	allocatedDiskSpace := config.AllocatedDiskSpace.Int64()
 	allocatedBandwidth := config.AllocatedBandwidth.Int64()
 
+	satelliteAllocated, err := parseSatelliteAllocations(config.SatelliteAllocations)
+	if err != nil {
+		return nil, err
+	}
+
 	// get the disk space details
 	// The returned path ends in a slash only if it represents a root directory, such as "/" on Unix or `C:\` on Windows.
 	rootPath := filepath.Dir(filepath.Clean(config.Path))
@@ -85,13 +102,12 @@ func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *ps
 	freeDiskSpace := int64(diskSpace.Free)
 
 	// get how much is currently used, if for the first time totalUsed = 0
-	totalUsed, err := db.SumTTLSizes()
+	totalUsed, err := db.UsedSpace()
 	if err != nil {
-		//first time setup
-		totalUsed = 0x00
+		return nil, ServerError.Wrap(err)
 	}
 
-	usedBandwidth, err := db.GetTotalBandwidthBetween(getBeginningOfMonth(), time.Now())
+	usedBandwidth, err := db.UsedBandwidth(time.Now())
 	if err != nil {
 		return nil, ServerError.Wrap(err)
 	}
@@ -124,29 +140,40 @@ func NewEndpoint(log *zap.Logger, config Config, storage *pstore.Storage, db *ps
 	}
 
 	return &Server{
-		startTime:        time.Now(),
-		log:              log,
-		storage:          storage,
-		DB:               db,
-		pkey:             pkey,
-		totalAllocated:   allocatedDiskSpace,
-		totalBwAllocated: allocatedBandwidth,
-		verifier:         auth.NewSignedMessageVerifier(),
-		kad:              k,
+		startTime:          time.Now(),
+		log:                log,
+		storage:            storage,
+		DB:                 db,
+		pkey:               pkey,
+		totalAllocated:     allocatedDiskSpace,
+		totalBwAllocated:   allocatedBandwidth,
+		verifier:           auth.NewSignedMessageVerifier(),
+		kad:                k,
+		trust:              trust,
+		retainTimeBuffer:   config.RetainTimeBuffer,
+		satelliteAllocated: satelliteAllocated,
 	}, nil
 }
 
 // New creates a Server with custom db
-func New(log *zap.Logger, storage *pstore.Storage, db *psdb.DB, config Config, pkey crypto.PrivateKey) *Server {
-	return &Server{
-		log:              log,
-		storage:          storage,
-		DB:               db,
-		pkey:             pkey,
-		totalAllocated:   config.AllocatedDiskSpace.Int64(),
-		totalBwAllocated: config.AllocatedBandwidth.Int64(),
-		verifier:         auth.NewSignedMessageVerifier(),
+func New(log *zap.Logger, storage *pstore.Storage, db *psdb.DB, config Config, pkey crypto.PrivateKey, trust *TrustedSatellites) (*Server, error) {
+	satelliteAllocated, err := parseSatelliteAllocations(config.SatelliteAllocations)
+	if err != nil {
+		return nil, err
 	}
+
+	return &Server{
+		log:                log,
+		storage:            storage,
+		DB:                 db,
+		pkey:               pkey,
+		totalAllocated:     config.AllocatedDiskSpace.Int64(),
+		totalBwAllocated:   config.AllocatedBandwidth.Int64(),
+		verifier:           auth.NewSignedMessageVerifier(),
+		trust:              trust,
+		retainTimeBuffer:   config.RetainTimeBuffer,
+		satelliteAllocated: satelliteAllocated,
+	}, nil
 }
 
 // Close stops the server
@@ -174,11 +201,6 @@ func (s *Server) Piece(ctx context.Context, in *pb.PieceId) (*pb.PieceSummary, e
 		return nil, err
 	}
 
-	path, err := s.storage.PiecePath(id)
-	if err != nil {
-		return nil, err
-	}
-
 	match, err := regexp.MatchString("^[A-Za-z0-9]{20,64}$", id)
 	if err != nil {
 		return nil, err
@@ -188,7 +210,7 @@ func (s *Server) Piece(ctx context.Context, in *pb.PieceId) (*pb.PieceSummary, e
 		return nil, ServerError.New("invalid ID")
 	}
 
-	fileInfo, err := os.Stat(path)
+	pieceSize, err := s.storage.Size(id)
 	if err != nil {
 		return nil, err
 	}
@@ -200,24 +222,68 @@ func (s *Server) Piece(ctx context.Context, in *pb.PieceId) (*pb.PieceSummary, e
 	}
 
 	s.log.Debug("Successfully retrieved meta", zap.String("Piece ID", in.GetId()))
-	return &pb.PieceSummary{Id: in.GetId(), PieceSize: fileInfo.Size(), ExpirationUnixSec: ttl}, nil
+	return &pb.PieceSummary{Id: in.GetId(), PieceSize: pieceSize, ExpirationUnixSec: ttl}, nil
 }
 
 // Stats will return statistics about the Server
 func (s *Server) Stats(ctx context.Context, in *pb.StatsReq) (*pb.StatSummary, error) {
 	s.log.Debug("Getting Stats...")
 
-	totalUsed, err := s.DB.SumTTLSizes()
+	totalUsed, err := s.DB.UsedSpace()
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	totalUsedBandwidth, err := s.DB.UsedBandwidth(now)
 	if err != nil {
 		return nil, err
 	}
 
-	totalUsedBandwidth, err := s.DB.GetTotalBandwidthBetween(getBeginningOfMonth(), time.Now())
+	satellites, err := s.satelliteStats(ctx, now)
 	if err != nil {
 		return nil, err
 	}
 
-	return &pb.StatSummary{UsedSpace: totalUsed, AvailableSpace: (s.totalAllocated - totalUsed), UsedBandwidth: totalUsedBandwidth, AvailableBandwidth: (s.totalBwAllocated - totalUsedBandwidth)}, nil
+	return &pb.StatSummary{UsedSpace: totalUsed, AvailableSpace: (s.totalAllocated - totalUsed), UsedBandwidth: totalUsedBandwidth, AvailableBandwidth: (s.totalBwAllocated - totalUsedBandwidth), Satellites: satellites}, nil
+}
+
+// satelliteStats returns the usage of the satellites the node stores data
+// for or has a storage cap for
+func (s *Server) satelliteStats(ctx context.Context, now time.Time) (stats []*pb.SatelliteStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	usage, err := s.DB.GetSatelliteUsage(ctx, now)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range usage {
+		stats = append(stats, &pb.SatelliteStats{
+			SatelliteId:    item.SatelliteID,
+			UsedSpace:      item.UsedSpace,
+			AllocatedSpace: s.satelliteAllocated[item.SatelliteID],
+			UsedBandwidth:  item.UsedBandwidth,
+		})
+	}
+
+	for satelliteID, allocated := range s.satelliteAllocated {
+		found := false
+		for _, item := range stats {
+			if item.SatelliteId == satelliteID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			stats = append(stats, &pb.SatelliteStats{SatelliteId: satelliteID, AllocatedSpace: allocated})
+		}
+	}
+
+	sort.Slice(stats, func(i, k int) bool {
+		return stats[i].SatelliteId.Less(stats[k].SatelliteId)
+	})
+	return stats, nil
 }
 
 // Dashboard is a stream that sends data every `interval` seconds to the listener.
@@ -269,11 +335,10 @@ func (s *Server) Delete(ctx context.Context, in *pb.PieceDelete) (*pb.PieceDelet
 }
 
 func (s *Server) deleteByID(id string) error {
-	if err := s.storage.Delete(id); err != nil {
-		return err
-	}
-
-	if err := s.DB.DeleteTTLByID(id); err != nil {
+	err := s.DB.DeletePiece(id, func() error {
+		return s.storage.Delete(id)
+	})
+	if err != nil {
 		return err
 	}
 
@@ -283,7 +348,6 @@ func (s *Server) deleteByID(id string) error {
 }
 
 func (s *Server) verifySignature(ctx context.Context, ba *pb.RenterBandwidthAllocation) error {
-	// TODO(security): detect replay attacks
 	pi, err := provider.PeerIdentityFromContext(ctx)
 	if err != nil {
 		return err
@@ -300,22 +364,66 @@ func (s *Server) verifySignature(ctx context.Context, ba *pb.RenterBandwidthAllo
 	return nil
 }
 
-func (s *Server) verifyPayerAllocation(pba *pb.PayerBandwidthAllocation_Data, actionPrefix string) (err error) {
+// verifyPayerAllocation checks that the payer bandwidth allocation is valid
+// for the action, signed by a trusted satellite and issued to the uplink
+// of the request
+func (s *Server) verifyPayerAllocation(ctx context.Context, pba *pb.PayerBandwidthAllocation, actionPrefix string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pbad := &pb.PayerBandwidthAllocation_Data{}
+	if err := proto.Unmarshal(pba.GetData(), pbad); err != nil {
+		return StoreError.New("payer bandwidth allocation: %v", err)
+	}
+
 	switch {
-	case pba.SatelliteId.IsZero():
+	case pbad.SatelliteId.IsZero():
 		return StoreError.New("payer bandwidth allocation: missing satellite id")
-	case pba.UplinkId.IsZero():
+	case pbad.UplinkId.IsZero():
 		return StoreError.New("payer bandwidth allocation: missing uplink id")
-	case !strings.HasPrefix(pba.Action.String(), actionPrefix):
-		return StoreError.New("payer bandwidth allocation: invalid action %v", pba.Action.String())
+	case pbad.SerialNumber == "":
+		return StoreError.New("payer bandwidth allocation: missing serial number")
+	case !strings.HasPrefix(pbad.Action.String(), actionPrefix):
+		return StoreError.New("payer bandwidth allocation: invalid action %v", pbad.Action.String())
+	case time.Unix(pbad.ExpirationUnixSec, 0).Before(time.Now()):
+		return StoreError.New("payer bandwidth allocation: expired at %v", time.Unix(pbad.ExpirationUnixSec, 0))
 	}
-	return nil
-}
 
-func getBeginningOfMonth() time.Time {
-	t := time.Now()
-	y, m, _ := t.Date()
-	return time.Date(y, m, 1, 0, 0, 0, 0, time.Now().Location())
+	if !s.trust.IsTrusted(pbad.SatelliteId) {
+		mon.Meter("untrusted_satellite").Mark(1)
+		return status.Errorf(codes.PermissionDenied, "payer bandwidth allocation: untrusted satellite %s", pbad.SatelliteId)
+	}
+
+	satelliteKey, err := s.trust.PublicKey(ctx, pbad.SatelliteId)
+	if err != nil {
+		return err
+	}
+	if !cryptopasta.Verify(pba.GetData(), pba.GetSignature(), satelliteKey) {
+		return StoreError.New("payer bandwidth allocation: invalid satellite signature")
+	}
+
+	// the allocation must be issued to the uplink which signs the renter
+	// bandwidth allocations of the request
+	pi, err := provider.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return err
+	}
+	if pbad.UplinkId != pi.ID {
+		return StoreError.New("payer bandwidth allocation: issued to uplink %s", pbad.UplinkId)
+	}
+	uplinkKey, err := x509.MarshalPKIXPublicKey(pi.Leaf.PublicKey)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(pbad.PubKey, uplinkKey) {
+		return StoreError.New("payer bandwidth allocation: public key doesn't match the uplink")
+	}
+
+	// an allocation pays for a single transfer, reject replays of it
+	if err := s.DB.AddSerialNumber(pbad.SatelliteId, pbad.SerialNumber, pbad.ExpirationUnixSec); err != nil {
+		return StoreError.New("payer bandwidth allocation: %v", err)
+	}
+
+	return nil
 }
 
 func getNamespacedPieceID(pieceID, namespace []byte) (string, error) {
@@ -336,6 +444,16 @@ func getNamespace(signedMessage *pb.SignedMessage) []byte {
 	return signedMessage.GetData()
 }
 
+// getSatelliteID returns the satellite of the authorization, which namespaces
+// the pieces. The id is zero for authorizations without a namespace.
+func getSatelliteID(authorization *pb.SignedMessage) (storj.NodeID, error) {
+	namespace := getNamespace(authorization)
+	if namespace == nil {
+		return storj.NodeID{}, nil
+	}
+	return storj.NodeIDFromBytes(namespace)
+}
+
 func (s *Server) getDashboardData(ctx context.Context) (*pb.DashboardStats, error) {
 	statsSummary, err := s.Stats(ctx, &pb.StatsReq{})
 	if err != nil {
@@ -4,8 +4,11 @@
 package psserver
 
 import (
+	"bytes"
 	"crypto"
 	"crypto/ecdsa"
+	"crypto/sha256"
+	"crypto/x509"
 	"fmt"
 	"io"
 	"io/ioutil"
@@ -17,11 +20,15 @@ import (
 	"runtime"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
+	"github.com/golang/protobuf/ptypes"
 	"github.com/gtank/cryptopasta"
 	_ "github.com/mattn/go-sqlite3"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
 	"go.uber.org/zap/zaptest"
 	"golang.org/x/net/context"
@@ -30,6 +37,9 @@ import (
 	"storj.io/storj/internal/testcontext"
 	"storj.io/storj/internal/testidentity"
 	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/bloomfilter"
+	"storj.io/storj/pkg/identity"
 	"storj.io/storj/pkg/pb"
 	pstore "storj.io/storj/pkg/piecestore"
 	"storj.io/storj/pkg/piecestore/psserver/psdb"
@@ -43,8 +53,10 @@ func (TS *TestServer) writeFile(pieceID string) error {
 	}
 
 	_, err = file.Write([]byte("xyzwq"))
-	return errs.Combine(err, file.Close())
-
+	if err != nil {
+		return errs.Combine(err, file.Cancel())
+	}
+	return file.Commit()
 }
 
 func TestPiece(t *testing.T) {
@@ -78,16 +90,13 @@ func TestPiece(t *testing.T) {
 			id:         "123",
 			size:       5,
 			expiration: 9999999999,
-			err:        "rpc error: code = Unknown desc = piecestore error: invalid id length",
+			err:        "rpc error: code = Unknown desc = PSServer error: invalid ID",
 		},
 		{ // server should err with nonexistent file
 			id:         "22222222222222222222",
 			size:       5,
 			expiration: 9999999999,
-			err: fmt.Sprintf("rpc error: code = Unknown desc = stat %s: no such file or directory", func() string {
-				path, _ := TS.s.storage.PiecePath("22222222222222222222")
-				return path
-			}()),
+			err:        "rpc error: code = Unknown desc = piecestore error: piece not found",
 		},
 		{ // server should err with invalid TTL
 			id:         "22222222222222222222;DELETE*FROM TTL;;;;",
@@ -210,10 +219,7 @@ func TestRetrieve(t *testing.T) {
 			allocSize: 5,
 			offset:    0,
 			content:   []byte("xyzwq"),
-			err: fmt.Sprintf("rpc error: code = Unknown desc = retrieve error: stat %s: no such file or directory", func() string {
-				path, _ := TS.s.storage.PiecePath("22222222222222222222")
-				return path
-			}()),
+			err:       "rpc error: code = Unknown desc = retrieve error: piecestore error: piece not found",
 		},
 		{ // server should return expected content and respSize with offset and excess reqSize
 			id:        "11111111111111111111",
@@ -296,6 +302,38 @@ func TestRetrieve(t *testing.T) {
 	}
 }
 
+func TestVerifyingReader(t *testing.T) {
+	content := []byte("xyzwq")
+
+	for _, tt := range []struct {
+		stored []byte
+		hash   []byte
+		err    bool
+	}{
+		{stored: content, hash: sha256Sum(content), err: false},
+		{stored: content, hash: sha256Sum([]byte("qwzyx")), err: true},
+		{stored: content[:3], hash: sha256Sum(content), err: true}, // truncated
+	} {
+		reader := &verifyingReader{
+			reader:    bytes.NewReader(tt.stored),
+			remaining: int64(len(content)),
+			hasher:    sha256.New(),
+			expected:  tt.hash,
+		}
+
+		data, err := ioutil.ReadAll(reader)
+		if tt.err {
+			assert.True(t, ErrPieceCorrupted.Has(err))
+			// the end of a corrupted piece isn't returned
+			assert.NotEqual(t, content, data)
+			continue
+		}
+
+		assert.NoError(t, err)
+		assert.Equal(t, content, data)
+	}
+}
+
 func TestStore(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -309,6 +347,7 @@ func TestStore(t *testing.T) {
 		id            string
 		ttl           int64
 		content       []byte
+		hash          []byte
 		message       string
 		totalReceived int64
 		err           string
@@ -321,6 +360,24 @@ func TestStore(t *testing.T) {
 			totalReceived: 5,
 			err:           "",
 		},
+		{ // should successfully store data with its hash
+			id:            "88888888888888888888",
+			ttl:           9999999999,
+			content:       []byte("xyzwq"),
+			hash:          sha256Sum([]byte("xyzwq")),
+			message:       "OK",
+			totalReceived: 5,
+			err:           "",
+		},
+		{ // should err with mismatching hash
+			id:            "77777777777777777777",
+			ttl:           9999999999,
+			content:       []byte("xyzwq"),
+			hash:          sha256Sum([]byte("qwzyx")),
+			message:       "",
+			totalReceived: 0,
+			err:           "rpc error: code = Unknown desc = store error: piece hash mismatch",
+		},
 		{ // should err with invalid id length
 			id:            "butts",
 			ttl:           9999999999,
@@ -349,14 +406,8 @@ func TestStore(t *testing.T) {
 			err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: tt.id, ExpirationUnixSec: tt.ttl}})
 			assert.NoError(err)
 
-			pbad := &pb.PayerBandwidthAllocation_Data{
-				SatelliteId: teststorj.NodeIDFromString("satelliteid"),
-				UplinkId:    teststorj.NodeIDFromString("uplinkid"),
-				Action:      pb.PayerBandwidthAllocation_PUT,
-			}
-			pbaData, err := proto.Marshal(pbad)
+			pba, err := TS.signPayerAllocation(TS.newPayerAllocationData(pb.PayerBandwidthAllocation_PUT), TS.satellite)
 			assert.NoError(err)
-			pba := &pb.PayerBandwidthAllocation{Data: pbaData}
 			// Send Bandwidth Allocation Data
 			msg := &pb.PieceStore{
 				PieceData: &pb.PieceStore_PieceData{Content: tt.content},
@@ -378,18 +429,34 @@ func TestStore(t *testing.T) {
 				assert.NoError(err)
 			}
 
+			if tt.hash != nil {
+				err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Hash: tt.hash}})
+				if err != io.EOF && err != nil {
+					assert.NoError(err)
+				}
+			}
+
 			resp, err := stream.CloseAndRecv()
 			if tt.err != "" {
 				assert.NotNil(err)
 				assert.Equal(tt.err, err.Error())
+
+				_, err = TS.s.storage.Size(tt.id)
+				assert.Error(err, "rejected piece stored")
 				return
 			}
 
 			assert.NoError(err)
 
+			hash, err := TS.s.DB.GetPieceHash(tt.id)
+			assert.NoError(err)
+			assert.Equal(sha256Sum(tt.content), hash)
+
 			defer func() {
 				_, err := db.Exec(fmt.Sprintf(`DELETE FROM ttl WHERE id="%s"`, tt.id))
 				assert.NoError(err)
+				_, err = db.Exec(`DELETE FROM bandwidth_agreements`)
+				assert.NoError(err)
 			}()
 
 			// check db to make sure agreement and signature were stored correctly
@@ -424,6 +491,88 @@ func TestStore(t *testing.T) {
 	}
 }
 
+func TestSatelliteAllocations(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	TS := NewTestServer(t)
+	defer TS.Stop()
+
+	TS.s.satelliteAllocated = map[storj.NodeID]int64{TS.satellite.ID: 8}
+	other := teststorj.NodeIDFromString("other")
+
+	store := func(id string, satelliteID storj.NodeID, content []byte) error {
+		stream, err := TS.c.Store(ctx)
+		require.NoError(t, err)
+
+		err = stream.Send(&pb.PieceStore{
+			PieceData:     &pb.PieceStore_PieceData{Id: id, ExpirationUnixSec: 9999999999},
+			Authorization: &pb.SignedMessage{Data: satelliteID.Bytes()},
+		})
+		require.NoError(t, err)
+
+		pba, err := TS.signPayerAllocation(TS.newPayerAllocationData(pb.PayerBandwidthAllocation_PUT), TS.satellite)
+		require.NoError(t, err)
+		msg := &pb.PieceStore{
+			PieceData: &pb.PieceStore_PieceData{Content: content},
+			BandwidthAllocation: &pb.RenterBandwidthAllocation{
+				Data: serializeData(&pb.RenterBandwidthAllocation_Data{
+					PayerAllocation: pba,
+					Total:           int64(len(content)),
+				}),
+			},
+		}
+		msg.BandwidthAllocation.Signature, err = cryptopasta.Sign(msg.BandwidthAllocation.Data, TS.k.(*ecdsa.PrivateKey))
+		require.NoError(t, err)
+
+		if err := stream.Send(msg); err != io.EOF && err != nil {
+			require.NoError(t, err)
+		}
+
+		_, err = stream.CloseAndRecv()
+		return err
+	}
+
+	require.NoError(t, store("11111111111111111111", TS.satellite.ID, []byte("xyzwq")))
+	// the piece would exceed the cap of the satellite
+	assert.Error(t, store("22222222222222222222", TS.satellite.ID, []byte("xyzwq")))
+	// satellites without a cap use the allocated disk space
+	require.NoError(t, store("33333333333333333333", other, []byte("xyzwq")))
+
+	stats, err := TS.s.Stats(ctx, &pb.StatsReq{})
+	require.NoError(t, err)
+
+	expected := map[storj.NodeID]pb.SatelliteStats{
+		TS.satellite.ID: {SatelliteId: TS.satellite.ID, UsedSpace: 5, AllocatedSpace: 8, UsedBandwidth: 5},
+		other:           {SatelliteId: other, UsedSpace: 5, UsedBandwidth: 5},
+	}
+	require.Len(t, stats.GetSatellites(), len(expected))
+	for _, satellite := range stats.GetSatellites() {
+		assert.Equal(t, expected[satellite.SatelliteId], *satellite)
+	}
+}
+
+func TestParseSatelliteAllocations(t *testing.T) {
+	satellite := teststorj.NodeIDFromString("satellite")
+
+	allocations, err := parseSatelliteAllocations("")
+	require.NoError(t, err)
+	assert.Empty(t, allocations)
+
+	allocations, err = parseSatelliteAllocations(satellite.String() + ":2GB, ")
+	require.NoError(t, err)
+	assert.Equal(t, map[storj.NodeID]int64{satellite: 2e9}, allocations)
+
+	for _, invalid := range []string{
+		satellite.String(),
+		"satellite:2GB",
+		satellite.String() + ":lots",
+	} {
+		_, err := parseSatelliteAllocations(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
 func TestPbaValidation(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -431,30 +580,72 @@ func TestPbaValidation(t *testing.T) {
 	TS := NewTestServer(t)
 	defer TS.Stop()
 
+	untrusted := teststorj.NodeIDFromString("satelliteid")
+
 	tests := []struct {
 		satelliteID storj.NodeID
 		uplinkID    storj.NodeID
 		action      pb.PayerBandwidthAllocation_Action
+		expiration  int64
+		signer      *identity.FullIdentity
 		err         string
 	}{
 		{ // missing satellite id
 			satelliteID: storj.NodeID{},
-			uplinkID:    teststorj.NodeIDFromString("uplinkid"),
+			uplinkID:    TS.uplink.ID,
 			action:      pb.PayerBandwidthAllocation_PUT,
+			signer:      TS.satellite,
 			err:         "rpc error: code = Unknown desc = store error: payer bandwidth allocation: missing satellite id",
 		},
 		{ // missing uplink id
-			satelliteID: teststorj.NodeIDFromString("satelliteid"),
+			satelliteID: TS.satellite.ID,
 			uplinkID:    storj.NodeID{},
 			action:      pb.PayerBandwidthAllocation_PUT,
+			signer:      TS.satellite,
 			err:         "rpc error: code = Unknown desc = store error: payer bandwidth allocation: missing uplink id",
 		},
 		{ // wrong action type
-			satelliteID: teststorj.NodeIDFromString("satelliteid"),
-			uplinkID:    teststorj.NodeIDFromString("uplinkid"),
+			satelliteID: TS.satellite.ID,
+			uplinkID:    TS.uplink.ID,
 			action:      pb.PayerBandwidthAllocation_GET,
+			signer:      TS.satellite,
 			err:         "rpc error: code = Unknown desc = store error: payer bandwidth allocation: invalid action GET",
 		},
+		{ // expired allocation
+			satelliteID: TS.satellite.ID,
+			uplinkID:    TS.uplink.ID,
+			action:      pb.PayerBandwidthAllocation_PUT,
+			expiration:  time.Now().Add(-time.Hour).Unix(),
+			signer:      TS.satellite,
+			err:         "rpc error: code = Unknown desc = store error: payer bandwidth allocation: expired at",
+		},
+		{ // untrusted satellite
+			satelliteID: untrusted,
+			uplinkID:    TS.uplink.ID,
+			action:      pb.PayerBandwidthAllocation_PUT,
+			signer:      TS.satellite,
+			err:         "rpc error: code = PermissionDenied desc = payer bandwidth allocation: untrusted satellite " + untrusted.String(),
+		},
+		{ // not signed by the satellite
+			satelliteID: TS.satellite.ID,
+			uplinkID:    TS.uplink.ID,
+			action:      pb.PayerBandwidthAllocation_PUT,
+			signer:      TS.uplink,
+			err:         "rpc error: code = Unknown desc = store error: payer bandwidth allocation: invalid satellite signature",
+		},
+		{ // issued to another uplink
+			satelliteID: TS.satellite.ID,
+			uplinkID:    teststorj.NodeIDFromString("uplinkid"),
+			action:      pb.PayerBandwidthAllocation_PUT,
+			signer:      TS.satellite,
+			err:         "rpc error: code = Unknown desc = store error: payer bandwidth allocation: issued to uplink",
+		},
+		{ // valid allocation
+			satelliteID: TS.satellite.ID,
+			uplinkID:    TS.uplink.ID,
+			action:      pb.PayerBandwidthAllocation_PUT,
+			signer:      TS.satellite,
+		},
 	}
 
 	for _, tt := range tests {
@@ -467,14 +658,14 @@ func TestPbaValidation(t *testing.T) {
 			err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: "99999999999999999999", ExpirationUnixSec: 9999999999}})
 			assert.NoError(err)
 
-			pbad := &pb.PayerBandwidthAllocation_Data{
-				SatelliteId: tt.satelliteID,
-				UplinkId:    tt.uplinkID,
-				Action:      tt.action,
+			pbad := TS.newPayerAllocationData(tt.action)
+			pbad.SatelliteId = tt.satelliteID
+			pbad.UplinkId = tt.uplinkID
+			if tt.expiration != 0 {
+				pbad.ExpirationUnixSec = tt.expiration
 			}
-			pbaData, err := proto.Marshal(pbad)
+			pba, err := TS.signPayerAllocation(pbad, tt.signer)
 			assert.NoError(err)
-			pba := &pb.PayerBandwidthAllocation{Data: pbaData}
 			// Send Bandwidth Allocation Data
 			content := []byte("content")
 			msg := &pb.PieceStore{
@@ -498,15 +689,72 @@ func TestPbaValidation(t *testing.T) {
 			}
 
 			_, err = stream.CloseAndRecv()
-			if err != nil {
-				//assert.NotNil(err)
-				assert.Equal(tt.err, err.Error())
+			if tt.err == "" {
+				assert.NoError(err)
 				return
 			}
+			if assert.Error(err) {
+				assert.True(strings.HasPrefix(err.Error(), tt.err), err.Error())
+			}
 		})
 	}
 }
 
+func TestPbaReplay(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	TS := NewTestServer(t)
+	defer TS.Stop()
+
+	pba, err := TS.signPayerAllocation(TS.newPayerAllocationData(pb.PayerBandwidthAllocation_PUT), TS.satellite)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	store := func(id string) error {
+		stream, err := TS.c.Store(ctx)
+		if err != nil {
+			return err
+		}
+
+		err = stream.Send(&pb.PieceStore{PieceData: &pb.PieceStore_PieceData{Id: id, ExpirationUnixSec: 9999999999}})
+		if err != nil {
+			return err
+		}
+
+		// the allocation is repeated in every message of the transfer
+		for total := int64(1); total <= 2; total++ {
+			msg := &pb.PieceStore{
+				PieceData: &pb.PieceStore_PieceData{Content: []byte("x")},
+				BandwidthAllocation: &pb.RenterBandwidthAllocation{
+					Data: serializeData(&pb.RenterBandwidthAllocation_Data{
+						PayerAllocation: pba,
+						Total:           total,
+					}),
+				},
+			}
+			msg.BandwidthAllocation.Signature, err = cryptopasta.Sign(msg.BandwidthAllocation.Data, TS.k.(*ecdsa.PrivateKey))
+			if err != nil {
+				return err
+			}
+			if err = stream.Send(msg); err != nil && err != io.EOF {
+				return err
+			}
+		}
+
+		_, err = stream.CloseAndRecv()
+		return err
+	}
+
+	assert.NoError(t, store("11111111111111111111"))
+
+	err = store("22222222222222222222")
+	if assert.Error(t, err) {
+		assert.Contains(t, err.Error(), "already used")
+	}
+}
+
 func TestDelete(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
@@ -574,16 +822,67 @@ func TestDelete(t *testing.T) {
 			assert.Equal(tt.message, resp.GetMessage())
 
 			// if test passes, check if file was indeed deleted
-			filePath, err := TS.s.storage.PiecePath(tt.id)
-			assert.NoError(err)
-			if _, err = os.Stat(filePath); os.IsExist(err) {
-				t.Errorf("File not deleted")
-				return
-			}
+			_, err = TS.s.storage.Size(tt.id)
+			assert.Error(err, "piece not deleted")
 		})
 	}
 }
 
+func TestRetain(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	TS := NewTestServer(t)
+	defer TS.Stop()
+
+	TS.s.retainTimeBuffer = time.Hour
+	other := teststorj.NodeIDFromString("other")
+
+	pieces := []struct {
+		id        string
+		satellite storj.NodeID
+		created   time.Time
+		kept      bool
+	}{
+		{"11111111111111111111", TS.satellite.ID, time.Now().Add(-2 * time.Hour), true},  // referenced
+		{"22222222222222222222", TS.satellite.ID, time.Now().Add(-2 * time.Hour), false}, // garbage
+		{"33333333333333333333", TS.satellite.ID, time.Now(), true},                      // stored during the collection
+		{"44444444444444444444", other, time.Now().Add(-2 * time.Hour), true},            // of another satellite
+	}
+
+	filter := bloomfilter.NewOptimal(len(pieces), 0.000001)
+	filter.Add([]byte("satellite-11111111111111111111"))
+
+	for _, piece := range pieces {
+		require.NoError(t, TS.writeFile(piece.id))
+		require.NoError(t, TS.s.DB.AddTTL(piece.id, 0, 5))
+		require.NoError(t, TS.s.DB.AddSatellitePiece(piece.id, piece.satellite, "satellite-"+piece.id))
+
+		_, err := TS.s.DB.DB.Exec(`UPDATE ttl SET created = ? WHERE id = ?`, piece.created.Unix(), piece.id)
+		require.NoError(t, err)
+	}
+
+	req := &pb.RetainRequest{Filter: filter.Bytes(), CreationDate: ptypes.TimestampNow()}
+
+	// the client of the test server is an uplink
+	_, err := TS.c.Retain(ctx, req)
+	assert.Error(t, err)
+
+	co, err := TS.satellite.DialOption(storj.NodeID{})
+	require.NoError(t, err)
+	c, conn := connect(TS.addr, co)
+	defer ctx.Check(conn.Close)
+
+	resp, err := c.Retain(ctx, req)
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), resp.GetDeleted())
+
+	for _, piece := range pieces {
+		_, err := TS.s.storage.Size(piece.id)
+		assert.Equal(t, piece.kept, err == nil, piece.id)
+	}
+}
+
 func newTestServerStruct(t *testing.T) (*Server, func()) {
 	tmp, err := ioutil.TempDir("", "storj-piecestore")
 	if err != nil {
@@ -592,7 +891,10 @@ func newTestServerStruct(t *testing.T) (*Server, func()) {
 
 	tempDBPath := filepath.Join(tmp, "test.db")
 	tempDir := filepath.Join(tmp, "test-data", "3000")
-	storage := pstore.NewStorage(tempDir)
+	storage, err := pstore.NewStorage(tempDir)
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
 
 	psDB, err := psdb.Open(context.TODO(), storage, tempDBPath)
 	if err != nil {
@@ -637,9 +939,13 @@ type TestServer struct {
 	s        *Server
 	scleanup func()
 	grpcs    *grpc.Server
+	addr     string
 	conn     *grpc.ClientConn
 	c        pb.PieceStoreRoutesClient
 	k        crypto.PrivateKey
+
+	satellite *identity.FullIdentity
+	uplink    *identity.FullIdentity
 }
 
 func NewTestServer(t *testing.T) *TestServer {
@@ -663,14 +969,24 @@ func NewTestServer(t *testing.T) *TestServer {
 	co, err := fiC.DialOption(storj.NodeID{})
 	check(err)
 
+	caSat, err := testidentity.NewTestCA(context.Background())
+	check(err)
+	fiSat, err := caSat.NewIdentity()
+	check(err)
+
 	s, cleanup := newTestServerStruct(t)
+	s.trust = &TrustedSatellites{
+		satellites: map[storj.NodeID]*trustedSatellite{
+			fiSat.ID: {key: fiSat.Leaf.PublicKey.(*ecdsa.PublicKey)},
+		},
+	}
 	grpcs := grpc.NewServer(so)
 
 	k, ok := fiC.Key.(*ecdsa.PrivateKey)
 	assert.True(t, ok)
-	ts := &TestServer{s: s, scleanup: cleanup, grpcs: grpcs, k: k}
-	addr := ts.start()
-	ts.c, ts.conn = connect(addr, co)
+	ts := &TestServer{s: s, scleanup: cleanup, grpcs: grpcs, k: k, satellite: fiSat, uplink: fiC}
+	ts.addr = ts.start()
+	ts.c, ts.conn = connect(ts.addr, co)
 
 	return ts
 }
@@ -698,6 +1014,39 @@ func (TS *TestServer) Stop() {
 	TS.scleanup()
 }
 
+// newPayerAllocationData returns the data of a valid payer bandwidth
+// allocation of the test satellite for the test client
+func (TS *TestServer) newPayerAllocationData(action pb.PayerBandwidthAllocation_Action) *pb.PayerBandwidthAllocation_Data {
+	pubKey, _ := x509.MarshalPKIXPublicKey(TS.uplink.Leaf.PublicKey)
+	serialNumber, _ := uuid.New()
+	return &pb.PayerBandwidthAllocation_Data{
+		SatelliteId:       TS.satellite.ID,
+		UplinkId:          TS.uplink.ID,
+		SerialNumber:      serialNumber.String(),
+		Action:            action,
+		CreatedUnixSec:    time.Now().Unix(),
+		ExpirationUnixSec: time.Now().Add(time.Hour).Unix(),
+		PubKey:            pubKey,
+	}
+}
+
+func (TS *TestServer) signPayerAllocation(pbad *pb.PayerBandwidthAllocation_Data, signer *identity.FullIdentity) (*pb.PayerBandwidthAllocation, error) {
+	data, err := proto.Marshal(pbad)
+	if err != nil {
+		return nil, err
+	}
+	signature, err := auth.GenerateSignature(data, signer)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PayerBandwidthAllocation{Signature: signature, Data: data}, nil
+}
+
+func sha256Sum(data []byte) []byte {
+	hash := sha256.Sum256(data)
+	return hash[:]
+}
+
 func serializeData(ba *pb.RenterBandwidthAllocation_Data) []byte {
 	data, _ := proto.Marshal(ba)
 	return data
@@ -4,7 +4,9 @@
 package psserver
 
 import (
+	"bytes"
 	"context"
+	"crypto/sha256"
 	"fmt"
 	"io"
 	"time"
@@ -13,7 +15,7 @@ import (
 	"go.uber.org/zap"
 
 	"storj.io/storj/pkg/pb"
-	"storj.io/storj/pkg/utils"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
 )
 
 // OK - Success!
@@ -55,17 +57,25 @@ func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 	if err != nil {
 		return err
 	}
-	total, err := s.storeData(ctx, reqStream, id)
+
+	// the satellite garbage collects the piece and its usage counts against
+	// the storage cap of the satellite
+	satelliteID, err := getSatelliteID(authorization)
 	if err != nil {
-		return err
+		return StoreError.Wrap(err)
 	}
 
-	if err = s.DB.AddTTL(id, pd.GetExpirationUnixSec(), total); err != nil {
-		deleteErr := s.deleteByID(id)
-		return StoreError.New("failed to write piece meta data to database: %v", utils.CombineErrors(err, deleteErr))
+	total, err := s.storeData(ctx, reqStream, psdb.StoredPiece{
+		ID:               id,
+		Expiration:       pd.GetExpirationUnixSec(),
+		SatelliteID:      satelliteID,
+		SatellitePieceID: pd.GetId(),
+	})
+	if err != nil {
+		return err
 	}
 
-	if err = s.DB.AddBandwidthUsed(total); err != nil {
+	if err = s.DB.AddBandwidthUsed(satelliteID, total); err != nil {
 		return StoreError.New("failed to write bandwidth info to database: %v", err)
 	}
 	s.log.Debug("Successfully stored", zap.String("Piece ID", fmt.Sprint(pd.GetId())))
@@ -73,9 +83,13 @@ func (s *Server) Store(reqStream pb.PieceStoreRoutes_StoreServer) (err error) {
 	return reqStream.SendAndClose(&pb.PieceStoreSummary{Message: OK, TotalReceived: total})
 }
 
-func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_StoreServer, id string) (total int64, err error) {
+// storeData receives the data of the piece and commits it together with its
+// records in the database
+func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_StoreServer, piece psdb.StoredPiece) (total int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
+	id, satelliteID := piece.ID, piece.SatelliteID
+
 	// Delete data if we error
 	defer func() {
 		if err != nil && err != io.EOF {
@@ -91,26 +105,64 @@ func (s *Server) storeData(ctx context.Context, stream pb.PieceStoreRoutes_Store
 		return 0, err
 	}
 
-	defer utils.LogClose(storeFile)
+	// discards the data unless it was committed
+	defer func() {
+		if cancelErr := storeFile.Cancel(); cancelErr != nil {
+			s.log.Error("Failed on Cancel in Store", zap.Error(cancelErr))
+		}
+	}()
 
-	bwUsed, err := s.DB.GetTotalBandwidthBetween(getBeginningOfMonth(), time.Now())
+	bwUsed, err := s.DB.UsedBandwidth(time.Now())
 	if err != nil {
 		return 0, err
 	}
-	spaceUsed, err := s.DB.SumTTLSizes()
+	spaceUsed, err := s.DB.UsedSpace()
 	if err != nil {
 		return 0, err
 	}
 	bwLeft := s.totalBwAllocated - bwUsed
 	spaceLeft := s.totalAllocated - spaceUsed
+
+	if allocated, ok := s.satelliteAllocated[satelliteID]; ok {
+		satelliteUsed, err := s.DB.SatelliteUsedSpace(satelliteID)
+		if err != nil {
+			return 0, err
+		}
+		if satelliteLeft := allocated - satelliteUsed; satelliteLeft < spaceLeft {
+			spaceLeft = satelliteLeft
+		}
+	}
+
+	// reject the piece before receiving it when nothing is left, otherwise
+	// the reader stops when the piece would overflow the allocation
+	if bwLeft <= 0 {
+		return 0, StoreError.New("out of bandwidth")
+	}
+	if spaceLeft <= 0 {
+		return 0, StoreError.New("out of space")
+	}
 	reader := NewStreamReader(s, stream, bwLeft, spaceLeft)
 
-	total, err = io.Copy(storeFile, reader)
+	hasher := sha256.New()
+	total, err = io.Copy(io.MultiWriter(storeFile, hasher), reader)
 
 	if err != nil && err != io.EOF {
 		return 0, err
 	}
 
+	// uplinks which don't send the hash of the piece get the piece stored
+	// with the hash of the received data
+	piece.Hash = hasher.Sum(nil)
+	if reader.hash != nil && !bytes.Equal(reader.hash, piece.Hash) {
+		mon.Meter("piece_hash_mismatch").Mark(1)
+		return 0, StoreError.New("piece hash mismatch")
+	}
+
+	piece.Size = total
+	if err = s.DB.AddPiece(piece, storeFile.Commit); err != nil {
+		return 0, StoreError.New("failed to write piece to database: %v", err)
+	}
+
 	err = s.DB.WriteBandwidthAllocToDB(reader.bandwidthAllocation)
 
 	return total, err
@@ -0,0 +1,320 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"crypto/ecdsa"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+	"golang.org/x/time/rate"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+
+	"storj.io/storj/pkg/identity"
+	"storj.io/storj/pkg/kademlia"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/peertls"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+const (
+	// unknownSatellitesCached is the number of keys of unknown satellites
+	// kept at most
+	unknownSatellitesCached = 100
+	// unknownSatelliteKeyTTL is how long the key of an unknown satellite is
+	// kept
+	unknownSatelliteKeyTTL = time.Hour
+	// unknownSatelliteLookups is the rate of key lookups of unknown
+	// satellites per second, with bursts of unknownSatelliteLookupBurst
+	unknownSatelliteLookups     = 1
+	unknownSatelliteLookupBurst = 10
+)
+
+// TrustedSatellites are the satellites whose bandwidth allocations a storage
+// node accepts, together with the keys they sign the allocations with
+type TrustedSatellites struct {
+	log       *zap.Logger
+	kad       *kademlia.Kademlia
+	transport transport.Client
+
+	// trustUnknown accepts the satellites which aren't configured
+	trustUnknown bool
+	limiter      *rate.Limiter
+	fetch        func(ctx context.Context, id storj.NodeID, address string) (*ecdsa.PublicKey, error)
+	now          func() time.Time
+
+	mu         sync.Mutex
+	satellites map[storj.NodeID]*trustedSatellite
+	unknown    map[storj.NodeID]*unknownSatellite
+}
+
+type trustedSatellite struct {
+	address string
+	key     *ecdsa.PublicKey
+}
+
+// unknownSatellite is the cached key of a satellite which isn't configured
+type unknownSatellite struct {
+	key     *ecdsa.PublicKey
+	expires time.Time
+}
+
+// NewTrustedSatellites creates the trusted satellites from a comma separated
+// list of <id>@<address> entries, the address of an entry without one is
+// looked up with kademlia. The satellites which aren't in the list are only
+// accepted with trustUnknown, a node with an empty list doesn't accept any
+// satellite otherwise.
+func NewTrustedSatellites(log *zap.Logger, list string, trustUnknown bool, kad *kademlia.Kademlia, transport transport.Client) (*TrustedSatellites, error) {
+	trust := &TrustedSatellites{
+		log:          log,
+		kad:          kad,
+		transport:    transport,
+		trustUnknown: trustUnknown,
+		limiter:      rate.NewLimiter(unknownSatelliteLookups, unknownSatelliteLookupBurst),
+		now:          time.Now,
+		satellites:   map[storj.NodeID]*trustedSatellite{},
+		unknown:      map[storj.NodeID]*unknownSatellite{},
+	}
+	trust.fetch = trust.fetchPublicKey
+
+	for _, entry := range strings.Split(list, ",") {
+		entry = strings.TrimSpace(entry)
+		if entry == "" {
+			continue
+		}
+
+		var address string
+		if i := strings.Index(entry, "@"); i >= 0 {
+			entry, address = entry[:i], entry[i+1:]
+		}
+
+		id, err := storj.NodeIDFromString(entry)
+		if err != nil {
+			return nil, ServerError.New("invalid trusted satellite %q: %v", entry, err)
+		}
+		trust.satellites[id] = &trustedSatellite{address: address}
+	}
+
+	if len(trust.satellites) == 0 && !trustUnknown {
+		log.Warn("no trusted satellites configured, the bandwidth allocations of all satellites are rejected")
+	}
+
+	return trust, nil
+}
+
+// IsTrusted returns whether allocations of the satellite are accepted
+func (trust *TrustedSatellites) IsTrusted(id storj.NodeID) bool {
+	if trust.trustUnknown {
+		return true
+	}
+
+	trust.mu.Lock()
+	defer trust.mu.Unlock()
+
+	_, ok := trust.satellites[id]
+	return ok
+}
+
+// PublicKey returns the key the satellite signs allocations with, it's
+// fetched from the satellite on first use. The keys of unknown satellites
+// expire and the lookups of them are rate limited.
+func (trust *TrustedSatellites) PublicKey(ctx context.Context, id storj.NodeID) (key *ecdsa.PublicKey, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	trust.mu.Lock()
+	satellite, ok := trust.satellites[id]
+	if !ok {
+		trust.mu.Unlock()
+		return trust.unknownPublicKey(ctx, id)
+	}
+	key, address := satellite.key, satellite.address
+	trust.mu.Unlock()
+
+	if key != nil {
+		return key, nil
+	}
+
+	key, err = trust.fetch(ctx, id, address)
+	if err != nil {
+		return nil, err
+	}
+
+	trust.mu.Lock()
+	satellite.key = key
+	trust.mu.Unlock()
+
+	return key, nil
+}
+
+// unknownPublicKey returns the key of a satellite which isn't configured
+func (trust *TrustedSatellites) unknownPublicKey(ctx context.Context, id storj.NodeID) (key *ecdsa.PublicKey, err error) {
+	if !trust.trustUnknown {
+		return nil, ServerError.New("untrusted satellite %s", id)
+	}
+
+	now := trust.now()
+
+	trust.mu.Lock()
+	if satellite, ok := trust.unknown[id]; ok {
+		if now.Before(satellite.expires) {
+			trust.mu.Unlock()
+			return satellite.key, nil
+		}
+		delete(trust.unknown, id)
+	}
+	trust.mu.Unlock()
+
+	if !trust.limiter.AllowN(now, 1) {
+		return nil, ServerError.New("too many lookups of unknown satellites, rejected %s", id)
+	}
+
+	key, err = trust.fetch(ctx, id, "")
+	if err != nil {
+		return nil, err
+	}
+
+	trust.mu.Lock()
+	defer trust.mu.Unlock()
+
+	// the key expiring first makes room for the new one
+	for len(trust.unknown) >= unknownSatellitesCached {
+		var oldest storj.NodeID
+		var expires time.Time
+		for cached, satellite := range trust.unknown {
+			if expires.IsZero() || satellite.expires.Before(expires) {
+				oldest, expires = cached, satellite.expires
+			}
+		}
+		delete(trust.unknown, oldest)
+	}
+	trust.unknown[id] = &unknownSatellite{
+		key:     key,
+		expires: now.Add(unknownSatelliteKeyTTL),
+	}
+
+	return key, nil
+}
+
+// Nodes returns the configured satellites, the satellites without an
+// address are looked up with kademlia
+func (trust *TrustedSatellites) Nodes(ctx context.Context) (nodes []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	trust.mu.Lock()
+	addresses := map[storj.NodeID]string{}
+	for id, satellite := range trust.satellites {
+		addresses[id] = satellite.address
+	}
+	trust.mu.Unlock()
+
+	for id, address := range addresses {
+		node, err := trust.lookup(ctx, id, address)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, &node)
+	}
+
+	sort.Slice(nodes, func(i, k int) bool {
+		return nodes[i].Id.Less(nodes[k].Id)
+	})
+	return nodes, nil
+}
+
+// IDs returns the configured satellites
+func (trust *TrustedSatellites) IDs() (ids storj.NodeIDList) {
+	trust.mu.Lock()
+	for id := range trust.satellites {
+		ids = append(ids, id)
+	}
+	trust.mu.Unlock()
+
+	sort.Slice(ids, func(i, k int) bool {
+		return ids[i].Less(ids[k])
+	})
+	return ids
+}
+
+// Node returns the node of the satellite, it's looked up with kademlia if
+// the address of the satellite isn't configured
+func (trust *TrustedSatellites) Node(ctx context.Context, id storj.NodeID) (node pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var address string
+	trust.mu.Lock()
+	if satellite, ok := trust.satellites[id]; ok {
+		address = satellite.address
+	}
+	trust.mu.Unlock()
+
+	return trust.lookup(ctx, id, address)
+}
+
+// lookup returns the node of the satellite, it's looked up with kademlia
+// if the address isn't known
+func (trust *TrustedSatellites) lookup(ctx context.Context, id storj.NodeID, address string) (pb.Node, error) {
+	if address != "" {
+		return pb.Node{
+			Id:   id,
+			Type: pb.NodeType_SATELLITE,
+			Address: &pb.NodeAddress{
+				Transport: pb.NodeTransport_TCP_TLS_GRPC,
+				Address:   address,
+			},
+		}, nil
+	}
+
+	if trust.kad == nil {
+		return pb.Node{}, ServerError.New("no address for satellite %s", id)
+	}
+
+	node, err := trust.kad.FindNode(ctx, id)
+	if err != nil {
+		return pb.Node{}, ServerError.New("failed to look up satellite %s: %v", id, err)
+	}
+	return node, nil
+}
+
+// fetchPublicKey dials the satellite and returns the leaf key of its identity
+func (trust *TrustedSatellites) fetchPublicKey(ctx context.Context, id storj.NodeID, address string) (*ecdsa.PublicKey, error) {
+	node, err := trust.lookup(ctx, id, address)
+	if err != nil {
+		return nil, err
+	}
+
+	// the dial option verifies the identity of the satellite
+	conn, err := trust.transport.DialNode(ctx, &node)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+	defer func() {
+		if err := conn.Close(); err != nil {
+			trust.log.Warn("failed to close connection to satellite", zap.Error(err))
+		}
+	}()
+
+	var p peer.Peer
+	_, err = pb.NewNodesClient(conn).Ping(ctx, &pb.PingRequest{}, grpc.Peer(&p))
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	pi, err := identity.PeerIdentityFromPeer(&p)
+	if err != nil {
+		return nil, ServerError.Wrap(err)
+	}
+
+	key, ok := pi.Leaf.PublicKey.(*ecdsa.PublicKey)
+	if !ok {
+		return nil, peertls.ErrUnsupportedKey.New("%T", pi.Leaf.PublicKey)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,101 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package psserver
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+	"golang.org/x/net/context"
+
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/storj"
+)
+
+func TestNewTrustedSatellites(t *testing.T) {
+	trusted := teststorj.NodeIDFromString("trusted")
+	unknown := teststorj.NodeIDFromString("unknown")
+
+	// a node without trusted satellites starts, but doesn't accept any
+	trust, err := NewTrustedSatellites(zap.NewNop(), "", false, nil, nil)
+	require.NoError(t, err)
+	assert.False(t, trust.IsTrusted(unknown))
+	assert.Empty(t, trust.IDs())
+
+	_, err = NewTrustedSatellites(zap.NewNop(), "not an id", false, nil, nil)
+	assert.Error(t, err)
+
+	trust, err = NewTrustedSatellites(zap.NewNop(), trusted.String()+"@127.0.0.1:7777", false, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, trust.IsTrusted(trusted))
+	assert.False(t, trust.IsTrusted(unknown))
+	assert.Equal(t, storj.NodeIDList{trusted}, trust.IDs())
+
+	_, err = trust.PublicKey(context.Background(), unknown)
+	assert.Error(t, err)
+
+	trust, err = NewTrustedSatellites(zap.NewNop(), "", true, nil, nil)
+	require.NoError(t, err)
+	assert.True(t, trust.IsTrusted(unknown))
+	assert.Empty(t, trust.IDs())
+}
+
+func TestTrustedSatellitesUnknownKeys(t *testing.T) {
+	ctx := context.Background()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	trust, err := NewTrustedSatellites(zap.NewNop(), "", true, nil, nil)
+	require.NoError(t, err)
+
+	now := time.Now()
+	trust.now = func() time.Time { return now }
+
+	fetched := 0
+	trust.fetch = func(ctx context.Context, id storj.NodeID, address string) (*ecdsa.PublicKey, error) {
+		fetched++
+		return &key.PublicKey, nil
+	}
+
+	first := teststorj.NodeIDFromString("first")
+
+	// the key is cached
+	for i := 0; i < 2; i++ {
+		got, err := trust.PublicKey(ctx, first)
+		require.NoError(t, err)
+		assert.Equal(t, &key.PublicKey, got)
+	}
+	assert.Equal(t, 1, fetched)
+
+	// and fetched again when it expired
+	now = now.Add(unknownSatelliteKeyTTL)
+	_, err = trust.PublicKey(ctx, first)
+	require.NoError(t, err)
+	assert.Equal(t, 2, fetched)
+
+	// the lookups are limited to bursts
+	for i := 0; i < unknownSatelliteLookupBurst; i++ {
+		_, err = trust.PublicKey(ctx, teststorj.NodeIDFromBytes([]byte{byte(i), 1}))
+		if i < unknownSatelliteLookupBurst-1 {
+			assert.NoError(t, err)
+		} else {
+			assert.Error(t, err)
+		}
+	}
+
+	// the cache is bounded
+	for i := 0; i < 2*unknownSatellitesCached; i++ {
+		now = now.Add(time.Second)
+		_, err = trust.PublicKey(ctx, teststorj.NodeIDFromBytes([]byte{byte(i), 2}))
+		require.NoError(t, err)
+	}
+	assert.Len(t, trust.unknown, unknownSatellitesCached)
+}
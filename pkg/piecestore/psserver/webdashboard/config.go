@@ -0,0 +1,23 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webdashboard
+
+import (
+	"github.com/zeebo/errs"
+	monkit "gopkg.in/spacemonkeygo/monkit.v2"
+)
+
+var (
+	// Error is the default error class for the web dashboard
+	Error = errs.Class("web dashboard error")
+	mon   = monkit.Package()
+)
+
+// Config contains the configuration of the web dashboard of a storage node
+type Config struct {
+	Address        string  `user:"true" help:"local address to serve the web dashboard on, empty disables the dashboard" default:""`
+	HistoryDays    int     `help:"number of days of bandwidth history shown on the dashboard" default:"30"`
+	StoragePrice   float64 `help:"price in USD paid for storing a TB for a month, used to estimate the earnings" default:"1.5"`
+	BandwidthPrice float64 `help:"price in USD paid for a TB of bandwidth, used to estimate the earnings" default:"20"`
+}
@@ -0,0 +1,133 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webdashboard
+
+// indexPage is the page of the dashboard, it renders the JSON API
+const indexPage = `<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Storage Node Dashboard</title>
+<style>
+body { font-family: sans-serif; margin: 2em; color: #222; }
+h1 { font-size: 1.4em; }
+h2 { font-size: 1.1em; margin-top: 2em; }
+table { border-collapse: collapse; }
+th, td { padding: 0.3em 1em; border-bottom: 1px solid #ddd; text-align: left; }
+td.number { text-align: right; }
+.bar { background: #2683ff; height: 0.8em; }
+.error { color: #c00; }
+</style>
+</head>
+<body>
+<h1>Storage Node <span id="node-id"></span></h1>
+
+<table id="summary"></table>
+
+<h2>Usage per satellite</h2>
+<table id="satellites"></table>
+
+<h2>Bandwidth history</h2>
+<table id="bandwidth"></table>
+
+<h2>Reputation</h2>
+<table id="reputation"><tr><td>loading...</td></tr></table>
+
+<script>
+function formatBytes(bytes) {
+	var units = ["B", "KB", "MB", "GB", "TB", "PB"];
+	var i = 0;
+	while (bytes >= 1000 && i < units.length - 1) {
+		bytes /= 1000;
+		i++;
+	}
+	return bytes.toFixed(i == 0 ? 0 : 2) + " " + units[i];
+}
+
+function formatPercent(ratio) {
+	return (ratio * 100).toFixed(2) + " %";
+}
+
+function row(cells, header) {
+	var tr = document.createElement("tr");
+	cells.forEach(function(cell) {
+		var td = document.createElement(header ? "th" : "td");
+		if (cell instanceof Node) {
+			td.appendChild(cell);
+		} else {
+			td.textContent = cell;
+		}
+		tr.appendChild(td);
+	});
+	return tr;
+}
+
+function fill(id, header, rows) {
+	var table = document.getElementById(id);
+	table.innerHTML = "";
+	table.appendChild(row(header, true));
+	rows.forEach(function(cells) { table.appendChild(row(cells)); });
+}
+
+function get(path, callback) {
+	var req = new XMLHttpRequest();
+	req.onload = function() {
+		if (req.status != 200) {
+			callback(null, req.responseText);
+			return;
+		}
+		callback(JSON.parse(req.responseText));
+	};
+	req.open("GET", path);
+	req.send();
+}
+
+get("/api/dashboard", function(data, error) {
+	if (!data) {
+		fill("summary", ["error"], [[error]]);
+		return;
+	}
+
+	document.getElementById("node-id").textContent = data.nodeId;
+	fill("summary", ["", "used", "allocated"], [
+		["Disk space", formatBytes(data.usedSpace), formatBytes(data.allocatedSpace)],
+		["Bandwidth this month", formatBytes(data.usedBandwidth), formatBytes(data.allocatedBandwidth)],
+		["Estimated earnings this month", "$" + data.earnings.total.toFixed(2),
+			"storage $" + data.earnings.storage.toFixed(2) + ", bandwidth $" + data.earnings.bandwidth.toFixed(2)]
+	]);
+
+	fill("satellites", ["satellite", "pieces", "stored", "bandwidth this month"], data.satellites.map(function(satellite) {
+		return [satellite.satelliteId, satellite.pieces, formatBytes(satellite.usedSpace), formatBytes(satellite.usedBandwidth)];
+	}));
+
+	var max = Math.max.apply(null, data.bandwidth.map(function(day) { return day.used; }).concat([1]));
+	fill("bandwidth", ["day", "used", ""], data.bandwidth.map(function(day) {
+		var bar = document.createElement("div");
+		bar.className = "bar";
+		bar.style.width = (day.used / max * 300) + "px";
+		return [day.day, formatBytes(day.used), bar];
+	}));
+});
+
+get("/api/reputation", function(data, error) {
+	if (!data) {
+		fill("reputation", ["error"], [[error]]);
+		return;
+	}
+
+	fill("reputation", ["satellite", "audits", "audit success", "uptime checks", "uptime"], data.map(function(reputation) {
+		if (reputation.error) {
+			var span = document.createElement("span");
+			span.className = "error";
+			span.textContent = reputation.error;
+			return [reputation.satelliteId, span, "", "", ""];
+		}
+		return [reputation.satelliteId, reputation.auditCount, formatPercent(reputation.auditRatio),
+			reputation.uptimeCount, formatPercent(reputation.uptimeRatio)];
+	}));
+});
+</script>
+</body>
+</html>
+`
@@ -0,0 +1,317 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webdashboard
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/zeebo/errs"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+// Satellites are the satellites the node reports the reputation of
+type Satellites interface {
+	// IDs returns the configured satellites
+	IDs() storj.NodeIDList
+	// Node returns the node to dial the satellite with
+	Node(ctx context.Context, id storj.NodeID) (pb.Node, error)
+}
+
+// Allocation is the space and the monthly bandwidth allocated to the node
+type Allocation struct {
+	Space     int64
+	Bandwidth int64
+}
+
+// Dashboard is the local data of the node served on /api/dashboard
+type Dashboard struct {
+	NodeID             string           `json:"nodeId"`
+	UsedSpace          int64            `json:"usedSpace"`
+	AllocatedSpace     int64            `json:"allocatedSpace"`
+	UsedBandwidth      int64            `json:"usedBandwidth"`
+	AllocatedBandwidth int64            `json:"allocatedBandwidth"`
+	Satellites         []SatelliteUsage `json:"satellites"`
+	Bandwidth          []DailyBandwidth `json:"bandwidth"`
+	Earnings           Earnings         `json:"earnings"`
+}
+
+// SatelliteUsage is the data the node stores and the bandwidth it used this
+// month for a satellite
+type SatelliteUsage struct {
+	SatelliteID   string `json:"satelliteId"`
+	Pieces        int64  `json:"pieces"`
+	UsedSpace     int64  `json:"usedSpace"`
+	UsedBandwidth int64  `json:"usedBandwidth"`
+}
+
+// DailyBandwidth is the bandwidth the node used on a day
+type DailyBandwidth struct {
+	Day  string `json:"day"`
+	Used int64  `json:"used"`
+}
+
+// Earnings are the estimated earnings of the current month in USD. Storage
+// assumes the used space is kept for the whole month and bandwidth counts
+// all the bandwidth used this month.
+type Earnings struct {
+	Storage   float64 `json:"storage"`
+	Bandwidth float64 `json:"bandwidth"`
+	Total     float64 `json:"total"`
+}
+
+// Reputation is the reputation of the node as reported by a satellite, served
+// on /api/reputation
+type Reputation struct {
+	SatelliteID string  `json:"satelliteId"`
+	AuditCount  int64   `json:"auditCount"`
+	AuditRatio  float64 `json:"auditRatio"`
+	UptimeCount int64   `json:"uptimeCount"`
+	UptimeRatio float64 `json:"uptimeRatio"`
+	// Error is set when the satellite couldn't be queried
+	Error string `json:"error,omitempty"`
+}
+
+// Server serves the web dashboard of a storage node, a JSON API together with
+// a page showing it
+type Server struct {
+	log        *zap.Logger
+	config     Config
+	db         *psdb.DB
+	satellites Satellites
+	transport  transport.Client
+	allocation Allocation
+	mux        *http.ServeMux
+}
+
+// NewServer creates the web dashboard server
+func NewServer(log *zap.Logger, config Config, db *psdb.DB, satellites Satellites, transport transport.Client, allocation Allocation) *Server {
+	server := &Server{
+		log:        log,
+		config:     config,
+		db:         db,
+		satellites: satellites,
+		transport:  transport,
+		allocation: allocation,
+		mux:        http.NewServeMux(),
+	}
+
+	server.mux.HandleFunc("/", server.indexHandler)
+	server.mux.HandleFunc("/api/dashboard", server.dashboardHandler)
+	server.mux.HandleFunc("/api/reputation", server.reputationHandler)
+
+	return server
+}
+
+// Run serves the dashboard on the configured address until the context is
+// canceled
+func (server *Server) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	listener, err := net.Listen("tcp", server.config.Address)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	return server.Serve(ctx, listener)
+}
+
+// Serve serves the dashboard on the listener until the context is canceled
+func (server *Server) Serve(ctx context.Context, listener net.Listener) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	httpServer := &http.Server{Handler: server}
+	go func() {
+		<-ctx.Done()
+		if err := httpServer.Close(); err != nil {
+			server.log.Warn("failed to close web dashboard", zap.Error(err))
+		}
+	}()
+
+	server.log.Info("serving web dashboard", zap.String("address", listener.Addr().String()))
+	err = httpServer.Serve(listener)
+	if err == http.ErrServerClosed {
+		return nil
+	}
+	return Error.Wrap(err)
+}
+
+// ServeHTTP implements http.Handler
+func (server *Server) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	server.mux.ServeHTTP(w, req)
+}
+
+// Dashboard returns the local data of the node
+func (server *Server) Dashboard(ctx context.Context) (dashboard *Dashboard, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	dashboard = &Dashboard{
+		NodeID:             server.transport.Identity().ID.String(),
+		AllocatedSpace:     server.allocation.Space,
+		AllocatedBandwidth: server.allocation.Bandwidth,
+		Satellites:         []SatelliteUsage{},
+		Bandwidth:          []DailyBandwidth{},
+	}
+
+	dashboard.UsedSpace, err = server.db.UsedSpace()
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	dashboard.UsedBandwidth, err = server.db.UsedBandwidth(now)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	usage, err := server.db.GetSatelliteUsage(ctx, now)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, item := range usage {
+		dashboard.Satellites = append(dashboard.Satellites, SatelliteUsage{
+			SatelliteID:   item.SatelliteID.String(),
+			Pieces:        item.Pieces,
+			UsedSpace:     item.UsedSpace,
+			UsedBandwidth: item.UsedBandwidth,
+		})
+	}
+
+	history, err := server.db.GetBandwidthHistory(ctx, now.AddDate(0, 0, -server.config.HistoryDays))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, item := range history {
+		dashboard.Bandwidth = append(dashboard.Bandwidth, DailyBandwidth{
+			Day:  item.Day.Format("2006-01-02"),
+			Used: item.Used,
+		})
+	}
+
+	dashboard.Earnings.Storage = float64(dashboard.UsedSpace) / memory.TB.Float64() * server.config.StoragePrice
+	dashboard.Earnings.Bandwidth = float64(dashboard.UsedBandwidth) / memory.TB.Float64() * server.config.BandwidthPrice
+	dashboard.Earnings.Total = dashboard.Earnings.Storage + dashboard.Earnings.Bandwidth
+
+	return dashboard, nil
+}
+
+// Reputation queries the reputation of the node from the configured
+// satellites and the satellites the node stores data for. The satellites which
+// can't be queried are reported with an error.
+func (server *Server) Reputation(ctx context.Context) (reputations []Reputation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	ids := server.satellites.IDs()
+
+	usage, err := server.db.GetSatelliteUsage(ctx, time.Now())
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	for _, item := range usage {
+		if !containsID(ids, item.SatelliteID) {
+			ids = append(ids, item.SatelliteID)
+		}
+	}
+
+	reputations = []Reputation{}
+	for _, id := range ids {
+		reputation, err := server.reputation(ctx, id)
+		if err != nil {
+			server.log.Debug("failed to query reputation", zap.String("satellite", id.String()), zap.Error(err))
+			reputation = &Reputation{SatelliteID: id.String(), Error: err.Error()}
+		}
+		reputations = append(reputations, *reputation)
+	}
+	return reputations, nil
+}
+
+// reputation queries the reputation of the node from the satellite
+func (server *Server) reputation(ctx context.Context, id storj.NodeID) (reputation *Reputation, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	node, err := server.satellites.Node(ctx, id)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	conn, err := server.transport.DialNode(ctx, &node)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, conn.Close()) }()
+
+	resp, err := pb.NewNodeStatsClient(conn).Reputation(ctx, &pb.ReputationRequest{})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &Reputation{
+		SatelliteID: id.String(),
+		AuditCount:  resp.GetAuditCount(),
+		AuditRatio:  resp.GetAuditRatio(),
+		UptimeCount: resp.GetUptimeCount(),
+		UptimeRatio: resp.GetUptimeRatio(),
+	}, nil
+}
+
+// indexHandler serves the page of the dashboard
+func (server *Server) indexHandler(w http.ResponseWriter, req *http.Request) {
+	if req.URL.Path != "/" {
+		http.NotFound(w, req)
+		return
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if _, err := w.Write([]byte(indexPage)); err != nil {
+		server.log.Debug("failed to write web dashboard page", zap.Error(err))
+	}
+}
+
+// dashboardHandler serves the local data of the node
+func (server *Server) dashboardHandler(w http.ResponseWriter, req *http.Request) {
+	dashboard, err := server.Dashboard(req.Context())
+	if err != nil {
+		server.log.Error("failed to get dashboard data", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	server.writeJSON(w, dashboard)
+}
+
+// reputationHandler serves the reputation of the node
+func (server *Server) reputationHandler(w http.ResponseWriter, req *http.Request) {
+	reputations, err := server.Reputation(req.Context())
+	if err != nil {
+		server.log.Error("failed to get reputation", zap.Error(err))
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	server.writeJSON(w, reputations)
+}
+
+// writeJSON writes the value as the JSON response
+func (server *Server) writeJSON(w http.ResponseWriter, value interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		server.log.Debug("failed to write web dashboard response", zap.Error(err))
+	}
+}
+
+func containsID(ids storj.NodeIDList, id storj.NodeID) bool {
+	for _, other := range ids {
+		if other == id {
+			return true
+		}
+	}
+	return false
+}
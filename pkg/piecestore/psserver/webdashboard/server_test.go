@@ -0,0 +1,111 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package webdashboard
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/memory"
+	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/teststorj"
+	"storj.io/storj/pkg/pb"
+	pstore "storj.io/storj/pkg/piecestore"
+	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
+)
+
+type unreachableSatellites struct {
+	ids storj.NodeIDList
+}
+
+func (satellites unreachableSatellites) IDs() storj.NodeIDList { return satellites.ids }
+
+func (satellites unreachableSatellites) Node(ctx context.Context, id storj.NodeID) (pb.Node, error) {
+	return pb.Node{}, errors.New("unreachable")
+}
+
+func TestServer(t *testing.T) {
+	ctx := context.Background()
+
+	tmpdir, err := ioutil.TempDir("", "storj-webdashboard")
+	require.NoError(t, err)
+	defer func() { _ = os.RemoveAll(tmpdir) }()
+
+	storage, err := pstore.NewStorage(tmpdir)
+	require.NoError(t, err)
+	defer func() { _ = storage.Close() }()
+
+	db, err := psdb.OpenInMemory(ctx, storage)
+	require.NoError(t, err)
+	defer func() { _ = db.Close() }()
+
+	identity, err := testidentity.NewTestIdentity(ctx)
+	require.NoError(t, err)
+
+	configured, stored := teststorj.NodeIDFromString("configured"), teststorj.NodeIDFromString("stored")
+
+	require.NoError(t, db.AddTTL("piece1", 0, memory.TB.Int64()/2))
+	require.NoError(t, db.AddSatellitePiece("piece1", stored, "satellite-piece1"))
+	require.NoError(t, db.AddBandwidthUsed(stored, memory.TB.Int64()/4))
+
+	config := Config{HistoryDays: 30, StoragePrice: 2, BandwidthPrice: 20}
+	server := NewServer(zap.NewNop(), config, db, unreachableSatellites{ids: storj.NodeIDList{configured}}, transport.NewClient(identity), Allocation{
+		Space:     memory.TB.Int64(),
+		Bandwidth: memory.TB.Int64(),
+	})
+
+	get := func(path string, value interface{}) {
+		recorder := httptest.NewRecorder()
+		server.ServeHTTP(recorder, httptest.NewRequest("GET", path, nil))
+		require.Equal(t, http.StatusOK, recorder.Code, recorder.Body.String())
+		require.NoError(t, json.Unmarshal(recorder.Body.Bytes(), value))
+	}
+
+	var dashboard Dashboard
+	get("/api/dashboard", &dashboard)
+
+	assert.Equal(t, identity.ID.String(), dashboard.NodeID)
+	assert.Equal(t, memory.TB.Int64()/2, dashboard.UsedSpace)
+	assert.Equal(t, memory.TB.Int64()/4, dashboard.UsedBandwidth)
+	assert.Equal(t, []SatelliteUsage{{
+		SatelliteID:   stored.String(),
+		Pieces:        1,
+		UsedSpace:     memory.TB.Int64() / 2,
+		UsedBandwidth: memory.TB.Int64() / 4,
+	}}, dashboard.Satellites)
+	require.Len(t, dashboard.Bandwidth, 1)
+	assert.Equal(t, memory.TB.Int64()/4, dashboard.Bandwidth[0].Used)
+	assert.Equal(t, Earnings{Storage: 1, Bandwidth: 5, Total: 6}, dashboard.Earnings)
+
+	var reputations []Reputation
+	get("/api/reputation", &reputations)
+
+	require.Len(t, reputations, 2)
+	assert.Equal(t, configured.String(), reputations[0].SatelliteID)
+	assert.Equal(t, stored.String(), reputations[1].SatelliteID)
+	for _, reputation := range reputations {
+		assert.Contains(t, reputation.Error, "unreachable")
+	}
+
+	recorder := httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest("GET", "/", nil))
+	assert.Equal(t, http.StatusOK, recorder.Code)
+	assert.Contains(t, recorder.Body.String(), "/api/dashboard")
+
+	recorder = httptest.NewRecorder()
+	server.ServeHTTP(recorder, httptest.NewRequest("GET", "/missing", nil))
+	assert.Equal(t, http.StatusNotFound, recorder.Code)
+}
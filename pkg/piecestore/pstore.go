@@ -5,23 +5,39 @@ package pstore
 
 import (
 	"context"
+	"crypto/sha256"
 	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 
 	"github.com/zeebo/errs"
 
-	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/filestore"
 )
 
-// Storage stores piecestore pieces
+// Storage stores piecestore pieces as blobs of a filestore.Dir. Pieces are
+// written to temporary files and committed atomically once they're
+// complete, so an interrupted upload never leaves a partial piece behind.
 type Storage struct {
-	dir string
+	dir *filestore.Dir
 }
 
-// NewStorage creates database for storing pieces
-func NewStorage(dir string) *Storage {
-	return &Storage{dir}
+// NewStorage creates the storage for pieces in dir and moves the pieces
+// stored in the layout of earlier versions into it
+func NewStorage(dir string) (*Storage, error) {
+	blobs, err := filestore.NewDir(dir)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	storage := &Storage{dir: blobs}
+	if err := storage.migrate(); err != nil {
+		return nil, err
+	}
+
+	return storage, nil
 }
 
 // Close closes resources
@@ -35,49 +51,102 @@ var (
 	Error = errs.Class("piecestore error")
 )
 
-// PiecePath creates piece storage path from id and dir
-func (storage *Storage) PiecePath(pieceID string) (string, error) {
+// pieceRef returns the reference of the blob storing a piece
+func pieceRef(pieceID string) (storage.BlobRef, error) {
 	if len(pieceID) < IDLength {
-		return "", Error.New("invalid id length")
+		return storage.BlobRef{}, Error.New("invalid id length")
 	}
 
-	folder1, folder2, filename := pieceID[0:2], pieceID[2:4], pieceID[4:]
-	return filepath.Join(storage.dir, folder1, folder2, filename), nil
+	return storage.BlobRef(sha256.Sum256([]byte(pieceID))), nil
+}
+
+// PieceWriter writes a piece to a temporary file, the piece is stored only
+// when the writer is committed
+type PieceWriter struct {
+	dir  *filestore.Dir
+	ref  storage.BlobRef
+	file *os.File
+	done bool
 }
 
 // Writer returns a writer that can be used to store piece.
-func (storage *Storage) Writer(pieceID string) (io.WriteCloser, error) {
-	path, err := storage.PiecePath(pieceID)
+func (storage *Storage) Writer(pieceID string) (*PieceWriter, error) {
+	ref, err := pieceRef(pieceID)
 	if err != nil {
 		return nil, err
 	}
 
-	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+	existing, err := storage.dir.Open(ref)
+	if err == nil {
+		return nil, errs.Combine(Error.New("piece %q already exists", pieceID), existing.Close())
+	}
+	if !os.IsNotExist(err) {
 		return nil, Error.Wrap(err)
 	}
 
-	file, err := os.OpenFile(path, os.O_CREATE|os.O_EXCL|os.O_WRONLY, 0600)
+	file, err := storage.dir.CreateTemporaryFile(-1)
 	if err != nil {
 		return nil, Error.Wrap(err)
 	}
 
-	return file, nil
+	return &PieceWriter{dir: storage.dir, ref: ref, file: file}, nil
+}
+
+// Write implements io.Writer
+func (writer *PieceWriter) Write(p []byte) (int, error) {
+	return writer.file.Write(p)
+}
+
+// Commit stores the written piece
+func (writer *PieceWriter) Commit() error {
+	if writer.done {
+		return Error.New("piece already committed or canceled")
+	}
+	writer.done = true
+
+	return Error.Wrap(writer.dir.Commit(writer.file, writer.ref))
+}
+
+// Cancel discards the written data unless the piece was committed
+func (writer *PieceWriter) Cancel() error {
+	if writer.done {
+		return nil
+	}
+	writer.done = true
+
+	return Error.Wrap(writer.dir.DeleteTemporary(writer.file))
+}
+
+// pieceReader reads a section of the file of a piece
+type pieceReader struct {
+	io.Reader
+	file *os.File
+}
+
+// Close closes the file of the piece
+func (reader *pieceReader) Close() error {
+	return reader.file.Close()
 }
 
 // Reader returns a reader for the specified piece at the location
 func (storage *Storage) Reader(ctx context.Context, pieceID string, offset int64, length int64) (io.ReadCloser, error) {
-	path, err := storage.PiecePath(pieceID)
+	ref, err := pieceRef(pieceID)
 	if err != nil {
 		return nil, err
 	}
 
-	info, err := os.Stat(path)
+	file, err := storage.dir.Open(ref)
 	if err != nil {
 		return nil, err
 	}
 
+	info, err := file.Stat()
+	if err != nil {
+		return nil, errs.Combine(err, file.Close())
+	}
+
 	if offset >= info.Size() || offset < 0 {
-		return nil, Error.New("invalid offset: %v", offset)
+		return nil, errs.Combine(Error.New("invalid offset: %v", offset), file.Close())
 	}
 
 	if length <= -1 {
@@ -89,24 +158,115 @@ func (storage *Storage) Reader(ctx context.Context, pieceID string, offset int64
 		length = info.Size() - offset
 	}
 
-	rr, err := ranger.FileRanger(path)
+	return &pieceReader{
+		Reader: io.NewSectionReader(file, offset, length),
+		file:   file,
+	}, nil
+}
+
+// Size returns the size of the specified piece
+func (storage *Storage) Size(pieceID string) (int64, error) {
+	ref, err := pieceRef(pieceID)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+
+	file, err := storage.dir.Open(ref)
+	if os.IsNotExist(err) {
+		return 0, Error.New("piece not found")
+	}
+	if err != nil {
+		return 0, err
+	}
+	defer func() { _ = file.Close() }()
+
+	info, err := file.Stat()
+	if err != nil {
+		return 0, err
 	}
 
-	return rr.Range(ctx, offset, length)
+	return info.Size(), nil
 }
 
 // Delete deletes piece from storage
 func (storage *Storage) Delete(pieceID string) error {
-	path, err := storage.PiecePath(pieceID)
+	ref, err := pieceRef(pieceID)
 	if err != nil {
 		return err
 	}
 
-	err = os.Remove(path)
-	if os.IsNotExist(err) {
-		err = nil
+	return Error.Wrap(storage.dir.Delete(ref))
+}
+
+// SpaceUsed returns the total size of the stored pieces on disk
+func (storage *Storage) SpaceUsed() (int64, error) {
+	used, err := storage.dir.SpaceUsed()
+	return used, Error.Wrap(err)
+}
+
+// GarbageCollect deletes the pieces whose deletion failed earlier
+func (storage *Storage) GarbageCollect(ctx context.Context) error {
+	return Error.Wrap(storage.dir.GarbageCollect())
+}
+
+// migrate moves the pieces stored at <dir>/<id[0:2]>/<id[2:4]>/<id[4:]> by
+// earlier versions to their blobs. A piece is moved with a single rename,
+// so an interrupted migration continues on the next start.
+func (storage *Storage) migrate() error {
+	root := storage.dir.Path()
+
+	firsts, err := ioutil.ReadDir(root)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	for _, first := range firsts {
+		// skips the temporary and trash directories
+		if !first.IsDir() || len(first.Name()) != 2 {
+			continue
+		}
+		firstPath := filepath.Join(root, first.Name())
+
+		seconds, err := ioutil.ReadDir(firstPath)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, second := range seconds {
+			// blobs are files at this level, pieces of the old layout are
+			// in another directory
+			if !second.IsDir() || len(second.Name()) != 2 {
+				continue
+			}
+			secondPath := filepath.Join(firstPath, second.Name())
+
+			pieces, err := ioutil.ReadDir(secondPath)
+			if err != nil {
+				return Error.Wrap(err)
+			}
+
+			for _, piece := range pieces {
+				if piece.IsDir() {
+					continue
+				}
+
+				ref, err := pieceRef(first.Name() + second.Name() + piece.Name())
+				if err != nil {
+					// not a piece
+					continue
+				}
+
+				err = storage.dir.Move(filepath.Join(secondPath, piece.Name()), ref)
+				if err != nil {
+					return Error.Wrap(err)
+				}
+			}
+
+			// the directories are removed once they're empty
+			_ = os.Remove(secondPath)
+		}
+		_ = os.Remove(firstPath)
 	}
-	return err
+
+	return nil
 }
@@ -8,6 +8,8 @@ import (
 	"io"
 	"io/ioutil"
 	"math/rand"
+	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -21,7 +23,8 @@ func TestStore(t *testing.T) {
 	ctx := testcontext.New(t)
 	defer ctx.Cleanup()
 
-	store := NewStorage(ctx.Dir("example"))
+	store, err := NewStorage(ctx.Dir("example"))
+	require.NoError(t, err)
 	defer ctx.Check(store.Close)
 
 	pieceID := strings.Repeat("AB01", 10)
@@ -37,7 +40,7 @@ func TestStore(t *testing.T) {
 		assert.Equal(t, n, int64(len(source)))
 		assert.NoError(t, err)
 
-		assert.NoError(t, w.Close())
+		assert.NoError(t, w.Commit())
 	}
 
 	{ // valid reads
@@ -79,3 +82,82 @@ func TestStore(t *testing.T) {
 		assert.Error(t, err)
 	}
 }
+
+func TestUncommitted(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	store, err := NewStorage(ctx.Dir("example"))
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	pieceID := strings.Repeat("AB01", 10)
+
+	w, err := store.Writer(pieceID)
+	require.NoError(t, err)
+
+	_, err = w.Write([]byte("partial"))
+	require.NoError(t, err)
+
+	// the piece isn't readable before it's committed
+	_, err = store.Reader(ctx, pieceID, 0, -1)
+	assert.Error(t, err)
+
+	assert.NoError(t, w.Cancel())
+	assert.Error(t, w.Commit())
+
+	_, err = store.Size(pieceID)
+	assert.Error(t, err)
+
+	// a canceled piece can be written again
+	w, err = store.Writer(pieceID)
+	require.NoError(t, err)
+	_, err = w.Write([]byte("complete"))
+	require.NoError(t, err)
+	require.NoError(t, w.Commit())
+	assert.NoError(t, w.Cancel())
+
+	size, err := store.Size(pieceID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len("complete")), size)
+
+	// a committed piece can't be overwritten
+	_, err = store.Writer(pieceID)
+	assert.Error(t, err)
+}
+
+func TestMigrate(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	dir := ctx.Dir("example")
+	pieceID := strings.Repeat("AB01", 10)
+
+	// pieces of earlier versions are stored at <id[0:2]>/<id[2:4]>/<id[4:]>
+	oldDir := filepath.Join(dir, pieceID[0:2], pieceID[2:4])
+	require.NoError(t, os.MkdirAll(oldDir, 0700))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(oldDir, pieceID[4:]), []byte("migrated"), 0600))
+
+	store, err := NewStorage(dir)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	reader, err := store.Reader(ctx, pieceID, 0, -1)
+	require.NoError(t, err)
+	data, err := ioutil.ReadAll(reader)
+	assert.NoError(t, err)
+	assert.NoError(t, reader.Close())
+	assert.Equal(t, []byte("migrated"), data)
+
+	_, err = os.Stat(filepath.Join(dir, pieceID[0:2], pieceID[2:4]))
+	assert.True(t, os.IsNotExist(err), "old piece directory not removed")
+
+	// migrating again leaves the pieces alone
+	store, err = NewStorage(dir)
+	require.NoError(t, err)
+	defer ctx.Check(store.Close)
+
+	size, err := store.Size(pieceID)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(len("migrated")), size)
+}
@@ -13,6 +13,7 @@ import (
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/provider"
 	"storj.io/storj/pkg/utils"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/boltdb"
 	"storj.io/storj/storage/postgreskv"
@@ -24,8 +25,10 @@ type CtxKeyPointerdb int
 
 const (
 	// BoltPointerBucket is the string representing the bucket used for `PointerEntries` in BoltDB
-	BoltPointerBucket                 = "pointers"
-	ctxKey            CtxKeyPointerdb = iota
+	BoltPointerBucket = "pointers"
+	// ReferenceBucket is the string representing the bucket used for counting shared pieces
+	ReferenceBucket                 = "piecerefs"
+	ctxKey          CtxKeyPointerdb = iota
 )
 
 // Config is a configuration struct that is everything you need to start a
@@ -36,34 +39,49 @@ type Config struct {
 	MaxInlineSegmentSize memory.Size `default:"8000" help:"maximum inline segment size"`
 	Overlay              bool        `default:"true" help:"toggle flag if overlay is enabled"`
 	BwExpiration         int         `default:"45"   help:"lifespan of bandwidth agreements in days"`
+	LimitGraceMargin     float64     `default:"0.1"  help:"fraction by which projects may exceed their storage and egress limits"`
 }
 
-func newKeyValueStore(dbURLString string) (db storage.KeyValueStore, err error) {
+func newKeyValueStores(dbURLString string) (db, refs storage.KeyValueStore, err error) {
 	driver, source, err := utils.SplitDBURL(dbURLString)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 	if driver == "bolt" {
-		db, err = boltdb.New(source, BoltPointerBucket)
+		var clients []*boltdb.Client
+		clients, err = boltdb.NewShared(source, BoltPointerBucket, ReferenceBucket)
+		if err == nil {
+			db, refs = clients[0], clients[1]
+		}
 	} else if driver == "postgresql" || driver == "postgres" {
-		db, err = postgreskv.New(source)
+		var clients []*postgreskv.Client
+		clients, err = postgreskv.NewShared(source, "", ReferenceBucket)
+		if err == nil {
+			db, refs = clients[0], clients[1]
+		}
 	} else {
 		err = Error.New("unsupported db scheme: %s", driver)
 	}
-	return db, err
+	return db, refs, err
 }
 
 // Run implements the provider.Responsibility interface
 func (c Config) Run(ctx context.Context, server *provider.Provider) error {
-	db, err := newKeyValueStore(c.DatabaseURL)
+	db, refs, err := newKeyValueStores(c.DatabaseURL)
 	if err != nil {
 		return err
 	}
 	defer func() { _ = db.Close() }()
+	defer func() { _ = refs.Close() }()
 
 	cache := overlay.LoadFromContext(ctx)
 	dblogged := storelogger.New(zap.L().Named("pdb"), db)
-	s := NewServer(dblogged, cache, zap.L(), c, server.Identity())
+	s := NewServer(dblogged, refs, cache, zap.L(), c, server.Identity())
+	if masterdb, ok := ctx.Value("masterdb").(interface{ Console() console.DB }); ok {
+		s.SetAPIKeys(masterdb.Console().APIKeys())
+		s.SetBuckets(masterdb.Console().Buckets())
+		s.SetUsageLimits(masterdb.Console().UsageLimits(), masterdb.Console().ProjectUsage())
+	}
 	pb.RegisterPointerDBServer(server.GRPC(), s)
 	// add the server to the context
 	ctx = context.WithValue(ctx, ctxKey, s)
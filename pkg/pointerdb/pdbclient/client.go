@@ -51,7 +51,8 @@ type Client interface {
 	Put(ctx context.Context, path storj.Path, pointer *pb.Pointer) error
 	Get(ctx context.Context, path storj.Path) (*pb.Pointer, []*pb.Node, *pb.PayerBandwidthAllocation, error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
-	Delete(ctx context.Context, path storj.Path) error
+	Delete(ctx context.Context, path storj.Path) (piecesReferenced bool, err error)
+	Copy(ctx context.Context, sourcePath, path storj.Path, metadata []byte) error
 
 	SignedMessage() *pb.SignedMessage
 	PayerBandwidthAllocation(context.Context, pb.PayerBandwidthAllocation_Action) (*pb.PayerBandwidthAllocation, error)
@@ -135,13 +136,35 @@ func (pdb *PointerDB) List(ctx context.Context, prefix, startAfter, endBefore st
 	return items, res.GetMore(), nil
 }
 
-// Delete is the interface to make a Delete request, needs Path and APIKey
-func (pdb *PointerDB) Delete(ctx context.Context, path storj.Path) (err error) {
+// Delete is the interface to make a Delete request, needs Path and APIKey.
+// It reports whether the pieces of the deleted pointer are still used by a copy.
+func (pdb *PointerDB) Delete(ctx context.Context, path storj.Path) (piecesReferenced bool, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	_, err = pdb.client.Delete(ctx, &pb.DeleteRequest{Path: path})
+	res, err := pdb.client.Delete(ctx, &pb.DeleteRequest{Path: path})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return false, storage.ErrKeyNotFound.Wrap(err)
+		}
+		return false, err
+	}
 
-	return err
+	return res.GetPiecesReferenced(), nil
+}
+
+// Copy is the interface to make a Copy request, needs SourcePath, Path and APIKey
+func (pdb *PointerDB) Copy(ctx context.Context, sourcePath, path storj.Path, metadata []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	_, err = pdb.client.Copy(ctx, &pb.CopyRequest{SourcePath: sourcePath, Path: path, Metadata: metadata})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return storage.ErrKeyNotFound.Wrap(err)
+		}
+		return Error.Wrap(err)
+	}
+
+	return nil
 }
 
 // PayerBandwidthAllocation gets payer bandwidth allocation message
@@ -37,13 +37,26 @@ func (m *MockClient) EXPECT() *MockClientMockRecorder {
 	return m.recorder
 }
 
-// Delete mocks base method
-func (m *MockClient) Delete(arg0 context.Context, arg1 string) error {
-	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+// Copy mocks base method
+func (m *MockClient) Copy(arg0 context.Context, arg1, arg2 string, arg3 []byte) error {
+	ret := m.ctrl.Call(m, "Copy", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
+// Copy indicates an expected call of Copy
+func (mr *MockClientMockRecorder) Copy(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Copy", reflect.TypeOf((*MockClient)(nil).Copy), arg0, arg1, arg2, arg3)
+}
+
+// Delete mocks base method
+func (m *MockClient) Delete(arg0 context.Context, arg1 string) (bool, error) {
+	ret := m.ctrl.Call(m, "Delete", arg0, arg1)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
 // Delete indicates an expected call of Delete
 func (mr *MockClientMockRecorder) Delete(arg0, arg1 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClient)(nil).Delete), arg0, arg1)
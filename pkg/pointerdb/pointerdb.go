@@ -4,9 +4,11 @@
 package pointerdb
 
 import (
+	"bytes"
 	"context"
 	"crypto/ecdsa"
 	"crypto/x509"
+	"strings"
 	"time"
 
 	"github.com/gogo/protobuf/proto"
@@ -25,6 +27,8 @@ import (
 	pointerdbAuth "storj.io/storj/pkg/pointerdb/auth"
 	"storj.io/storj/pkg/provider"
 	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/storage"
 )
 
@@ -40,12 +44,22 @@ type Server struct {
 	config   Config
 	cache    *overlay.Cache
 	identity *provider.FullIdentity
+	apiKeys  APIKeys
+	buckets  Buckets
+	limits   UsageLimits
+	usage    ProjectUsage
+
+	// refs keeps the paths of the pointers sharing the pieces of a copied
+	// segment, keyed by piece id. Pieces used by a single pointer have no
+	// entry. All pointers sharing a piece id reference the same pieces.
+	refs storage.KeyValueStore
 }
 
 // NewServer creates instance of Server
-func NewServer(db storage.KeyValueStore, cache *overlay.Cache, logger *zap.Logger, c Config, identity *provider.FullIdentity) *Server {
+func NewServer(db, refs storage.KeyValueStore, cache *overlay.Cache, logger *zap.Logger, c Config, identity *provider.FullIdentity) *Server {
 	return &Server{
 		DB:       db,
+		refs:     refs,
 		logger:   logger,
 		config:   c,
 		cache:    cache,
@@ -53,15 +67,219 @@ func NewServer(db storage.KeyValueStore, cache *overlay.Cache, logger *zap.Logge
 	}
 }
 
-func (s *Server) validateAuth(ctx context.Context) error {
+// APIKeys is the store of project API keys used for authorizing requests
+type APIKeys interface {
+	GetByKey(ctx context.Context, key console.APIKey) (*console.APIKeyInfo, error)
+}
+
+// SetAPIKeys makes the server accept project API keys from the given store.
+// Requests made with a project key are scoped to the paths of the project.
+func (s *Server) SetAPIKeys(apiKeys APIKeys) {
+	s.apiKeys = apiKeys
+}
+
+// Buckets is the store recording the project owning each bucket
+type Buckets interface {
+	GetBucket(ctx context.Context, name string) (*console.Bucket, error)
+	AttachBucket(ctx context.Context, name string, projectID uuid.UUID) (*console.Bucket, error)
+	DeattachBucket(ctx context.Context, name string) error
+}
+
+// SetBuckets makes the server record the buckets created and deleted with
+// project API keys in the given store.
+func (s *Server) SetBuckets(buckets Buckets) {
+	s.buckets = buckets
+}
+
+// UsageLimits is the store of the storage and egress limits of projects
+type UsageLimits interface {
+	GetLimits(ctx context.Context, projectID uuid.UUID) (*console.UsageLimit, error)
+}
+
+// ProjectUsage is the store of the usage rollups of projects
+type ProjectUsage interface {
+	GetDailyUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]console.DailyUsage, error)
+}
+
+// SetUsageLimits makes the server refuse bandwidth allocations to projects
+// which exceeded their limits according to the given usage rollups.
+func (s *Server) SetUsageLimits(limits UsageLimits, usage ProjectUsage) {
+	s.limits = limits
+	s.usage = usage
+}
+
+// validateAuth checks the API key of the request and returns the path
+// prefix of the project owning the key. The satellite's own key is not
+// bound to a project and has an empty prefix.
+func (s *Server) validateAuth(ctx context.Context) (scope string, err error) {
 	APIKey, ok := auth.GetAPIKey(ctx)
-	if !ok || !pointerdbAuth.ValidateAPIKey(string(APIKey)) {
-		s.logger.Error("unauthorized request: ", zap.Error(status.Errorf(codes.Unauthenticated, "Invalid API credential")))
-		return status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	if !ok {
+		return "", s.unauthenticated()
+	}
+
+	if s.apiKeys != nil {
+		key, err := console.APIKeyFromBase64(string(APIKey))
+		if err == nil {
+			info, err := s.apiKeys.GetByKey(ctx, *key)
+			if err == nil {
+				return info.ProjectID.String(), nil
+			}
+		}
+
+		// the satellite's own key must be set when serving projects
+		if len(APIKey) == 0 {
+			return "", s.unauthenticated()
+		}
+	}
+
+	if !pointerdbAuth.ValidateAPIKey(string(APIKey)) {
+		return "", s.unauthenticated()
+	}
+	return "", nil
+}
+
+func (s *Server) unauthenticated() error {
+	err := status.Errorf(codes.Unauthenticated, "Invalid API credential")
+	s.logger.Error("unauthorized request: ", zap.Error(err))
+	return err
+}
+
+// checkUsageLimits fails with ResourceExhausted when the project of the
+// scope exceeded its storage limit on PUT or its egress limit for the
+// current month on GET by more than the configured grace margin.
+func (s *Server) checkUsageLimits(ctx context.Context, scope string, action pb.PayerBandwidthAllocation_Action) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if scope == "" || s.limits == nil || s.usage == nil {
+		return nil
+	}
+	if action != pb.PayerBandwidthAllocation_PUT && action != pb.PayerBandwidthAllocation_GET {
+		return nil
+	}
+
+	projectID, err := uuid.Parse(scope)
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	limit, err := s.limits.GetLimits(ctx, *projectID)
+	if err != nil {
+		s.logger.Error("err getting project limits", zap.Error(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	max := limit.Storage
+	if action == pb.PayerBandwidthAllocation_GET {
+		max = limit.Egress
+	}
+	if max <= 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	monthStart := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	days, err := s.usage.GetDailyUsage(ctx, *projectID, now.AddDate(0, 0, -31), now)
+	if err != nil {
+		s.logger.Error("err getting project usage", zap.Error(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	var used float64
+	if action == pb.PayerBandwidthAllocation_PUT {
+		if len(days) > 0 {
+			used = days[len(days)-1].Storage
+		}
+	} else {
+		for _, day := range days {
+			if !day.Date.Before(monthStart) {
+				used += float64(day.Egress)
+			}
+		}
+	}
+
+	if used > float64(max)*(1+s.config.LimitGraceMargin) {
+		if action == pb.PayerBandwidthAllocation_PUT {
+			return status.Errorf(codes.ResourceExhausted, "project %s exceeded its storage limit of %d bytes", scope, max)
+		}
+		return status.Errorf(codes.ResourceExhausted, "project %s exceeded its monthly egress limit of %d bytes", scope, max)
 	}
 	return nil
 }
 
+// bucketName returns the name of the bucket if the path is the one of a
+// bucket record, which is stored like an object at the root.
+func bucketName(path storj.Path) (string, bool) {
+	if !strings.HasPrefix(path, "l/") {
+		return "", false
+	}
+	name := strings.TrimPrefix(path, "l/")
+	if name == "" || strings.Contains(name, "/") {
+		return "", false
+	}
+	return name, true
+}
+
+// attachBucket records the project of the scope as owner of the bucket
+// created at path, failing if the bucket belongs to another project.
+func (s *Server) attachBucket(ctx context.Context, scope string, path storj.Path) (attached bool, err error) {
+	name, ok := bucketName(path)
+	if !ok || scope == "" || s.buckets == nil {
+		return false, nil
+	}
+
+	projectID, err := uuid.Parse(scope)
+	if err != nil {
+		return false, status.Error(codes.Internal, err.Error())
+	}
+
+	_, err = s.buckets.AttachBucket(ctx, name, *projectID)
+	if err == nil {
+		return true, nil
+	}
+
+	bucket, err := s.buckets.GetBucket(ctx, name)
+	if err != nil {
+		s.logger.Error("err getting bucket", zap.Error(err))
+		return false, status.Error(codes.Internal, err.Error())
+	}
+	if bucket.ProjectID != *projectID {
+		return false, status.Errorf(codes.AlreadyExists, "bucket %q already exists", name)
+	}
+	return false, nil
+}
+
+// deattachBucket removes the owner of the bucket deleted at path
+func (s *Server) deattachBucket(ctx context.Context, scope string, path storj.Path) error {
+	name, ok := bucketName(path)
+	if !ok || scope == "" || s.buckets == nil {
+		return nil
+	}
+
+	err := s.buckets.DeattachBucket(ctx, name)
+	if err != nil {
+		s.logger.Error("err deattaching bucket", zap.Error(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+	return nil
+}
+
+// segmentBucket returns the name of the bucket of the segment at path
+func segmentBucket(path storj.Path) string {
+	components := storj.SplitPath(path)
+	if len(components) < 3 {
+		return ""
+	}
+	return components[1]
+}
+
+// scopedPath returns the path within the key space of the given scope
+func scopedPath(scope string, path storj.Path) storj.Path {
+	if scope == "" {
+		return path
+	}
+	return storj.JoinPaths(scope, path)
+}
+
 func (s *Server) validateSegment(req *pb.PutRequest) error {
 	min := s.config.MinRemoteSegmentSize
 	remote := req.GetPointer().Remote
@@ -71,6 +289,11 @@ func (s *Server) validateSegment(req *pb.PutRequest) error {
 		return segmentError.New("remote segment size %d less than minimum allowed %d", remoteSize, min)
 	}
 
+	// the root hash must match the recorded piece hashes
+	if len(remote.GetMerkleRoot()) > 0 && !bytes.Equal(remote.GetMerkleRoot(), pb.PieceHashesRoot(remote.GetRemotePieces())) {
+		return segmentError.New("piece hashes don't match the merkle root")
+	}
+
 	max := s.config.MaxInlineSegmentSize.Int()
 	inlineSize := len(req.GetPointer().InlineSegment)
 
@@ -87,28 +310,44 @@ func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (resp *pb.PutRespo
 
 	err = s.validateSegment(req)
 	if err != nil {
-		return nil, status.Errorf(codes.InvalidArgument, err.Error())
+		return nil, status.Error(codes.InvalidArgument, err.Error())
 	}
 
-	if err = s.validateAuth(ctx); err != nil {
+	scope, err := s.validateAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	// Update the pointer with the creation date
-	req.GetPointer().CreationDate = ptypes.TimestampNow()
+	pointer := req.GetPointer()
+	pointer.CreationDate = ptypes.TimestampNow()
 
-	pointerBytes, err := proto.Marshal(req.GetPointer())
+	attached, err := s.attachBucket(ctx, scope, req.GetPath())
 	if err != nil {
-		s.logger.Error("err marshaling pointer", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, err
 	}
 
-	// TODO(kaloyan): make sure that we know we are overwriting the pointer!
-	// In such case we should delete the pieces of the old segment if it was
-	// a remote one.
-	if err = s.DB.Put([]byte(req.GetPath()), pointerBytes); err != nil {
-		s.logger.Error("err putting pointer", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+	path := scopedPath(scope, req.GetPath())
+	old, err := s.updatePointer(storage.Key(path), func(*pb.Pointer) (*pb.Pointer, error) {
+		return pointer, nil
+	})
+	if err != nil {
+		// the bucket isn't owned by the project if it couldn't be created
+		if attached {
+			if deattachErr := s.deattachBucket(ctx, scope, req.GetPath()); deattachErr != nil {
+				s.logger.Error("err deattaching bucket", zap.Error(deattachErr))
+			}
+		}
+		return nil, err
+	}
+
+	if old.GetRemote().GetPieceId() != pointer.GetRemote().GetPieceId() {
+		if _, err = s.releasePieces(path, old); err != nil {
+			return nil, err
+		}
+	} else if err = s.syncSharedPieces(path, pointer.GetRemote()); err != nil {
+		// repairs of shared segments apply to all pointers sharing them
+		return nil, err
 	}
 
 	return &pb.PutResponse{}, nil
@@ -118,17 +357,18 @@ func (s *Server) Put(ctx context.Context, req *pb.PutRequest) (resp *pb.PutRespo
 func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err = s.validateAuth(ctx); err != nil {
+	scope, err := s.validateAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	pointerBytes, err := s.DB.Get([]byte(req.GetPath()))
+	pointerBytes, err := s.DB.Get([]byte(scopedPath(scope, req.GetPath())))
 	if err != nil {
 		if storage.ErrKeyNotFound.Has(err) {
-			return nil, status.Errorf(codes.NotFound, err.Error())
+			return nil, status.Error(codes.NotFound, err.Error())
 		}
 		s.logger.Error("err getting pointer", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	pointer := &pb.Pointer{}
@@ -138,16 +378,31 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 		return nil, err
 	}
 
-	pba, err := s.PayerBandwidthAllocation(ctx, &pb.PayerBandwidthAllocationRequest{Action: pb.PayerBandwidthAllocation_GET})
-	if err != nil {
-		s.logger.Error("err getting payer bandwidth allocation", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+	// expired segments are kept only until the reaper deletes them
+	if Expired(pointer, time.Now()) {
+		return nil, status.Errorf(codes.NotFound, "segment %q expired", req.GetPath())
+	}
+
+	// projects over their egress limit get the pointer to stat or delete
+	// the segment, but no allocation to download it
+	var pba *pb.PayerBandwidthAllocation
+	err = s.checkUsageLimits(ctx, scope, pb.PayerBandwidthAllocation_GET)
+	switch status.Code(err) {
+	case codes.OK:
+		pba, err = s.payerBandwidthAllocation(ctx, pb.PayerBandwidthAllocation_GET, scope, segmentBucket(req.GetPath()))
+		if err != nil {
+			s.logger.Error("err getting payer bandwidth allocation", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	case codes.ResourceExhausted:
+	default:
+		return nil, err
 	}
 
 	authorization, err := s.getSignedMessage()
 	if err != nil {
 		s.logger.Error("err getting signed message", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	nodes := []*pb.Node{}
@@ -155,7 +410,7 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 	var r = &pb.GetResponse{
 		Pointer:       pointer,
 		Nodes:         nil,
-		Pba:           pba.GetPba(),
+		Pba:           pba,
 		Authorization: authorization,
 	}
 
@@ -177,7 +432,7 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 	r = &pb.GetResponse{
 		Pointer:       pointer,
 		Nodes:         nodes,
-		Pba:           pba.GetPba(),
+		Pba:           pba,
 		Authorization: authorization,
 	}
 
@@ -188,13 +443,14 @@ func (s *Server) Get(ctx context.Context, req *pb.GetRequest) (resp *pb.GetRespo
 func (s *Server) List(ctx context.Context, req *pb.ListRequest) (resp *pb.ListResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err = s.validateAuth(ctx); err != nil {
+	scope, err := s.validateAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
 	var prefix storage.Key
-	if req.Prefix != "" {
-		prefix = storage.Key(req.Prefix)
+	if scopedPrefix := scopedPath(scope, req.Prefix); scopedPrefix != "" {
+		prefix = storage.Key(scopedPrefix)
 		if prefix[len(prefix)-1] != storage.Delimiter {
 			prefix = append(prefix, storage.Delimiter)
 		}
@@ -206,14 +462,19 @@ func (s *Server) List(ctx context.Context, req *pb.ListRequest) (resp *pb.ListRe
 		EndBefore:    storage.Key(req.EndBefore),
 		Recursive:    req.Recursive,
 		Limit:        int(req.Limit),
-		IncludeValue: req.MetaFlags != meta.None,
+		IncludeValue: true, // needed for skipping expired segments
 	})
 	if err != nil {
 		return nil, status.Errorf(codes.Internal, "ListV2: %v", err)
 	}
 
+	now := time.Now()
+
 	var items []*pb.ListResponse_Item
 	for _, rawItem := range rawItems {
+		if !rawItem.IsPrefix && expiredValue(rawItem.Value, now) {
+			continue
+		}
 		items = append(items, s.createListItem(rawItem, req.MetaFlags))
 	}
 
@@ -274,24 +535,427 @@ func (s *Server) setMetadata(item *pb.ListResponse_Item, data []byte, metaFlags
 func (s *Server) Delete(ctx context.Context, req *pb.DeleteRequest) (resp *pb.DeleteResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err = s.validateAuth(ctx); err != nil {
+	scope, err := s.validateAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
+	path := scopedPath(scope, req.GetPath())
 
-	err = s.DB.Delete([]byte(req.GetPath()))
+	pointer, err := s.updatePointer(storage.Key(path), func(pointer *pb.Pointer) (*pb.Pointer, error) {
+		if pointer == nil {
+			return nil, status.Errorf(codes.NotFound, "%q not found", req.GetPath())
+		}
+		return nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err = s.deattachBucket(ctx, scope, req.GetPath()); err != nil {
+		return nil, err
+	}
+
+	referenced, err := s.releasePieces(path, pointer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteResponse{PiecesReferenced: referenced}, nil
+}
+
+// DeleteExpired deletes the pointer stored at the given key of the database
+// if it expired before now. The pieces of expired segments are deleted by
+// the storage nodes themselves.
+func (s *Server) DeleteExpired(ctx context.Context, key storage.Key, now time.Time) (deleted bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pointer, err := s.updatePointer(key, func(pointer *pb.Pointer) (*pb.Pointer, error) {
+		// the pointer may have been overwritten since it was found expired
+		deleted = pointer != nil && Expired(pointer, now)
+		if !deleted {
+			return pointer, nil
+		}
+		return nil, nil
+	})
+	if err != nil || !deleted {
+		return false, err
+	}
+
+	_, err = s.releasePieces(key.String(), pointer)
 	if err != nil {
-		s.logger.Error("err deleting path and pointer", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return false, err
 	}
+	return true, nil
+}
 
-	return &pb.DeleteResponse{}, nil
+// releasePieces drops the reference of the replaced or deleted pointer at
+// path to the pieces of its segment and returns whether other pointers still
+// use the pieces
+func (s *Server) releasePieces(path string, pointer *pb.Pointer) (referenced bool, err error) {
+	if pointer.GetRemote() == nil {
+		return false, nil
+	}
+
+	paths, err := s.updateReferences(storage.Key(pointer.GetRemote().GetPieceId()), func(paths []string) []string {
+		return removePath(paths, path)
+	})
+	if err != nil {
+		s.logger.Error("err updating piece references", zap.Error(err))
+		return false, status.Error(codes.Internal, err.Error())
+	}
+	return len(paths) > 0, nil
+}
+
+// syncSharedPieces replaces the remote pieces of all other pointers sharing
+// the pieces of the pointer at path, so that they keep referencing the same
+// pieces after a piece of the segment was replaced
+func (s *Server) syncSharedPieces(path string, remote *pb.RemoteSegment) error {
+	if remote == nil {
+		return nil
+	}
+
+	paths, _, err := s.sharingPaths(storage.Key(remote.GetPieceId()))
+	if err != nil {
+		s.logger.Error("err getting piece references", zap.Error(err))
+		return status.Error(codes.Internal, err.Error())
+	}
+
+	for _, other := range paths {
+		if other == path {
+			continue
+		}
+		_, err = s.updatePointer(storage.Key(other), func(pointer *pb.Pointer) (*pb.Pointer, error) {
+			if pointer.GetRemote().GetPieceId() != remote.GetPieceId() {
+				return pointer, nil
+			}
+			pointer.Remote = remote
+			return pointer, nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Expired returns whether the segment of the pointer expired before now.
+// Segments without expiration date never expire.
+func Expired(pointer *pb.Pointer, now time.Time) bool {
+	if pointer.GetExpirationDate() == nil {
+		return false
+	}
+	expiration, err := ptypes.Timestamp(pointer.GetExpirationDate())
+	if err != nil || expiration.IsZero() {
+		return false
+	}
+	return expiration.Before(now)
+}
+
+// expiredValue returns whether the marshaled pointer expired before now
+func expiredValue(value storage.Value, now time.Time) bool {
+	if len(value) == 0 {
+		return false
+	}
+	pointer := &pb.Pointer{}
+	if err := proto.Unmarshal(value, pointer); err != nil {
+		return false
+	}
+	return Expired(pointer, now)
+}
+
+// Copy duplicates the pointer at the source path to the path, replacing its
+// metadata. The pieces of remote segments are shared between both pointers.
+func (s *Server) Copy(ctx context.Context, req *pb.CopyRequest) (resp *pb.CopyResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	scope, err := s.validateAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sourcePath := scopedPath(scope, req.GetSourcePath())
+	path := scopedPath(scope, req.GetPath())
+
+	var pointer *pb.Pointer
+	for pointer == nil {
+		sourceBytes, err := s.DB.Get(storage.Key(sourcePath))
+		if err != nil {
+			if storage.ErrKeyNotFound.Has(err) {
+				return nil, status.Error(codes.NotFound, err.Error())
+			}
+			s.logger.Error("err getting pointer", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		source := &pb.Pointer{}
+		err = proto.Unmarshal(sourceBytes, source)
+		if err != nil {
+			s.logger.Error("err unmarshaling pointer", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		if source.GetRemote() == nil {
+			pointer = source
+			break
+		}
+
+		pieceID := storage.Key(source.GetRemote().GetPieceId())
+		_, err = s.updateReferences(pieceID, func(paths []string) []string {
+			if len(paths) == 0 {
+				paths = []string{sourcePath}
+			}
+			return addPath(paths, path)
+		})
+		if err != nil {
+			s.logger.Error("err updating piece references", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		// the pieces may have been deleted with the source pointer before
+		// the reference was added, so the source must still be unchanged
+		currentBytes, err := s.DB.Get(storage.Key(sourcePath))
+		if err == nil && bytes.Equal(currentBytes, sourceBytes) {
+			pointer = source
+			break
+		}
+
+		if _, releaseErr := s.releasePieces(path, source); releaseErr != nil {
+			return nil, releaseErr
+		}
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			s.logger.Error("err getting pointer", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+
+	pointer.Metadata = req.GetMetadata()
+	pointer.CreationDate = ptypes.TimestampNow()
+
+	old, err := s.updatePointer(storage.Key(path), func(*pb.Pointer) (*pb.Pointer, error) {
+		return pointer, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if old.GetRemote().GetPieceId() != pointer.GetRemote().GetPieceId() {
+		if _, err = s.releasePieces(path, old); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.CopyResponse{}, nil
+}
+
+// ReplacePiece moves a piece of the segment at the given key from one node
+// to another, storing the hash the new node verified the piece against. It
+// fails with FailedPrecondition if the piece isn't stored on the old node.
+func (s *Server) ReplacePiece(ctx context.Context, key storage.Key, pieceNum int32, from, to storj.NodeID, hash []byte) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var pointer *pb.Pointer
+	_, err = s.updatePointer(key, func(current *pb.Pointer) (*pb.Pointer, error) {
+		if current == nil {
+			return nil, status.Errorf(codes.NotFound, "%q not found", key)
+		}
+		pointer = current
+
+		var replaced bool
+		pieces := pointer.GetRemote().GetRemotePieces()
+		for _, piece := range pieces {
+			if piece.NodeId == to {
+				return nil, status.Errorf(codes.FailedPrecondition, "segment already has a piece on node %s", to)
+			}
+			if piece.PieceNum == pieceNum && piece.NodeId == from {
+				piece.NodeId = to
+				piece.Hash = hash
+				replaced = true
+			}
+		}
+		if !replaced {
+			return nil, status.Errorf(codes.FailedPrecondition, "piece %d isn't stored on node %s", pieceNum, from)
+		}
+
+		hashed := true
+		for _, piece := range pieces {
+			hashed = hashed && len(piece.GetHash()) > 0
+		}
+		pointer.Remote.MerkleRoot = nil
+		if hashed {
+			pointer.Remote.MerkleRoot = pb.PieceHashesRoot(pieces)
+		}
+		return pointer, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	return s.syncSharedPieces(key.String(), pointer.GetRemote())
+}
+
+// TransferAllocation returns a PUT_REPAIR allocation for the node of the
+// request and the authorization of the satellite, which storage nodes use to
+// upload their pieces to other nodes
+func (s *Server) TransferAllocation(ctx context.Context) (pba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pba, err = s.payerBandwidthAllocation(ctx, pb.PayerBandwidthAllocation_PUT_REPAIR, "", "")
+	if err != nil {
+		return nil, nil, err
+	}
+
+	authorization, err = s.getSignedMessage()
+	if err != nil {
+		return nil, nil, err
+	}
+	return pba, authorization, nil
+}
+
+// getPointer loads the pointer at the given path
+func (s *Server) getPointer(path string) (*pb.Pointer, error) {
+	pointerBytes, err := s.DB.Get([]byte(path))
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		s.logger.Error("err getting pointer", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	pointer := &pb.Pointer{}
+	err = proto.Unmarshal(pointerBytes, pointer)
+	if err != nil {
+		s.logger.Error("err unmarshaling pointer", zap.Error(err))
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return pointer, nil
+}
+
+// updatePointer atomically replaces the pointer at key with the result of
+// update, which gets the current pointer or nil if there is none. Returning
+// nil deletes the pointer. It returns the replaced pointer.
+func (s *Server) updatePointer(key storage.Key, update func(pointer *pb.Pointer) (*pb.Pointer, error)) (old *pb.Pointer, err error) {
+	for {
+		oldBytes, err := s.DB.Get(key)
+		if storage.ErrKeyNotFound.Has(err) {
+			oldBytes, err = nil, nil
+		}
+		if err != nil {
+			s.logger.Error("err getting pointer", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+
+		old = nil
+		if oldBytes != nil {
+			old = &pb.Pointer{}
+			err = proto.Unmarshal(oldBytes, old)
+			if err != nil {
+				s.logger.Error("err unmarshaling pointer", zap.Error(err))
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+
+		pointer, err := update(old)
+		if err != nil {
+			return nil, err
+		}
+
+		var pointerBytes storage.Value
+		if pointer != nil {
+			pointerBytes, err = proto.Marshal(pointer)
+			if err != nil {
+				s.logger.Error("err marshaling pointer", zap.Error(err))
+				return nil, status.Error(codes.Internal, err.Error())
+			}
+		}
+		if (pointerBytes == nil) == (oldBytes == nil) && bytes.Equal(pointerBytes, oldBytes) {
+			return old, nil
+		}
+
+		err = s.DB.CompareAndSwap(key, oldBytes, pointerBytes)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		if err != nil {
+			s.logger.Error("err putting pointer", zap.Error(err))
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+		return old, nil
+	}
+}
+
+// sharingPaths returns the paths of the pointers sharing the pieces with the
+// given piece id. There are none if the pieces are used by a single pointer.
+func (s *Server) sharingPaths(pieceID storage.Key) (paths []string, value storage.Value, err error) {
+	value, err = s.refs.Get(pieceID)
+	if storage.ErrKeyNotFound.Has(err) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
+	refs := &pb.PieceReferences{}
+	err = proto.Unmarshal(value, refs)
+	return refs.GetPaths(), value, err
+}
+
+// updateReferences atomically replaces the paths of the pointers sharing the
+// pieces with the given piece id with the result of update and returns them
+func (s *Server) updateReferences(pieceID storage.Key, update func(paths []string) []string) (paths []string, err error) {
+	for {
+		current, value, err := s.sharingPaths(pieceID)
+		if err != nil {
+			return nil, err
+		}
+
+		paths = update(current)
+
+		// pieces used by a single pointer aren't shared anymore
+		var newValue storage.Value
+		if len(paths) > 1 {
+			newValue, err = proto.Marshal(&pb.PieceReferences{Paths: paths})
+			if err != nil {
+				return nil, err
+			}
+		}
+		if value == nil && newValue == nil {
+			return paths, nil
+		}
+
+		err = s.refs.CompareAndSwap(pieceID, value, newValue)
+		if storage.ErrValueChanged.Has(err) {
+			continue
+		}
+		return paths, err
+	}
+}
+
+// addPath returns paths with path added if it isn't contained yet
+func addPath(paths []string, path string) []string {
+	for _, p := range paths {
+		if p == path {
+			return paths
+		}
+	}
+	return append(paths, path)
+}
+
+// removePath returns paths without path
+func removePath(paths []string, path string) []string {
+	var result []string
+	for _, p := range paths {
+		if p != path {
+			result = append(result, p)
+		}
+	}
+	return result
 }
 
 // Iterate iterates over items based on IterateRequest
 func (s *Server) Iterate(ctx context.Context, req *pb.IterateRequest, f func(it storage.Iterator) error) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err = s.validateAuth(ctx); err != nil {
+	if _, err = s.validateAuth(ctx); err != nil {
 		return err
 	}
 
@@ -308,10 +972,25 @@ func (s *Server) Iterate(ctx context.Context, req *pb.IterateRequest, f func(it
 func (s *Server) PayerBandwidthAllocation(ctx context.Context, req *pb.PayerBandwidthAllocationRequest) (pba *pb.PayerBandwidthAllocationResponse, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	if err = s.validateAuth(ctx); err != nil {
+	scope, err := s.validateAuth(ctx)
+	if err != nil {
 		return nil, err
 	}
 
+	if err = s.checkUsageLimits(ctx, scope, req.GetAction()); err != nil {
+		return nil, err
+	}
+
+	allocation, err := s.payerBandwidthAllocation(ctx, req.GetAction(), scope, "")
+	if err != nil {
+		return nil, err
+	}
+	return &pb.PayerBandwidthAllocationResponse{Pba: allocation}, nil
+}
+
+// payerBandwidthAllocation creates a signed PayerBandwidthAllocation for the
+// given action, accounted to the project of the scope and to the bucket
+func (s *Server) payerBandwidthAllocation(ctx context.Context, action pb.PayerBandwidthAllocation_Action, scope string, bucket string) (pba *pb.PayerBandwidthAllocation, err error) {
 	payer := s.identity.ID
 
 	// TODO(michal) should be replaced with renter id when available
@@ -329,7 +1008,7 @@ func (s *Server) PayerBandwidthAllocation(ctx context.Context, req *pb.PayerBand
 	pubbytes, err := x509.MarshalPKIXPublicKey(pk)
 	if err != nil {
 		s.logger.Error("Can't Marshal Public Key for PayerBandwidthAllocation: %+v", zap.Error(err))
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, status.Error(codes.Internal, err.Error())
 	}
 
 	serialNum, err := uuid.New()
@@ -348,9 +1027,11 @@ func (s *Server) PayerBandwidthAllocation(ctx context.Context, req *pb.PayerBand
 		UplinkId:          pi.ID,
 		CreatedUnixSec:    created,
 		ExpirationUnixSec: created + int64(ttl),
-		Action:            req.GetAction(),
+		Action:            action,
 		SerialNumber:      serialNum.String(),
 		PubKey:            pubbytes,
+		ProjectId:         scope,
+		Bucket:            bucket,
 	}
 
 	data, err := proto.Marshal(pbad)
@@ -361,7 +1042,7 @@ func (s *Server) PayerBandwidthAllocation(ctx context.Context, req *pb.PayerBand
 	if err != nil {
 		return nil, err
 	}
-	return &pb.PayerBandwidthAllocationResponse{Pba: &pb.PayerBandwidthAllocation{Signature: signature, Data: data}}, nil
+	return &pb.PayerBandwidthAllocation{Signature: signature, Data: data}, nil
 }
 
 func (s *Server) getSignedMessage() (*pb.SignedMessage, error) {
@@ -10,11 +10,14 @@ import (
 	"errors"
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"github.com/golang/protobuf/ptypes"
 	"github.com/google/go-cmp/cmp"
+	"github.com/skyrings/skyring-common/tools/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"go.uber.org/zap"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
@@ -22,9 +25,11 @@ import (
 	"google.golang.org/grpc/status"
 
 	"storj.io/storj/internal/testidentity"
+	"storj.io/storj/internal/teststorj"
 	"storj.io/storj/pkg/auth"
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/storage/meta"
+	"storj.io/storj/satellite/console"
 	"storj.io/storj/storage"
 	"storj.io/storj/storage/teststore"
 )
@@ -140,8 +145,11 @@ func TestServiceDelete(t *testing.T) {
 
 		path := "a/b/c"
 
+		prBytes, err := proto.Marshal(&pb.Pointer{})
+		assert.NoError(t, err, errTag)
+
 		db := teststore.New()
-		_ = db.Put(storage.Key(path), storage.Value("hello"))
+		_ = db.Put(storage.Key(path), storage.Value(prBytes))
 		s := Server{DB: db, logger: zap.NewNop()}
 
 		if tt.err != nil {
@@ -149,7 +157,7 @@ func TestServiceDelete(t *testing.T) {
 		}
 
 		req := pb.DeleteRequest{Path: path}
-		_, err := s.Delete(ctx, &req)
+		_, err = s.Delete(ctx, &req)
 
 		if err != nil {
 			assert.EqualError(t, err, tt.errString, errTag)
@@ -159,6 +167,326 @@ func TestServiceDelete(t *testing.T) {
 	}
 }
 
+func TestServiceCopy(t *testing.T) {
+	ctx := auth.WithAPIKey(context.Background(), nil)
+
+	s := Server{DB: teststore.New(), refs: teststore.New(), logger: zap.NewNop()}
+
+	pr := &pb.Pointer{
+		Type:     pb.Pointer_REMOTE,
+		Remote:   &pb.RemoteSegment{PieceId: "piece"},
+		Metadata: []byte("source"),
+	}
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: pr})
+	assert.NoError(t, err)
+
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", Path: "a/b/d", Metadata: []byte("copy")})
+	assert.NoError(t, err)
+
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/x", Path: "a/b/y"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	copied, err := s.getPointer("a/b/d")
+	if assert.NoError(t, err) {
+		assert.Equal(t, "piece", copied.GetRemote().GetPieceId())
+		assert.Equal(t, []byte("copy"), copied.GetMetadata())
+	}
+
+	deleted, err := s.Delete(ctx, &pb.DeleteRequest{Path: "a/b/c"})
+	if assert.NoError(t, err) {
+		assert.True(t, deleted.GetPiecesReferenced())
+	}
+
+	deleted, err = s.Delete(ctx, &pb.DeleteRequest{Path: "a/b/d"})
+	if assert.NoError(t, err) {
+		assert.False(t, deleted.GetPiecesReferenced())
+	}
+}
+
+func TestServiceReplacePiece(t *testing.T) {
+	ctx := context.Background()
+
+	db := teststore.New()
+	s := Server{DB: db, refs: teststore.New(), logger: zap.NewNop()}
+
+	from, to, other := teststorj.NodeIDFromString("from"), teststorj.NodeIDFromString("to"), teststorj.NodeIDFromString("other")
+
+	pr := &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			PieceId: "piece",
+			RemotePieces: []*pb.RemotePiece{
+				{PieceNum: 0, NodeId: from, Hash: []byte("hash0")},
+				{PieceNum: 1, NodeId: other, Hash: []byte("hash1")},
+			},
+		},
+	}
+	prBytes, err := proto.Marshal(pr)
+	require.NoError(t, err)
+	require.NoError(t, db.Put(storage.Key("a/b/c"), prBytes))
+
+	err = s.ReplacePiece(ctx, storage.Key("a/b/c"), 1, from, to, []byte("new"))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	err = s.ReplacePiece(ctx, storage.Key("a/b/c"), 0, from, other, []byte("new"))
+	assert.Equal(t, codes.FailedPrecondition, status.Code(err))
+
+	err = s.ReplacePiece(ctx, storage.Key("a/b/c"), 0, from, to, []byte("new"))
+	require.NoError(t, err)
+
+	replaced, err := s.getPointer("a/b/c")
+	require.NoError(t, err)
+
+	pieces := replaced.GetRemote().GetRemotePieces()
+	assert.Equal(t, to, pieces[0].NodeId)
+	assert.Equal(t, []byte("new"), pieces[0].Hash)
+	assert.Equal(t, pb.PieceHashesRoot(pieces), replaced.GetRemote().GetMerkleRoot())
+}
+
+func TestServiceSharedPieces(t *testing.T) {
+	ctx := auth.WithAPIKey(context.Background(), nil)
+
+	s := Server{DB: teststore.New(), refs: teststore.New(), logger: zap.NewNop()}
+
+	from, to := teststorj.NodeIDFromString("from"), teststorj.NodeIDFromString("to")
+
+	pr := &pb.Pointer{
+		Type: pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{
+			PieceId:      "piece",
+			RemotePieces: []*pb.RemotePiece{{PieceNum: 0, NodeId: from}},
+		},
+	}
+	_, err := s.Put(ctx, &pb.PutRequest{Path: "a/b/c", Pointer: pr})
+	require.NoError(t, err)
+
+	_, err = s.Copy(ctx, &pb.CopyRequest{SourcePath: "a/b/c", Path: "a/b/d"})
+	require.NoError(t, err)
+
+	// replacing a piece of the source replaces it for the copy too
+	err = s.ReplacePiece(ctx, storage.Key("a/b/c"), 0, from, to, nil)
+	require.NoError(t, err)
+
+	copied, err := s.getPointer("a/b/d")
+	require.NoError(t, err)
+	assert.Equal(t, to, copied.GetRemote().GetRemotePieces()[0].NodeId)
+
+	// overwriting the copy with another segment releases the pieces
+	_, err = s.Put(ctx, &pb.PutRequest{Path: "a/b/d", Pointer: &pb.Pointer{
+		Type:   pb.Pointer_REMOTE,
+		Remote: &pb.RemoteSegment{PieceId: "other"},
+	}})
+	require.NoError(t, err)
+
+	deleted, err := s.Delete(ctx, &pb.DeleteRequest{Path: "a/b/c"})
+	if assert.NoError(t, err) {
+		assert.False(t, deleted.GetPiecesReferenced())
+	}
+}
+
+type mockAPIKeys map[console.APIKey]console.APIKeyInfo
+
+func (keys mockAPIKeys) GetByKey(ctx context.Context, key console.APIKey) (*console.APIKeyInfo, error) {
+	info, ok := keys[key]
+	if !ok {
+		return nil, errors.New("api key not found")
+	}
+	return &info, nil
+}
+
+func TestServiceProjectAPIKeys(t *testing.T) {
+	keys := mockAPIKeys{}
+	projectKeys := []*console.APIKey{}
+	for i := 0; i < 2; i++ {
+		key, err := console.CreateAPIKey()
+		assert.NoError(t, err)
+		projectID, err := uuid.New()
+		assert.NoError(t, err)
+
+		keys[*key] = console.APIKeyInfo{ProjectID: *projectID}
+		projectKeys = append(projectKeys, key)
+	}
+
+	s := Server{DB: teststore.New(), logger: zap.NewNop()}
+	s.SetAPIKeys(keys)
+
+	for _, key := range projectKeys {
+		ctx := auth.WithAPIKey(context.Background(), []byte(key.String()))
+		_, err := s.Put(ctx, &pb.PutRequest{Path: "bucket/" + key.String(), Pointer: &pb.Pointer{}})
+		assert.NoError(t, err)
+	}
+
+	for _, key := range projectKeys {
+		ctx := auth.WithAPIKey(context.Background(), []byte(key.String()))
+		resp, err := s.List(ctx, &pb.ListRequest{Recursive: true})
+		if assert.NoError(t, err) && assert.Len(t, resp.GetItems(), 1) {
+			assert.Equal(t, "bucket/"+key.String(), resp.GetItems()[0].GetPath())
+		}
+	}
+
+	deletedKey, err := console.CreateAPIKey()
+	assert.NoError(t, err)
+
+	for _, apiKey := range [][]byte{nil, []byte("wrong key"), []byte(deletedKey.String())} {
+		ctx := auth.WithAPIKey(context.Background(), apiKey)
+		_, err := s.List(ctx, &pb.ListRequest{Recursive: true})
+		assert.Equal(t, codes.Unauthenticated, status.Code(err))
+	}
+}
+
+type mockBuckets map[string]console.Bucket
+
+func (buckets mockBuckets) GetBucket(ctx context.Context, name string) (*console.Bucket, error) {
+	bucket, ok := buckets[name]
+	if !ok {
+		return nil, errors.New("bucket not found")
+	}
+	return &bucket, nil
+}
+
+func (buckets mockBuckets) AttachBucket(ctx context.Context, name string, projectID uuid.UUID) (*console.Bucket, error) {
+	if _, ok := buckets[name]; ok {
+		return nil, errors.New("bucket already attached")
+	}
+	buckets[name] = console.Bucket{Name: name, ProjectID: projectID}
+	return nil, nil
+}
+
+func (buckets mockBuckets) DeattachBucket(ctx context.Context, name string) error {
+	delete(buckets, name)
+	return nil
+}
+
+func TestServiceBucketOwnership(t *testing.T) {
+	keys := mockAPIKeys{}
+	ctxs := []context.Context{}
+	projectIDs := []uuid.UUID{}
+	for i := 0; i < 2; i++ {
+		key, err := console.CreateAPIKey()
+		assert.NoError(t, err)
+		projectID, err := uuid.New()
+		assert.NoError(t, err)
+
+		keys[*key] = console.APIKeyInfo{ProjectID: *projectID}
+		ctxs = append(ctxs, auth.WithAPIKey(context.Background(), []byte(key.String())))
+		projectIDs = append(projectIDs, *projectID)
+	}
+
+	db, buckets := teststore.New(), mockBuckets{}
+	s := Server{DB: db, logger: zap.NewNop()}
+	s.SetAPIKeys(keys)
+	s.SetBuckets(buckets)
+
+	_, err := s.Put(ctxs[0], &pb.PutRequest{Path: "l/photos", Pointer: &pb.Pointer{}})
+	assert.NoError(t, err)
+	assert.Equal(t, projectIDs[0], buckets["photos"].ProjectID)
+
+	// objects in the bucket are not bucket records
+	_, err = s.Put(ctxs[0], &pb.PutRequest{Path: "l/photos/file", Pointer: &pb.Pointer{}})
+	assert.NoError(t, err)
+	assert.Len(t, buckets, 1)
+
+	// recreating the bucket in the same project is fine
+	_, err = s.Put(ctxs[0], &pb.PutRequest{Path: "l/photos", Pointer: &pb.Pointer{}})
+	assert.NoError(t, err)
+
+	_, err = s.Put(ctxs[1], &pb.PutRequest{Path: "l/photos", Pointer: &pb.Pointer{}})
+	assert.Equal(t, codes.AlreadyExists, status.Code(err))
+
+	_, err = s.Delete(ctxs[0], &pb.DeleteRequest{Path: "l/photos"})
+	assert.NoError(t, err)
+	assert.Empty(t, buckets)
+
+	_, err = s.Put(ctxs[1], &pb.PutRequest{Path: "l/photos", Pointer: &pb.Pointer{}})
+	assert.NoError(t, err)
+	assert.Equal(t, projectIDs[1], buckets["photos"].ProjectID)
+
+	// buckets which failed to be created are not owned
+	db.ForceError++
+	_, err = s.Put(ctxs[0], &pb.PutRequest{Path: "l/videos", Pointer: &pb.Pointer{}})
+	assert.Equal(t, codes.Internal, status.Code(err))
+	assert.NotContains(t, buckets, "videos")
+}
+
+type mockUsageLimits map[uuid.UUID]console.UsageLimit
+
+func (limits mockUsageLimits) GetLimits(ctx context.Context, projectID uuid.UUID) (*console.UsageLimit, error) {
+	limit := limits[projectID]
+	return &limit, nil
+}
+
+type mockProjectUsage map[uuid.UUID][]console.DailyUsage
+
+func (usage mockProjectUsage) GetDailyUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]console.DailyUsage, error) {
+	return usage[projectID], nil
+}
+
+func TestServiceUsageLimits(t *testing.T) {
+	keys := mockAPIKeys{}
+	ctxs := []context.Context{}
+	projectIDs := []uuid.UUID{}
+	for i := 0; i < 2; i++ {
+		key, err := console.CreateAPIKey()
+		assert.NoError(t, err)
+		projectID, err := uuid.New()
+		assert.NoError(t, err)
+
+		keys[*key] = console.APIKeyInfo{ProjectID: *projectID}
+		ctxs = append(ctxs, auth.WithAPIKey(context.Background(), []byte(key.String())))
+		projectIDs = append(projectIDs, *projectID)
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	limits := mockUsageLimits{
+		projectIDs[0]: {Storage: 1000, Egress: 1000},
+		projectIDs[1]: {Storage: 1000},
+	}
+	usage := mockProjectUsage{
+		projectIDs[0]: {{Date: today, Storage: 1050, Egress: 1200}},
+		projectIDs[1]: {{Date: today, Storage: 1200, Egress: 1200}},
+	}
+
+	ca, err := testidentity.NewTestCA(ctxs[0])
+	assert.NoError(t, err)
+	identity, err := ca.NewIdentity()
+	assert.NoError(t, err)
+
+	s := Server{DB: teststore.New(), logger: zap.NewNop(), identity: identity, config: Config{LimitGraceMargin: 0.1}}
+	s.SetAPIKeys(keys)
+	s.SetUsageLimits(limits, usage)
+
+	// within the grace margin
+	err = s.checkUsageLimits(ctxs[0], projectIDs[0].String(), pb.PayerBandwidthAllocation_PUT)
+	assert.NoError(t, err)
+
+	_, err = s.PayerBandwidthAllocation(ctxs[0], &pb.PayerBandwidthAllocationRequest{Action: pb.PayerBandwidthAllocation_GET})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// segments can be looked up and deleted, but not downloaded
+	_, err = s.Put(ctxs[0], &pb.PutRequest{Path: "bucket/file", Pointer: &pb.Pointer{}})
+	assert.NoError(t, err)
+
+	resp, err := s.Get(ctxs[0], &pb.GetRequest{Path: "bucket/file"})
+	if assert.NoError(t, err) {
+		assert.NotNil(t, resp.GetPointer())
+		assert.Nil(t, resp.GetPba())
+	}
+
+	_, err = s.Delete(ctxs[0], &pb.DeleteRequest{Path: "bucket/file"})
+	assert.NoError(t, err)
+
+	_, err = s.PayerBandwidthAllocation(ctxs[1], &pb.PayerBandwidthAllocationRequest{Action: pb.PayerBandwidthAllocation_PUT})
+	assert.Equal(t, codes.ResourceExhausted, status.Code(err))
+
+	// no egress limit
+	_, err = s.Get(ctxs[1], &pb.GetRequest{Path: "bucket/file"})
+	assert.Equal(t, codes.NotFound, status.Code(err))
+
+	// the satellite itself is not limited
+	err = s.checkUsageLimits(context.Background(), "", pb.PayerBandwidthAllocation_PUT)
+	assert.NoError(t, err)
+}
+
 func TestServiceList(t *testing.T) {
 	db := teststore.New()
 	server := Server{DB: db, logger: zap.NewNop()}
@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reaper
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/pkg/provider"
+)
+
+// Config contains configurable values for reaper
+type Config struct {
+	Interval time.Duration `help:"how frequently reaper should delete expired segments" default:"1h"`
+}
+
+// Initialize a Reaper struct
+func (c Config) initialize(ctx context.Context) (Reaper, error) {
+	pdb := pointerdb.LoadFromContext(ctx)
+	if pdb == nil {
+		return nil, Error.New("failed to load pointerdb from context")
+	}
+
+	return newReaper(pdb, 0, zap.L(), c.Interval), nil
+}
+
+// Run runs the reaper with configured values
+func (c Config) Run(ctx context.Context, server *provider.Provider) (err error) {
+	reap, err := c.initialize(ctx)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithCancel(ctx)
+
+	go func() {
+		if err := reap.Run(ctx); err != nil {
+			defer cancel()
+			zap.L().Debug("Reaper is shutting down", zap.Error(err))
+		}
+	}()
+
+	return server.Run(ctx)
+}
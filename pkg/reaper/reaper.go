@@ -0,0 +1,125 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reaper
+
+import (
+	"context"
+	"time"
+
+	"github.com/gogo/protobuf/proto"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/storage"
+)
+
+// Reaper is the interface for the expired segment reaper
+type Reaper interface {
+	Run(ctx context.Context) error
+}
+
+// reaper deletes the pointers of expired segments from pointerdb
+type reaper struct {
+	pointerdb *pointerdb.Server
+	limit     int
+	logger    *zap.Logger
+	ticker    *time.Ticker
+}
+
+// newReaper creates a new instance of reaper
+func newReaper(pointerdb *pointerdb.Server, limit int, logger *zap.Logger, interval time.Duration) *reaper {
+	return &reaper{
+		pointerdb: pointerdb,
+		limit:     limit,
+		logger:    logger,
+		ticker:    time.NewTicker(interval),
+	}
+}
+
+// Run the reaper loop
+func (r *reaper) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		_, err = r.deleteExpiredSegments(ctx, time.Now())
+		if err != nil {
+			r.logger.Error("Reaper failed", zap.Error(err))
+		}
+
+		select {
+		case <-r.ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the reaper is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// deleteExpiredSegments deletes the pointers of the segments which expired
+// before now and returns how many were deleted
+func (r *reaper) deleteExpiredSegments(ctx context.Context, now time.Time) (deleted int, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	lim := r.limit
+	if lim <= 0 || lim > storage.LookupLimit {
+		lim = storage.LookupLimit
+	}
+
+	// the pointers are iterated in batches of lim, each one starting after
+	// the last key of the previous batch
+	var last storage.Key
+	for more := true; more; {
+		// the keys are collected first as the database can't be modified
+		// while it is iterated
+		var expired []storage.Key
+		more = false
+		err = r.pointerdb.Iterate(ctx, &pb.IterateRequest{Recurse: true, First: string(last)},
+			func(it storage.Iterator) error {
+				var item storage.ListItem
+				for n := 0; it.Next(&item); {
+					// the first key is inclusive
+					if last != nil && item.Key.Equal(last) {
+						continue
+					}
+					if n == lim {
+						more = true
+						return nil
+					}
+					n++
+
+					last = storage.CloneKey(item.Key)
+
+					pointer := &pb.Pointer{}
+					err := proto.Unmarshal(item.Value, pointer)
+					if err != nil {
+						return Error.New("error unmarshalling pointer %s", err)
+					}
+
+					if pointerdb.Expired(pointer, now) {
+						expired = append(expired, last)
+					}
+				}
+				return nil
+			},
+		)
+		if err != nil {
+			return deleted, err
+		}
+
+		for _, key := range expired {
+			ok, err := r.pointerdb.DeleteExpired(ctx, key, now)
+			if err != nil {
+				return deleted, Error.New("error deleting expired segment %s", err)
+			}
+			if ok {
+				deleted++
+			}
+		}
+	}
+
+	if deleted > 0 {
+		r.logger.Info("Reaper deleted expired segments", zap.Int("count", deleted))
+	}
+	return deleted, nil
+}
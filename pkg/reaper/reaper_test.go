@@ -0,0 +1,121 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package reaper
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/zap"
+
+	"storj.io/storj/pkg/auth"
+	"storj.io/storj/pkg/overlay"
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/pointerdb"
+	"storj.io/storj/storage"
+	"storj.io/storj/storage/teststore"
+)
+
+func TestDeleteExpiredSegments(t *testing.T) {
+	ctx := auth.WithAPIKey(context.Background(), nil)
+	now := time.Now()
+
+	db := teststore.New()
+	pdb := pointerdb.NewServer(db, teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
+
+	for path, expiration := range map[string]time.Time{
+		"never":   {},
+		"expired": now.Add(-time.Hour),
+		"future":  now.Add(time.Hour),
+	} {
+		exp, err := ptypes.TimestampProto(expiration)
+		if !assert.NoError(t, err) {
+			return
+		}
+
+		_, err = pdb.Put(ctx, &pb.PutRequest{
+			Path: path,
+			Pointer: &pb.Pointer{
+				Type:           pb.Pointer_INLINE,
+				ExpirationDate: exp,
+			},
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+
+	_, err := pdb.Get(ctx, &pb.GetRequest{Path: "expired"})
+	assert.Error(t, err)
+
+	list, err := pdb.List(ctx, &pb.ListRequest{Recursive: true})
+	if assert.NoError(t, err) {
+		assert.Len(t, list.GetItems(), 2)
+	}
+
+	r := newReaper(pdb, 0, zap.NewNop(), time.Hour)
+
+	deleted, err := r.deleteExpiredSegments(ctx, now)
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+
+	keys, err := storage.ListKeys(db, nil, 0)
+	if assert.NoError(t, err) {
+		assert.Equal(t, storage.Keys{storage.Key("future"), storage.Key("never")}, keys)
+	}
+
+	deleted, err = r.deleteExpiredSegments(ctx, now.Add(2*time.Hour))
+	assert.NoError(t, err)
+	assert.Equal(t, 1, deleted)
+}
+
+func TestDeleteExpiredSegmentsPastLookupLimit(t *testing.T) {
+	ctx := auth.WithAPIKey(context.Background(), nil)
+	now := time.Now()
+
+	db := teststore.New()
+	pdb := pointerdb.NewServer(db, teststore.New(), &overlay.Cache{}, zap.NewNop(), pointerdb.Config{}, nil)
+
+	expired, err := ptypes.TimestampProto(now.Add(-time.Hour))
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// every other pointer expired, spread over more than two batches
+	count := 2*storage.LookupLimit + 10
+	for i := 0; i < count; i++ {
+		pointer := &pb.Pointer{Type: pb.Pointer_INLINE}
+		if i%2 == 0 {
+			pointer.ExpirationDate = expired
+		}
+
+		_, err = pdb.Put(ctx, &pb.PutRequest{
+			Path:    fmt.Sprintf("path%05d", i),
+			Pointer: pointer,
+		})
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+
+	r := newReaper(pdb, 0, zap.NewNop(), time.Hour)
+
+	deleted, err := r.deleteExpiredSegments(ctx, now)
+	assert.NoError(t, err)
+	assert.Equal(t, count/2, deleted)
+
+	remaining := 0
+	err = db.Iterate(storage.IterateOptions{Recurse: true}, func(it storage.Iterator) error {
+		var item storage.ListItem
+		for ; it.Next(&item); remaining++ {
+		}
+		return nil
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, count/2, remaining)
+}
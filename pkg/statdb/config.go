@@ -5,6 +5,7 @@ package statdb
 
 import (
 	"context"
+	"time"
 
 	"go.uber.org/zap"
 
@@ -13,10 +14,46 @@ import (
 )
 
 // Config represents a StatDB service
-type Config struct{}
+type Config struct {
+	StateInterval  time.Duration `help:"how frequently the states of the nodes are updated by their reputation" default:"1m"`
+	ReinstateAfter time.Duration `help:"how long a node is suspended at least, before it's reinstated once its reputations are above the suspension thresholds again" default:"24h"`
+
+	MinAuditCount              int64   `help:"the number of audits after which the audit reputation of a node is checked" default:"10"`
+	SuspendAuditReputation     float64 `help:"the audit reputation below which a node is suspended, 0 disables suspensions" default:"0"`
+	DisqualifyAuditReputation  float64 `help:"the audit reputation below which a node is disqualified, 0 disables disqualifications" default:"0"`
+	MinUptimeCount             int64   `help:"the number of uptime checks after which the uptime reputation of a node is checked" default:"10"`
+	SuspendUptimeReputation    float64 `help:"the uptime reputation below which a node is suspended, 0 disables suspensions" default:"0"`
+	DisqualifyUptimeReputation float64 `help:"the uptime reputation below which a node is disqualified, 0 disables disqualifications" default:"0"`
+
+	AuditReputationLambda  float64 `help:"the forgetting factor of the audit reputation, 1 never forgets an audit" default:"0.95"`
+	AuditReputationWeight  float64 `help:"the weight of a new audit in the audit reputation" default:"1"`
+	UptimeReputationLambda float64 `help:"the forgetting factor of the uptime reputation, 1 never forgets an uptime check" default:"0.99"`
+	UptimeReputationWeight float64 `help:"the weight of a new uptime check in the uptime reputation" default:"1"`
+}
+
+// Reputation returns the parameters of the reputations of the config
+func (c Config) Reputation() ReputationConfig {
+	return ReputationConfig{
+		Audit:  ReputationParams{Lambda: c.AuditReputationLambda, Weight: c.AuditReputationWeight},
+		Uptime: ReputationParams{Lambda: c.UptimeReputationLambda, Weight: c.UptimeReputationWeight},
+	}
+}
+
+// Thresholds returns the reputation requirements of the config
+func (c Config) Thresholds() Thresholds {
+	return Thresholds{
+		MinAuditCount:              c.MinAuditCount,
+		SuspendAuditReputation:     c.SuspendAuditReputation,
+		DisqualifyAuditReputation:  c.DisqualifyAuditReputation,
+		MinUptimeCount:             c.MinUptimeCount,
+		SuspendUptimeReputation:    c.SuspendUptimeReputation,
+		DisqualifyUptimeReputation: c.DisqualifyUptimeReputation,
+		ReinstateAfter:             c.ReinstateAfter,
+	}
+}
 
 // Run implements server.Service
-func (Config) Run(ctx context.Context, server *server.Server) (err error) {
+func (c Config) Run(ctx context.Context, server *server.Server) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	sdb, ok := ctx.Value("masterdb").(interface {
@@ -30,6 +67,18 @@ func (Config) Run(ctx context.Context, server *server.Server) (err error) {
 		"gRPC server that only listens on localhost")
 	// TODO: register on a private rpc server
 	pb.RegisterStatDBInspectorServer(server.GRPC(), NewInspector(sdb.StatDB()))
+	pb.RegisterNodeStatsServer(server.GRPC(), NewEndpoint(sdb.StatDB()))
+
+	// the overlay and audits update the reputations with the config of
+	// statdb, they are started after statdb
+	ctx = WithReputation(ctx, c.Reputation())
+
+	service := NewStateService(zap.L().Named("statdb"), sdb.StatDB(), c.Thresholds(), c.StateInterval)
+	go func() {
+		if err := service.Run(ctx); err != nil {
+			zap.L().Debug("node state service is shutting down", zap.Error(err))
+		}
+	}()
 
 	return server.Run(ctx)
 }
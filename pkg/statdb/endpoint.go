@@ -0,0 +1,47 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/provider"
+)
+
+// Endpoint implements the node stats service, storage nodes query the
+// reputation the satellite keeps for them with it
+type Endpoint struct {
+	statdb DB
+}
+
+// NewEndpoint creates the node stats endpoint
+func NewEndpoint(sdb DB) *Endpoint {
+	return &Endpoint{statdb: sdb}
+}
+
+// Reputation returns the stats of the node of the request
+func (endpoint *Endpoint) Reputation(ctx context.Context, req *pb.ReputationRequest) (resp *pb.ReputationResponse, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	pi, err := provider.PeerIdentityFromContext(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	stats, err := endpoint.statdb.Get(ctx, pi.ID)
+	if err != nil {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+
+	return &pb.ReputationResponse{
+		AuditCount:  stats.AuditCount,
+		AuditRatio:  stats.AuditSuccessRatio,
+		UptimeCount: stats.UptimeCount,
+		UptimeRatio: stats.UptimeRatio,
+	}, nil
+}
@@ -6,6 +6,8 @@ package statdb
 import (
 	"context"
 
+	"github.com/golang/protobuf/ptypes"
+
 	"storj.io/storj/pkg/pb"
 )
 
@@ -50,3 +52,54 @@ func (srv *Inspector) CreateStats(ctx context.Context, req *pb.CreateStatsReques
 
 	return &pb.CreateStatsResponse{}, nil
 }
+
+// ListNodeStates lists the nodes in the state of the request
+func (srv *Inspector) ListNodeStates(ctx context.Context, req *pb.ListNodeStatesRequest) (*pb.ListNodeStatesResponse, error) {
+	statsList, err := srv.statdb.ListByState(ctx, NodeState(req.State))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &pb.ListNodeStatesResponse{}
+	for _, stats := range statsList {
+		info, err := nodeStateInfo(stats)
+		if err != nil {
+			return nil, err
+		}
+		resp.Nodes = append(resp.Nodes, info)
+	}
+	return resp, nil
+}
+
+// SetNodeState changes the state of a node, a node can be reinstated or
+// disqualified manually
+func (srv *Inspector) SetNodeState(ctx context.Context, req *pb.SetNodeStateRequest) (*pb.SetNodeStateResponse, error) {
+	if _, ok := pb.NodeState_name[int32(req.State)]; !ok {
+		return nil, Error.New("invalid node state %d", req.State)
+	}
+
+	stats, err := srv.statdb.UpdateState(ctx, req.NodeId, NodeState(req.State), req.Reason)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := nodeStateInfo(stats)
+	if err != nil {
+		return nil, err
+	}
+	return &pb.SetNodeStateResponse{Node: info}, nil
+}
+
+func nodeStateInfo(stats *NodeStats) (*pb.NodeStateInfo, error) {
+	changedAt, err := ptypes.TimestampProto(stats.StateChangedAt)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &pb.NodeStateInfo{
+		NodeId:    stats.NodeID,
+		State:     pb.NodeState(stats.State),
+		Reason:    stats.StateReason,
+		ChangedAt: changedAt,
+	}, nil
+}
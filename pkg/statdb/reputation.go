@@ -0,0 +1,73 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb
+
+import (
+	"context"
+)
+
+// ReputationParams are the parameters of a decaying reputation, which is the
+// mean alpha / (alpha + beta) of a beta distribution. Every new result decays
+// the alpha and beta of the previous results by Lambda, the forgetting
+// factor, and adds Weight to alpha on success or to beta on failure.
+//
+// The zero value never forgets and weighs every result 1, the reputation is
+// the lifetime success ratio then.
+type ReputationParams struct {
+	Lambda float64
+	Weight float64
+}
+
+// Update returns the alpha and beta after a new result
+func (params ReputationParams) Update(alpha, beta float64, success bool) (float64, float64) {
+	lambda, weight := params.Lambda, params.Weight
+	if lambda <= 0 || lambda > 1 {
+		lambda = 1
+	}
+	if weight <= 0 {
+		weight = 1
+	}
+
+	alpha, beta = lambda*alpha, lambda*beta
+	if success {
+		alpha += weight
+	} else {
+		beta += weight
+	}
+	return alpha, beta
+}
+
+// Reputation returns the mean of the beta distribution, a node without any
+// results has a reputation of 1
+func Reputation(alpha, beta float64) float64 {
+	if alpha+beta <= 0 {
+		return 1
+	}
+	return alpha / (alpha + beta)
+}
+
+// ReputationConfig are the parameters of the audit and uptime reputations
+type ReputationConfig struct {
+	Audit  ReputationParams
+	Uptime ReputationParams
+}
+
+type ctxKey int
+
+const ctxKeyReputation ctxKey = iota
+
+// WithReputation returns a context with the reputation config, the services
+// started after statdb update the reputations with it
+func WithReputation(ctx context.Context, config ReputationConfig) context.Context {
+	return context.WithValue(ctx, ctxKeyReputation, config)
+}
+
+// LoadReputationFromContext gives access to the reputation config from the
+// context, or returns the zero config
+func LoadReputationFromContext(ctx context.Context) ReputationConfig {
+	if v, ok := ctx.Value(ctxKeyReputation).(ReputationConfig); ok {
+		return v
+	}
+	return ReputationConfig{}
+}
@@ -0,0 +1,36 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/pkg/statdb"
+)
+
+func TestReputationParamsUpdate(t *testing.T) {
+	// the zero value counts the results
+	alpha, beta := statdb.ReputationParams{}.Update(3, 1, true)
+	assert.Equal(t, 4.0, alpha)
+	assert.Equal(t, 1.0, beta)
+
+	params := statdb.ReputationParams{Lambda: 0.5, Weight: 2}
+	alpha, beta = params.Update(4, 2, false)
+	assert.Equal(t, 2.0, alpha)
+	assert.Equal(t, 3.0, beta)
+
+	// a long history of successes is forgotten, while the lifetime ratio
+	// would still be above 0.95
+	long := statdb.ReputationParams{Lambda: 0.9, Weight: 1}
+	alpha, beta = 1000, 0
+	for i := 0; i < 50; i++ {
+		alpha, beta = long.Update(alpha, beta, false)
+	}
+	assert.True(t, statdb.Reputation(alpha, beta) < 0.5)
+
+	assert.Equal(t, 1.0, statdb.Reputation(0, 0))
+	assert.Equal(t, 0.25, statdb.Reputation(1, 3))
+}
@@ -5,6 +5,7 @@ package statdb
 
 import (
 	"context"
+	"time"
 
 	"storj.io/storj/pkg/storj"
 )
@@ -19,14 +20,21 @@ type DB interface {
 	FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeIDList, maxStats *NodeStats) (invalid storj.NodeIDList, err error)
 	// Update all parts of single storagenode's stats.
 	Update(ctx context.Context, request *UpdateRequest) (stats *NodeStats, err error)
-	// UpdateUptime updates a single storagenode's uptime stats.
-	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *NodeStats, err error)
-	// UpdateAuditSuccess updates a single storagenode's audit stats.
-	UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *NodeStats, err error)
+	// UpdateUptime updates a single storagenode's uptime stats and reputation.
+	UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, params ReputationParams) (stats *NodeStats, err error)
+	// UpdateAuditSuccess updates a single storagenode's audit stats and reputation.
+	UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool, params ReputationParams) (stats *NodeStats, err error)
 	// UpdateBatch for updating multiple storage nodes' stats.
 	UpdateBatch(ctx context.Context, requests []*UpdateRequest) (statslist []*NodeStats, failed []*UpdateRequest, err error)
 	// CreateEntryIfNotExists creates a node stats entry if it didn't already exist.
 	CreateEntryIfNotExists(ctx context.Context, nodeID storj.NodeID) (stats *NodeStats, err error)
+
+	// UpdateState sets the state of a single storagenode and the reason of the change.
+	UpdateState(ctx context.Context, nodeID storj.NodeID, state NodeState, reason string) (stats *NodeStats, err error)
+	// FindNodesInState finds a subset of storagenodes in the state.
+	FindNodesInState(ctx context.Context, nodeIDs storj.NodeIDList, state NodeState) (ids storj.NodeIDList, err error)
+	// ListByState lists the stats of all storagenodes in the state.
+	ListByState(ctx context.Context, state NodeState) (statslist []*NodeStats, err error)
 }
 
 // UpdateRequest is used to update a node status.
@@ -34,6 +42,9 @@ type UpdateRequest struct {
 	NodeID       storj.NodeID
 	AuditSuccess bool
 	IsUp         bool
+
+	AuditReputation  ReputationParams
+	UptimeReputation ReputationParams
 }
 
 // NodeStats contains statistics abot a node.
@@ -45,4 +56,51 @@ type NodeStats struct {
 	UptimeRatio        float64
 	UptimeSuccessCount int64
 	UptimeCount        int64
+
+	// the alpha and beta of the decaying reputations, new nodes are seeded
+	// with their success and failure counts
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+
+	State          NodeState
+	StateReason    string
+	StateChangedAt time.Time
+}
+
+// AuditReputation returns the decaying audit reputation of the node
+func (stats *NodeStats) AuditReputation() float64 {
+	return Reputation(stats.AuditReputationAlpha, stats.AuditReputationBeta)
+}
+
+// UptimeReputation returns the decaying uptime reputation of the node
+func (stats *NodeStats) UptimeReputation() float64 {
+	return Reputation(stats.UptimeReputationAlpha, stats.UptimeReputationBeta)
+}
+
+// NodeState is the lifecycle state of a node.
+type NodeState int
+
+const (
+	// Active nodes are selected for new pieces.
+	Active NodeState = iota
+	// Suspended nodes keep their pieces, but aren't selected for new pieces.
+	Suspended
+	// Disqualified nodes are never selected again and their pieces are lost.
+	Disqualified
+)
+
+// String returns the name of the state.
+func (state NodeState) String() string {
+	switch state {
+	case Active:
+		return "active"
+	case Suspended:
+		return "suspended"
+	case Disqualified:
+		return "disqualified"
+	default:
+		return "unknown"
+	}
 }
@@ -188,7 +188,7 @@ func testDatabase(ctx context.Context, t *testing.T, sdb statdb.DB) {
 		assert.EqualValues(t, currUptimeSuccess, stats.UptimeSuccessCount)
 		assert.EqualValues(t, uptimeRatio, stats.UptimeRatio)
 
-		stats, err = sdb.UpdateUptime(ctx, nodeID, false)
+		stats, err = sdb.UpdateUptime(ctx, nodeID, false, statdb.ReputationParams{})
 		assert.NoError(t, err)
 
 		currUptimeCount++
@@ -213,7 +213,7 @@ func testDatabase(ctx context.Context, t *testing.T, sdb statdb.DB) {
 		assert.EqualValues(t, currUptimeSuccess, stats.UptimeSuccessCount)
 		assert.EqualValues(t, uptimeRatio, stats.UptimeRatio)
 
-		stats, err = sdb.UpdateAuditSuccess(ctx, nodeID, false)
+		stats, err = sdb.UpdateAuditSuccess(ctx, nodeID, false, statdb.ReputationParams{})
 		assert.NoError(t, err)
 
 		currAuditCount++
@@ -297,4 +297,73 @@ func testDatabase(ctx context.Context, t *testing.T, sdb statdb.DB) {
 		assert.EqualValues(t, newAuditRatio2, stats2.AuditSuccessRatio)
 		assert.EqualValues(t, newUptimeRatio2, stats2.UptimeRatio)
 	}
+
+	{ // TestUpdateState
+		stats, err := sdb.Get(ctx, nodeID)
+		assert.NoError(t, err)
+		assert.Equal(t, statdb.Active, stats.State)
+
+		stats, err = sdb.UpdateState(ctx, nodeID, statdb.Disqualified, "failed audits")
+		assert.NoError(t, err)
+		assert.Equal(t, statdb.Disqualified, stats.State)
+		assert.Equal(t, "failed audits", stats.StateReason)
+
+		ids, err := sdb.FindNodesInState(ctx, storj.NodeIDList{nodeID, {2, 3, 4}}, statdb.Disqualified)
+		assert.NoError(t, err)
+		assert.Equal(t, storj.NodeIDList{nodeID}, ids)
+
+		statsList, err := sdb.ListByState(ctx, statdb.Disqualified)
+		assert.NoError(t, err)
+		if assert.Len(t, statsList, 1) {
+			assert.Equal(t, nodeID, statsList[0].NodeID)
+			assert.Equal(t, "failed audits", statsList[0].StateReason)
+		}
+
+		_, err = sdb.UpdateState(ctx, storj.NodeID{9, 9, 9}, statdb.Suspended, "")
+		assert.Error(t, err)
+	}
+
+	{ // TestReputation
+		reputationID := storj.NodeID{3, 1}
+
+		// the reputations are seeded with the counts
+		stats, err := sdb.Create(ctx, reputationID, &statdb.NodeStats{
+			AuditCount:         10,
+			AuditSuccessCount:  8,
+			UptimeCount:        4,
+			UptimeSuccessCount: 4,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 8, stats.AuditReputationAlpha)
+		assert.EqualValues(t, 2, stats.AuditReputationBeta)
+		assert.EqualValues(t, 4, stats.UptimeReputationAlpha)
+		assert.EqualValues(t, 0, stats.UptimeReputationBeta)
+
+		params := statdb.ReputationParams{Lambda: 0.5, Weight: 1}
+		stats, err = sdb.UpdateAuditSuccess(ctx, reputationID, false, params)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 4, stats.AuditReputationAlpha)
+		assert.EqualValues(t, 2, stats.AuditReputationBeta)
+		assert.InDelta(t, 4.0/6.0, stats.AuditReputation(), 1e-9)
+
+		stats, err = sdb.UpdateUptime(ctx, reputationID, false, params)
+		assert.NoError(t, err)
+		assert.EqualValues(t, 2, stats.UptimeReputationAlpha)
+		assert.EqualValues(t, 1, stats.UptimeReputationBeta)
+
+		stats, err = sdb.Update(ctx, &statdb.UpdateRequest{
+			NodeID:           reputationID,
+			AuditSuccess:     true,
+			IsUp:             true,
+			AuditReputation:  params,
+			UptimeReputation: params,
+		})
+		assert.NoError(t, err)
+		assert.EqualValues(t, 3, stats.AuditReputationAlpha)
+		assert.EqualValues(t, 1, stats.AuditReputationBeta)
+		assert.EqualValues(t, 2, stats.UptimeReputationAlpha)
+		assert.EqualValues(t, 0.5, stats.UptimeReputationBeta)
+		// the lifetime ratio still counts every audit
+		assert.EqualValues(t, 12, stats.AuditCount)
+	}
 }
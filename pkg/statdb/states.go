@@ -0,0 +1,129 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+// Thresholds are the reputation requirements of the nodes. The reputations
+// are only checked once a node was audited or checked for uptime the minimum
+// number of times, a reputation of 0 disables the check. Suspended nodes are
+// reinstated, when they were suspended for at least ReinstateAfter.
+type Thresholds struct {
+	MinAuditCount              int64
+	SuspendAuditReputation     float64
+	DisqualifyAuditReputation  float64
+	MinUptimeCount             int64
+	SuspendUptimeReputation    float64
+	DisqualifyUptimeReputation float64
+	ReinstateAfter             time.Duration
+}
+
+// Evaluate returns the state of the node by its reputation at now and the
+// reason of the state. Disqualified nodes stay disqualified. Suspended nodes
+// are reinstated, once their reputations are above the suspension thresholds
+// again and they were suspended long enough.
+func (thresholds *Thresholds) Evaluate(stats *NodeStats, now time.Time) (state NodeState, reason string) {
+	if stats.State == Disqualified {
+		return stats.State, stats.StateReason
+	}
+
+	audited := stats.AuditCount >= thresholds.MinAuditCount
+	checked := stats.UptimeCount >= thresholds.MinUptimeCount
+
+	audit, uptime := stats.AuditReputation(), stats.UptimeReputation()
+
+	switch {
+	case audited && audit < thresholds.DisqualifyAuditReputation:
+		return Disqualified, fmt.Sprintf("audit reputation %.3f below %.3f", audit, thresholds.DisqualifyAuditReputation)
+	case checked && uptime < thresholds.DisqualifyUptimeReputation:
+		return Disqualified, fmt.Sprintf("uptime reputation %.3f below %.3f", uptime, thresholds.DisqualifyUptimeReputation)
+	case stats.State == Suspended && now.Sub(stats.StateChangedAt) < thresholds.ReinstateAfter:
+		return stats.State, stats.StateReason
+	case audited && audit < thresholds.SuspendAuditReputation:
+		if stats.State == Suspended {
+			return stats.State, stats.StateReason
+		}
+		return Suspended, fmt.Sprintf("audit reputation %.3f below %.3f", audit, thresholds.SuspendAuditReputation)
+	case checked && uptime < thresholds.SuspendUptimeReputation:
+		if stats.State == Suspended {
+			return stats.State, stats.StateReason
+		}
+		return Suspended, fmt.Sprintf("uptime reputation %.3f below %.3f", uptime, thresholds.SuspendUptimeReputation)
+	case stats.State == Suspended:
+		return Active, fmt.Sprintf("reinstated, audit reputation %.3f and uptime reputation %.3f recovered", audit, uptime)
+	}
+	return stats.State, stats.StateReason
+}
+
+// StateService periodically suspends and disqualifies the nodes, which
+// reputation dropped below the thresholds, and reinstates the suspended
+// nodes, which reputation recovered
+type StateService struct {
+	log        *zap.Logger
+	statdb     DB
+	thresholds Thresholds
+	ticker     *time.Ticker
+}
+
+// NewStateService creates a service updating the states of the nodes every interval
+func NewStateService(log *zap.Logger, sdb DB, thresholds Thresholds, interval time.Duration) *StateService {
+	return &StateService{
+		log:        log,
+		statdb:     sdb,
+		thresholds: thresholds,
+		ticker:     time.NewTicker(interval),
+	}
+}
+
+// Run updates the states of the nodes until the context is canceled
+func (service *StateService) Run(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	for {
+		if err := service.UpdateStates(ctx); err != nil {
+			service.log.Error("updating node states failed", zap.Error(err))
+		}
+
+		select {
+		case <-service.ticker.C: // wait for the next interval to happen
+		case <-ctx.Done(): // or the service is canceled via context
+			return ctx.Err()
+		}
+	}
+}
+
+// UpdateStates evaluates the active and suspended nodes and stores the changed states
+func (service *StateService) UpdateStates(ctx context.Context) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	now := time.Now()
+	for _, current := range []NodeState{Active, Suspended} {
+		statsList, err := service.statdb.ListByState(ctx, current)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+
+		for _, stats := range statsList {
+			state, reason := service.thresholds.Evaluate(stats, now)
+			if state == stats.State {
+				continue
+			}
+
+			if _, err := service.statdb.UpdateState(ctx, stats.NodeID, state, reason); err != nil {
+				return Error.Wrap(err)
+			}
+			service.log.Info("node state changed",
+				zap.String("node", stats.NodeID.String()),
+				zap.Stringer("state", state),
+				zap.String("reason", reason))
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package statdb_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
+	"storj.io/storj/satellite"
+	"storj.io/storj/satellite/satellitedb/satellitedbtest"
+)
+
+func TestThresholdsEvaluate(t *testing.T) {
+	thresholds := &statdb.Thresholds{
+		MinAuditCount:              10,
+		SuspendAuditReputation:     0.8,
+		DisqualifyAuditReputation:  0.5,
+		MinUptimeCount:             10,
+		SuspendUptimeReputation:    0.9,
+		DisqualifyUptimeReputation: 0,
+		ReinstateAfter:             time.Hour,
+	}
+	now := time.Now()
+
+	for _, tt := range []struct {
+		stats statdb.NodeStats
+		state statdb.NodeState
+	}{
+		{statdb.NodeStats{AuditCount: 5, AuditReputationBeta: 5}, statdb.Active},
+		{statdb.NodeStats{AuditCount: 10, AuditReputationAlpha: 9, AuditReputationBeta: 1, UptimeCount: 10, UptimeReputationAlpha: 10}, statdb.Active},
+		{statdb.NodeStats{AuditCount: 10, AuditReputationAlpha: 7, AuditReputationBeta: 3, UptimeCount: 10, UptimeReputationAlpha: 10}, statdb.Suspended},
+		{statdb.NodeStats{AuditCount: 10, AuditReputationAlpha: 9, AuditReputationBeta: 1, UptimeCount: 10, UptimeReputationAlpha: 5, UptimeReputationBeta: 5}, statdb.Suspended},
+		{statdb.NodeStats{AuditCount: 10, AuditReputationAlpha: 4, AuditReputationBeta: 6, UptimeCount: 10, UptimeReputationAlpha: 10}, statdb.Disqualified},
+		{statdb.NodeStats{AuditCount: 10, AuditReputationAlpha: 4, AuditReputationBeta: 6, State: statdb.Suspended}, statdb.Disqualified},
+		// the reputation forgets old audits, so the lifetime ratio doesn't matter
+		{statdb.NodeStats{AuditCount: 1000, AuditSuccessRatio: 0.99, AuditReputationAlpha: 4, AuditReputationBeta: 6}, statdb.Disqualified},
+		// suspended nodes are reinstated, once their reputation recovered
+		// and they were suspended long enough
+		{statdb.NodeStats{AuditCount: 10, AuditReputationAlpha: 10, State: statdb.Suspended, StateChangedAt: now.Add(-2 * time.Hour)}, statdb.Active},
+		{statdb.NodeStats{AuditCount: 10, AuditReputationAlpha: 10, State: statdb.Suspended, StateChangedAt: now.Add(-time.Minute)}, statdb.Suspended},
+		{statdb.NodeStats{AuditCount: 10, AuditReputationAlpha: 7, AuditReputationBeta: 3, State: statdb.Suspended, StateChangedAt: now.Add(-2 * time.Hour)}, statdb.Suspended},
+		// disqualified nodes stay disqualified
+		{statdb.NodeStats{AuditCount: 10, AuditReputationAlpha: 10, State: statdb.Disqualified}, statdb.Disqualified},
+	} {
+		state, reason := thresholds.Evaluate(&tt.stats, now)
+		assert.Equal(t, tt.state, state, "%+v", tt.stats)
+		if state != tt.stats.State {
+			assert.NotEmpty(t, reason)
+		}
+	}
+}
+
+func TestStateService(t *testing.T) {
+	satellitedbtest.Run(t, func(t *testing.T, db satellite.DB) {
+		ctx := testcontext.New(t)
+		defer ctx.Cleanup()
+
+		sdb := db.StatDB()
+		good, bad, recovered := storj.NodeID{1}, storj.NodeID{2}, storj.NodeID{3}
+
+		_, err := sdb.Create(ctx, good, &statdb.NodeStats{AuditCount: 10, AuditSuccessCount: 10, UptimeCount: 10, UptimeSuccessCount: 10})
+		require.NoError(t, err)
+		_, err = sdb.Create(ctx, bad, &statdb.NodeStats{AuditCount: 10, AuditSuccessCount: 2, UptimeCount: 10, UptimeSuccessCount: 10})
+		require.NoError(t, err)
+		_, err = sdb.Create(ctx, recovered, &statdb.NodeStats{AuditCount: 10, AuditSuccessCount: 10, UptimeCount: 10, UptimeSuccessCount: 10})
+		require.NoError(t, err)
+		_, err = sdb.UpdateState(ctx, recovered, statdb.Suspended, "audit reputation below the threshold")
+		require.NoError(t, err)
+
+		thresholds := statdb.Thresholds{MinAuditCount: 5, SuspendAuditReputation: 0.8, DisqualifyAuditReputation: 0.5}
+		service := statdb.NewStateService(zap.NewNop(), sdb, thresholds, time.Hour)
+		require.NoError(t, service.UpdateStates(ctx))
+
+		stats, err := sdb.Get(ctx, good)
+		require.NoError(t, err)
+		assert.Equal(t, statdb.Active, stats.State)
+
+		stats, err = sdb.Get(ctx, bad)
+		require.NoError(t, err)
+		assert.Equal(t, statdb.Disqualified, stats.State)
+		assert.NotEmpty(t, stats.StateReason)
+
+		// the suspended node, which reputation recovered, is reinstated
+		stats, err = sdb.Get(ctx, recovered)
+		require.NoError(t, err)
+		assert.Equal(t, statdb.Active, stats.State)
+	})
+}
@@ -91,14 +91,27 @@ func (mr *MockStoreMockRecorder) List(arg0, arg1, arg2, arg3 interface{}) *gomoc
 }
 
 // Put mocks base method
-func (m *MockStore) Put(arg0 context.Context, arg1 string, arg2 storj.Cipher) (buckets.Meta, error) {
-	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2)
+func (m *MockStore) Put(arg0 context.Context, arg1 string, arg2 storj.Cipher, arg3 bool) (buckets.Meta, error) {
+	ret := m.ctrl.Call(m, "Put", arg0, arg1, arg2, arg3)
 	ret0, _ := ret[0].(buckets.Meta)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
 // Put indicates an expected call of Put
-func (mr *MockStoreMockRecorder) Put(arg0, arg1, arg2 interface{}) *gomock.Call {
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), arg0, arg1, arg2)
+func (mr *MockStoreMockRecorder) Put(arg0, arg1, arg2, arg3 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockStore)(nil).Put), arg0, arg1, arg2, arg3)
+}
+
+// SetExpirationRules mocks base method
+func (m *MockStore) SetExpirationRules(arg0 context.Context, arg1 string, arg2 []storj.ExpirationRule) (buckets.Meta, error) {
+	ret := m.ctrl.Call(m, "SetExpirationRules", arg0, arg1, arg2)
+	ret0, _ := ret[0].(buckets.Meta)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetExpirationRules indicates an expected call of SetExpirationRules
+func (mr *MockStoreMockRecorder) SetExpirationRules(arg0, arg1, arg2 interface{}) *gomock.Call {
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetExpirationRules", reflect.TypeOf((*MockStore)(nil).SetExpirationRules), arg0, arg1, arg2)
 }
@@ -64,3 +64,15 @@ func (o *prefixedObjStore) List(ctx context.Context, prefix, startAfter, endBefo
 
 	return o.store.List(ctx, storj.JoinPaths(o.prefix, prefix), startAfter, endBefore, recursive, limit, metaFlags)
 }
+
+func (o *prefixedObjStore) ListPending(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []objects.ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return o.store.ListPending(ctx, storj.JoinPaths(o.prefix, prefix), startAfter, endBefore, recursive, limit, metaFlags)
+}
+
+func (o *prefixedObjStore) ListVersions(ctx context.Context, prefix, startAfter, endBefore storj.Path, limit int, metaFlags uint32) (items []objects.ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	return o.store.ListVersions(ctx, storj.JoinPaths(o.prefix, prefix), startAfter, endBefore, limit, metaFlags)
+}
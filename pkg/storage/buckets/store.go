@@ -6,6 +6,7 @@ package buckets
 import (
 	"bytes"
 	"context"
+	"encoding/json"
 	"strconv"
 	"time"
 
@@ -25,7 +26,8 @@ var mon = monkit.Package()
 // Store creates an interface for interacting with buckets
 type Store interface {
 	Get(ctx context.Context, bucket string) (meta Meta, err error)
-	Put(ctx context.Context, bucket string, pathCipher storj.Cipher) (meta Meta, err error)
+	Put(ctx context.Context, bucket string, pathCipher storj.Cipher, versioning bool) (meta Meta, err error)
+	SetExpirationRules(ctx context.Context, bucket string, rules []storj.ExpirationRule) (meta Meta, err error)
 	Delete(ctx context.Context, bucket string) (err error)
 	List(ctx context.Context, startAfter, endBefore string, limit int) (items []ListItem, more bool, err error)
 	GetObjectStore(ctx context.Context, bucketName string) (store objects.Store, err error)
@@ -47,6 +49,8 @@ type BucketStore struct {
 type Meta struct {
 	Created            time.Time
 	PathEncryptionType storj.Cipher
+	Versioning         bool
+	ExpirationRules    []storj.ExpirationRule
 }
 
 // NewStore instantiates BucketStore
@@ -96,7 +100,7 @@ func (b *BucketStore) Get(ctx context.Context, bucket string) (meta Meta, err er
 }
 
 // Put calls objects store Put
-func (b *BucketStore) Put(ctx context.Context, bucket string, pathCipher storj.Cipher) (meta Meta, err error) {
+func (b *BucketStore) Put(ctx context.Context, bucket string, pathCipher storj.Cipher, versioning bool) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	if bucket == "" {
@@ -111,6 +115,48 @@ func (b *BucketStore) Put(ctx context.Context, bucket string, pathCipher storj.C
 	userMeta := map[string]string{
 		"path-enc-type": strconv.Itoa(int(pathCipher)),
 	}
+	if versioning {
+		userMeta["versioning"] = "enabled"
+	}
+	var exp time.Time
+	m, err := b.store.Put(ctx, bucket, r, pb.SerializableMeta{UserDefined: userMeta}, exp)
+	if err != nil {
+		return Meta{}, err
+	}
+	return convertMeta(m)
+}
+
+// SetExpirationRules replaces the expiration rules stored with the bucket
+func (b *BucketStore) SetExpirationRules(ctx context.Context, bucket string, rules []storj.ExpirationRule) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if bucket == "" {
+		return Meta{}, storj.ErrNoBucket.New("")
+	}
+
+	objMeta, err := b.store.Meta(ctx, bucket)
+	if err != nil {
+		if storage.ErrKeyNotFound.Has(err) {
+			err = storj.ErrBucketNotFound.Wrap(err)
+		}
+		return Meta{}, err
+	}
+
+	userMeta := make(map[string]string, len(objMeta.UserDefined)+1)
+	for key, value := range objMeta.UserDefined {
+		userMeta[key] = value
+	}
+	delete(userMeta, "expiration-rules")
+
+	if len(rules) > 0 {
+		data, err := json.Marshal(rules)
+		if err != nil {
+			return Meta{}, err
+		}
+		userMeta["expiration-rules"] = string(data)
+	}
+
+	r := bytes.NewReader(nil)
 	var exp time.Time
 	m, err := b.store.Put(ctx, bucket, r, pb.SerializableMeta{UserDefined: userMeta}, exp)
 	if err != nil {
@@ -179,8 +225,17 @@ func convertMeta(m objects.Meta) (Meta, error) {
 		cipher = storj.Cipher(pet)
 	}
 
+	var rules []storj.ExpirationRule
+	if data := m.UserDefined["expiration-rules"]; data != "" {
+		if err := json.Unmarshal([]byte(data), &rules); err != nil {
+			return Meta{}, err
+		}
+	}
+
 	return Meta{
 		Created:            m.Modified,
 		PathEncryptionType: cipher,
+		Versioning:         m.UserDefined["versioning"] == "enabled",
+		ExpirationRules:    rules,
 	}, nil
 }
@@ -134,7 +134,7 @@ TestLoop:
 			}
 			ps := NewMockPSClient(ctrl)
 			gomock.InOrder(
-				ps.EXPECT().Put(gomock.Any(), derivedID, gomock.Any(), ttl, gomock.Any(), gomock.Any()).Return(errs[n]).
+				ps.EXPECT().Put(gomock.Any(), derivedID, gomock.Any(), ttl, gomock.Any(), gomock.Any()).Return([]byte(derivedID), errs[n]).
 					Do(func(ctx context.Context, id psclient.PieceID, data io.Reader, ttl time.Time, ba *pb.PayerBandwidthAllocation, authorization *pb.SignedMessage) {
 						// simulate that the mocked piece store client is reading the data
 						_, err := io.Copy(ioutil.Discard, data)
@@ -151,18 +151,25 @@ TestLoop:
 		r := io.LimitReader(rand.Reader, int64(size))
 		ec := ecClient{newPSClientFunc: mockNewPSClient(clients), memoryLimit: tt.mbm}
 
-		successfulNodes, err := ec.Put(ctx, tt.nodes, rs, id, r, ttl, nil, nil)
+		successfulNodes, successfulHashes, err := ec.Put(ctx, tt.nodes, rs, id, r, ttl, nil, nil)
 
 		if tt.errString != "" {
 			assert.EqualError(t, err, tt.errString, errTag)
 		} else {
 			assert.NoError(t, err, errTag)
 			assert.Equal(t, len(tt.nodes), len(successfulNodes), errTag)
+			assert.Equal(t, len(tt.nodes), len(successfulHashes), errTag)
 			for i := range tt.nodes {
 				if tt.errs[i] != nil {
 					assert.Nil(t, successfulNodes[i], errTag)
+					assert.Nil(t, successfulHashes[i], errTag)
 				} else {
 					assert.Equal(t, tt.nodes[i], successfulNodes[i], errTag)
+					if tt.nodes[i] != nil {
+						derivedID, err := id.Derive(tt.nodes[i].Id.Bytes())
+						assert.NoError(t, err, errTag)
+						assert.Equal(t, []byte(derivedID), successfulHashes[i], errTag)
+					}
 				}
 			}
 		}
@@ -44,6 +44,8 @@ type Store interface {
 	Put(ctx context.Context, path storj.Path, data io.Reader, metadata pb.SerializableMeta, expiration time.Time) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	ListPending(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	ListVersions(ctx context.Context, prefix, startAfter, endBefore storj.Path, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
 type objStore struct {
@@ -132,6 +134,35 @@ func (o *objStore) List(ctx context.Context, prefix, startAfter, endBefore storj
 		return nil, false, err
 	}
 
+	return convertListItems(strItems), more, nil
+}
+
+func (o *objStore) ListPending(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (
+	items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	strItems, more, err := o.store.ListPending(ctx, prefix, startAfter, endBefore, o.pathCipher, recursive, limit, metaFlags)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return convertListItems(strItems), more, nil
+}
+
+func (o *objStore) ListVersions(ctx context.Context, prefix, startAfter, endBefore storj.Path, limit int, metaFlags uint32) (
+	items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	strItems, more, err := o.store.ListVersions(ctx, prefix, startAfter, endBefore, o.pathCipher, limit, metaFlags)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return convertListItems(strItems), more, nil
+}
+
+// convertListItems converts stream list items to object list items
+func convertListItems(strItems []streams.ListItem) (items []ListItem) {
 	items = make([]ListItem, len(strItems))
 	for i, itm := range strItems {
 		items[i] = ListItem{
@@ -141,7 +172,7 @@ func (o *objStore) List(ctx context.Context, prefix, startAfter, endBefore storj
 		}
 	}
 
-	return items, more, nil
+	return items
 }
 
 // convertMeta converts stream metadata to object metadata
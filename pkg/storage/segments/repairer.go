@@ -75,7 +75,8 @@ func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int
 		}
 	}
 
-	// Request Overlay for n-h new storage nodes
+	// Request Overlay for n-h new storage nodes, the overlay doesn't select
+	// nodes on the networks of the excluded nodes either
 	op := overlay.Options{Amount: totalNilNodes, Space: 0, Excluded: excludeNodeIDs}
 	newNodes, err := s.oc.Choose(ctx, op)
 	if err != nil {
@@ -139,21 +140,31 @@ func (s *Repairer) Repair(ctx context.Context, path storj.Path, lostPieces []int
 		return Error.Wrap(err)
 	}
 	// Upload the repaired pieces to the repairNodes
-	successfulNodes, err := s.ec.Put(ctx, repairNodes, rs, pid, r, convertTime(pr.GetExpirationDate()), pbaPut, signedMessage)
+	successfulNodes, successfulHashes, err := s.ec.Put(ctx, repairNodes, rs, pid, r, convertTime(pr.GetExpirationDate()), pbaPut, signedMessage)
 	if err != nil {
 		return Error.Wrap(err)
 	}
 
+	// Keep the hashes of the healthy pieces
+	hashes := make([][]byte, len(healthyNodes))
+	for _, piece := range seg.GetRemotePieces() {
+		num := int(piece.GetPieceNum())
+		if num >= 0 && num < len(hashes) && healthyNodes[num] != nil {
+			hashes[num] = piece.GetHash()
+		}
+	}
+
 	// Merge the successful nodes list into the healthy nodes list
 	for i, v := range healthyNodes {
 		if v == nil {
 			// copy the successfuNode info
 			healthyNodes[i] = successfulNodes[i]
+			hashes[i] = successfulHashes[i]
 		}
 	}
 
 	metadata := pr.GetMetadata()
-	pointer, err := makeRemotePointer(healthyNodes, rs, pid, rr.Size(), pr.GetExpirationDate(), metadata)
+	pointer, err := makeRemotePointer(healthyNodes, hashes, rs, pid, rr.Size(), pr.GetExpirationDate(), metadata)
 	if err != nil {
 		return err
 	}
@@ -50,6 +50,7 @@ type Store interface {
 	Get(ctx context.Context, path storj.Path) (rr ranger.Ranger, meta Meta, err error)
 	Put(ctx context.Context, data io.Reader, expiration time.Time, segmentInfo func() (storj.Path, []byte, error)) (meta Meta, err error)
 	Delete(ctx context.Context, path storj.Path) (err error)
+	Copy(ctx context.Context, sourcePath, path storj.Path, metadata []byte) (meta Meta, err error)
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
@@ -135,7 +136,7 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 			return Meta{}, Error.Wrap(err)
 		}
 
-		successfulNodes, err := s.ec.Put(ctx, nodes, s.rs, pieceID, sizedReader, expiration, pba, authorization)
+		successfulNodes, successfulHashes, err := s.ec.Put(ctx, nodes, s.rs, pieceID, sizedReader, expiration, pba, authorization)
 		if err != nil {
 			return Meta{}, Error.Wrap(err)
 		}
@@ -146,7 +147,7 @@ func (s *segmentStore) Put(ctx context.Context, data io.Reader, expiration time.
 		}
 		path = p
 
-		pointer, err = makeRemotePointer(successfulNodes, s.rs, pieceID, sizedReader.Size(), exp, metadata)
+		pointer, err = makeRemotePointer(successfulNodes, successfulHashes, s.rs, pieceID, sizedReader.Size(), exp, metadata)
 		if err != nil {
 			return Meta{}, err
 		}
@@ -210,6 +211,15 @@ func (s *segmentStore) Get(ctx context.Context, path storj.Path) (rr ranger.Rang
 			node.Type.DPanicOnInvalid("ss get")
 		}
 
+		// the satellite withholds the allocation of segments it won't serve,
+		// e.g. over the egress limit, and tells why when asked for one
+		if pba == nil {
+			pba, err = s.pdb.PayerBandwidthAllocation(ctx, pb.PayerBandwidthAllocation_GET)
+			if err != nil {
+				return nil, Meta{}, Error.Wrap(err)
+			}
+		}
+
 		authorization := s.pdb.SignedMessage()
 		rr, err = s.ec.Get(ctx, selected, rs, pid, pr.GetSegmentSize(), pba, authorization)
 		if err != nil {
@@ -222,20 +232,36 @@ func (s *segmentStore) Get(ctx context.Context, path storj.Path) (rr ranger.Rang
 	return rr, convertMeta(pr), nil
 }
 
-// makeRemotePointer creates a pointer of type remote
-func makeRemotePointer(nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, readerSize int64, exp *timestamp.Timestamp, metadata []byte) (pointer *pb.Pointer, err error) {
+// makeRemotePointer creates a pointer of type remote, hashes are the hashes
+// of the pieces stored on nodes
+func makeRemotePointer(nodes []*pb.Node, hashes [][]byte, rs eestream.RedundancyStrategy, pieceID psclient.PieceID, readerSize int64, exp *timestamp.Timestamp, metadata []byte) (pointer *pb.Pointer, err error) {
 	var remotePieces []*pb.RemotePiece
+	hashed := true
 	for i := range nodes {
 		if nodes[i] == nil {
 			continue
 		}
 		nodes[i].Type.DPanicOnInvalid("makeremotepointer")
+
+		var hash []byte
+		if i < len(hashes) {
+			hash = hashes[i]
+		}
+		hashed = hashed && len(hash) > 0
+
 		remotePieces = append(remotePieces, &pb.RemotePiece{
 			PieceNum: int32(i),
 			NodeId:   nodes[i].Id,
+			Hash:     hash,
 		})
 	}
 
+	// pieces stored before their hashes were recorded don't have one
+	var merkleRoot []byte
+	if hashed {
+		merkleRoot = pb.PieceHashesRoot(remotePieces)
+	}
+
 	pointer = &pb.Pointer{
 		Type: pb.Pointer_REMOTE,
 		Remote: &pb.RemoteSegment{
@@ -249,6 +275,7 @@ func makeRemotePointer(nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID
 			},
 			PieceId:      string(pieceID),
 			RemotePieces: remotePieces,
+			MerkleRoot:   merkleRoot,
 		},
 		SegmentSize:    readerSize,
 		ExpirationDate: exp,
@@ -257,7 +284,8 @@ func makeRemotePointer(nodes []*pb.Node, rs eestream.RedundancyStrategy, pieceID
 	return pointer, nil
 }
 
-// Delete tells piece stores to delete a segment and deletes pointer from pointerdb
+// Delete tells piece stores to delete a segment and deletes pointer from pointerdb.
+// The pieces are kept if they are still used by a copy of the segment.
 func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error) {
 	defer mon.Task()(&ctx)(&err)
 
@@ -266,30 +294,45 @@ func (s *segmentStore) Delete(ctx context.Context, path storj.Path) (err error)
 		return Error.Wrap(err)
 	}
 
-	if pr.GetType() == pb.Pointer_REMOTE {
-		seg := pr.GetRemote()
-		pid := psclient.PieceID(seg.PieceId)
+	// deletes pointer from pointerdb
+	piecesReferenced, err := s.pdb.Delete(ctx, path)
+	if err != nil {
+		return Error.Wrap(err)
+	}
 
-		nodes, err = lookupAndAlignNodes(ctx, s.oc, nodes, seg)
-		if err != nil {
-			return Error.Wrap(err)
-		}
-		for _, v := range nodes {
-			if v != nil {
-				v.Type.DPanicOnInvalid("ss delete")
-			}
-		}
+	if pr.GetType() != pb.Pointer_REMOTE || piecesReferenced {
+		return nil
+	}
 
-		authorization := s.pdb.SignedMessage()
-		// ecclient sends delete request
-		err = s.ec.Delete(ctx, nodes, pid, authorization)
-		if err != nil {
-			return Error.Wrap(err)
+	seg := pr.GetRemote()
+	pid := psclient.PieceID(seg.PieceId)
+
+	nodes, err = lookupAndAlignNodes(ctx, s.oc, nodes, seg)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	for _, v := range nodes {
+		if v != nil {
+			v.Type.DPanicOnInvalid("ss delete")
 		}
 	}
 
-	// deletes pointer from pointerdb
-	return s.pdb.Delete(ctx, path)
+	authorization := s.pdb.SignedMessage()
+	// ecclient sends delete request
+	return Error.Wrap(s.ec.Delete(ctx, nodes, pid, authorization))
+}
+
+// Copy duplicates the segment at sourcePath to path with the given metadata
+// without transferring its data. Remote pieces are shared by both segments.
+func (s *segmentStore) Copy(ctx context.Context, sourcePath, path storj.Path, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.pdb.Copy(ctx, sourcePath, path, metadata)
+	if err != nil {
+		return Meta{}, Error.Wrap(err)
+	}
+
+	return s.Meta(ctx, path)
 }
 
 // List retrieves paths to segments and their metadata stored in the pointerdb
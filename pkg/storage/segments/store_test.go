@@ -280,7 +280,7 @@ func TestSegmentStoreGetRemote(t *testing.T) {
 				ExpirationDate: someTime,
 				SegmentSize:    tt.size,
 				Metadata:       tt.metadata,
-			}, nil, nil, nil),
+			}, nil, &pb.PayerBandwidthAllocation{}, nil),
 			mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()),
 			mockPDB.EXPECT().SignedMessage(),
 			mockEC.EXPECT().Get(
@@ -354,13 +354,15 @@ func TestSegmentStoreDeleteRemote(t *testing.T) {
 	assert.NoError(t, err)
 
 	for _, tt := range []struct {
-		pathInput     string
-		thresholdSize int
-		pointerType   pb.Pointer_DataType
-		size          int64
-		metadata      []byte
+		pathInput        string
+		thresholdSize    int
+		pointerType      pb.Pointer_DataType
+		size             int64
+		metadata         []byte
+		piecesReferenced bool
 	}{
-		{"path/1/2/3", 10, pb.Pointer_REMOTE, int64(3), []byte("metadata")},
+		{"path/1/2/3", 10, pb.Pointer_REMOTE, int64(3), []byte("metadata"), false},
+		{"path/1/2/3", 10, pb.Pointer_REMOTE, int64(3), []byte("metadata"), true},
 	} {
 		mockOC := mock_overlay.NewMockClient(ctrl)
 		mockEC := mock_ecclient.NewMockClient(ctrl)
@@ -394,14 +396,18 @@ func TestSegmentStoreDeleteRemote(t *testing.T) {
 				SegmentSize:    tt.size,
 				Metadata:       tt.metadata,
 			}, nil, nil, nil),
-			mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()),
-			mockPDB.EXPECT().SignedMessage(),
-			mockEC.EXPECT().Delete(
-				gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-			),
 			mockPDB.EXPECT().Delete(
 				gomock.Any(), gomock.Any(),
-			),
+			).Return(tt.piecesReferenced, nil),
+		}
+		if !tt.piecesReferenced {
+			calls = append(calls,
+				mockOC.EXPECT().BulkLookup(gomock.Any(), gomock.Any()),
+				mockPDB.EXPECT().SignedMessage(),
+				mockEC.EXPECT().Delete(
+					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+				),
+			)
 		}
 		gomock.InOrder(calls...)
 
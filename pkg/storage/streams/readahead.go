@@ -0,0 +1,207 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package streams
+
+import (
+	"context"
+	"io"
+
+	"github.com/zeebo/errs"
+
+	"storj.io/storj/pkg/ranger"
+)
+
+// readAheadRanger concatenates the rangers of the segments of a stream like
+// ranger.Concat, but its readers open the ranges of up to parallelism
+// segments at once, so the upcoming segments are downloaded while the
+// current one is read
+type readAheadRanger struct {
+	rangers     []ranger.Ranger
+	size        int64
+	parallelism int
+}
+
+// readAhead concatenates rangers reading ahead up to parallelism of them
+func readAhead(parallelism int, rangers ...ranger.Ranger) ranger.Ranger {
+	if parallelism <= 1 || len(rangers) <= 1 {
+		return ranger.Concat(rangers...)
+	}
+
+	var size int64
+	for _, rr := range rangers {
+		size += rr.Size()
+	}
+
+	return &readAheadRanger{
+		rangers:     rangers,
+		size:        size,
+		parallelism: parallelism,
+	}
+}
+
+// Size implements Ranger.Size
+func (r *readAheadRanger) Size() int64 {
+	return r.size
+}
+
+// Range implements Ranger.Range
+func (r *readAheadRanger) Range(ctx context.Context, offset, length int64) (io.ReadCloser, error) {
+	if offset < 0 {
+		return nil, errs.New("negative offset")
+	}
+	if length < 0 {
+		return nil, errs.New("negative length")
+	}
+	if offset+length > r.size {
+		return nil, errs.New("range beyond end")
+	}
+
+	var parts []rangePart
+	for _, rr := range r.rangers {
+		if length <= 0 {
+			break
+		}
+		size := rr.Size()
+		if offset >= size {
+			offset -= size
+			continue
+		}
+		partLength := size - offset
+		if partLength > length {
+			partLength = length
+		}
+		parts = append(parts, rangePart{ranger: rr, offset: offset, length: partLength})
+		offset, length = 0, length-partLength
+	}
+
+	if len(parts) == 0 {
+		return ranger.ByteRanger(nil).Range(ctx, 0, 0)
+	}
+
+	// open the first range right away to return its error from Range
+	current, err := parts[0].open(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	reader := &readAheadReader{
+		ctx:     ctx,
+		cancel:  cancel,
+		limit:   r.parallelism,
+		parts:   parts[1:],
+		current: current,
+	}
+	reader.fill()
+
+	return reader, nil
+}
+
+// rangePart is the part of a segment's ranger within a range of the stream
+type rangePart struct {
+	ranger         ranger.Ranger
+	offset, length int64
+}
+
+func (part rangePart) open(ctx context.Context) (io.ReadCloser, error) {
+	return part.ranger.Range(ctx, part.offset, part.length)
+}
+
+// openResult is the result of opening a rangePart in the background
+type openResult struct {
+	reader io.ReadCloser
+	err    error
+}
+
+// readAheadReader reads the parts of a range one after another while up to
+// limit parts are opened at the same time
+type readAheadReader struct {
+	ctx    context.Context
+	cancel func()
+	limit  int
+
+	parts   []rangePart
+	pending []chan openResult
+	current io.ReadCloser
+}
+
+// fill starts opening the next parts until the limit is reached
+func (r *readAheadReader) fill() {
+	for len(r.parts) > 0 {
+		opened := len(r.pending)
+		if r.current != nil {
+			opened++
+		}
+		if opened >= r.limit {
+			return
+		}
+
+		part := r.parts[0]
+		r.parts = r.parts[1:]
+
+		result := make(chan openResult, 1)
+		go func() {
+			reader, err := part.open(r.ctx)
+			result <- openResult{reader: reader, err: err}
+		}()
+		r.pending = append(r.pending, result)
+	}
+}
+
+// Read implements io.Reader
+func (r *readAheadReader) Read(p []byte) (n int, err error) {
+	for {
+		if r.current == nil {
+			if len(r.pending) == 0 {
+				return 0, io.EOF
+			}
+
+			result := <-r.pending[0]
+			r.pending = r.pending[1:]
+			if result.err != nil {
+				return 0, result.err
+			}
+
+			r.current = result.reader
+			r.fill()
+		}
+
+		n, err = r.current.Read(p)
+		if err != io.EOF {
+			return n, err
+		}
+
+		err = r.current.Close()
+		r.current = nil
+		if err != nil {
+			return n, err
+		}
+		r.fill()
+
+		if n > 0 {
+			return n, nil
+		}
+	}
+}
+
+// Close implements io.Closer and closes the parts which were opened
+func (r *readAheadReader) Close() error {
+	r.cancel()
+
+	var group errs.Group
+	if r.current != nil {
+		group.Add(r.current.Close())
+		r.current = nil
+	}
+	for _, pending := range r.pending {
+		result := <-pending
+		if result.err == nil {
+			group.Add(result.reader.Close())
+		}
+	}
+	r.pending = nil
+	r.parts = nil
+
+	return group.Err()
+}
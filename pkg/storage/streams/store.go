@@ -18,6 +18,7 @@ import (
 	"go.uber.org/zap"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
 
+	"storj.io/storj/internal/sync2"
 	"storj.io/storj/pkg/eestream"
 	"storj.io/storj/pkg/encryption"
 	"storj.io/storj/pkg/pb"
@@ -49,7 +50,7 @@ func convertMeta(lastSegmentMeta segments.Meta) (Meta, error) {
 	return Meta{
 		Modified:   lastSegmentMeta.Modified,
 		Expiration: lastSegmentMeta.Expiration,
-		Size:       ((stream.NumberOfSegments - 1) * stream.SegmentsSize) + stream.LastSegmentSize,
+		Size:       StreamSize(&stream),
 		Data:       stream.Metadata,
 	}, nil
 }
@@ -61,8 +62,34 @@ type Store interface {
 	Put(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
 	Delete(ctx context.Context, path storj.Path, pathCipher storj.Cipher) error
 	List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	PutResumable(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	Continue(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	PutPending(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (Meta, error)
+	GetPending(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (ranger.Ranger, Meta, error)
+	DeletePending(ctx context.Context, path storj.Path, pathCipher storj.Cipher) error
+	ListPending(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
+	Copy(ctx context.Context, sourcePath storj.Path, sourcePathCipher storj.Cipher, path storj.Path, pathCipher storj.Cipher, metadata []byte) (Meta, error)
+	SaveVersion(ctx context.Context, path storj.Path, pathCipher storj.Cipher, version string) (Meta, error)
+	GetVersion(ctx context.Context, path storj.Path, pathCipher storj.Cipher, version string) (ranger.Ranger, Meta, error)
+	DeleteVersion(ctx context.Context, path storj.Path, pathCipher storj.Cipher, version string) error
+	Concat(ctx context.Context, sourcePaths []storj.Path, sourcePathCipher storj.Cipher, path storj.Path, pathCipher storj.Cipher, metadata []byte) (Meta, error)
+	ListVersions(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, limit int, metaFlags uint32) (items []ListItem, more bool, err error)
 }
 
+// uploadMode decides where the upload keeps the info about the stream
+type uploadMode int
+
+const (
+	// commitUpload stores the last segment and the stream info at l/<path>
+	commitUpload uploadMode = iota
+	// resumableUpload is like commitUpload, but refreshes the pending record
+	// at p/<path> after each committed segment
+	resumableUpload
+	// pendingUpload stores all segments at s<N>/<path> and the stream info
+	// in the pending record at p/<path>
+	pendingUpload
+)
+
 // streamStore is a store for streams
 type streamStore struct {
 	segments     segments.Store
@@ -70,10 +97,11 @@ type streamStore struct {
 	rootKey      *storj.Key
 	encBlockSize int
 	cipher       storj.Cipher
+	parallelism  int
 }
 
 // NewStreamStore stuff
-func NewStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.Key, encBlockSize int, cipher storj.Cipher) (Store, error) {
+func NewStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.Key, encBlockSize int, cipher storj.Cipher, parallelism int) (Store, error) {
 	if segmentSize <= 0 {
 		return nil, errs.New("segment size must be larger than 0")
 	}
@@ -83,6 +111,9 @@ func NewStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.K
 	if encBlockSize <= 0 {
 		return nil, errs.New("encryption block size must be larger than 0")
 	}
+	if parallelism <= 0 {
+		return nil, errs.New("parallelism must be larger than 0")
+	}
 
 	return &streamStore{
 		segments:     segments,
@@ -90,6 +121,7 @@ func NewStreamStore(segments segments.Store, segmentSize int64, rootKey *storj.K
 		rootKey:      rootKey,
 		encBlockSize: encBlockSize,
 		cipher:       cipher,
+		parallelism:  parallelism,
 	}, nil
 }
 
@@ -107,7 +139,7 @@ func (s *streamStore) Put(ctx context.Context, path storj.Path, pathCipher storj
 		return Meta{}, err
 	}
 
-	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration)
+	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration, 0, commitUpload)
 	if err != nil {
 		s.cancelHandler(context.Background(), lastSegment, path, pathCipher)
 	}
@@ -115,14 +147,87 @@ func (s *streamStore) Put(ctx context.Context, path storj.Path, pathCipher storj
 	return m, err
 }
 
-func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (m Meta, lastSegment int64, err error) {
+// PutResumable works like Put, but keeps a pending record at p/<path> with
+// the number of segments committed so far. If the upload is interrupted the
+// committed segments are kept, so the upload can be finished with Continue
+// or discarded with DeletePending.
+func (s *streamStore) PutResumable(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.Delete(ctx, path, pathCipher)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	// discard the segments of a previously interrupted upload
+	err = s.DeletePending(ctx, path, pathCipher)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	m, _, err = s.upload(ctx, path, pathCipher, data, metadata, expiration, 0, resumableUpload)
+	return m, err
+}
+
+// PutPending uploads a complete stream without committing it. All segments
+// are stored at s0/<path>, s1/<path>, ... and the stream info is kept in the
+// pending record at p/<path>, so the stream is not visible in listings of
+// committed streams. It can be read back with GetPending.
+func (s *streamStore) PutPending(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = s.DeletePending(ctx, path, pathCipher)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	m, lastSegment, err := s.upload(ctx, path, pathCipher, data, metadata, expiration, 0, pendingUpload)
+	if err != nil {
+		s.cancelHandler(context.Background(), lastSegment, path, pathCipher)
+	}
+
+	return m, err
+}
+
+// Continue resumes an interrupted upload from the segment following the last
+// committed one. The data must start at the beginning of that segment, i.e.
+// at the size of the pending stream.
+func (s *streamStore) Continue(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (m Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stream, err := s.pendingInfo(ctx, path, pathCipher)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	if stream.SegmentsSize != s.segmentSize {
+		return Meta{}, errs.New("segment size %d of pending upload does not match configured segment size %d", stream.SegmentsSize, s.segmentSize)
+	}
+	if stream.LastSegmentSize != stream.SegmentsSize {
+		return Meta{}, errs.New("pending upload ends with a partial segment and cannot be continued")
+	}
+
+	m, _, err = s.upload(ctx, path, pathCipher, data, metadata, expiration, stream.NumberOfSegments, resumableUpload)
+	return m, err
+}
+
+// upload stores the segments of data with up to s.parallelism segments in
+// flight. The next segment is read as soon as the previous one was read
+// completely, and the last segment, which commits the stream, is only put
+// after all other segments were stored.
+func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time, startSegment int64, mode uploadMode) (m Meta, lastSegment int64, err error) {
 	defer mon.Task()(&ctx)(&err)
 
-	var currentSegment int64
-	var streamSize int64
+	currentSegment := startSegment
+	streamSize := startSegment * s.segmentSize
+	var lastSegmentSize int64
 	var putMeta segments.Meta
 
 	defer func() {
+		if mode == resumableUpload {
+			// keep the committed segments for continuing the upload later
+			return
+		}
 		select {
 		case <-ctx.Done():
 			s.cancelHandler(context.Background(), currentSegment, path, pathCipher)
@@ -135,6 +240,21 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 		return Meta{}, currentSegment, err
 	}
 
+	// the pending record of resumable uploads must only count segments
+	// which are stored, so they are uploaded one after another
+	parallelism := s.parallelism
+	if mode == resumableUpload {
+		parallelism = 1
+	}
+
+	limiter := sync2.NewLimiter(parallelism)
+	defer limiter.Wait()
+
+	uploadCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var uploads []*segmentUpload
+
 	eofReader := NewEOFReader(data)
 
 	for !eofReader.isEOF() && !eofReader.hasError() {
@@ -171,8 +291,20 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 			return Meta{}, currentSegment, err
 		}
 
+		upload := &segmentUpload{
+			index:    currentSegment,
+			read:     make(chan struct{}),
+			done:     make(chan struct{}),
+			previous: append([]*segmentUpload(nil), uploads...),
+		}
+		uploads = append(uploads, upload)
+
 		sizeReader := NewSizeReader(eofReader)
-		segmentReader := io.LimitReader(sizeReader, s.segmentSize)
+		segmentReader := &segmentReader{
+			reader: io.LimitReader(sizeReader, s.segmentSize),
+			stream: eofReader,
+			upload: upload,
+		}
 		peekReader := segments.NewPeekThresholdReader(segmentReader)
 		largeData, err := peekReader.IsLargerThan(encrypter.InBlockSize())
 		if err != nil {
@@ -194,80 +326,124 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 			transformedReader = bytes.NewReader(cipherData)
 		}
 
-		putMeta, err = s.segments.Put(ctx, transformedReader, expiration, func() (storj.Path, []byte, error) {
-			encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
-			if err != nil {
-				return "", nil, err
-			}
+		started := limiter.Go(uploadCtx, func() {
+			defer close(upload.done)
 
-			if !eofReader.isEOF() {
-				segmentPath := getSegmentPath(encPath, currentSegment)
+			upload.meta, upload.err = s.segments.Put(uploadCtx, transformedReader, expiration, func() (storj.Path, []byte, error) {
+				encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+				if err != nil {
+					return "", nil, err
+				}
+
+				if !upload.last || mode == pendingUpload {
+					segmentPath := getSegmentPath(encPath, upload.index)
+
+					if s.cipher == storj.Unencrypted {
+						return segmentPath, nil, nil
+					}
+
+					segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
+						EncryptedKey: encryptedKey,
+						KeyNonce:     keyNonce[:],
+					})
+					if err != nil {
+						return "", nil, err
+					}
+
+					return segmentPath, segmentMeta, nil
+				}
 
-				if s.cipher == storj.Unencrypted {
-					return segmentPath, nil, nil
+				// commit the stream only when all its segments are stored
+				for _, previous := range upload.previous {
+					<-previous.done
+					if previous.err != nil {
+						return "", nil, previous.err
+					}
 				}
 
-				segmentMeta, err := proto.Marshal(&pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
-				})
+				lastSegmentPath := storj.JoinPaths("l", encPath)
+
+				lastSegmentMeta, err := s.marshalStreamMeta(&pb.StreamInfo{
+					NumberOfSegments: upload.index + 1,
+					SegmentsSize:     s.segmentSize,
+					LastSegmentSize:  sizeReader.Size(),
+					Metadata:         metadata,
+				}, &contentKey, encryptedKey, &keyNonce)
 				if err != nil {
 					return "", nil, err
 				}
 
-				return segmentPath, segmentMeta, nil
+				return lastSegmentPath, lastSegmentMeta, nil
+			})
+			if upload.err != nil {
+				cancel()
 			}
+		})
+		if !started {
+			// an upload failed or the upload was canceled
+			upload.err = uploadCtx.Err()
+			close(upload.done)
+			break
+		}
 
-			lastSegmentPath := storj.JoinPaths("l", encPath)
+		currentSegment++
 
-			streamInfo, err := proto.Marshal(&pb.StreamInfo{
-				NumberOfSegments: currentSegment + 1,
-				SegmentsSize:     s.segmentSize,
-				LastSegmentSize:  sizeReader.Size(),
-				Metadata:         metadata,
-			})
-			if err != nil {
-				return "", nil, err
+		// wait until the segment was read before reading the next one
+		select {
+		case <-upload.read:
+		case <-upload.done:
+			if upload.err != nil {
+				return Meta{}, currentSegment, upload.err
 			}
+		}
 
-			// encrypt metadata with the content encryption key and zero nonce
-			encryptedStreamInfo, err := encryption.Encrypt(streamInfo, s.cipher, &contentKey, &storj.Nonce{})
-			if err != nil {
-				return "", nil, err
-			}
+		lastSegmentSize = sizeReader.Size()
+		streamSize += lastSegmentSize
 
-			streamMeta := pb.StreamMeta{
-				EncryptedStreamInfo: encryptedStreamInfo,
-				EncryptionType:      int32(s.cipher),
-				EncryptionBlockSize: int32(s.encBlockSize),
+		if mode == resumableUpload {
+			<-upload.done
+			if upload.err != nil {
+				return Meta{}, currentSegment, upload.err
 			}
 
-			if s.cipher != storj.Unencrypted {
-				streamMeta.LastSegmentMeta = &pb.SegmentMeta{
-					EncryptedKey: encryptedKey,
-					KeyNonce:     keyNonce[:],
+			if !eofReader.isEOF() {
+				err = s.putPending(ctx, path, pathCipher, currentSegment, s.segmentSize, metadata, expiration)
+				if err != nil {
+					return Meta{}, currentSegment, err
 				}
 			}
-
-			lastSegmentMeta, err := proto.Marshal(&streamMeta)
-			if err != nil {
-				return "", nil, err
-			}
-
-			return lastSegmentPath, lastSegmentMeta, nil
-		})
-		if err != nil {
-			return Meta{}, currentSegment, err
 		}
+	}
 
-		currentSegment++
-		streamSize += sizeReader.Size()
+	for _, upload := range uploads {
+		<-upload.done
+		if upload.err != nil {
+			return Meta{}, currentSegment, upload.err
+		}
+	}
+	if len(uploads) > 0 {
+		putMeta = uploads[len(uploads)-1].meta
 	}
 
 	if eofReader.hasError() {
 		return Meta{}, currentSegment, eofReader.err
 	}
 
+	switch mode {
+	case resumableUpload:
+		if currentSegment > 1 {
+			err = s.deletePendingRecord(ctx, path, pathCipher)
+			if err != nil && !storage.ErrKeyNotFound.Has(err) {
+				return Meta{}, currentSegment, err
+			}
+		}
+	case pendingUpload:
+		err = s.putPending(ctx, path, pathCipher, currentSegment, lastSegmentSize, metadata, expiration)
+		if err != nil {
+			return Meta{}, currentSegment, err
+		}
+	}
+
 	resultMeta := Meta{
 		Modified:   putMeta.Modified,
 		Expiration: expiration,
@@ -278,23 +454,226 @@ func (s *streamStore) upload(ctx context.Context, path storj.Path, pathCipher st
 	return resultMeta, currentSegment, nil
 }
 
+// segmentUpload is the state of a segment uploaded by upload
+type segmentUpload struct {
+	index int64
+	// last is set before read is closed when the segment is the last one
+	last bool
+	read chan struct{}
+	done chan struct{}
+	meta segments.Meta
+	err  error
+
+	// previous are the uploads of the preceding segments of the stream
+	previous []*segmentUpload
+}
+
+// segmentReader reads the data of a segment from the stream and notifies
+// the upload when it was read completely
+type segmentReader struct {
+	reader io.Reader
+	stream *EOFReader
+	upload *segmentUpload
+	eof    bool
+}
+
+func (r *segmentReader) Read(p []byte) (n int, err error) {
+	if r.eof {
+		// don't touch the stream anymore, the next segment is read from it
+		return 0, io.EOF
+	}
+	n, err = r.reader.Read(p)
+	if err == io.EOF {
+		r.eof = true
+		r.upload.last = r.stream.isEOF()
+		close(r.upload.read)
+	}
+	return n, err
+}
+
+// marshalStreamMeta encrypts the stream info with the content key and zero
+// nonce and marshals it together with the encrypted content key
+func (s *streamStore) marshalStreamMeta(streamInfo *pb.StreamInfo, contentKey *storj.Key, encryptedKey storj.EncryptedPrivateKey, keyNonce *storj.Nonce) ([]byte, error) {
+	streamInfoData, err := proto.Marshal(streamInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	// encrypt metadata with the content encryption key and zero nonce
+	encryptedStreamInfo, err := encryption.Encrypt(streamInfoData, s.cipher, contentKey, &storj.Nonce{})
+	if err != nil {
+		return nil, err
+	}
+
+	streamMeta := pb.StreamMeta{
+		EncryptedStreamInfo: encryptedStreamInfo,
+		EncryptionType:      int32(s.cipher),
+		EncryptionBlockSize: int32(s.encBlockSize),
+	}
+
+	if s.cipher != storj.Unencrypted {
+		streamMeta.LastSegmentMeta = &pb.SegmentMeta{
+			EncryptedKey: encryptedKey,
+			KeyNonce:     keyNonce[:],
+		}
+	}
+
+	return proto.Marshal(&streamMeta)
+}
+
+// putPending stores the pending record of an uncommitted stream at p/<path>.
+// The record has no data and describes the segments stored at s<N>/<path>
+// the same way the last segment describes a committed stream.
+func (s *streamStore) putPending(ctx context.Context, path storj.Path, pathCipher storj.Cipher, numberOfSegments, lastSegmentSize int64, metadata []byte, expiration time.Time) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	if err != nil {
+		return err
+	}
+
+	var contentKey storj.Key
+	_, err = rand.Read(contentKey[:])
+	if err != nil {
+		return err
+	}
+
+	var keyNonce storj.Nonce
+	_, err = rand.Read(keyNonce[:])
+	if err != nil {
+		return err
+	}
+
+	encryptedKey, err := encryption.EncryptKey(&contentKey, s.cipher, derivedKey, &keyNonce)
+	if err != nil {
+		return err
+	}
+
+	pendingMeta, err := s.marshalStreamMeta(&pb.StreamInfo{
+		NumberOfSegments: numberOfSegments,
+		SegmentsSize:     s.segmentSize,
+		LastSegmentSize:  lastSegmentSize,
+		Metadata:         metadata,
+	}, &contentKey, encryptedKey, &keyNonce)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.segments.Put(ctx, bytes.NewReader(nil), expiration, func() (storj.Path, []byte, error) {
+		encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+		if err != nil {
+			return "", nil, err
+		}
+		return storj.JoinPaths("p", encPath), pendingMeta, nil
+	})
+	return err
+}
+
+// pendingInfo returns the stream info of the pending record at p/<path>
+func (s *streamStore) pendingInfo(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (stream pb.StreamInfo, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	if err != nil {
+		return pb.StreamInfo{}, err
+	}
+
+	pendingMeta, err := s.segments.Meta(ctx, storj.JoinPaths("p", encPath))
+	if err != nil {
+		return pb.StreamInfo{}, err
+	}
+
+	streamInfo, err := DecryptStreamInfo(ctx, pendingMeta, path, s.rootKey)
+	if err != nil {
+		return pb.StreamInfo{}, err
+	}
+
+	err = proto.Unmarshal(streamInfo, &stream)
+	return stream, err
+}
+
+// deletePendingRecord deletes only the pending record at p/<path>
+func (s *streamStore) deletePendingRecord(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	if err != nil {
+		return err
+	}
+
+	return s.segments.Delete(ctx, storj.JoinPaths("p", encPath))
+}
+
 // getSegmentPath returns the unique path for a particular segment
 func getSegmentPath(path storj.Path, segNum int64) storj.Path {
 	return storj.JoinPaths(fmt.Sprintf("s%d", segNum), path)
 }
 
+// getRootSegmentPath returns the path of a segment of the stream whose last
+// segment is stored at <root>/<path>. The segments of versions are stored at
+// v<N>/<path>, apart from the segments of committed and pending streams.
+func getRootSegmentPath(root string, path storj.Path, segNum int64) storj.Path {
+	if root == "v" {
+		return storj.JoinPaths(fmt.Sprintf("v%d", segNum), path)
+	}
+	return getSegmentPath(path, segNum)
+}
+
+// SegmentSize returns the size of the segment at index of the stream
+func SegmentSize(stream *pb.StreamInfo, index int64) int64 {
+	switch {
+	case index < int64(len(stream.SegmentSizes)):
+		return stream.SegmentSizes[index]
+	case index == stream.NumberOfSegments-1:
+		return stream.LastSegmentSize
+	default:
+		return stream.SegmentsSize
+	}
+}
+
+// StreamSize returns the size of all segments of the stream
+func StreamSize(stream *pb.StreamInfo) int64 {
+	if len(stream.SegmentSizes) == 0 {
+		return (stream.NumberOfSegments-1)*stream.SegmentsSize + stream.LastSegmentSize
+	}
+
+	var size int64
+	for _, segmentSize := range stream.SegmentSizes {
+		size += segmentSize
+	}
+	return size
+}
+
+// ContentNonce returns the nonce the content of the segment at index of the
+// stream was encrypted with
+func ContentNonce(stream *pb.StreamInfo, index int64) (nonce storj.Nonce, err error) {
+	increment := index + 1
+	if index < int64(len(stream.ContentNonces)) {
+		increment = stream.ContentNonces[index]
+	}
+	_, err = encryption.Increment(&nonce, increment)
+	return nonce, err
+}
+
 // Get returns a ranger that knows what the overall size is (from l/<path>)
 // and then returns the appropriate data from segments s0/<path>, s1/<path>,
 // ..., l/<path>.
 func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (rr ranger.Ranger, meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return s.get(ctx, "l", path, pathCipher)
+}
+
+// get returns a ranger over the stream whose last segment is stored at
+// <root>/<path>
+func (s *streamStore) get(ctx context.Context, root string, path storj.Path, pathCipher storj.Cipher) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
 	if err != nil {
 		return nil, Meta{}, err
 	}
 
-	lastSegmentRanger, lastSegmentMeta, err := s.segments.Get(ctx, storj.JoinPaths("l", encPath))
+	lastSegmentRanger, lastSegmentMeta, err := s.segments.Get(ctx, storj.JoinPaths(root, encPath))
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -323,17 +702,15 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 
 	var rangers []ranger.Ranger
 	for i := int64(0); i < stream.NumberOfSegments-1; i++ {
-		currentPath := getSegmentPath(encPath, i)
-		size := stream.SegmentsSize
-		var contentNonce storj.Nonce
-		_, err := encryption.Increment(&contentNonce, i+1)
+		currentPath := getRootSegmentPath(root, encPath, i)
+		contentNonce, err := ContentNonce(&stream, i)
 		if err != nil {
 			return nil, Meta{}, err
 		}
 		rr := &lazySegmentRanger{
 			segments:      s.segments,
 			path:          currentPath,
-			size:          size,
+			size:          SegmentSize(&stream, i),
 			derivedKey:    derivedKey,
 			startingNonce: &contentNonce,
 			encBlockSize:  int(streamMeta.EncryptionBlockSize),
@@ -342,8 +719,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 		rangers = append(rangers, rr)
 	}
 
-	var contentNonce storj.Nonce
-	_, err = encryption.Increment(&contentNonce, stream.NumberOfSegments)
+	contentNonce, err := ContentNonce(&stream, stream.NumberOfSegments-1)
 	if err != nil {
 		return nil, Meta{}, err
 	}
@@ -364,7 +740,7 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 	}
 	rangers = append(rangers, decryptedLastSegmentRanger)
 
-	catRangers := ranger.Concat(rangers...)
+	catRangers := readAhead(s.parallelism, rangers...)
 
 	lastSegmentMeta.Data = streamInfo
 	meta, err = convertMeta(lastSegmentMeta)
@@ -375,6 +751,69 @@ func (s *streamStore) Get(ctx context.Context, path storj.Path, pathCipher storj
 	return catRangers, meta, nil
 }
 
+// GetPending returns a ranger over the segments of an uncommitted stream
+// stored with PutPending
+func (s *streamStore) GetPending(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	pendingMeta, err := s.segments.Meta(ctx, storj.JoinPaths("p", encPath))
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	streamInfo, err := DecryptStreamInfo(ctx, pendingMeta, path, s.rootKey)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	stream := pb.StreamInfo{}
+	err = proto.Unmarshal(streamInfo, &stream)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	streamMeta := pb.StreamMeta{}
+	err = proto.Unmarshal(pendingMeta.Data, &streamMeta)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	var rangers []ranger.Ranger
+	for i := int64(0); i < stream.NumberOfSegments; i++ {
+		contentNonce, err := ContentNonce(&stream, i)
+		if err != nil {
+			return nil, Meta{}, err
+		}
+		rangers = append(rangers, &lazySegmentRanger{
+			segments:      s.segments,
+			path:          getSegmentPath(encPath, i),
+			size:          SegmentSize(&stream, i),
+			derivedKey:    derivedKey,
+			startingNonce: &contentNonce,
+			encBlockSize:  int(streamMeta.EncryptionBlockSize),
+			cipher:        storj.Cipher(streamMeta.EncryptionType),
+		})
+	}
+
+	pendingMeta.Data = streamInfo
+	meta, err = convertMeta(pendingMeta)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	return readAhead(s.parallelism, rangers...), meta, nil
+}
+
 // Meta implements Store.Meta
 func (s *streamStore) Meta(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (meta Meta, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -406,12 +845,18 @@ func (s *streamStore) Meta(ctx context.Context, path storj.Path, pathCipher stor
 // Delete all the segments, with the last one last
 func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (err error) {
 	defer mon.Task()(&ctx)(&err)
+	return s.delete(ctx, "l", path, pathCipher)
+}
+
+// delete deletes the stream whose last segment is stored at <root>/<path>
+func (s *streamStore) delete(ctx context.Context, root string, path storj.Path, pathCipher storj.Cipher) (err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
 	if err != nil {
 		return err
 	}
-	lastSegmentMeta, err := s.segments.Meta(ctx, storj.JoinPaths("l", encPath))
+	lastSegmentMeta, err := s.segments.Meta(ctx, storj.JoinPaths(root, encPath))
 	if err != nil {
 		return err
 	}
@@ -432,14 +877,421 @@ func (s *streamStore) Delete(ctx context.Context, path storj.Path, pathCipher st
 		if err != nil {
 			return err
 		}
-		currentPath := getSegmentPath(encPath, int64(i))
+		currentPath := getRootSegmentPath(root, encPath, int64(i))
 		err := s.segments.Delete(ctx, currentPath)
 		if err != nil {
 			return err
 		}
 	}
 
-	return s.segments.Delete(ctx, storj.JoinPaths("l", encPath))
+	return s.segments.Delete(ctx, storj.JoinPaths(root, encPath))
+}
+
+// DeletePending deletes the segments of an uncommitted stream, either an
+// interrupted upload or one stored with PutPending, and its pending record
+func (s *streamStore) DeletePending(ctx context.Context, path storj.Path, pathCipher storj.Cipher) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	stream, err := s.pendingInfo(ctx, path, pathCipher)
+	if err != nil {
+		return err
+	}
+
+	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	if err != nil {
+		return err
+	}
+
+	for i := int64(0); i < stream.NumberOfSegments; i++ {
+		err = s.segments.Delete(ctx, getSegmentPath(encPath, i))
+		if err != nil && !storage.ErrKeyNotFound.Has(err) {
+			return err
+		}
+	}
+
+	return s.segments.Delete(ctx, storj.JoinPaths("p", encPath))
+}
+
+// Copy duplicates the stream at sourcePath to path without transferring its
+// data. The segments keep their content keys, which are only re-encrypted
+// with the key derived from the destination path. The copy keeps the
+// metadata of the source stream when metadata is nil.
+func (s *streamStore) Copy(ctx context.Context, sourcePath storj.Path, sourcePathCipher storj.Cipher, path storj.Path, pathCipher storj.Cipher, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.copy(ctx, "l", sourcePath, sourcePathCipher, "l", path, pathCipher, metadata)
+}
+
+// copy duplicates the stream with the last segment at <sourceRoot>/<sourcePath>
+// to the stream with the last segment at <root>/<path>
+func (s *streamStore) copy(ctx context.Context, sourceRoot string, sourcePath storj.Path, sourcePathCipher storj.Cipher, root string, path storj.Path, pathCipher storj.Cipher, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if sourceRoot == root && sourcePath == path {
+		return Meta{}, errs.New("cannot copy %q onto itself", path)
+	}
+
+	sourceEncPath, err := EncryptAfterBucket(sourcePath, sourcePathCipher, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	lastSegmentMeta, err := s.segments.Meta(ctx, storj.JoinPaths(sourceRoot, sourceEncPath))
+	if err != nil {
+		return Meta{}, err
+	}
+
+	streamInfo, err := DecryptStreamInfo(ctx, lastSegmentMeta, sourcePath, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	stream := pb.StreamInfo{}
+	err = proto.Unmarshal(streamInfo, &stream)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	streamMeta := pb.StreamMeta{}
+	err = proto.Unmarshal(lastSegmentMeta.Data, &streamMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	cipher := storj.Cipher(streamMeta.EncryptionType)
+
+	sourceDerivedKey, err := encryption.DeriveContentKey(sourcePath, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	if metadata != nil {
+		// the stream info is encrypted with the content key of the last
+		// segment, which stays the same
+		encryptedKey, keyNonce := getEncryptedKeyAndNonce(streamMeta.LastSegmentMeta)
+		contentKey, err := encryption.DecryptKey(encryptedKey, cipher, sourceDerivedKey, keyNonce)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		stream.Metadata = metadata
+		streamInfo, err = proto.Marshal(&stream)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		streamMeta.EncryptedStreamInfo, err = encryption.Encrypt(streamInfo, cipher, contentKey, &storj.Nonce{})
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	// replace an existing object at the destination like Put would
+	err = s.delete(ctx, root, path, pathCipher)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	for i := int64(0); i < stream.NumberOfSegments-1; i++ {
+		sourceSegmentPath := getRootSegmentPath(sourceRoot, sourceEncPath, i)
+
+		var segmentMeta []byte
+		if cipher != storj.Unencrypted {
+			sourceMeta, err := s.segments.Meta(ctx, sourceSegmentPath)
+			if err != nil {
+				return Meta{}, err
+			}
+
+			segMeta := pb.SegmentMeta{}
+			err = proto.Unmarshal(sourceMeta.Data, &segMeta)
+			if err != nil {
+				return Meta{}, err
+			}
+
+			err = reencryptSegmentKey(&segMeta, cipher, sourceDerivedKey, derivedKey)
+			if err != nil {
+				return Meta{}, err
+			}
+
+			segmentMeta, err = proto.Marshal(&segMeta)
+			if err != nil {
+				return Meta{}, err
+			}
+		}
+
+		_, err = s.segments.Copy(ctx, sourceSegmentPath, getRootSegmentPath(root, encPath, i), segmentMeta)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	if streamMeta.LastSegmentMeta != nil {
+		err = reencryptSegmentKey(streamMeta.LastSegmentMeta, cipher, sourceDerivedKey, derivedKey)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	lastSegmentData, err := proto.Marshal(&streamMeta)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	lastSegmentMeta, err = s.segments.Copy(ctx, storj.JoinPaths(sourceRoot, sourceEncPath), storj.JoinPaths(root, encPath), lastSegmentData)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	lastSegmentMeta.Data = streamInfo
+	return convertMeta(lastSegmentMeta)
+}
+
+// concatSegment is a segment of a pending stream concatenated by Concat
+type concatSegment struct {
+	path        storj.Path
+	segmentMeta *pb.SegmentMeta
+	size        int64
+	nonce       int64
+}
+
+// Concat commits the pending streams stored with PutPending at sourcePaths
+// as one stream at path without transferring their data. The segments of
+// the pending streams are shared with the new stream and keep their content
+// keys, so the pending streams can be deleted with DeletePending afterwards.
+func (s *streamStore) Concat(ctx context.Context, sourcePaths []storj.Path, sourcePathCipher storj.Cipher, path storj.Path, pathCipher storj.Cipher, metadata []byte) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	derivedKey, err := encryption.DeriveContentKey(path, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	var stitched []concatSegment
+	for _, sourcePath := range sourcePaths {
+		sourceEncPath, err := EncryptAfterBucket(sourcePath, sourcePathCipher, s.rootKey)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		pendingMeta, err := s.segments.Meta(ctx, storj.JoinPaths("p", sourceEncPath))
+		if err != nil {
+			return Meta{}, err
+		}
+
+		streamInfo, err := DecryptStreamInfo(ctx, pendingMeta, sourcePath, s.rootKey)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		stream := pb.StreamInfo{}
+		err = proto.Unmarshal(streamInfo, &stream)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		streamMeta := pb.StreamMeta{}
+		err = proto.Unmarshal(pendingMeta.Data, &streamMeta)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		// the stream info of the new stream is encrypted like the one of
+		// streams uploaded by this store
+		if storj.Cipher(streamMeta.EncryptionType) != s.cipher || int(streamMeta.EncryptionBlockSize) != s.encBlockSize {
+			return Meta{}, errs.New("pending stream %q is not encrypted with the configured encryption scheme", sourcePath)
+		}
+
+		sourceDerivedKey, err := encryption.DeriveContentKey(sourcePath, s.rootKey)
+		if err != nil {
+			return Meta{}, err
+		}
+
+		for i := int64(0); i < stream.NumberOfSegments; i++ {
+			nonce := i + 1
+			if i < int64(len(stream.ContentNonces)) {
+				nonce = stream.ContentNonces[i]
+			}
+
+			segment := concatSegment{
+				path:  getSegmentPath(sourceEncPath, i),
+				size:  SegmentSize(&stream, i),
+				nonce: nonce,
+			}
+
+			if s.cipher != storj.Unencrypted {
+				sourceMeta, err := s.segments.Meta(ctx, segment.path)
+				if err != nil {
+					return Meta{}, err
+				}
+
+				segment.segmentMeta = &pb.SegmentMeta{}
+				err = proto.Unmarshal(sourceMeta.Data, segment.segmentMeta)
+				if err != nil {
+					return Meta{}, err
+				}
+
+				err = reencryptSegmentKey(segment.segmentMeta, s.cipher, sourceDerivedKey, derivedKey)
+				if err != nil {
+					return Meta{}, err
+				}
+			}
+
+			stitched = append(stitched, segment)
+		}
+	}
+
+	if len(stitched) == 0 {
+		return Meta{}, errs.New("no segments to concatenate")
+	}
+
+	encPath, err := EncryptAfterBucket(path, pathCipher, s.rootKey)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	// replace an existing object at the destination like Put would
+	err = s.Delete(ctx, path, pathCipher)
+	if err != nil && !storage.ErrKeyNotFound.Has(err) {
+		return Meta{}, err
+	}
+
+	stream := pb.StreamInfo{
+		NumberOfSegments: int64(len(stitched)),
+		SegmentsSize:     s.segmentSize,
+		Metadata:         metadata,
+	}
+
+	for i, segment := range stitched {
+		stream.SegmentSizes = append(stream.SegmentSizes, segment.size)
+		stream.ContentNonces = append(stream.ContentNonces, segment.nonce)
+
+		if i == len(stitched)-1 {
+			break
+		}
+
+		var segmentMeta []byte
+		if segment.segmentMeta != nil {
+			segmentMeta, err = proto.Marshal(segment.segmentMeta)
+			if err != nil {
+				return Meta{}, err
+			}
+		}
+
+		_, err = s.segments.Copy(ctx, segment.path, getSegmentPath(encPath, int64(i)), segmentMeta)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	last := stitched[len(stitched)-1]
+	stream.LastSegmentSize = last.size
+
+	// the stream info is encrypted with the content key of the last segment
+	contentKey, encryptedKey, keyNonce := &storj.Key{}, storj.EncryptedPrivateKey(nil), &storj.Nonce{}
+	if last.segmentMeta != nil {
+		encryptedKey, keyNonce = getEncryptedKeyAndNonce(last.segmentMeta)
+		contentKey, err = encryption.DecryptKey(encryptedKey, s.cipher, derivedKey, keyNonce)
+		if err != nil {
+			return Meta{}, err
+		}
+	}
+
+	lastSegmentData, err := s.marshalStreamMeta(&stream, contentKey, encryptedKey, keyNonce)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	lastSegmentMeta, err := s.segments.Copy(ctx, last.path, storj.JoinPaths("l", encPath), lastSegmentData)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	lastSegmentMeta.Data, err = proto.Marshal(&stream)
+	if err != nil {
+		return Meta{}, err
+	}
+	return convertMeta(lastSegmentMeta)
+}
+
+// SaveVersion copies the committed stream at l/<path> to v/<path>/<version>
+// without transferring its data, the copied pointers share the pieces of the
+// stream. The copy is kept when the stream gets overwritten or deleted.
+func (s *streamStore) SaveVersion(ctx context.Context, path storj.Path, pathCipher storj.Cipher, version string) (meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versionPath, err := getVersionPath(path, version)
+	if err != nil {
+		return Meta{}, err
+	}
+
+	return s.copy(ctx, "l", path, pathCipher, "v", versionPath, pathCipher, nil)
+}
+
+// GetVersion returns a ranger over a version of the stream saved with
+// SaveVersion
+func (s *streamStore) GetVersion(ctx context.Context, path storj.Path, pathCipher storj.Cipher, version string) (rr ranger.Ranger, meta Meta, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versionPath, err := getVersionPath(path, version)
+	if err != nil {
+		return nil, Meta{}, err
+	}
+
+	return s.get(ctx, "v", versionPath, pathCipher)
+}
+
+// DeleteVersion deletes a version of the stream saved with SaveVersion
+func (s *streamStore) DeleteVersion(ctx context.Context, path storj.Path, pathCipher storj.Cipher, version string) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	versionPath, err := getVersionPath(path, version)
+	if err != nil {
+		return err
+	}
+
+	return s.delete(ctx, "v", versionPath, pathCipher)
+}
+
+// getVersionPath returns the path of the stream keeping a version
+func getVersionPath(path storj.Path, version string) (storj.Path, error) {
+	if version == "" || strings.Contains(version, "/") {
+		return "", errs.New("invalid version %q", version)
+	}
+	return storj.JoinPaths(path, version), nil
+}
+
+// reencryptSegmentKey decrypts the content key of a segment with sourceKey
+// and encrypts it with key using a new random nonce
+func reencryptSegmentKey(segmentMeta *pb.SegmentMeta, cipher storj.Cipher, sourceKey, key *storj.Key) error {
+	encryptedKey, keyNonce := getEncryptedKeyAndNonce(segmentMeta)
+	contentKey, err := encryption.DecryptKey(encryptedKey, cipher, sourceKey, keyNonce)
+	if err != nil {
+		return err
+	}
+
+	var newKeyNonce storj.Nonce
+	_, err = rand.Read(newKeyNonce[:])
+	if err != nil {
+		return err
+	}
+
+	newEncryptedKey, err := encryption.EncryptKey(contentKey, cipher, key, &newKeyNonce)
+	if err != nil {
+		return err
+	}
+
+	segmentMeta.EncryptedKey = newEncryptedKey
+	segmentMeta.KeyNonce = newKeyNonce[:]
+	return nil
 }
 
 // ListItem is a single item in a listing
@@ -452,6 +1304,25 @@ type ListItem struct {
 // List all the paths inside l/, stripping off the l/ prefix
 func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
 	defer mon.Task()(&ctx)(&err)
+	return s.list(ctx, "l", prefix, startAfter, endBefore, pathCipher, recursive, limit, metaFlags)
+}
+
+// ListPending lists the paths of uncommitted streams inside p/, stripping off
+// the p/ prefix
+func (s *streamStore) ListPending(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.list(ctx, "p", prefix, startAfter, endBefore, pathCipher, recursive, limit, metaFlags)
+}
+
+// ListVersions recursively lists the versions of the streams inside v/,
+// stripping off the v/ prefix. The listed paths end with the version.
+func (s *streamStore) ListVersions(ctx context.Context, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
+	return s.list(ctx, "v", prefix, startAfter, endBefore, pathCipher, true, limit, metaFlags)
+}
+
+func (s *streamStore) list(ctx context.Context, root string, prefix, startAfter, endBefore storj.Path, pathCipher storj.Cipher, recursive bool, limit int, metaFlags uint32) (items []ListItem, more bool, err error) {
+	defer mon.Task()(&ctx)(&err)
 
 	if metaFlags&meta.Size != 0 {
 		// Calculating the stream's size require also the user-defined metadata,
@@ -481,7 +1352,7 @@ func (s *streamStore) List(ctx context.Context, prefix, startAfter, endBefore st
 		return nil, false, err
 	}
 
-	segments, more, err := s.segments.List(ctx, storj.JoinPaths("l", encPrefix), encStartAfter, encEndBefore, recursive, limit, metaFlags)
+	segments, more, err := s.segments.List(ctx, storj.JoinPaths(root, encPrefix), encStartAfter, encEndBefore, recursive, limit, metaFlags)
 	if err != nil {
 		return nil, false, err
 	}
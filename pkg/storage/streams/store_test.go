@@ -4,10 +4,14 @@
 package streams
 
 import (
+	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"io/ioutil"
 	"strings"
+	"sync"
 	"testing"
 	"time"
 
@@ -17,8 +21,10 @@ import (
 
 	"storj.io/storj/pkg/pb"
 	"storj.io/storj/pkg/ranger"
+	"storj.io/storj/pkg/storage/meta"
 	"storj.io/storj/pkg/storage/segments"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/storage"
 )
 
 var (
@@ -91,7 +97,7 @@ func TestStreamStoreMeta(t *testing.T) {
 			Meta(gomock.Any(), gomock.Any()).
 			Return(test.segmentMeta, test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, storj.AESGCM)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, storj.AESGCM, 1)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -163,7 +169,7 @@ func TestStreamStorePut(t *testing.T) {
 			Delete(gomock.Any(), gomock.Any()).
 			Return(test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, 0, 1)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -263,7 +269,7 @@ func TestStreamStoreGet(t *testing.T) {
 
 		gomock.InOrder(calls...)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, 0, 1)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -312,7 +318,7 @@ func TestStreamStoreDelete(t *testing.T) {
 			Delete(gomock.Any(), gomock.Any()).
 			Return(test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, 0, 1)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -356,7 +362,7 @@ func TestStreamStoreList(t *testing.T) {
 			List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 			Return(test.segments, test.segmentMore, test.segmentError)
 
-		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, 0)
+		streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, 0, 1)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -370,3 +376,472 @@ func TestStreamStoreList(t *testing.T) {
 		assert.Equal(t, test.streamMore, more, errTag)
 	}
 }
+
+func TestStreamStorePutResumable(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, storedData := map[storj.Path]segments.Meta{}, map[storj.Path][]byte{}
+	mockSegmentStore := newMemorySegmentStore(ctrl, stored, storedData)
+
+	streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, storj.Unencrypted, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := "bucket/object"
+	data := []byte("0123456789abcdefghijklmnopqrstuvwxyz")
+
+	// interrupt the upload in the middle of the third segment
+	pipeReader, pipeWriter := io.Pipe()
+	go func() {
+		_, _ = pipeWriter.Write(data[:25])
+		_ = pipeWriter.CloseWithError(errors.New("interrupted"))
+	}()
+	_, err = streamStore.PutResumable(ctx, path, storj.Unencrypted, pipeReader, []byte("metadata"), time.Time{})
+	assert.Error(t, err)
+
+	items, _, err := streamStore.ListPending(ctx, "bucket", "", "", storj.Unencrypted, true, 0, meta.All)
+	if assert.NoError(t, err) && assert.Len(t, items, 1) {
+		assert.Equal(t, "object", items[0].Path)
+		assert.EqualValues(t, 20, items[0].Meta.Size)
+		assert.Equal(t, []byte("metadata"), items[0].Meta.Data)
+	}
+
+	_, err = streamStore.Meta(ctx, path, storj.Unencrypted)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	m, err := streamStore.Continue(ctx, path, storj.Unencrypted, bytes.NewReader(data[20:]), []byte("metadata"), time.Time{})
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, len(data), m.Size)
+	}
+
+	items, _, err = streamStore.ListPending(ctx, "bucket", "", "", storj.Unencrypted, true, 0, meta.All)
+	if assert.NoError(t, err) {
+		assert.Len(t, items, 0)
+	}
+
+	m, err = streamStore.Meta(ctx, path, storj.Unencrypted)
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, len(data), m.Size)
+	}
+
+	rr, _, err := streamStore.Get(ctx, path, storj.Unencrypted)
+	if !assert.NoError(t, err) {
+		return
+	}
+	reader, err := rr.Range(ctx, 0, rr.Size())
+	if !assert.NoError(t, err) {
+		return
+	}
+	defer func() { assert.NoError(t, reader.Close()) }()
+
+	downloaded, err := ioutil.ReadAll(reader)
+	if assert.NoError(t, err) {
+		assert.Equal(t, data, downloaded)
+	}
+}
+
+func TestStreamStorePutPending(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, storedData := map[storj.Path]segments.Meta{}, map[storj.Path][]byte{}
+	mockSegmentStore := newMemorySegmentStore(ctrl, stored, storedData)
+
+	streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, storj.Unencrypted, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := "bucket/part"
+	data := []byte("0123456789abcdefghijklmno")
+
+	m, err := streamStore.PutPending(ctx, path, storj.Unencrypted, bytes.NewReader(data), []byte("metadata"), time.Time{})
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, len(data), m.Size)
+	}
+
+	_, err = streamStore.Meta(ctx, path, storj.Unencrypted)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+
+	items, _, err := streamStore.ListPending(ctx, "bucket", "", "", storj.Unencrypted, true, 0, meta.All)
+	if assert.NoError(t, err) && assert.Len(t, items, 1) {
+		assert.Equal(t, "part", items[0].Path)
+		assert.EqualValues(t, len(data), items[0].Meta.Size)
+	}
+
+	rr, m, err := streamStore.GetPending(ctx, path, storj.Unencrypted)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.EqualValues(t, len(data), m.Size)
+	assert.Equal(t, []byte("metadata"), m.Data)
+
+	reader, err := rr.Range(ctx, 0, rr.Size())
+	if !assert.NoError(t, err) {
+		return
+	}
+	downloaded, err := ioutil.ReadAll(reader)
+	assert.NoError(t, reader.Close())
+	if assert.NoError(t, err) {
+		assert.Equal(t, data, downloaded)
+	}
+
+	// a pending stream ending with a partial segment cannot be continued
+	_, err = streamStore.Continue(ctx, path, storj.Unencrypted, bytes.NewReader(data), []byte("metadata"), time.Time{})
+	assert.Error(t, err)
+
+	err = streamStore.DeletePending(ctx, path, storj.Unencrypted)
+	assert.NoError(t, err)
+	assert.Empty(t, stored)
+}
+
+func TestStreamStoreCopy(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, storedData := map[storj.Path]segments.Meta{}, map[storj.Path][]byte{}
+	mockSegmentStore := newMemorySegmentStore(ctrl, stored, storedData)
+
+	rootKey := storj.Key{1, 2, 3}
+	streamStore, err := NewStreamStore(mockSegmentStore, 10, &rootKey, 32, storj.AESGCM, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("0123456789abcdefghijklmno")
+
+	_, err = streamStore.Put(ctx, "bucket/source", storj.AESGCM, bytes.NewReader(data), []byte("metadata"), time.Time{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	segmentCount := len(stored)
+
+	m, err := streamStore.Copy(ctx, "bucket/source", storj.AESGCM, "other/copy", storj.AESGCM, nil)
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, len(data), m.Size)
+		assert.Equal(t, []byte("metadata"), m.Data)
+	}
+	assert.Len(t, stored, 2*segmentCount)
+
+	_, err = streamStore.Copy(ctx, "bucket/source", storj.AESGCM, "bucket/source", storj.AESGCM, nil)
+	assert.Error(t, err)
+
+	m, err = streamStore.Copy(ctx, "bucket/source", storj.AESGCM, "other/renamed", storj.AESGCM, []byte("renamed"))
+	if assert.NoError(t, err) {
+		assert.Equal(t, []byte("renamed"), m.Data)
+	}
+	m, err = streamStore.Meta(ctx, "other/renamed", storj.AESGCM)
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, len(data), m.Size)
+		assert.Equal(t, []byte("renamed"), m.Data)
+	}
+
+	err = streamStore.Delete(ctx, "bucket/source", storj.AESGCM)
+	assert.NoError(t, err)
+
+	rr, m, err := streamStore.Get(ctx, "other/copy", storj.AESGCM)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []byte("metadata"), m.Data)
+
+	reader, err := rr.Range(ctx, 0, rr.Size())
+	if !assert.NoError(t, err) {
+		return
+	}
+	downloaded, err := ioutil.ReadAll(reader)
+	assert.NoError(t, reader.Close())
+	if assert.NoError(t, err) {
+		assert.Equal(t, data, downloaded)
+	}
+}
+
+func TestStreamStoreConcat(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, storedData := map[storj.Path]segments.Meta{}, map[storj.Path][]byte{}
+	mockSegmentStore := newMemorySegmentStore(ctrl, stored, storedData)
+
+	rootKey := storj.Key{1, 2, 3}
+	streamStore, err := NewStreamStore(mockSegmentStore, 10, &rootKey, 32, storj.AESGCM, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	parts := map[storj.Path][]byte{
+		"bucket/parts/1": []byte("0123456789abcdefghijklmno"),
+		"bucket/parts/2": []byte("pqrstuv"),
+		"bucket/parts/3": []byte("wxyzABCDEFGHIJ"),
+	}
+	for path, data := range parts {
+		_, err = streamStore.PutPending(ctx, path, storj.AESGCM, bytes.NewReader(data), nil, time.Time{})
+		if !assert.NoError(t, err) {
+			return
+		}
+	}
+	data := []byte("0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJ")
+
+	m, err := streamStore.Concat(ctx, []storj.Path{"bucket/parts/1", "bucket/parts/2", "bucket/parts/3"}, storj.AESGCM, "bucket/object", storj.AESGCM, []byte("metadata"))
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, len(data), m.Size)
+		assert.Equal(t, []byte("metadata"), m.Data)
+	}
+
+	// the pending streams are not needed by the concatenated stream
+	for path := range parts {
+		assert.NoError(t, streamStore.DeletePending(ctx, path, storj.AESGCM))
+	}
+
+	rr, m, err := streamStore.Get(ctx, "bucket/object", storj.AESGCM)
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.EqualValues(t, len(data), m.Size)
+	assert.EqualValues(t, len(data), rr.Size())
+
+	for _, test := range []struct {
+		offset, length int64
+	}{
+		{0, int64(len(data))},
+		{20, 10},
+		{24, 2},
+		{30, 16},
+	} {
+		reader, err := rr.Range(ctx, test.offset, test.length)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		downloaded, err := ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.NoError(t, reader.Close())
+		assert.Equal(t, data[test.offset:test.offset+test.length], downloaded)
+	}
+
+	// copies of concatenated streams keep their segment layout
+	_, err = streamStore.Copy(ctx, "bucket/object", storj.AESGCM, "bucket/copy", storj.AESGCM, nil)
+	if !assert.NoError(t, err) {
+		return
+	}
+	rr, _, err = streamStore.Get(ctx, "bucket/copy", storj.AESGCM)
+	if !assert.NoError(t, err) {
+		return
+	}
+	reader, err := rr.Range(ctx, 0, rr.Size())
+	if !assert.NoError(t, err) {
+		return
+	}
+	downloaded, err := ioutil.ReadAll(reader)
+	assert.NoError(t, reader.Close())
+	if assert.NoError(t, err) {
+		assert.Equal(t, data, downloaded)
+	}
+
+	_, err = streamStore.Concat(ctx, []storj.Path{"bucket/parts/1"}, storj.AESGCM, "bucket/object", storj.AESGCM, nil)
+	assert.True(t, storage.ErrKeyNotFound.Has(err))
+}
+
+func TestStreamStoreVersions(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, storedData := map[storj.Path]segments.Meta{}, map[storj.Path][]byte{}
+	mockSegmentStore := newMemorySegmentStore(ctrl, stored, storedData)
+
+	rootKey := storj.Key{1, 2, 3}
+	streamStore, err := NewStreamStore(mockSegmentStore, 10, &rootKey, 32, storj.AESGCM, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	data := []byte("0123456789abcdefghijklmno")
+	_, err = streamStore.Put(ctx, "bucket/object", storj.AESGCM, bytes.NewReader(data), []byte("metadata"), time.Time{})
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	_, err = streamStore.SaveVersion(ctx, "bucket/object", storj.AESGCM, "version")
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	// an object at the path of the version doesn't share its segments
+	other := []byte("ABCDEFGHIJKLMNOPQRSTUVWXY")
+	_, err = streamStore.Put(ctx, "bucket/object/version", storj.AESGCM, bytes.NewReader(other), nil, time.Time{})
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.NoError(t, streamStore.Delete(ctx, "bucket/object/version", storj.AESGCM))
+	assert.NoError(t, streamStore.Delete(ctx, "bucket/object", storj.AESGCM))
+
+	rr, m, err := streamStore.GetVersion(ctx, "bucket/object", storj.AESGCM, "version")
+	if !assert.NoError(t, err) {
+		return
+	}
+	assert.Equal(t, []byte("metadata"), m.Data)
+
+	reader, err := rr.Range(ctx, 0, rr.Size())
+	if !assert.NoError(t, err) {
+		return
+	}
+	downloaded, err := ioutil.ReadAll(reader)
+	assert.NoError(t, reader.Close())
+	if assert.NoError(t, err) {
+		assert.Equal(t, data, downloaded)
+	}
+
+	for path := range stored {
+		assert.True(t, strings.HasPrefix(path, "v"), path)
+	}
+
+	assert.NoError(t, streamStore.DeleteVersion(ctx, "bucket/object", storj.AESGCM, "version"))
+	assert.Len(t, stored, 0)
+}
+
+// newMemorySegmentStore returns a mock segment store keeping the segments
+// in the given maps
+func TestStreamStoreParallelSegments(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	stored, storedData := map[storj.Path]segments.Meta{}, map[storj.Path][]byte{}
+	mockSegmentStore := newMemorySegmentStore(ctrl, stored, storedData)
+
+	streamStore, err := NewStreamStore(mockSegmentStore, 10, new(storj.Key), 10, storj.Unencrypted, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := "bucket/object"
+	data := []byte("0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHI")
+
+	m, err := streamStore.Put(ctx, path, storj.Unencrypted, bytes.NewReader(data), []byte("metadata"), time.Time{})
+	if assert.NoError(t, err) {
+		assert.EqualValues(t, len(data), m.Size)
+	}
+
+	for _, segmentPath := range []storj.Path{"s0/bucket/object", "s1/bucket/object", "s2/bucket/object", "s3/bucket/object", "l/bucket/object"} {
+		assert.Contains(t, stored, segmentPath)
+	}
+
+	rr, _, err := streamStore.Get(ctx, path, storj.Unencrypted)
+	if !assert.NoError(t, err) {
+		return
+	}
+
+	for _, test := range []struct {
+		offset, length int64
+	}{
+		{0, int64(len(data))},
+		{5, 30},
+		{20, 10},
+		{38, 7},
+		{12, 0},
+	} {
+		reader, err := rr.Range(ctx, test.offset, test.length)
+		if !assert.NoError(t, err) {
+			continue
+		}
+		downloaded, err := ioutil.ReadAll(reader)
+		assert.NoError(t, err)
+		assert.NoError(t, reader.Close())
+		assert.Equal(t, data[test.offset:test.offset+test.length], downloaded)
+	}
+}
+
+func newMemorySegmentStore(ctrl *gomock.Controller, stored map[storj.Path]segments.Meta, storedData map[storj.Path][]byte) *segments.MockStore {
+	mockSegmentStore := segments.NewMockStore(ctrl)
+
+	// segments may be put and read concurrently
+	var mu sync.Mutex
+
+	mockSegmentStore.EXPECT().
+		Put(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, data io.Reader, expiration time.Time, info func() (storj.Path, []byte, error)) (segments.Meta, error) {
+			buf, err := ioutil.ReadAll(data)
+			if err != nil {
+				return segments.Meta{}, err
+			}
+			path, metadata, err := info()
+			if err != nil {
+				return segments.Meta{}, err
+			}
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			stored[path] = segments.Meta{Expiration: expiration, Size: int64(len(buf)), Data: metadata}
+			storedData[path] = buf
+			return stored[path], nil
+		}).AnyTimes()
+	mockSegmentStore.EXPECT().
+		Get(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path storj.Path) (ranger.Ranger, segments.Meta, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			meta, ok := stored[path]
+			if !ok {
+				return nil, segments.Meta{}, storage.ErrKeyNotFound.New("%q", path)
+			}
+			return ranger.ByteRanger(storedData[path]), meta, nil
+		}).AnyTimes()
+	mockSegmentStore.EXPECT().
+		Meta(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path storj.Path) (segments.Meta, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			meta, ok := stored[path]
+			if !ok {
+				return segments.Meta{}, storage.ErrKeyNotFound.New("%q", path)
+			}
+			return meta, nil
+		}).AnyTimes()
+	mockSegmentStore.EXPECT().
+		Delete(gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, path storj.Path) error {
+			mu.Lock()
+			defer mu.Unlock()
+
+			if _, ok := stored[path]; !ok {
+				return storage.ErrKeyNotFound.New("%q", path)
+			}
+			delete(stored, path)
+			delete(storedData, path)
+			return nil
+		}).AnyTimes()
+	mockSegmentStore.EXPECT().
+		Copy(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, sourcePath, path storj.Path, metadata []byte) (segments.Meta, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			meta, ok := stored[sourcePath]
+			if !ok {
+				return segments.Meta{}, storage.ErrKeyNotFound.New("%q", sourcePath)
+			}
+			meta.Data = metadata
+			stored[path] = meta
+			storedData[path] = storedData[sourcePath]
+			return meta, nil
+		}).AnyTimes()
+	mockSegmentStore.EXPECT().
+		List(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(ctx context.Context, prefix, startAfter, endBefore storj.Path, recursive bool, limit int, metaFlags uint32) ([]segments.ListItem, bool, error) {
+			mu.Lock()
+			defer mu.Unlock()
+
+			var items []segments.ListItem
+			for path, meta := range stored {
+				if strings.HasPrefix(path, prefix+"/") {
+					items = append(items, segments.ListItem{Path: strings.TrimPrefix(path, prefix+"/"), Meta: meta})
+				}
+			}
+			return items, false, nil
+		}).AnyTimes()
+
+	return mockSegmentStore
+}
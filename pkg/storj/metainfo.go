@@ -22,11 +22,15 @@ type Metainfo interface {
 	GetBucket(ctx context.Context, bucket string) (Bucket, error)
 	// ListBuckets lists buckets starting from first
 	ListBuckets(ctx context.Context, options BucketListOptions) (BucketList, error)
+	// SetBucketExpirationRules replaces the expiration rules of a bucket
+	SetBucketExpirationRules(ctx context.Context, bucket string, rules []ExpirationRule) (Bucket, error)
 
-	// GetObject returns information about an object
-	GetObject(ctx context.Context, bucket string, path Path) (Object, error)
-	// GetObjectStream returns interface for reading the object stream
-	GetObjectStream(ctx context.Context, bucket string, path Path) (ReadOnlyStream, error)
+	// GetObject returns information about an object, or about one of its
+	// versions when the version is not empty
+	GetObject(ctx context.Context, bucket string, path Path, version string) (Object, error)
+	// GetObjectStream returns interface for reading the object stream, or the
+	// stream of one of its versions when the version is not empty
+	GetObjectStream(ctx context.Context, bucket string, path Path, version string) (ReadOnlyStream, error)
 
 	// CreateObject creates a mutable object for uploading stream info
 	CreateObject(ctx context.Context, bucket string, path Path, info *CreateObject) (MutableObject, error)
@@ -34,11 +38,18 @@ type Metainfo interface {
 	ModifyObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
 	// DeleteObject deletes an object from database
 	DeleteObject(ctx context.Context, bucket string, path Path) error
+	// DeleteObjectVersion deletes a version of an object from database
+	DeleteObjectVersion(ctx context.Context, bucket string, path Path, version string) error
+	// CopyObject copies an object without transferring its data
+	CopyObject(ctx context.Context, sourceBucket string, sourcePath Path, bucket string, path Path) (Object, error)
 	// ListObjects lists objects in bucket based on the ListOptions
 	ListObjects(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
 
 	// ModifyPendingObject creates a mutable object for updating a partially uploaded object
 	ModifyPendingObject(ctx context.Context, bucket string, path Path) (MutableObject, error)
+	// ConcatPendingObjects commits pending objects as a single object without
+	// transferring their data
+	ConcatPendingObjects(ctx context.Context, bucket string, sourcePaths []Path, path Path, info *CreateObject) (Object, error)
 	// ListPendingObjects lists pending objects in bucket based on the ListOptions
 	ListPendingObjects(ctx context.Context, bucket string, options ListOptions) (ObjectList, error)
 }
@@ -95,6 +106,9 @@ type ListOptions struct {
 	Recursive bool
 	Direction ListDirection
 	Limit     int
+	// Versions lists every version of the objects instead of the objects.
+	// The listing is always recursive.
+	Versions bool
 }
 
 // ObjectList is a list of objects
@@ -118,22 +132,33 @@ func (opts ListOptions) NextPage(list ObjectList) ListOptions {
 	case Before, Backward:
 		return ListOptions{
 			Prefix:    opts.Prefix,
-			Cursor:    list.Items[0].Path,
+			Cursor:    opts.cursor(list.Items[0]),
 			Direction: Before,
 			Limit:     opts.Limit,
+			Versions:  opts.Versions,
 		}
 	case After, Forward:
 		return ListOptions{
 			Prefix:    opts.Prefix,
-			Cursor:    list.Items[len(list.Items)-1].Path,
+			Cursor:    opts.cursor(list.Items[len(list.Items)-1]),
 			Direction: After,
 			Limit:     opts.Limit,
+			Versions:  opts.Versions,
 		}
 	}
 
 	return ListOptions{}
 }
 
+// cursor returns the cursor pointing at the listed object, versions are
+// listed below the path of their object
+func (opts ListOptions) cursor(item Object) Path {
+	if opts.Versions {
+		return JoinPaths(item.Path, item.VersionID)
+	}
+	return item.Path
+}
+
 // BucketListOptions lists objects
 type BucketListOptions struct {
 	Cursor    string
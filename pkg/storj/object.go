@@ -4,6 +4,7 @@
 package storj
 
 import (
+	"strings"
 	"time"
 
 	"github.com/zeebo/errs"
@@ -21,6 +22,9 @@ var (
 
 	// ErrObjectNotFound is an error class for non-existing object
 	ErrObjectNotFound = errs.Class("object not found")
+
+	// ErrVersioningDisabled is an error class for using versions in a bucket without versioning
+	ErrVersioningDisabled = errs.Class("bucket versioning disabled")
 )
 
 // Bucket contains information about a specific bucket
@@ -28,6 +32,36 @@ type Bucket struct {
 	Name       string
 	Created    time.Time
 	PathCipher Cipher
+	// Versioning keeps every committed version of the objects in the bucket
+	Versioning bool
+	// ExpirationRules make the objects created in the bucket expire
+	ExpirationRules []ExpirationRule
+}
+
+// ExpirationRule makes the objects created below a prefix of a bucket expire
+// after a time to live, unless they have an expiration of their own
+type ExpirationRule struct {
+	Prefix Path
+	TTL    time.Duration
+}
+
+// Expiration returns the expiration of an object created at path, according
+// to the rule with the longest matching prefix. The zero time means that the
+// object doesn't expire.
+func (bucket Bucket) Expiration(path Path, created time.Time) time.Time {
+	var match *ExpirationRule
+	for i, rule := range bucket.ExpirationRules {
+		if !strings.HasPrefix(path, rule.Prefix) {
+			continue
+		}
+		if match == nil || len(rule.Prefix) > len(match.Prefix) {
+			match = &bucket.ExpirationRules[i]
+		}
+	}
+	if match == nil || match.TTL <= 0 {
+		return time.Time{}
+	}
+	return created.Add(match.TTL)
 }
 
 // Object contains information about a specific object
@@ -37,6 +71,11 @@ type Object struct {
 	Path     Path
 	IsPrefix bool
 
+	// VersionID identifies the version of the object in a bucket with versioning
+	VersionID string
+	// IsLatest is set when the object is the current version at its path
+	IsLatest bool
+
 	Metadata map[string]string
 
 	ContentType string
@@ -0,0 +1,40 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package storj
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketExpiration(t *testing.T) {
+	created := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	bucket := Bucket{
+		ExpirationRules: []ExpirationRule{
+			{Prefix: "logs/", TTL: 24 * time.Hour},
+			{Prefix: "logs/debug/", TTL: time.Hour},
+			{Prefix: "keep/", TTL: 0},
+		},
+	}
+
+	for i, tt := range []struct {
+		path       Path
+		expiration time.Time
+	}{
+		{"file", time.Time{}},
+		{"logs", time.Time{}},
+		{"logs/file", created.Add(24 * time.Hour)},
+		{"logs/debug/file", created.Add(time.Hour)},
+		{"keep/file", time.Time{}},
+	} {
+		errTag := fmt.Sprintf("Test case #%d", i)
+		assert.Equal(t, tt.expiration, bucket.Expiration(tt.path, created), errTag)
+	}
+
+	assert.Equal(t, time.Time{}, Bucket{}.Expiration("file", created))
+}
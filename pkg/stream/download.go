@@ -7,6 +7,7 @@ import (
 	"context"
 	"io"
 
+	"storj.io/storj/pkg/ranger"
 	"storj.io/storj/pkg/storage/streams"
 	"storj.io/storj/pkg/storj"
 )
@@ -106,7 +107,13 @@ func (download *Download) resetReader(offset int64) error {
 
 	obj := download.stream.Info()
 
-	rr, _, err := download.streams.Get(download.ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher)
+	var rr ranger.Ranger
+	var err error
+	if obj.VersionID != "" && !obj.IsLatest {
+		rr, _, err = download.streams.GetVersion(download.ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, obj.VersionID)
+	} else {
+		rr, _, err = download.streams.Get(download.ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher)
+	}
 	if err != nil {
 		return err
 	}
@@ -6,6 +6,7 @@ package stream
 import (
 	"context"
 	"io"
+	"time"
 
 	"github.com/gogo/protobuf/proto"
 	"golang.org/x/sync/errgroup"
@@ -26,8 +27,26 @@ type Upload struct {
 	errgroup errgroup.Group
 }
 
+type putFunc func(ctx context.Context, path storj.Path, pathCipher storj.Cipher, data io.Reader, metadata []byte, expiration time.Time) (streams.Meta, error)
+
 // NewUpload creates new stream upload.
 func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store) *Upload {
+	return newUpload(ctx, stream, streams, streams.Put)
+}
+
+// NewResumableUpload creates new stream upload, which can be continued with
+// NewContinuedUpload if it gets interrupted.
+func NewResumableUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store) *Upload {
+	return newUpload(ctx, stream, streams, streams.PutResumable)
+}
+
+// NewContinuedUpload creates stream upload continuing an interrupted resumable
+// upload. The written data must start at the size of the pending stream.
+func NewContinuedUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store) *Upload {
+	return newUpload(ctx, stream, streams, streams.Continue)
+}
+
+func newUpload(ctx context.Context, stream storj.MutableStream, streams streams.Store, put putFunc) *Upload {
 	reader, writer := io.Pipe()
 
 	upload := Upload{
@@ -43,13 +62,14 @@ func NewUpload(ctx context.Context, stream storj.MutableStream, streams streams.
 		serMetaInfo := pb.SerializableMeta{
 			ContentType: obj.ContentType,
 			UserDefined: obj.Metadata,
+			VersionId:   obj.VersionID,
 		}
 		metadata, err := proto.Marshal(&serMetaInfo)
 		if err != nil {
 			return utils.CombineErrors(err, reader.CloseWithError(err))
 		}
 
-		_, err = streams.Put(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
+		_, err = put(ctx, storj.JoinPaths(obj.Bucket.Name, obj.Path), obj.Bucket.PathCipher, reader, metadata, obj.Expires)
 		if err != nil {
 			return utils.CombineErrors(err, reader.CloseWithError(err))
 		}
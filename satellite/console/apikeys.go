@@ -63,6 +63,21 @@ func APIKeyFromBytes(b []byte) *APIKey {
 	return key
 }
 
+// APIKeyFromBase64 parses the api key from its string representation
+func APIKeyFromBase64(s string) (*APIKey, error) {
+	b, err := base64.URLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	key := new(APIKey)
+	if len(b) != len(key) {
+		return nil, errs.New("invalid api key length %d", len(b))
+	}
+	copy(key[:], b)
+	return key, nil
+}
+
 // CreateAPIKey creates new api key
 func CreateAPIKey() (*APIKey, error) {
 	key := new(APIKey)
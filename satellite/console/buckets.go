@@ -8,8 +8,12 @@ import (
 	"time"
 
 	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/zeebo/errs"
 )
 
+// ErrProjectNotEmpty is returned when deleting a project that still owns buckets
+var ErrProjectNotEmpty = errs.Class("project not empty error")
+
 // Buckets is interface for working with bucket to project relations
 type Buckets interface {
 	// ListBuckets returns bucket list of a given project
@@ -5,6 +5,7 @@ package consoleweb
 
 import (
 	"context"
+	"strings"
 
 	"github.com/graphql-go/graphql"
 	"github.com/zeebo/errs"
@@ -24,6 +25,7 @@ type Config struct {
 	GatewayConfig
 	SatelliteAddr string `help:"satellite main endpoint" default:""`
 	DatabaseURL   string `help:"" default:"sqlite3://$CONFDIR/satellitedb.db"`
+	AdminEmails   string `help:"comma separated emails of the users allowed to manage all projects" default:""`
 }
 
 // Run implements Responsibility interface
@@ -48,6 +50,8 @@ func (c Config) Run(ctx context.Context, server *provider.Provider) error {
 		return Error.Wrap(err)
 	}
 
+	service.SetAdmins(strings.Split(c.AdminEmails, ",")...)
+
 	creator := consoleql.TypeCreator{}
 	err = creator.Create(service)
 	if err != nil {
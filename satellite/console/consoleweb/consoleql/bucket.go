@@ -0,0 +1,30 @@
+// Copyright (C) 2018 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	bucketType = "bucket"
+)
+
+// graphqlBucket creates *graphql.Object type representation of satellite.Bucket
+func graphqlBucket() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: bucketType,
+		Fields: graphql.Fields{
+			fieldName: &graphql.Field{
+				Type: graphql.String,
+			},
+			fieldProjectID: &graphql.Field{
+				Type: graphql.String,
+			},
+			fieldCreatedAt: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+		},
+	})
+}
@@ -22,6 +22,7 @@ const (
 	createProjectMutation            = "createProject"
 	deleteProjectMutation            = "deleteProject"
 	updateProjectDescriptionMutation = "updateProjectDescription"
+	updateProjectLimitsMutation      = "updateProjectLimits"
 
 	addProjectMembersMutation    = "addProjectMembers"
 	deleteProjectMembersMutation = "deleteProjectMembers"
@@ -200,6 +201,41 @@ func rootMutation(service *console.Service, types Types) *graphql.Object {
 					return service.UpdateProject(p.Context, *projectID, description)
 				},
 			},
+			// updates storage and egress limits of the project
+			updateProjectLimitsMutation: &graphql.Field{
+				Type: types.Project(),
+				Args: graphql.FieldConfigArgument{
+					fieldID: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.String),
+					},
+					fieldStorage: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Float),
+					},
+					fieldEgress: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.Float),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					storage, _ := p.Args[fieldStorage].(float64)
+					egress, _ := p.Args[fieldEgress].(float64)
+
+					inputID := p.Args[fieldID].(string)
+					projectID, err := uuid.Parse(inputID)
+					if err != nil {
+						return nil, err
+					}
+
+					err = service.SetProjectLimits(p.Context, *projectID, console.UsageLimit{
+						Storage: int64(storage),
+						Egress:  int64(egress),
+					})
+					if err != nil {
+						return nil, err
+					}
+
+					return service.GetProject(p.Context, *projectID)
+				},
+			},
 			// add user as member of given project
 			addProjectMembersMutation: &graphql.Field{
 				Type: types.Project(),
@@ -335,6 +335,25 @@ func TestGrapqhlMutation(t *testing.T) {
 		assert.Equal(t, "", proj[fieldDescription])
 	})
 
+	t.Run("Update project limits mutation", func(t *testing.T) {
+		query := fmt.Sprintf(
+			"mutation {updateProjectLimits(id:\"%s\",storage:%d,egress:%d){id,limits{storage,egress}}}",
+			project.ID.String(),
+			1<<30,
+			1<<31,
+		)
+
+		result := testQuery(t, query)
+
+		data := result.(map[string]interface{})
+		proj := data[updateProjectLimitsMutation].(map[string]interface{})
+		limits := proj[fieldLimits].(map[string]interface{})
+
+		assert.Equal(t, project.ID.String(), proj[fieldID])
+		assert.Equal(t, float64(1<<30), limits[fieldStorage])
+		assert.Equal(t, float64(1<<31), limits[fieldEgress])
+	})
+
 	user1, err := service.CreateUser(authCtx, console.CreateUser{
 		UserInfo: console.UserInfo{
 			FirstName: "User1",
@@ -377,6 +396,19 @@ func TestGrapqhlMutation(t *testing.T) {
 		assert.Equal(t, 3, len(proj[fieldMembers].([]interface{})))
 	})
 
+	t.Run("Update project limits by member", func(t *testing.T) {
+		memberCtx := console.WithAuth(ctx, console.Authorization{User: *user1})
+
+		err := service.SetProjectLimits(memberCtx, project.ID, console.UsageLimit{})
+		assert.True(t, console.ErrUnauthorized.Has(err))
+
+		service.SetAdmins(user1.Email)
+		defer service.SetAdmins()
+
+		err = service.SetProjectLimits(memberCtx, project.ID, console.UsageLimit{Storage: 1 << 30, Egress: 1 << 31})
+		assert.NoError(t, err)
+	})
+
 	t.Run("Delete project members mutation", func(t *testing.T) {
 		query := fmt.Sprintf(
 			"mutation {deleteProjectMembers(projectID:\"%s\",email:[\"%s\",\"%s\"]){id,name,members(limit:50,offset:0){user{id}}}}",
@@ -4,6 +4,8 @@
 package consoleql
 
 import (
+	"time"
+
 	"github.com/graphql-go/graphql"
 
 	"storj.io/storj/satellite/console"
@@ -20,11 +22,16 @@ const (
 	fieldIsTermsAccepted = "isTermsAccepted"
 	fieldMembers         = "members"
 	fieldAPIKeys         = "apiKeys"
+	fieldBuckets         = "buckets"
+	fieldUsage           = "usage"
+	fieldLimits          = "limits"
 
 	limit  = "limit"
 	offset = "offset"
 	search = "search"
 	order  = "order"
+	since  = "since"
+	before = "before"
 )
 
 // graphqlProject creates *graphql.Object type representation of satellite.ProjectInfo
@@ -107,6 +114,41 @@ func graphqlProject(service *console.Service, types Types) *graphql.Object {
 					return service.GetAPIKeysInfoByProjectID(p.Context, project.ID)
 				},
 			},
+			fieldBuckets: &graphql.Field{
+				Type: graphql.NewList(types.Bucket()),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					return service.GetProjectBuckets(p.Context, project.ID)
+				},
+			},
+			fieldUsage: &graphql.Field{
+				Type: graphql.NewList(types.DailyUsage()),
+				Args: graphql.FieldConfigArgument{
+					since: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.DateTime),
+					},
+					before: &graphql.ArgumentConfig{
+						Type: graphql.NewNonNull(graphql.DateTime),
+					},
+				},
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					since, _ := p.Args[since].(time.Time)
+					before, _ := p.Args[before].(time.Time)
+
+					return service.GetProjectUsage(p.Context, project.ID, since, before)
+				},
+			},
+			fieldLimits: &graphql.Field{
+				Type: types.UsageLimit(),
+				Resolve: func(p graphql.ResolveParams) (interface{}, error) {
+					project, _ := p.Source.(*console.Project)
+
+					return service.GetProjectLimits(p.Context, project.ID)
+				},
+			},
 		},
 	})
 }
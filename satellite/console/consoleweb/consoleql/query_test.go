@@ -316,6 +316,47 @@ func TestGraphqlQuery(t *testing.T) {
 		assert.True(t, foundKey2)
 	})
 
+	_, err = db.Buckets().AttachBucket(ctx, "bucket1", createdProject.ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	t.Run("Project query buckets", func(t *testing.T) {
+		query := fmt.Sprintf(
+			"query {project(id:\"%s\"){buckets{name,projectID}}}",
+			createdProject.ID.String(),
+		)
+
+		result := testQuery(t, query)
+
+		data := result.(map[string]interface{})
+		project := data[projectQuery].(map[string]interface{})
+		buckets := project[fieldBuckets].([]interface{})
+
+		if assert.Equal(t, 1, len(buckets)) {
+			bucket := buckets[0].(map[string]interface{})
+			assert.Equal(t, "bucket1", bucket[fieldName])
+			assert.Equal(t, createdProject.ID.String(), bucket[fieldProjectID])
+		}
+	})
+
+	t.Run("Project query usage", func(t *testing.T) {
+		query := fmt.Sprintf(
+			"query {project(id:\"%s\"){usage(since:\"%s\",before:\"%s\"){date,storage,egress,objectCount}}}",
+			createdProject.ID.String(),
+			time.Now().Add(-24*time.Hour).UTC().Format(time.RFC3339),
+			time.Now().UTC().Format(time.RFC3339),
+		)
+
+		result := testQuery(t, query)
+
+		data := result.(map[string]interface{})
+		project := data[projectQuery].(map[string]interface{})
+		usage := project[fieldUsage].([]interface{})
+
+		assert.Equal(t, 0, len(usage))
+	})
+
 	project2, err := service.CreateProject(authCtx, console.ProjectInfo{
 		Name:            "Project2",
 		Description:     "Test desc",
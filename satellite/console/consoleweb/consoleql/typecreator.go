@@ -21,6 +21,9 @@ type Types interface {
 	ProjectMember() *graphql.Object
 	APIKeyInfo() *graphql.Object
 	CreateAPIKey() *graphql.Object
+	Bucket() *graphql.Object
+	DailyUsage() *graphql.Object
+	UsageLimit() *graphql.Object
 
 	UserInput() *graphql.InputObject
 	ProjectInput() *graphql.InputObject
@@ -38,6 +41,9 @@ type TypeCreator struct {
 	projectMember *graphql.Object
 	apiKeyInfo    *graphql.Object
 	createAPIKey  *graphql.Object
+	bucket        *graphql.Object
+	dailyUsage    *graphql.Object
+	usageLimit    *graphql.Object
 
 	userInput    *graphql.InputObject
 	projectInput *graphql.InputObject
@@ -72,6 +78,21 @@ func (c *TypeCreator) Create(service *console.Service) error {
 		return err
 	}
 
+	c.bucket = graphqlBucket()
+	if err := c.bucket.Error(); err != nil {
+		return err
+	}
+
+	c.dailyUsage = graphqlDailyUsage()
+	if err := c.dailyUsage.Error(); err != nil {
+		return err
+	}
+
+	c.usageLimit = graphqlUsageLimit()
+	if err := c.usageLimit.Error(); err != nil {
+		return err
+	}
+
 	c.projectMember = graphqlProjectMember(service, c)
 	if err := c.projectMember.Error(); err != nil {
 		return err
@@ -132,6 +153,21 @@ func (c *TypeCreator) CreateAPIKey() *graphql.Object {
 	return c.createAPIKey
 }
 
+// Bucket returns instance of satellite.Bucket *graphql.Object
+func (c *TypeCreator) Bucket() *graphql.Object {
+	return c.bucket
+}
+
+// DailyUsage returns instance of satellite.DailyUsage *graphql.Object
+func (c *TypeCreator) DailyUsage() *graphql.Object {
+	return c.dailyUsage
+}
+
+// UsageLimit returns instance of satellite.UsageLimit *graphql.Object
+func (c *TypeCreator) UsageLimit() *graphql.Object {
+	return c.usageLimit
+}
+
 // Project returns instance of satellite.Project *graphql.Object
 func (c *TypeCreator) Project() *graphql.Object {
 	return c.project
@@ -0,0 +1,54 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package consoleql
+
+import (
+	"github.com/graphql-go/graphql"
+)
+
+const (
+	dailyUsageType = "dailyUsage"
+	usageLimitType = "usageLimit"
+
+	fieldDate        = "date"
+	fieldStorage     = "storage"
+	fieldEgress      = "egress"
+	fieldObjectCount = "objectCount"
+)
+
+// graphqlDailyUsage creates *graphql.Object type representation of satellite.DailyUsage
+func graphqlDailyUsage() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: dailyUsageType,
+		Fields: graphql.Fields{
+			fieldDate: &graphql.Field{
+				Type: graphql.DateTime,
+			},
+			fieldStorage: &graphql.Field{
+				Type: graphql.Float,
+			},
+			fieldEgress: &graphql.Field{
+				Type: graphql.Float,
+			},
+			fieldObjectCount: &graphql.Field{
+				Type: graphql.Int,
+			},
+		},
+	})
+}
+
+// graphqlUsageLimit creates *graphql.Object type representation of satellite.UsageLimit
+func graphqlUsageLimit() *graphql.Object {
+	return graphql.NewObject(graphql.ObjectConfig{
+		Name: usageLimitType,
+		Fields: graphql.Fields{
+			fieldStorage: &graphql.Field{
+				Type: graphql.Float,
+			},
+			fieldEgress: &graphql.Field{
+				Type: graphql.Float,
+			},
+		},
+	})
+}
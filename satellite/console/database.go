@@ -17,6 +17,10 @@ type DB interface {
 	APIKeys() APIKeys
 	// Buckets is a getter for Buckets repository
 	Buckets() Buckets
+	// ProjectUsage is a getter for ProjectUsage repository
+	ProjectUsage() ProjectUsage
+	// UsageLimits is a getter for UsageLimits repository
+	UsageLimits() UsageLimits
 
 	// CreateTables is a method for creating all tables for satellitedb
 	CreateTables() error
@@ -34,6 +34,9 @@ type Project struct {
 	Description string `json:"description"`
 	// stores last accepted version of terms of use.
 	TermsAccepted int `json:"termsAccepted"`
+	// OwnerID is the user who created the project, it's zero for the
+	// projects without members created before the owners were stored
+	OwnerID uuid.UUID `json:"ownerId"`
 
 	CreatedAt time.Time `json:"createdAt"`
 }
@@ -33,6 +33,9 @@ type Service struct {
 
 	store DB
 	log   *zap.Logger
+
+	// admins are the emails of the users allowed to manage all projects
+	admins map[string]bool
 }
 
 // NewService returns new instance of Service
@@ -52,6 +55,16 @@ func NewService(log *zap.Logger, signer Signer, store DB) (*Service, error) {
 	return &Service{Signer: signer, store: store, log: log}, nil
 }
 
+// SetAdmins sets the emails of the users allowed to manage all projects
+func (s *Service) SetAdmins(emails ...string) {
+	s.admins = make(map[string]bool)
+	for _, email := range emails {
+		if email != "" {
+			s.admins[email] = true
+		}
+	}
+}
+
 // CreateUser gets password hash value and creates new User
 func (s *Service) CreateUser(ctx context.Context, user CreateUser) (u *User, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -223,6 +236,7 @@ func (s *Service) CreateProject(ctx context.Context, projectInfo ProjectInfo) (p
 		Description:   projectInfo.Description,
 		Name:          projectInfo.Name,
 		TermsAccepted: 1, //TODO: get lat version of Term of Use
+		OwnerID:       auth.User.ID,
 	}
 
 	transaction, err := s.store.BeginTx(ctx)
@@ -261,6 +275,15 @@ func (s *Service) DeleteProject(ctx context.Context, projectID uuid.UUID) (err e
 	}
 
 	// TODO: before deletion we should check if user is a project member
+	buckets, err := s.store.Buckets().ListBuckets(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if len(buckets) > 0 {
+		return ErrProjectNotEmpty.New("project has %d buckets", len(buckets))
+	}
+
 	return s.store.Projects().Delete(ctx, projectID)
 }
 
@@ -503,6 +526,76 @@ func (s *Service) GetAPIKeysInfoByProjectID(ctx context.Context, projectID uuid.
 	return s.store.APIKeys().GetByProjectID(ctx, projectID)
 }
 
+// GetProjectBuckets retrieves all buckets owned by a given project
+func (s *Service) GetProjectBuckets(ctx context.Context, projectID uuid.UUID) (buckets []Bucket, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.store.Buckets().ListBuckets(ctx, projectID)
+}
+
+// GetProjectUsage retrieves the daily storage, egress and object counts of a given project
+func (s *Service) GetProjectUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) (usage []DailyUsage, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.store.ProjectUsage().GetDailyUsage(ctx, projectID, since, before)
+}
+
+// GetProjectLimits retrieves the storage and egress limits of a given project
+func (s *Service) GetProjectLimits(ctx context.Context, projectID uuid.UUID) (limit *UsageLimit, err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	_, err = s.isProjectMember(ctx, auth.User.ID, projectID)
+	if err != nil {
+		return nil, ErrUnauthorized.Wrap(err)
+	}
+
+	return s.store.UsageLimits().GetLimits(ctx, projectID)
+}
+
+// SetProjectLimits sets the storage and egress limits of a given project, zero removes a limit
+func (s *Service) SetProjectLimits(ctx context.Context, projectID uuid.UUID, limit UsageLimit) (err error) {
+	defer mon.Task()(&ctx)(&err)
+	auth, err := GetAuth(ctx)
+	if err != nil {
+		return err
+	}
+
+	if limit.Storage < 0 || limit.Egress < 0 {
+		return errs.New("limits can't be negative")
+	}
+
+	if !s.admins[auth.User.Email] {
+		err = s.isProjectOwner(ctx, auth.User.ID, projectID)
+		if err != nil {
+			return ErrUnauthorized.Wrap(err)
+		}
+	}
+
+	return s.store.UsageLimits().SetLimits(ctx, projectID, limit)
+}
+
 // Authorize validates token from context and returns authorized Authorization
 func (s *Service) Authorize(ctx context.Context) (a Authorization, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -614,3 +707,16 @@ func (s *Service) isProjectMember(ctx context.Context, userID uuid.UUID, project
 
 	return isProjectMember{}, ErrNoMembership.New("user %s is not a member of project %s", userID, project.ID)
 }
+
+// isProjectOwner checks if the user is the owner of given project
+func (s *Service) isProjectOwner(ctx context.Context, userID uuid.UUID, projectID uuid.UUID) error {
+	project, err := s.store.Projects().Get(ctx, projectID)
+	if err != nil {
+		return err
+	}
+
+	if project.OwnerID != userID {
+		return ErrNoMembership.New("user %s is not the owner of project %s", userID, projectID)
+	}
+	return nil
+}
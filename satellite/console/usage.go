@@ -0,0 +1,45 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package console
+
+import (
+	"context"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+)
+
+// ProjectUsage is interface for retrieving the storage and bandwidth usage of projects
+type ProjectUsage interface {
+	// GetDailyUsage returns the usage of a project for every day of the given period
+	GetDailyUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]DailyUsage, error)
+}
+
+// DailyUsage represents the usage of a project on a single day
+type DailyUsage struct {
+	Date time.Time
+
+	// Storage is the average amount of bytes stored during the day
+	Storage float64
+	// Egress is the amount of bytes downloaded during the day
+	Egress int64
+	// ObjectCount is the amount of objects stored at the end of the day
+	ObjectCount int64
+}
+
+// UsageLimits is interface for working with the storage and egress limits of projects
+type UsageLimits interface {
+	// GetLimits returns the limits of a project, zero limits when none were set
+	GetLimits(ctx context.Context, projectID uuid.UUID) (*UsageLimit, error)
+	// SetLimits sets the limits of a project
+	SetLimits(ctx context.Context, projectID uuid.UUID, limit UsageLimit) error
+}
+
+// UsageLimit represents the maximum usage allowed for a project, zero means unlimited
+type UsageLimit struct {
+	// Storage is the maximum amount of bytes a project may store
+	Storage int64
+	// Egress is the maximum amount of bytes a project may download during a month
+	Egress int64
+}
@@ -27,9 +27,9 @@ func (db *accountingDB) LastRawTime(ctx context.Context, timestampType string) (
 	return lastTally.Value, false, err
 }
 
-// SaveBWRaw records granular tallies (sums of bw agreement values) to the database
-// and updates the LastRawTime
-func (db *accountingDB) SaveBWRaw(ctx context.Context, latestBwa time.Time, bwTotals accounting.BWTally) (err error) {
+// SaveBWRaw records granular tallies (sums of bw agreement values) and the bandwidth
+// of the buckets to the database and updates the LastRawTime
+func (db *accountingDB) SaveBWRaw(ctx context.Context, latestBwa time.Time, bwTotals accounting.BWTally, bucketBandwidth []*accounting.BucketBandwidth) (err error) {
 	// We use the latest bandwidth agreement value of a batch of records as the start of the next batch
 	// todo:  consider finding the sum of bwagreements using SQL sum() direct against the bwa table
 	if len(bwTotals) == 0 {
@@ -60,15 +60,16 @@ func (db *accountingDB) SaveBWRaw(ctx context.Context, latestBwa time.Time, bwTo
 			}
 		}
 	}
+	if err = saveBucketBandwidth(ctx, tx, latestBwa, bucketBandwidth); err != nil {
+		return err
+	}
 	//save this batch's greatest time
-	update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(latestBwa)}
-	_, err = tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastBandwidthTally), update)
-	return err
+	return saveTimestamp(ctx, tx, accounting.LastBandwidthTally, latestBwa)
 }
 
-// SaveAtRestRaw records raw tallies of at rest data to the database
-func (db *accountingDB) SaveAtRestRaw(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64) error {
-	if len(nodeData) == 0 {
+// SaveAtRestRaw records raw tallies of at rest data and the tallies of the buckets to the database
+func (db *accountingDB) SaveAtRestRaw(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64, bucketTallies []*accounting.BucketTally) (err error) {
+	if len(nodeData) == 0 && len(bucketTallies) == 0 {
 		return Error.New("In SaveAtRestRaw with empty nodeData")
 	}
 	tx, err := db.db.Open(ctx)
@@ -92,9 +93,42 @@ func (db *accountingDB) SaveAtRestRaw(ctx context.Context, latestTally time.Time
 			return Error.Wrap(err)
 		}
 	}
-	update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(latestTally)}
-	_, err = tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastAtRestTally), update)
-	return Error.Wrap(err)
+	if err = saveBucketTallies(ctx, tx, latestTally, bucketTallies); err != nil {
+		return err
+	}
+	return Error.Wrap(saveTimestamp(ctx, tx, accounting.LastAtRestTally, latestTally))
+}
+
+// saveBucketTallies records the at-rest data of the buckets of every project
+func saveBucketTallies(ctx context.Context, tx *dbx.Tx, intervalEnd time.Time, tallies []*accounting.BucketTally) error {
+	for _, tally := range tallies {
+		projectID := dbx.BucketStorageTally_ProjectId(tally.ProjectID[:])
+		bucketName := dbx.BucketStorageTally_BucketName(tally.BucketName)
+		end := dbx.BucketStorageTally_IntervalEndTime(intervalEnd)
+		storedBytes := dbx.BucketStorageTally_StoredBytes(tally.Bytes)
+		objectCount := dbx.BucketStorageTally_ObjectCount(tally.ObjectCount)
+		_, err := tx.Create_BucketStorageTally(ctx, projectID, bucketName, end, storedBytes, objectCount)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
+}
+
+// saveBucketBandwidth records the bandwidth used by the buckets of every project
+func saveBucketBandwidth(ctx context.Context, tx *dbx.Tx, intervalEnd time.Time, bandwidth []*accounting.BucketBandwidth) error {
+	for _, bw := range bandwidth {
+		projectID := dbx.BucketBandwidthTally_ProjectId(bw.ProjectID[:])
+		bucketName := dbx.BucketBandwidthTally_BucketName(bw.BucketName)
+		end := dbx.BucketBandwidthTally_IntervalEndTime(intervalEnd)
+		action := dbx.BucketBandwidthTally_Action(int(bw.Action))
+		total := dbx.BucketBandwidthTally_Total(bw.Total)
+		_, err := tx.Create_BucketBandwidthTally(ctx, projectID, bucketName, end, action, total)
+		if err != nil {
+			return Error.Wrap(err)
+		}
+	}
+	return nil
 }
 
 // GetRaw retrieves all raw tallies
@@ -171,9 +205,19 @@ func (db *accountingDB) SaveRollup(ctx context.Context, latestRollup time.Time,
 			}
 		}
 	}
-	update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(latestRollup)}
-	_, err = tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(accounting.LastRollup), update)
-	return Error.Wrap(err)
+	return Error.Wrap(saveTimestamp(ctx, tx, accounting.LastRollup, latestRollup))
+}
+
+// saveTimestamp updates the accounting timestamp with the given name,
+// creating it when it hasn't been saved before
+func saveTimestamp(ctx context.Context, tx *dbx.Tx, name string, value time.Time) error {
+	update := dbx.AccountingTimestamps_Update_Fields{Value: dbx.AccountingTimestamps_Value(value)}
+	timestamp, err := tx.Update_AccountingTimestamps_By_Name(ctx, dbx.AccountingTimestamps_Name(name), update)
+	if err != nil || timestamp != nil {
+		return err
+	}
+	_, err = tx.Create_AccountingTimestamps(ctx, dbx.AccountingTimestamps_Name(name), dbx.AccountingTimestamps_Value(value))
+	return err
 }
 
 // QueryPaymentInfo queries StatDB, Accounting Rollup on nodeID
@@ -5,8 +5,11 @@ package satellitedb
 
 import (
 	"context"
+	"database/sql"
 	"time"
 
+	"github.com/zeebo/errs"
+
 	"storj.io/storj/pkg/bwagreement"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 )
@@ -26,6 +29,37 @@ func (b *bandwidthagreement) CreateAgreement(ctx context.Context, serialNum stri
 	return err
 }
 
+func (b *bandwidthagreement) CreateAgreements(ctx context.Context, agreements map[string]bwagreement.Agreement) (duplicates []string, err error) {
+	tx, err := b.db.Open(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	for serialNum, agreement := range agreements {
+		_, err = tx.Get_Bwagreement_By_Serialnum(ctx, dbx.Bwagreement_Serialnum(serialNum))
+		if err == nil {
+			duplicates = append(duplicates, serialNum)
+			continue
+		}
+		if err != sql.ErrNoRows {
+			return nil, Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+
+		_, err = tx.Create_Bwagreement(
+			ctx,
+			dbx.Bwagreement_Signature(agreement.Signature),
+			dbx.Bwagreement_Serialnum(serialNum),
+			dbx.Bwagreement_Data(agreement.Agreement),
+			dbx.Bwagreement_ExpiresAt(agreement.ExpiresAt),
+		)
+		if err != nil {
+			return nil, Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	}
+
+	return duplicates, Error.Wrap(tx.Commit())
+}
+
 func (b *bandwidthagreement) GetAgreements(ctx context.Context) ([]bwagreement.Agreement, error) {
 	rows, err := b.db.All_Bwagreement(ctx)
 	if err != nil {
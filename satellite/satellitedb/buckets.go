@@ -31,7 +31,7 @@ func (buck *buckets) ListBuckets(ctx context.Context, projectID uuid.UUID) ([]co
 	var consoleBuckets []console.Bucket
 	for _, bucket := range buckets {
 		consoleBucket, bucketErr := fromDBXBucket(bucket)
-		if err != nil {
+		if bucketErr != nil {
 			err = errs.Combine(err, bucketErr)
 			continue
 		}
@@ -62,6 +62,16 @@ func (db *ConsoleDB) Buckets() console.Buckets {
 	return &buckets{db.methods}
 }
 
+// ProjectUsage is a getter for ProjectUsage repository
+func (db *ConsoleDB) ProjectUsage() console.ProjectUsage {
+	return &projectUsage{db.methods}
+}
+
+// UsageLimits is a getter for UsageLimits repository
+func (db *ConsoleDB) UsageLimits() console.UsageLimits {
+	return &usageLimits{db.methods}
+}
+
 // CreateTables is a method for creating all tables for satellitedb
 func (db *ConsoleDB) CreateTables() error {
 	if db.db == nil {
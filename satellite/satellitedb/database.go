@@ -6,7 +6,6 @@ package satellitedb
 import (
 	"github.com/zeebo/errs"
 
-	"storj.io/storj/internal/migrate"
 	"storj.io/storj/pkg/accounting"
 	"storj.io/storj/pkg/bwagreement"
 	"storj.io/storj/pkg/datarepair/irreparable"
@@ -28,7 +27,8 @@ var (
 
 // DB contains access to different database tables
 type DB struct {
-	db *dbx.DB
+	db     *dbx.DB
+	driver string
 }
 
 // New creates instance of database (supports: postgres, sqlite3)
@@ -44,7 +44,7 @@ func New(databaseURL string) (satellite.DB, error) {
 			driver, source, err)
 	}
 
-	core := &DB{db: db}
+	core := &DB{db: db, driver: driver}
 	if driver == "sqlite3" {
 		return newLocked(core), nil
 	}
@@ -99,9 +99,10 @@ func (db *DB) Console() console.DB {
 	}
 }
 
-// CreateTables is a method for creating all tables for database
+// CreateTables is a method for creating all tables for database, the tables
+// of an existing database are migrated to the current schema
 func (db *DB) CreateTables() error {
-	return migrate.Create("database", db.db)
+	return migrateSchema(db.db, db.driver)
 }
 
 // Close is used to close db connection
@@ -299,6 +299,26 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE bucket_bandwidth_tallies (
+	id bigserial NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	interval_end_time timestamp with time zone NOT NULL,
+	action integer NOT NULL,
+	total bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_storage_tallies (
+	id bigserial NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	interval_end_time timestamp with time zone NOT NULL,
+	stored_bytes bigint NOT NULL,
+	object_count bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bwagreements (
 	signature bytea NOT NULL,
 	serialnum text NOT NULL,
@@ -308,6 +328,27 @@ CREATE TABLE bwagreements (
 	PRIMARY KEY ( signature ),
 	UNIQUE ( serialnum )
 );
+CREATE TABLE graceful_exit_transfers (
+	node_id bytea NOT NULL,
+	path bytea NOT NULL,
+	piece_num integer NOT NULL,
+	target_id bytea NOT NULL,
+	state integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, path, piece_num )
+);
+CREATE TABLE graceful_exits (
+	node_id bytea NOT NULL,
+	pieces_transferred bigint NOT NULL,
+	pieces_failed bigint NOT NULL,
+	exited boolean NOT NULL,
+	transfers_cursor bytea NOT NULL,
+	transfers_listed boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE injuredsegments (
 	id bigserial NOT NULL,
 	info bytea NOT NULL,
@@ -329,6 +370,13 @@ CREATE TABLE nodes (
 	uptime_success_count bigint NOT NULL,
 	total_uptime_count bigint NOT NULL,
 	uptime_ratio double precision NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
+	uptime_reputation_alpha double precision NOT NULL,
+	uptime_reputation_beta double precision NOT NULL,
+	state integer NOT NULL,
+	state_reason text NOT NULL,
+	state_changed_at timestamp with time zone NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
@@ -349,6 +397,7 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count bigint NOT NULL,
 	uptime_count bigint NOT NULL,
 	uptime_success_count bigint NOT NULL,
+	last_net text NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
@@ -357,6 +406,7 @@ CREATE TABLE projects (
 	name text NOT NULL,
 	description text NOT NULL,
 	terms_accepted integer NOT NULL,
+	owner_id bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -386,6 +436,14 @@ CREATE TABLE bucket_infos (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE project_limits (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_limit bigint NOT NULL,
+	egress_limit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -481,6 +539,26 @@ CREATE TABLE accounting_timestamps (
 	value TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE bucket_bandwidth_tallies (
+	id INTEGER NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	interval_end_time TIMESTAMP NOT NULL,
+	action INTEGER NOT NULL,
+	total INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_storage_tallies (
+	id INTEGER NOT NULL,
+	project_id BLOB NOT NULL,
+	bucket_name TEXT NOT NULL,
+	interval_end_time TIMESTAMP NOT NULL,
+	stored_bytes INTEGER NOT NULL,
+	object_count INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bwagreements (
 	signature BLOB NOT NULL,
 	serialnum TEXT NOT NULL,
@@ -490,6 +568,27 @@ CREATE TABLE bwagreements (
 	PRIMARY KEY ( signature ),
 	UNIQUE ( serialnum )
 );
+CREATE TABLE graceful_exit_transfers (
+	node_id BLOB NOT NULL,
+	path BLOB NOT NULL,
+	piece_num INTEGER NOT NULL,
+	target_id BLOB NOT NULL,
+	state INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id, path, piece_num )
+);
+CREATE TABLE graceful_exits (
+	node_id BLOB NOT NULL,
+	pieces_transferred INTEGER NOT NULL,
+	pieces_failed INTEGER NOT NULL,
+	exited INTEGER NOT NULL,
+	transfers_cursor BLOB NOT NULL,
+	transfers_listed INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE injuredsegments (
 	id INTEGER NOT NULL,
 	info BLOB NOT NULL,
@@ -511,6 +610,13 @@ CREATE TABLE nodes (
 	uptime_success_count INTEGER NOT NULL,
 	total_uptime_count INTEGER NOT NULL,
 	uptime_ratio REAL NOT NULL,
+	audit_reputation_alpha REAL NOT NULL,
+	audit_reputation_beta REAL NOT NULL,
+	uptime_reputation_alpha REAL NOT NULL,
+	uptime_reputation_beta REAL NOT NULL,
+	state INTEGER NOT NULL,
+	state_reason TEXT NOT NULL,
+	state_changed_at TIMESTAMP NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	updated_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
@@ -531,6 +637,7 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count INTEGER NOT NULL,
 	uptime_count INTEGER NOT NULL,
 	uptime_success_count INTEGER NOT NULL,
+	last_net TEXT NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
@@ -539,6 +646,7 @@ CREATE TABLE projects (
 	name TEXT NOT NULL,
 	description TEXT NOT NULL,
 	terms_accepted INTEGER NOT NULL,
+	owner_id BLOB NOT NULL,
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -568,6 +676,14 @@ CREATE TABLE bucket_infos (
 	created_at TIMESTAMP NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE project_limits (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_limit INTEGER NOT NULL,
+	egress_limit INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE project_members (
 	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
@@ -1001,429 +1117,1194 @@ func (f AccountingTimestamps_Value_Field) value() interface{} {
 
 func (AccountingTimestamps_Value_Field) _Column() string { return "value" }
 
-type Bwagreement struct {
-	Signature []byte
-	Serialnum string
-	Data      []byte
-	CreatedAt time.Time
-	ExpiresAt time.Time
+type BucketBandwidthTally struct {
+	Id              int64
+	ProjectId       []byte
+	BucketName      string
+	IntervalEndTime time.Time
+	Action          int
+	Total           int64
+	CreatedAt       time.Time
 }
 
-func (Bwagreement) _Table() string { return "bwagreements" }
+func (BucketBandwidthTally) _Table() string { return "bucket_bandwidth_tallies" }
 
-type Bwagreement_Update_Fields struct {
+type BucketBandwidthTally_Update_Fields struct {
 }
 
-type Bwagreement_Signature_Field struct {
+type BucketBandwidthTally_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketBandwidthTally_Id(v int64) BucketBandwidthTally_Id_Field {
+	return BucketBandwidthTally_Id_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthTally_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthTally_Id_Field) _Column() string { return "id" }
+
+type BucketBandwidthTally_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Bwagreement_Signature(v []byte) Bwagreement_Signature_Field {
-	return Bwagreement_Signature_Field{_set: true, _value: v}
+func BucketBandwidthTally_ProjectId(v []byte) BucketBandwidthTally_ProjectId_Field {
+	return BucketBandwidthTally_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_Signature_Field) value() interface{} {
+func (f BucketBandwidthTally_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_Signature_Field) _Column() string { return "signature" }
+func (BucketBandwidthTally_ProjectId_Field) _Column() string { return "project_id" }
 
-type Bwagreement_Serialnum_Field struct {
+type BucketBandwidthTally_BucketName_Field struct {
 	_set   bool
 	_null  bool
 	_value string
 }
 
-func Bwagreement_Serialnum(v string) Bwagreement_Serialnum_Field {
-	return Bwagreement_Serialnum_Field{_set: true, _value: v}
+func BucketBandwidthTally_BucketName(v string) BucketBandwidthTally_BucketName_Field {
+	return BucketBandwidthTally_BucketName_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_Serialnum_Field) value() interface{} {
+func (f BucketBandwidthTally_BucketName_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_Serialnum_Field) _Column() string { return "serialnum" }
+func (BucketBandwidthTally_BucketName_Field) _Column() string { return "bucket_name" }
 
-type Bwagreement_Data_Field struct {
+type BucketBandwidthTally_IntervalEndTime_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Bwagreement_Data(v []byte) Bwagreement_Data_Field {
-	return Bwagreement_Data_Field{_set: true, _value: v}
+func BucketBandwidthTally_IntervalEndTime(v time.Time) BucketBandwidthTally_IntervalEndTime_Field {
+	return BucketBandwidthTally_IntervalEndTime_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_Data_Field) value() interface{} {
+func (f BucketBandwidthTally_IntervalEndTime_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_Data_Field) _Column() string { return "data" }
+func (BucketBandwidthTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
 
-type Bwagreement_CreatedAt_Field struct {
+type BucketBandwidthTally_Action_Field struct {
 	_set   bool
 	_null  bool
-	_value time.Time
+	_value int
 }
 
-func Bwagreement_CreatedAt(v time.Time) Bwagreement_CreatedAt_Field {
-	return Bwagreement_CreatedAt_Field{_set: true, _value: v}
+func BucketBandwidthTally_Action(v int) BucketBandwidthTally_Action_Field {
+	return BucketBandwidthTally_Action_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_CreatedAt_Field) value() interface{} {
+func (f BucketBandwidthTally_Action_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_CreatedAt_Field) _Column() string { return "created_at" }
+func (BucketBandwidthTally_Action_Field) _Column() string { return "action" }
 
-type Bwagreement_ExpiresAt_Field struct {
+type BucketBandwidthTally_Total_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func BucketBandwidthTally_Total(v int64) BucketBandwidthTally_Total_Field {
+	return BucketBandwidthTally_Total_Field{_set: true, _value: v}
+}
+
+func (f BucketBandwidthTally_Total_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (BucketBandwidthTally_Total_Field) _Column() string { return "total" }
+
+type BucketBandwidthTally_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
 	_value time.Time
 }
 
-func Bwagreement_ExpiresAt(v time.Time) Bwagreement_ExpiresAt_Field {
-	return Bwagreement_ExpiresAt_Field{_set: true, _value: v}
+func BucketBandwidthTally_CreatedAt(v time.Time) BucketBandwidthTally_CreatedAt_Field {
+	return BucketBandwidthTally_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Bwagreement_ExpiresAt_Field) value() interface{} {
+func (f BucketBandwidthTally_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Bwagreement_ExpiresAt_Field) _Column() string { return "expires_at" }
+func (BucketBandwidthTally_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Injuredsegment struct {
-	Id   int64
-	Info []byte
+type BucketStorageTally struct {
+	Id              int64
+	ProjectId       []byte
+	BucketName      string
+	IntervalEndTime time.Time
+	StoredBytes     int64
+	ObjectCount     int64
+	CreatedAt       time.Time
 }
 
-func (Injuredsegment) _Table() string { return "injuredsegments" }
+func (BucketStorageTally) _Table() string { return "bucket_storage_tallies" }
 
-type Injuredsegment_Update_Fields struct {
+type BucketStorageTally_Update_Fields struct {
 }
 
-type Injuredsegment_Id_Field struct {
+type BucketStorageTally_Id_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Injuredsegment_Id(v int64) Injuredsegment_Id_Field {
-	return Injuredsegment_Id_Field{_set: true, _value: v}
+func BucketStorageTally_Id(v int64) BucketStorageTally_Id_Field {
+	return BucketStorageTally_Id_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Id_Field) value() interface{} {
+func (f BucketStorageTally_Id_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Id_Field) _Column() string { return "id" }
+func (BucketStorageTally_Id_Field) _Column() string { return "id" }
 
-type Injuredsegment_Info_Field struct {
+type BucketStorageTally_ProjectId_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Injuredsegment_Info(v []byte) Injuredsegment_Info_Field {
-	return Injuredsegment_Info_Field{_set: true, _value: v}
+func BucketStorageTally_ProjectId(v []byte) BucketStorageTally_ProjectId_Field {
+	return BucketStorageTally_ProjectId_Field{_set: true, _value: v}
 }
 
-func (f Injuredsegment_Info_Field) value() interface{} {
+func (f BucketStorageTally_ProjectId_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Injuredsegment_Info_Field) _Column() string { return "info" }
+func (BucketStorageTally_ProjectId_Field) _Column() string { return "project_id" }
 
-type Irreparabledb struct {
-	Segmentpath        []byte
-	Segmentdetail      []byte
-	PiecesLostCount    int64
-	SegDamagedUnixSec  int64
-	RepairAttemptCount int64
+type BucketStorageTally_BucketName_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (Irreparabledb) _Table() string { return "irreparabledbs" }
+func BucketStorageTally_BucketName(v string) BucketStorageTally_BucketName_Field {
+	return BucketStorageTally_BucketName_Field{_set: true, _value: v}
+}
 
-type Irreparabledb_Update_Fields struct {
-	Segmentdetail      Irreparabledb_Segmentdetail_Field
-	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
-	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
-	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+func (f BucketStorageTally_BucketName_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Irreparabledb_Segmentpath_Field struct {
+func (BucketStorageTally_BucketName_Field) _Column() string { return "bucket_name" }
+
+type BucketStorageTally_IntervalEndTime_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value time.Time
 }
 
-func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
-	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+func BucketStorageTally_IntervalEndTime(v time.Time) BucketStorageTally_IntervalEndTime_Field {
+	return BucketStorageTally_IntervalEndTime_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+func (f BucketStorageTally_IntervalEndTime_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+func (BucketStorageTally_IntervalEndTime_Field) _Column() string { return "interval_end_time" }
 
-type Irreparabledb_Segmentdetail_Field struct {
+type BucketStorageTally_StoredBytes_Field struct {
 	_set   bool
 	_null  bool
-	_value []byte
+	_value int64
 }
 
-func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
-	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+func BucketStorageTally_StoredBytes(v int64) BucketStorageTally_StoredBytes_Field {
+	return BucketStorageTally_StoredBytes_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+func (f BucketStorageTally_StoredBytes_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+func (BucketStorageTally_StoredBytes_Field) _Column() string { return "stored_bytes" }
 
-type Irreparabledb_PiecesLostCount_Field struct {
+type BucketStorageTally_ObjectCount_Field struct {
 	_set   bool
 	_null  bool
 	_value int64
 }
 
-func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
-	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+func BucketStorageTally_ObjectCount(v int64) BucketStorageTally_ObjectCount_Field {
+	return BucketStorageTally_ObjectCount_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+func (f BucketStorageTally_ObjectCount_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+func (BucketStorageTally_ObjectCount_Field) _Column() string { return "object_count" }
 
-type Irreparabledb_SegDamagedUnixSec_Field struct {
+type BucketStorageTally_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
-	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+func BucketStorageTally_CreatedAt(v time.Time) BucketStorageTally_CreatedAt_Field {
+	return BucketStorageTally_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+func (f BucketStorageTally_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+func (BucketStorageTally_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Irreparabledb_RepairAttemptCount_Field struct {
+type Bwagreement struct {
+	Signature []byte
+	Serialnum string
+	Data      []byte
+	CreatedAt time.Time
+	ExpiresAt time.Time
+}
+
+func (Bwagreement) _Table() string { return "bwagreements" }
+
+type Bwagreement_Update_Fields struct {
+}
+
+type Bwagreement_Signature_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value []byte
 }
 
-func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
-	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+func Bwagreement_Signature(v []byte) Bwagreement_Signature_Field {
+	return Bwagreement_Signature_Field{_set: true, _value: v}
 }
 
-func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+func (f Bwagreement_Signature_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+func (Bwagreement_Signature_Field) _Column() string { return "signature" }
 
-type Node struct {
-	Id                 []byte
-	AuditSuccessCount  int64
-	TotalAuditCount    int64
-	AuditSuccessRatio  float64
-	UptimeSuccessCount int64
-	TotalUptimeCount   int64
-	UptimeRatio        float64
-	CreatedAt          time.Time
-	UpdatedAt          time.Time
+type Bwagreement_Serialnum_Field struct {
+	_set   bool
+	_null  bool
+	_value string
 }
 
-func (Node) _Table() string { return "nodes" }
+func Bwagreement_Serialnum(v string) Bwagreement_Serialnum_Field {
+	return Bwagreement_Serialnum_Field{_set: true, _value: v}
+}
 
-type Node_Update_Fields struct {
-	AuditSuccessCount  Node_AuditSuccessCount_Field
-	TotalAuditCount    Node_TotalAuditCount_Field
-	AuditSuccessRatio  Node_AuditSuccessRatio_Field
-	UptimeSuccessCount Node_UptimeSuccessCount_Field
-	TotalUptimeCount   Node_TotalUptimeCount_Field
-	UptimeRatio        Node_UptimeRatio_Field
+func (f Bwagreement_Serialnum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
 }
 
-type Node_Id_Field struct {
+func (Bwagreement_Serialnum_Field) _Column() string { return "serialnum" }
+
+type Bwagreement_Data_Field struct {
 	_set   bool
 	_null  bool
 	_value []byte
 }
 
-func Node_Id(v []byte) Node_Id_Field {
-	return Node_Id_Field{_set: true, _value: v}
+func Bwagreement_Data(v []byte) Bwagreement_Data_Field {
+	return Bwagreement_Data_Field{_set: true, _value: v}
 }
 
-func (f Node_Id_Field) value() interface{} {
+func (f Bwagreement_Data_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_Id_Field) _Column() string { return "id" }
+func (Bwagreement_Data_Field) _Column() string { return "data" }
 
-type Node_AuditSuccessCount_Field struct {
+type Bwagreement_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
-	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+func Bwagreement_CreatedAt(v time.Time) Bwagreement_CreatedAt_Field {
+	return Bwagreement_CreatedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditSuccessCount_Field) value() interface{} {
+func (f Bwagreement_CreatedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+func (Bwagreement_CreatedAt_Field) _Column() string { return "created_at" }
 
-type Node_TotalAuditCount_Field struct {
+type Bwagreement_ExpiresAt_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value time.Time
 }
 
-func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
-	return Node_TotalAuditCount_Field{_set: true, _value: v}
+func Bwagreement_ExpiresAt(v time.Time) Bwagreement_ExpiresAt_Field {
+	return Bwagreement_ExpiresAt_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalAuditCount_Field) value() interface{} {
+func (f Bwagreement_ExpiresAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+func (Bwagreement_ExpiresAt_Field) _Column() string { return "expires_at" }
+
+type GracefulExit struct {
+	NodeId            []byte
+	PiecesTransferred int64
+	PiecesFailed      int64
+	Exited            bool
+	TransfersCursor   []byte
+	TransfersListed   bool
+	CreatedAt         time.Time
+	UpdatedAt         time.Time
+}
+
+func (GracefulExit) _Table() string { return "graceful_exits" }
+
+type GracefulExit_Update_Fields struct {
+	PiecesTransferred GracefulExit_PiecesTransferred_Field
+	PiecesFailed      GracefulExit_PiecesFailed_Field
+	Exited            GracefulExit_Exited_Field
+	TransfersCursor   GracefulExit_TransfersCursor_Field
+	TransfersListed   GracefulExit_TransfersListed_Field
+}
+
+type GracefulExit_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExit_NodeId(v []byte) GracefulExit_NodeId_Field {
+	return GracefulExit_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExit_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExit_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExit_PiecesTransferred_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExit_PiecesTransferred(v int64) GracefulExit_PiecesTransferred_Field {
+	return GracefulExit_PiecesTransferred_Field{_set: true, _value: v}
+}
+
+func (f GracefulExit_PiecesTransferred_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExit_PiecesTransferred_Field) _Column() string { return "pieces_transferred" }
+
+type GracefulExit_PiecesFailed_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func GracefulExit_PiecesFailed(v int64) GracefulExit_PiecesFailed_Field {
+	return GracefulExit_PiecesFailed_Field{_set: true, _value: v}
+}
+
+func (f GracefulExit_PiecesFailed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExit_PiecesFailed_Field) _Column() string { return "pieces_failed" }
+
+type GracefulExit_Exited_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func GracefulExit_Exited(v bool) GracefulExit_Exited_Field {
+	return GracefulExit_Exited_Field{_set: true, _value: v}
+}
+
+func (f GracefulExit_Exited_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExit_Exited_Field) _Column() string { return "exited" }
+
+type GracefulExit_TransfersCursor_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExit_TransfersCursor(v []byte) GracefulExit_TransfersCursor_Field {
+	return GracefulExit_TransfersCursor_Field{_set: true, _value: v}
+}
+
+func (f GracefulExit_TransfersCursor_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExit_TransfersCursor_Field) _Column() string { return "transfers_cursor" }
+
+type GracefulExit_TransfersListed_Field struct {
+	_set   bool
+	_null  bool
+	_value bool
+}
+
+func GracefulExit_TransfersListed(v bool) GracefulExit_TransfersListed_Field {
+	return GracefulExit_TransfersListed_Field{_set: true, _value: v}
+}
+
+func (f GracefulExit_TransfersListed_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExit_TransfersListed_Field) _Column() string { return "transfers_listed" }
+
+type GracefulExit_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExit_CreatedAt(v time.Time) GracefulExit_CreatedAt_Field {
+	return GracefulExit_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExit_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExit_CreatedAt_Field) _Column() string { return "created_at" }
+
+type GracefulExit_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExit_UpdatedAt(v time.Time) GracefulExit_UpdatedAt_Field {
+	return GracefulExit_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExit_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExit_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type GracefulExitTransfer struct {
+	NodeId    []byte
+	Path      []byte
+	PieceNum  int
+	TargetId  []byte
+	State     int
+	CreatedAt time.Time
+	UpdatedAt time.Time
+}
+
+func (GracefulExitTransfer) _Table() string { return "graceful_exit_transfers" }
+
+type GracefulExitTransfer_Update_Fields struct {
+	State GracefulExitTransfer_State_Field
+}
+
+type GracefulExitTransfer_NodeId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitTransfer_NodeId(v []byte) GracefulExitTransfer_NodeId_Field {
+	return GracefulExitTransfer_NodeId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransfer_NodeId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransfer_NodeId_Field) _Column() string { return "node_id" }
+
+type GracefulExitTransfer_Path_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitTransfer_Path(v []byte) GracefulExitTransfer_Path_Field {
+	return GracefulExitTransfer_Path_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransfer_Path_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransfer_Path_Field) _Column() string { return "path" }
+
+type GracefulExitTransfer_PieceNum_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitTransfer_PieceNum(v int) GracefulExitTransfer_PieceNum_Field {
+	return GracefulExitTransfer_PieceNum_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransfer_PieceNum_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransfer_PieceNum_Field) _Column() string { return "piece_num" }
+
+type GracefulExitTransfer_TargetId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func GracefulExitTransfer_TargetId(v []byte) GracefulExitTransfer_TargetId_Field {
+	return GracefulExitTransfer_TargetId_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransfer_TargetId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransfer_TargetId_Field) _Column() string { return "target_id" }
+
+type GracefulExitTransfer_State_Field struct {
+	_set   bool
+	_null  bool
+	_value int
+}
+
+func GracefulExitTransfer_State(v int) GracefulExitTransfer_State_Field {
+	return GracefulExitTransfer_State_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransfer_State_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransfer_State_Field) _Column() string { return "state" }
+
+type GracefulExitTransfer_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitTransfer_CreatedAt(v time.Time) GracefulExitTransfer_CreatedAt_Field {
+	return GracefulExitTransfer_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransfer_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransfer_CreatedAt_Field) _Column() string { return "created_at" }
+
+type GracefulExitTransfer_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func GracefulExitTransfer_UpdatedAt(v time.Time) GracefulExitTransfer_UpdatedAt_Field {
+	return GracefulExitTransfer_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f GracefulExitTransfer_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (GracefulExitTransfer_UpdatedAt_Field) _Column() string { return "updated_at" }
+
+type Injuredsegment struct {
+	Id   int64
+	Info []byte
+}
+
+func (Injuredsegment) _Table() string { return "injuredsegments" }
+
+type Injuredsegment_Update_Fields struct {
+}
+
+type Injuredsegment_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Injuredsegment_Id(v int64) Injuredsegment_Id_Field {
+	return Injuredsegment_Id_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Id_Field) _Column() string { return "id" }
+
+type Injuredsegment_Info_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Injuredsegment_Info(v []byte) Injuredsegment_Info_Field {
+	return Injuredsegment_Info_Field{_set: true, _value: v}
+}
+
+func (f Injuredsegment_Info_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Injuredsegment_Info_Field) _Column() string { return "info" }
+
+type Irreparabledb struct {
+	Segmentpath        []byte
+	Segmentdetail      []byte
+	PiecesLostCount    int64
+	SegDamagedUnixSec  int64
+	RepairAttemptCount int64
+}
+
+func (Irreparabledb) _Table() string { return "irreparabledbs" }
+
+type Irreparabledb_Update_Fields struct {
+	Segmentdetail      Irreparabledb_Segmentdetail_Field
+	PiecesLostCount    Irreparabledb_PiecesLostCount_Field
+	SegDamagedUnixSec  Irreparabledb_SegDamagedUnixSec_Field
+	RepairAttemptCount Irreparabledb_RepairAttemptCount_Field
+}
+
+type Irreparabledb_Segmentpath_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentpath(v []byte) Irreparabledb_Segmentpath_Field {
+	return Irreparabledb_Segmentpath_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentpath_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentpath_Field) _Column() string { return "segmentpath" }
+
+type Irreparabledb_Segmentdetail_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Irreparabledb_Segmentdetail(v []byte) Irreparabledb_Segmentdetail_Field {
+	return Irreparabledb_Segmentdetail_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_Segmentdetail_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_Segmentdetail_Field) _Column() string { return "segmentdetail" }
+
+type Irreparabledb_PiecesLostCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_PiecesLostCount(v int64) Irreparabledb_PiecesLostCount_Field {
+	return Irreparabledb_PiecesLostCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_PiecesLostCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_PiecesLostCount_Field) _Column() string { return "pieces_lost_count" }
+
+type Irreparabledb_SegDamagedUnixSec_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_SegDamagedUnixSec(v int64) Irreparabledb_SegDamagedUnixSec_Field {
+	return Irreparabledb_SegDamagedUnixSec_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_SegDamagedUnixSec_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_SegDamagedUnixSec_Field) _Column() string { return "seg_damaged_unix_sec" }
+
+type Irreparabledb_RepairAttemptCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Irreparabledb_RepairAttemptCount(v int64) Irreparabledb_RepairAttemptCount_Field {
+	return Irreparabledb_RepairAttemptCount_Field{_set: true, _value: v}
+}
+
+func (f Irreparabledb_RepairAttemptCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Irreparabledb_RepairAttemptCount_Field) _Column() string { return "repair_attempt_count" }
+
+type Node struct {
+	Id                    []byte
+	AuditSuccessCount     int64
+	TotalAuditCount       int64
+	AuditSuccessRatio     float64
+	UptimeSuccessCount    int64
+	TotalUptimeCount      int64
+	UptimeRatio           float64
+	AuditReputationAlpha  float64
+	AuditReputationBeta   float64
+	UptimeReputationAlpha float64
+	UptimeReputationBeta  float64
+	State                 int
+	StateReason           string
+	StateChangedAt        time.Time
+	CreatedAt             time.Time
+	UpdatedAt             time.Time
+}
+
+func (Node) _Table() string { return "nodes" }
+
+type Node_Update_Fields struct {
+	AuditSuccessCount     Node_AuditSuccessCount_Field
+	TotalAuditCount       Node_TotalAuditCount_Field
+	AuditSuccessRatio     Node_AuditSuccessRatio_Field
+	UptimeSuccessCount    Node_UptimeSuccessCount_Field
+	TotalUptimeCount      Node_TotalUptimeCount_Field
+	UptimeRatio           Node_UptimeRatio_Field
+	AuditReputationAlpha  Node_AuditReputationAlpha_Field
+	AuditReputationBeta   Node_AuditReputationBeta_Field
+	UptimeReputationAlpha Node_UptimeReputationAlpha_Field
+	UptimeReputationBeta  Node_UptimeReputationBeta_Field
+	State                 Node_State_Field
+	StateReason           Node_StateReason_Field
+	StateChangedAt        Node_StateChangedAt_Field
+}
+
+type Node_Id_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Node_Id(v []byte) Node_Id_Field {
+	return Node_Id_Field{_set: true, _value: v}
+}
+
+func (f Node_Id_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_Id_Field) _Column() string { return "id" }
+
+type Node_AuditSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_AuditSuccessCount(v int64) Node_AuditSuccessCount_Field {
+	return Node_AuditSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditSuccessCount_Field) _Column() string { return "audit_success_count" }
+
+type Node_TotalAuditCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_TotalAuditCount(v int64) Node_TotalAuditCount_Field {
+	return Node_TotalAuditCount_Field{_set: true, _value: v}
+}
+
+func (f Node_TotalAuditCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_TotalAuditCount_Field) _Column() string { return "total_audit_count" }
+
+type Node_AuditSuccessRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditSuccessRatio(v float64) Node_AuditSuccessRatio_Field {
+	return Node_AuditSuccessRatio_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditSuccessRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+
+type Node_UptimeSuccessCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
+	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeSuccessCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+
+type Node_TotalUptimeCount_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
+	return Node_TotalUptimeCount_Field{_set: true, _value: v}
+}
+
+func (f Node_TotalUptimeCount_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
+
+type Node_UptimeRatio_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeRatio(v float64) Node_UptimeRatio_Field {
+	return Node_UptimeRatio_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeRatio_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeRatio_Field) _Column() string { return "uptime_ratio" }
+
+type Node_AuditReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditReputationAlpha(v float64) Node_AuditReputationAlpha_Field {
+	return Node_AuditReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationAlpha_Field) _Column() string { return "audit_reputation_alpha" }
+
+type Node_AuditReputationBeta_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_AuditReputationBeta(v float64) Node_AuditReputationBeta_Field {
+	return Node_AuditReputationBeta_Field{_set: true, _value: v}
+}
+
+func (f Node_AuditReputationBeta_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_AuditReputationBeta_Field) _Column() string { return "audit_reputation_beta" }
+
+type Node_UptimeReputationAlpha_Field struct {
+	_set   bool
+	_null  bool
+	_value float64
+}
+
+func Node_UptimeReputationAlpha(v float64) Node_UptimeReputationAlpha_Field {
+	return Node_UptimeReputationAlpha_Field{_set: true, _value: v}
+}
+
+func (f Node_UptimeReputationAlpha_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Node_UptimeReputationAlpha_Field) _Column() string { return "uptime_reputation_alpha" }
 
-type Node_AuditSuccessRatio_Field struct {
+type Node_UptimeReputationBeta_Field struct {
 	_set   bool
 	_null  bool
 	_value float64
 }
 
-func Node_AuditSuccessRatio(v float64) Node_AuditSuccessRatio_Field {
-	return Node_AuditSuccessRatio_Field{_set: true, _value: v}
+func Node_UptimeReputationBeta(v float64) Node_UptimeReputationBeta_Field {
+	return Node_UptimeReputationBeta_Field{_set: true, _value: v}
 }
 
-func (f Node_AuditSuccessRatio_Field) value() interface{} {
+func (f Node_UptimeReputationBeta_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_AuditSuccessRatio_Field) _Column() string { return "audit_success_ratio" }
+func (Node_UptimeReputationBeta_Field) _Column() string { return "uptime_reputation_beta" }
 
-type Node_UptimeSuccessCount_Field struct {
+type Node_State_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value int
 }
 
-func Node_UptimeSuccessCount(v int64) Node_UptimeSuccessCount_Field {
-	return Node_UptimeSuccessCount_Field{_set: true, _value: v}
+func Node_State(v int) Node_State_Field {
+	return Node_State_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeSuccessCount_Field) value() interface{} {
+func (f Node_State_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
+func (Node_State_Field) _Column() string { return "state" }
 
-type Node_TotalUptimeCount_Field struct {
+type Node_StateReason_Field struct {
 	_set   bool
 	_null  bool
-	_value int64
+	_value string
 }
 
-func Node_TotalUptimeCount(v int64) Node_TotalUptimeCount_Field {
-	return Node_TotalUptimeCount_Field{_set: true, _value: v}
+func Node_StateReason(v string) Node_StateReason_Field {
+	return Node_StateReason_Field{_set: true, _value: v}
 }
 
-func (f Node_TotalUptimeCount_Field) value() interface{} {
+func (f Node_StateReason_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_TotalUptimeCount_Field) _Column() string { return "total_uptime_count" }
+func (Node_StateReason_Field) _Column() string { return "state_reason" }
 
-type Node_UptimeRatio_Field struct {
+type Node_StateChangedAt_Field struct {
 	_set   bool
 	_null  bool
-	_value float64
+	_value time.Time
 }
 
-func Node_UptimeRatio(v float64) Node_UptimeRatio_Field {
-	return Node_UptimeRatio_Field{_set: true, _value: v}
+func Node_StateChangedAt(v time.Time) Node_StateChangedAt_Field {
+	return Node_StateChangedAt_Field{_set: true, _value: v}
 }
 
-func (f Node_UptimeRatio_Field) value() interface{} {
+func (f Node_StateChangedAt_Field) value() interface{} {
 	if !f._set || f._null {
 		return nil
 	}
 	return f._value
 }
 
-func (Node_UptimeRatio_Field) _Column() string { return "uptime_ratio" }
+func (Node_StateChangedAt_Field) _Column() string { return "state_changed_at" }
 
 type Node_CreatedAt_Field struct {
 	_set   bool
@@ -1479,6 +2360,7 @@ type OverlayCacheNode struct {
 	AuditSuccessCount  int64
 	UptimeCount        int64
 	UptimeSuccessCount int64
+	LastNet            string
 }
 
 func (OverlayCacheNode) _Table() string { return "overlay_cache_nodes" }
@@ -1497,6 +2379,7 @@ type OverlayCacheNode_Update_Fields struct {
 	AuditSuccessCount  OverlayCacheNode_AuditSuccessCount_Field
 	UptimeCount        OverlayCacheNode_UptimeCount_Field
 	UptimeSuccessCount OverlayCacheNode_UptimeSuccessCount_Field
+	LastNet            OverlayCacheNode_LastNet_Field
 }
 
 type OverlayCacheNode_NodeId_Field struct {
@@ -1784,11 +2667,31 @@ func (f OverlayCacheNode_UptimeSuccessCount_Field) value() interface{} {
 
 func (OverlayCacheNode_UptimeSuccessCount_Field) _Column() string { return "uptime_success_count" }
 
+type OverlayCacheNode_LastNet_Field struct {
+	_set   bool
+	_null  bool
+	_value string
+}
+
+func OverlayCacheNode_LastNet(v string) OverlayCacheNode_LastNet_Field {
+	return OverlayCacheNode_LastNet_Field{_set: true, _value: v}
+}
+
+func (f OverlayCacheNode_LastNet_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (OverlayCacheNode_LastNet_Field) _Column() string { return "last_net" }
+
 type Project struct {
 	Id            []byte
 	Name          string
 	Description   string
 	TermsAccepted int
+	OwnerId       []byte
 	CreatedAt     time.Time
 }
 
@@ -1875,6 +2778,25 @@ func (f Project_TermsAccepted_Field) value() interface{} {
 
 func (Project_TermsAccepted_Field) _Column() string { return "terms_accepted" }
 
+type Project_OwnerId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func Project_OwnerId(v []byte) Project_OwnerId_Field {
+	return Project_OwnerId_Field{_set: true, _value: v}
+}
+
+func (f Project_OwnerId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (Project_OwnerId_Field) _Column() string { return "owner_id" }
+
 type Project_CreatedAt_Field struct {
 	_set   bool
 	_null  bool
@@ -2203,6 +3125,116 @@ func (f BucketInfo_CreatedAt_Field) value() interface{} {
 
 func (BucketInfo_CreatedAt_Field) _Column() string { return "created_at" }
 
+type ProjectLimit struct {
+	ProjectId    []byte
+	StorageLimit int64
+	EgressLimit  int64
+	CreatedAt    time.Time
+	UpdatedAt    time.Time
+}
+
+func (ProjectLimit) _Table() string { return "project_limits" }
+
+type ProjectLimit_Update_Fields struct {
+	StorageLimit ProjectLimit_StorageLimit_Field
+	EgressLimit  ProjectLimit_EgressLimit_Field
+}
+
+type ProjectLimit_ProjectId_Field struct {
+	_set   bool
+	_null  bool
+	_value []byte
+}
+
+func ProjectLimit_ProjectId(v []byte) ProjectLimit_ProjectId_Field {
+	return ProjectLimit_ProjectId_Field{_set: true, _value: v}
+}
+
+func (f ProjectLimit_ProjectId_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectLimit_ProjectId_Field) _Column() string { return "project_id" }
+
+type ProjectLimit_StorageLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectLimit_StorageLimit(v int64) ProjectLimit_StorageLimit_Field {
+	return ProjectLimit_StorageLimit_Field{_set: true, _value: v}
+}
+
+func (f ProjectLimit_StorageLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectLimit_StorageLimit_Field) _Column() string { return "storage_limit" }
+
+type ProjectLimit_EgressLimit_Field struct {
+	_set   bool
+	_null  bool
+	_value int64
+}
+
+func ProjectLimit_EgressLimit(v int64) ProjectLimit_EgressLimit_Field {
+	return ProjectLimit_EgressLimit_Field{_set: true, _value: v}
+}
+
+func (f ProjectLimit_EgressLimit_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectLimit_EgressLimit_Field) _Column() string { return "egress_limit" }
+
+type ProjectLimit_CreatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectLimit_CreatedAt(v time.Time) ProjectLimit_CreatedAt_Field {
+	return ProjectLimit_CreatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectLimit_CreatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectLimit_CreatedAt_Field) _Column() string { return "created_at" }
+
+type ProjectLimit_UpdatedAt_Field struct {
+	_set   bool
+	_null  bool
+	_value time.Time
+}
+
+func ProjectLimit_UpdatedAt(v time.Time) ProjectLimit_UpdatedAt_Field {
+	return ProjectLimit_UpdatedAt_Field{_set: true, _value: v}
+}
+
+func (f ProjectLimit_UpdatedAt_Field) value() interface{} {
+	if !f._set || f._null {
+		return nil
+	}
+	return f._value
+}
+
+func (ProjectLimit_UpdatedAt_Field) _Column() string { return "updated_at" }
+
 type ProjectMember struct {
 	MemberId  []byte
 	ProjectId []byte
@@ -2601,6 +3633,66 @@ func (obj *postgresImpl) Create_AccountingRaw(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Create_BucketStorageTally(ctx context.Context,
+	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
+	bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
+	bucket_storage_tally_interval_end_time BucketStorageTally_IntervalEndTime_Field,
+	bucket_storage_tally_stored_bytes BucketStorageTally_StoredBytes_Field,
+	bucket_storage_tally_object_count BucketStorageTally_ObjectCount_Field) (
+	bucket_storage_tally *BucketStorageTally, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_storage_tally_project_id.value()
+	__bucket_name_val := bucket_storage_tally_bucket_name.value()
+	__interval_end_time_val := bucket_storage_tally_interval_end_time.value()
+	__stored_bytes_val := bucket_storage_tally_stored_bytes.value()
+	__object_count_val := bucket_storage_tally_object_count.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_storage_tallies ( project_id, bucket_name, interval_end_time, stored_bytes, object_count, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING bucket_storage_tallies.id, bucket_storage_tallies.project_id, bucket_storage_tallies.bucket_name, bucket_storage_tallies.interval_end_time, bucket_storage_tallies.stored_bytes, bucket_storage_tallies.object_count, bucket_storage_tallies.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __interval_end_time_val, __stored_bytes_val, __object_count_val, __created_at_val)
+
+	bucket_storage_tally = &BucketStorageTally{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __interval_end_time_val, __stored_bytes_val, __object_count_val, __created_at_val).Scan(&bucket_storage_tally.Id, &bucket_storage_tally.ProjectId, &bucket_storage_tally.BucketName, &bucket_storage_tally.IntervalEndTime, &bucket_storage_tally.StoredBytes, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_storage_tally, nil
+
+}
+
+func (obj *postgresImpl) Create_BucketBandwidthTally(ctx context.Context,
+	bucket_bandwidth_tally_project_id BucketBandwidthTally_ProjectId_Field,
+	bucket_bandwidth_tally_bucket_name BucketBandwidthTally_BucketName_Field,
+	bucket_bandwidth_tally_interval_end_time BucketBandwidthTally_IntervalEndTime_Field,
+	bucket_bandwidth_tally_action BucketBandwidthTally_Action_Field,
+	bucket_bandwidth_tally_total BucketBandwidthTally_Total_Field) (
+	bucket_bandwidth_tally *BucketBandwidthTally, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_bandwidth_tally_project_id.value()
+	__bucket_name_val := bucket_bandwidth_tally_bucket_name.value()
+	__interval_end_time_val := bucket_bandwidth_tally_interval_end_time.value()
+	__action_val := bucket_bandwidth_tally_action.value()
+	__total_val := bucket_bandwidth_tally_total.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_bandwidth_tallies ( project_id, bucket_name, interval_end_time, action, total, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING bucket_bandwidth_tallies.id, bucket_bandwidth_tallies.project_id, bucket_bandwidth_tallies.bucket_name, bucket_bandwidth_tallies.interval_end_time, bucket_bandwidth_tallies.action, bucket_bandwidth_tallies.total, bucket_bandwidth_tallies.created_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __interval_end_time_val, __action_val, __total_val, __created_at_val)
+
+	bucket_bandwidth_tally = &BucketBandwidthTally{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __bucket_name_val, __interval_end_time_val, __action_val, __total_val, __created_at_val).Scan(&bucket_bandwidth_tally.Id, &bucket_bandwidth_tally.ProjectId, &bucket_bandwidth_tally.BucketName, &bucket_bandwidth_tally.IntervalEndTime, &bucket_bandwidth_tally.Action, &bucket_bandwidth_tally.Total, &bucket_bandwidth_tally.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_bandwidth_tally, nil
+
+}
+
 func (obj *postgresImpl) Create_Node(ctx context.Context,
 	node_id Node_Id_Field,
 	node_audit_success_count Node_AuditSuccessCount_Field,
@@ -2608,7 +3700,14 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	node_audit_success_ratio Node_AuditSuccessRatio_Field,
 	node_uptime_success_count Node_UptimeSuccessCount_Field,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
-	node_uptime_ratio Node_UptimeRatio_Field) (
+	node_uptime_ratio Node_UptimeRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	node_state Node_State_Field,
+	node_state_reason Node_StateReason_Field,
+	node_state_changed_at Node_StateChangedAt_Field) (
 	node *Node, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -2619,16 +3718,23 @@ func (obj *postgresImpl) Create_Node(ctx context.Context,
 	__uptime_success_count_val := node_uptime_success_count.value()
 	__total_uptime_count_val := node_total_uptime_count.value()
 	__uptime_ratio_val := node_uptime_ratio.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__state_val := node_state.value()
+	__state_reason_val := node_state_reason.value()
+	__state_changed_at_val := node_state_changed_at.value()
 	__created_at_val := __now
 	__updated_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, state, state_reason, state_changed_at, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.state, nodes.state_reason, nodes.state_changed_at, nodes.created_at, nodes.updated_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val)
+	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __state_val, __state_reason_val, __state_changed_at_val, __created_at_val, __updated_at_val)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __state_val, __state_reason_val, __state_changed_at_val, __created_at_val, __updated_at_val).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.State, &node.StateReason, &node.StateChangedAt, &node.CreatedAt, &node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -2651,7 +3757,8 @@ func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
 	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
 	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
 	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
-	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
+	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+	overlay_cache_node_last_net OverlayCacheNode_LastNet_Field) (
 	overlay_cache_node *OverlayCacheNode, err error) {
 	__node_id_val := overlay_cache_node_node_id.value()
 	__node_type_val := overlay_cache_node_node_type.value()
@@ -2668,14 +3775,15 @@ func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
 	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
 	__uptime_count_val := overlay_cache_node_uptime_count.value()
 	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+	__last_net_val := overlay_cache_node_last_net.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count, last_net ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.last_net")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val)
+	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __last_net_val)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __last_net_val).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.LastNet)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -2683,6 +3791,39 @@ func (obj *postgresImpl) Create_OverlayCacheNode(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Create_GracefulExit(ctx context.Context,
+	graceful_exit_node_id GracefulExit_NodeId_Field,
+	graceful_exit_pieces_transferred GracefulExit_PiecesTransferred_Field,
+	graceful_exit_pieces_failed GracefulExit_PiecesFailed_Field,
+	graceful_exit_exited GracefulExit_Exited_Field,
+	graceful_exit_transfers_cursor GracefulExit_TransfersCursor_Field,
+	graceful_exit_transfers_listed GracefulExit_TransfersListed_Field) (
+	graceful_exit *GracefulExit, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := graceful_exit_node_id.value()
+	__pieces_transferred_val := graceful_exit_pieces_transferred.value()
+	__pieces_failed_val := graceful_exit_pieces_failed.value()
+	__exited_val := graceful_exit_exited.value()
+	__transfers_cursor_val := graceful_exit_transfers_cursor.value()
+	__transfers_listed_val := graceful_exit_transfers_listed.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO graceful_exits ( node_id, pieces_transferred, pieces_failed, exited, transfers_cursor, transfers_listed, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? ) RETURNING graceful_exits.node_id, graceful_exits.pieces_transferred, graceful_exits.pieces_failed, graceful_exits.exited, graceful_exits.transfers_cursor, graceful_exits.transfers_listed, graceful_exits.created_at, graceful_exits.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __pieces_transferred_val, __pieces_failed_val, __exited_val, __transfers_cursor_val, __transfers_listed_val, __created_at_val, __updated_at_val)
+
+	graceful_exit = &GracefulExit{}
+	err = obj.driver.QueryRow(__stmt, __node_id_val, __pieces_transferred_val, __pieces_failed_val, __exited_val, __transfers_cursor_val, __transfers_listed_val, __created_at_val, __updated_at_val).Scan(&graceful_exit.NodeId, &graceful_exit.PiecesTransferred, &graceful_exit.PiecesFailed, &graceful_exit.Exited, &graceful_exit.TransfersCursor, &graceful_exit.TransfersListed, &graceful_exit.CreatedAt, &graceful_exit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return graceful_exit, nil
+
+}
+
 func (obj *postgresImpl) Create_Injuredsegment(ctx context.Context,
 	injuredsegment_info Injuredsegment_Info_Field) (
 	injuredsegment *Injuredsegment, err error) {
@@ -2736,7 +3877,8 @@ func (obj *postgresImpl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
 	project_description Project_Description_Field,
-	project_terms_accepted Project_TermsAccepted_Field) (
+	project_terms_accepted Project_TermsAccepted_Field,
+	project_owner_id Project_OwnerId_Field) (
 	project *Project, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -2744,15 +3886,16 @@ func (obj *postgresImpl) Create_Project(ctx context.Context,
 	__name_val := project_name.value()
 	__description_val := project_description.value()
 	__terms_accepted_val := project_terms_accepted.value()
+	__owner_id_val := project_owner_id.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, terms_accepted, created_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, terms_accepted, owner_id, created_at ) VALUES ( ?, ?, ?, ?, ?, ? ) RETURNING projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __terms_accepted_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __terms_accepted_val, __owner_id_val, __created_at_val)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __terms_accepted_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __id_val, __name_val, __description_val, __terms_accepted_val, __owner_id_val, __created_at_val).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -2836,6 +3979,54 @@ func (obj *postgresImpl) Create_BucketInfo(ctx context.Context,
 
 }
 
+func (obj *postgresImpl) Create_ProjectLimit(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	project_limit_storage_limit ProjectLimit_StorageLimit_Field,
+	project_limit_egress_limit ProjectLimit_EgressLimit_Field) (
+	project_limit *ProjectLimit, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_limit_project_id.value()
+	__storage_limit_val := project_limit_storage_limit.value()
+	__egress_limit_val := project_limit_egress_limit.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_limits ( project_id, storage_limit, egress_limit, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ? ) RETURNING project_limits.project_id, project_limits.storage_limit, project_limits.egress_limit, project_limits.created_at, project_limits.updated_at")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __storage_limit_val, __egress_limit_val, __created_at_val, __updated_at_val)
+
+	project_limit = &ProjectLimit{}
+	err = obj.driver.QueryRow(__stmt, __project_id_val, __storage_limit_val, __egress_limit_val, __created_at_val, __updated_at_val).Scan(&project_limit.ProjectId, &project_limit.StorageLimit, &project_limit.EgressLimit, &project_limit.CreatedAt, &project_limit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_limit, nil
+
+}
+
+func (obj *postgresImpl) Get_Bwagreement_By_Serialnum(ctx context.Context,
+	bwagreement_serialnum Bwagreement_Serialnum_Field) (
+	bwagreement *Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.serialnum, bwagreements.data, bwagreements.created_at, bwagreements.expires_at FROM bwagreements WHERE bwagreements.serialnum = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_serialnum.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement = &Bwagreement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement.Signature, &bwagreement.Serialnum, &bwagreement.Data, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement, nil
+
+}
+
 func (obj *postgresImpl) Get_Bwagreement_By_Signature(ctx context.Context,
 	bwagreement_signature Bwagreement_Signature_Field) (
 	bwagreement *Bwagreement, err error) {
@@ -3073,17 +4264,85 @@ func (obj *postgresImpl) Get_AccountingRaw_By_Id(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return accounting_raw, nil
+	return accounting_raw, nil
+
+}
+
+func (obj *postgresImpl) All_AccountingRaw(ctx context.Context) (
+	rows []*AccountingRaw, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_raw := &AccountingRaw{}
+		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_raw)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *postgresImpl) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx context.Context,
+	accounting_raw_interval_end_time_greater_or_equal AccountingRaw_IntervalEndTime_Field) (
+	rows []*AccountingRaw, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.interval_end_time >= ?")
+
+	var __values []interface{}
+	__values = append(__values, accounting_raw_interval_end_time_greater_or_equal.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		accounting_raw := &AccountingRaw{}
+		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, accounting_raw)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
 
 }
 
-func (obj *postgresImpl) All_AccountingRaw(ctx context.Context) (
-	rows []*AccountingRaw, err error) {
+func (obj *postgresImpl) All_BucketStorageTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx context.Context,
+	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
+	bucket_storage_tally_interval_end_time_greater_or_equal BucketStorageTally_IntervalEndTime_Field,
+	bucket_storage_tally_interval_end_time_less BucketStorageTally_IntervalEndTime_Field) (
+	rows []*BucketStorageTally, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.id, bucket_storage_tallies.project_id, bucket_storage_tallies.bucket_name, bucket_storage_tallies.interval_end_time, bucket_storage_tallies.stored_bytes, bucket_storage_tallies.object_count, bucket_storage_tallies.created_at FROM bucket_storage_tallies WHERE bucket_storage_tallies.project_id = ? AND bucket_storage_tallies.interval_end_time >= ? AND bucket_storage_tallies.interval_end_time < ? ORDER BY bucket_storage_tallies.interval_end_time")
 
 	var __values []interface{}
-	__values = append(__values)
+	__values = append(__values, bucket_storage_tally_project_id.value(), bucket_storage_tally_interval_end_time_greater_or_equal.value(), bucket_storage_tally_interval_end_time_less.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -3095,12 +4354,12 @@ func (obj *postgresImpl) All_AccountingRaw(ctx context.Context) (
 	defer __rows.Close()
 
 	for __rows.Next() {
-		accounting_raw := &AccountingRaw{}
-		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+		bucket_storage_tally := &BucketStorageTally{}
+		err = __rows.Scan(&bucket_storage_tally.Id, &bucket_storage_tally.ProjectId, &bucket_storage_tally.BucketName, &bucket_storage_tally.IntervalEndTime, &bucket_storage_tally.StoredBytes, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, accounting_raw)
+		rows = append(rows, bucket_storage_tally)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -3109,14 +4368,16 @@ func (obj *postgresImpl) All_AccountingRaw(ctx context.Context) (
 
 }
 
-func (obj *postgresImpl) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx context.Context,
-	accounting_raw_interval_end_time_greater_or_equal AccountingRaw_IntervalEndTime_Field) (
-	rows []*AccountingRaw, err error) {
+func (obj *postgresImpl) All_BucketBandwidthTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx context.Context,
+	bucket_bandwidth_tally_project_id BucketBandwidthTally_ProjectId_Field,
+	bucket_bandwidth_tally_interval_end_time_greater_or_equal BucketBandwidthTally_IntervalEndTime_Field,
+	bucket_bandwidth_tally_interval_end_time_less BucketBandwidthTally_IntervalEndTime_Field) (
+	rows []*BucketBandwidthTally, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT accounting_raws.id, accounting_raws.node_id, accounting_raws.interval_end_time, accounting_raws.data_total, accounting_raws.data_type, accounting_raws.created_at FROM accounting_raws WHERE accounting_raws.interval_end_time >= ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_tallies.id, bucket_bandwidth_tallies.project_id, bucket_bandwidth_tallies.bucket_name, bucket_bandwidth_tallies.interval_end_time, bucket_bandwidth_tallies.action, bucket_bandwidth_tallies.total, bucket_bandwidth_tallies.created_at FROM bucket_bandwidth_tallies WHERE bucket_bandwidth_tallies.project_id = ? AND bucket_bandwidth_tallies.interval_end_time >= ? AND bucket_bandwidth_tallies.interval_end_time < ? ORDER BY bucket_bandwidth_tallies.interval_end_time")
 
 	var __values []interface{}
-	__values = append(__values, accounting_raw_interval_end_time_greater_or_equal.value())
+	__values = append(__values, bucket_bandwidth_tally_project_id.value(), bucket_bandwidth_tally_interval_end_time_greater_or_equal.value(), bucket_bandwidth_tally_interval_end_time_less.value())
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
@@ -3128,12 +4389,12 @@ func (obj *postgresImpl) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx
 	defer __rows.Close()
 
 	for __rows.Next() {
-		accounting_raw := &AccountingRaw{}
-		err = __rows.Scan(&accounting_raw.Id, &accounting_raw.NodeId, &accounting_raw.IntervalEndTime, &accounting_raw.DataTotal, &accounting_raw.DataType, &accounting_raw.CreatedAt)
+		bucket_bandwidth_tally := &BucketBandwidthTally{}
+		err = __rows.Scan(&bucket_bandwidth_tally.Id, &bucket_bandwidth_tally.ProjectId, &bucket_bandwidth_tally.BucketName, &bucket_bandwidth_tally.IntervalEndTime, &bucket_bandwidth_tally.Action, &bucket_bandwidth_tally.Total, &bucket_bandwidth_tally.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
-		rows = append(rows, accounting_raw)
+		rows = append(rows, bucket_bandwidth_tally)
 	}
 	if err := __rows.Err(); err != nil {
 		return nil, obj.makeErr(err)
@@ -3146,7 +4407,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.state, nodes.state_reason, nodes.state_changed_at, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -3155,7 +4416,7 @@ func (obj *postgresImpl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.State, &node.StateReason, &node.StateChangedAt, &node.CreatedAt, &node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3233,7 +4494,7 @@ func (obj *postgresImpl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
 	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
 	overlay_cache_node *OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.last_net FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, overlay_cache_node_node_id.value())
@@ -3242,7 +4503,7 @@ func (obj *postgresImpl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.LastNet)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3276,7 +4537,7 @@ func (obj *postgresImpl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx c
 	limit int, offset int64) (
 	rows []*OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.last_net FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, overlay_cache_node_node_id_greater_or_equal.value())
@@ -3294,7 +4555,7 @@ func (obj *postgresImpl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx c
 
 	for __rows.Next() {
 		overlay_cache_node := &OverlayCacheNode{}
-		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.LastNet)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -3307,6 +4568,59 @@ func (obj *postgresImpl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx c
 
 }
 
+func (obj *postgresImpl) Get_GracefulExit_By_NodeId(ctx context.Context,
+	graceful_exit_node_id GracefulExit_NodeId_Field) (
+	graceful_exit *GracefulExit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exits.node_id, graceful_exits.pieces_transferred, graceful_exits.pieces_failed, graceful_exits.exited, graceful_exits.transfers_cursor, graceful_exits.transfers_listed, graceful_exits.created_at, graceful_exits.updated_at FROM graceful_exits WHERE graceful_exits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	graceful_exit = &GracefulExit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&graceful_exit.NodeId, &graceful_exit.PiecesTransferred, &graceful_exit.PiecesFailed, &graceful_exit.Exited, &graceful_exit.TransfersCursor, &graceful_exit.TransfersListed, &graceful_exit.CreatedAt, &graceful_exit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return graceful_exit, nil
+
+}
+
+func (obj *postgresImpl) All_GracefulExit(ctx context.Context) (
+	rows []*GracefulExit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exits.node_id, graceful_exits.pieces_transferred, graceful_exits.pieces_failed, graceful_exits.exited, graceful_exits.transfers_cursor, graceful_exits.transfers_listed, graceful_exits.created_at, graceful_exits.updated_at FROM graceful_exits")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		graceful_exit := &GracefulExit{}
+		err = __rows.Scan(&graceful_exit.NodeId, &graceful_exit.PiecesTransferred, &graceful_exit.PiecesFailed, &graceful_exit.Exited, &graceful_exit.TransfersCursor, &graceful_exit.TransfersListed, &graceful_exit.CreatedAt, &graceful_exit.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, graceful_exit)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
 func (obj *postgresImpl) First_Injuredsegment(ctx context.Context) (
 	injuredsegment *Injuredsegment, err error) {
 
@@ -3421,7 +4735,7 @@ func (obj *postgresImpl) Get_User_By_Id(ctx context.Context,
 func (obj *postgresImpl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -3437,7 +4751,7 @@ func (obj *postgresImpl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -3454,7 +4768,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -3463,7 +4777,7 @@ func (obj *postgresImpl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -3475,7 +4789,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -3491,7 +4805,7 @@ func (obj *postgresImpl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Proje
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -3702,6 +5016,27 @@ func (obj *postgresImpl) All_BucketInfo_By_ProjectId_OrderBy_Asc_Name(ctx contex
 
 }
 
+func (obj *postgresImpl) Get_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field) (
+	project_limit *ProjectLimit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_limits.project_id, project_limits.storage_limit, project_limits.egress_limit, project_limits.created_at, project_limits.updated_at FROM project_limits WHERE project_limits.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_limit_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_limit = &ProjectLimit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_limit.ProjectId, &project_limit.StorageLimit, &project_limit.EgressLimit, &project_limit.CreatedAt, &project_limit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_limit, nil
+
+}
+
 func (obj *postgresImpl) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 	update Irreparabledb_Update_Fields) (
@@ -3803,7 +5138,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	node *Node, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE nodes SET "), __sets, __sqlbundle_Literal(" WHERE nodes.id = ? RETURNING nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.state, nodes.state_reason, nodes.state_changed_at, nodes.created_at, nodes.updated_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -3839,6 +5174,41 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
 	}
 
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	if update.State._set {
+		__values = append(__values, update.State.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state = ?"))
+	}
+
+	if update.StateReason._set {
+		__values = append(__values, update.StateReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state_reason = ?"))
+	}
+
+	if update.StateChangedAt._set {
+		__values = append(__values, update.StateChangedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state_changed_at = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -3853,7 +5223,7 @@ func (obj *postgresImpl) Update_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.State, &node.StateReason, &node.StateChangedAt, &node.CreatedAt, &node.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -3869,7 +5239,7 @@ func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 	overlay_cache_node *OverlayCacheNode, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ? RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE overlay_cache_nodes SET "), __sets, __sqlbundle_Literal(" WHERE overlay_cache_nodes.node_id = ? RETURNING overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.last_net")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -3940,6 +5310,11 @@ func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
 	}
 
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
 	if len(__sets_sql.SQLs) == 0 {
 		return nil, emptyUpdate()
 	}
@@ -3953,7 +5328,7 @@ func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.LastNet)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -3963,6 +5338,67 @@ func (obj *postgresImpl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 	return overlay_cache_node, nil
 }
 
+func (obj *postgresImpl) Update_GracefulExit_By_NodeId(ctx context.Context,
+	graceful_exit_node_id GracefulExit_NodeId_Field,
+	update GracefulExit_Update_Fields) (
+	graceful_exit *GracefulExit, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE graceful_exits SET "), __sets, __sqlbundle_Literal(" WHERE graceful_exits.node_id = ? RETURNING graceful_exits.node_id, graceful_exits.pieces_transferred, graceful_exits.pieces_failed, graceful_exits.exited, graceful_exits.transfers_cursor, graceful_exits.transfers_listed, graceful_exits.created_at, graceful_exits.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.PiecesTransferred._set {
+		__values = append(__values, update.PiecesTransferred.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_transferred = ?"))
+	}
+
+	if update.PiecesFailed._set {
+		__values = append(__values, update.PiecesFailed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_failed = ?"))
+	}
+
+	if update.Exited._set {
+		__values = append(__values, update.Exited.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exited = ?"))
+	}
+
+	if update.TransfersCursor._set {
+		__values = append(__values, update.TransfersCursor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("transfers_cursor = ?"))
+	}
+
+	if update.TransfersListed._set {
+		__values = append(__values, update.TransfersListed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("transfers_listed = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, graceful_exit_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	graceful_exit = &GracefulExit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&graceful_exit.NodeId, &graceful_exit.PiecesTransferred, &graceful_exit.PiecesFailed, &graceful_exit.Exited, &graceful_exit.TransfersCursor, &graceful_exit.TransfersListed, &graceful_exit.CreatedAt, &graceful_exit.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return graceful_exit, nil
+}
+
 func (obj *postgresImpl) Update_User_By_Id(ctx context.Context,
 	user_id User_Id_Field,
 	update User_Update_Fields) (
@@ -4024,7 +5460,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	project *Project, err error) {
 	var __sets = &__sqlbundle_Hole{}
 
-	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at")}}
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE projects SET "), __sets, __sqlbundle_Literal(" WHERE projects.id = ? RETURNING projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at")}}
 
 	__sets_sql := __sqlbundle_Literals{Join: ", "}
 	var __values []interface{}
@@ -4053,7 +5489,7 @@ func (obj *postgresImpl) Update_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -4103,6 +5539,52 @@ func (obj *postgresImpl) Update_ApiKey_By_Id(ctx context.Context,
 	return api_key, nil
 }
 
+func (obj *postgresImpl) Update_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	update ProjectLimit_Update_Fields) (
+	project_limit *ProjectLimit, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_limits SET "), __sets, __sqlbundle_Literal(" WHERE project_limits.project_id = ? RETURNING project_limits.project_id, project_limits.storage_limit, project_limits.egress_limit, project_limits.created_at, project_limits.updated_at")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.EgressLimit._set {
+		__values = append(__values, update.EgressLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_limit = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, project_limit_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_limit = &ProjectLimit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_limit.ProjectId, &project_limit.StorageLimit, &project_limit.EgressLimit, &project_limit.CreatedAt, &project_limit.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_limit, nil
+}
+
 func (obj *postgresImpl) Delete_Bwagreement_By_Signature(ctx context.Context,
 	bwagreement_signature Bwagreement_Signature_Field) (
 	deleted bool, err error) {
@@ -4460,6 +5942,16 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_limits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -4540,6 +6032,26 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM graceful_exits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM graceful_exit_transfers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -4550,6 +6062,26 @@ func (obj *postgresImpl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_storage_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_bandwidth_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -4694,12 +6226,76 @@ func (obj *sqlite3Impl) Create_AccountingRollup(ctx context.Context,
 	__put_repair_total_val := accounting_rollup_put_repair_total.value()
 	__at_rest_total_val := accounting_rollup_at_rest_total.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_rollups ( node_id, start_time, put_total, get_total, get_audit_total, get_repair_total, put_repair_total, at_rest_total ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_rollups ( node_id, start_time, put_total, get_total, get_audit_total, get_repair_total, put_repair_total, at_rest_total ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAccountingRollup(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_AccountingRaw(ctx context.Context,
+	accounting_raw_node_id AccountingRaw_NodeId_Field,
+	accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
+	accounting_raw_data_total AccountingRaw_DataTotal_Field,
+	accounting_raw_data_type AccountingRaw_DataType_Field) (
+	accounting_raw *AccountingRaw, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := accounting_raw_node_id.value()
+	__interval_end_time_val := accounting_raw_interval_end_time.value()
+	__data_total_val := accounting_raw_data_total.value()
+	__data_type_val := accounting_raw_data_type.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_raws ( node_id, interval_end_time, data_total, data_type, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastAccountingRaw(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Create_BucketStorageTally(ctx context.Context,
+	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
+	bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
+	bucket_storage_tally_interval_end_time BucketStorageTally_IntervalEndTime_Field,
+	bucket_storage_tally_stored_bytes BucketStorageTally_StoredBytes_Field,
+	bucket_storage_tally_object_count BucketStorageTally_ObjectCount_Field) (
+	bucket_storage_tally *BucketStorageTally, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := bucket_storage_tally_project_id.value()
+	__bucket_name_val := bucket_storage_tally_bucket_name.value()
+	__interval_end_time_val := bucket_storage_tally_interval_end_time.value()
+	__stored_bytes_val := bucket_storage_tally_stored_bytes.value()
+	__object_count_val := bucket_storage_tally_object_count.value()
+	__created_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_storage_tallies ( project_id, bucket_name, interval_end_time, stored_bytes, object_count, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __interval_end_time_val, __stored_bytes_val, __object_count_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __start_time_val, __put_total_val, __get_total_val, __get_audit_total_val, __get_repair_total_val, __put_repair_total_val, __at_rest_total_val)
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __interval_end_time_val, __stored_bytes_val, __object_count_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4707,30 +6303,32 @@ func (obj *sqlite3Impl) Create_AccountingRollup(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastAccountingRollup(ctx, __pk)
+	return obj.getLastBucketStorageTally(ctx, __pk)
 
 }
 
-func (obj *sqlite3Impl) Create_AccountingRaw(ctx context.Context,
-	accounting_raw_node_id AccountingRaw_NodeId_Field,
-	accounting_raw_interval_end_time AccountingRaw_IntervalEndTime_Field,
-	accounting_raw_data_total AccountingRaw_DataTotal_Field,
-	accounting_raw_data_type AccountingRaw_DataType_Field) (
-	accounting_raw *AccountingRaw, err error) {
+func (obj *sqlite3Impl) Create_BucketBandwidthTally(ctx context.Context,
+	bucket_bandwidth_tally_project_id BucketBandwidthTally_ProjectId_Field,
+	bucket_bandwidth_tally_bucket_name BucketBandwidthTally_BucketName_Field,
+	bucket_bandwidth_tally_interval_end_time BucketBandwidthTally_IntervalEndTime_Field,
+	bucket_bandwidth_tally_action BucketBandwidthTally_Action_Field,
+	bucket_bandwidth_tally_total BucketBandwidthTally_Total_Field) (
+	bucket_bandwidth_tally *BucketBandwidthTally, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
-	__node_id_val := accounting_raw_node_id.value()
-	__interval_end_time_val := accounting_raw_interval_end_time.value()
-	__data_total_val := accounting_raw_data_total.value()
-	__data_type_val := accounting_raw_data_type.value()
+	__project_id_val := bucket_bandwidth_tally_project_id.value()
+	__bucket_name_val := bucket_bandwidth_tally_bucket_name.value()
+	__interval_end_time_val := bucket_bandwidth_tally_interval_end_time.value()
+	__action_val := bucket_bandwidth_tally_action.value()
+	__total_val := bucket_bandwidth_tally_total.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO accounting_raws ( node_id, interval_end_time, data_total, data_type, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO bucket_bandwidth_tallies ( project_id, bucket_name, interval_end_time, action, total, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+	obj.logStmt(__stmt, __project_id_val, __bucket_name_val, __interval_end_time_val, __action_val, __total_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __interval_end_time_val, __data_total_val, __data_type_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __bucket_name_val, __interval_end_time_val, __action_val, __total_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4738,7 +6336,7 @@ func (obj *sqlite3Impl) Create_AccountingRaw(ctx context.Context,
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return obj.getLastAccountingRaw(ctx, __pk)
+	return obj.getLastBucketBandwidthTally(ctx, __pk)
 
 }
 
@@ -4749,7 +6347,14 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	node_audit_success_ratio Node_AuditSuccessRatio_Field,
 	node_uptime_success_count Node_UptimeSuccessCount_Field,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
-	node_uptime_ratio Node_UptimeRatio_Field) (
+	node_uptime_ratio Node_UptimeRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	node_state Node_State_Field,
+	node_state_reason Node_StateReason_Field,
+	node_state_changed_at Node_StateChangedAt_Field) (
 	node *Node, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -4760,15 +6365,22 @@ func (obj *sqlite3Impl) Create_Node(ctx context.Context,
 	__uptime_success_count_val := node_uptime_success_count.value()
 	__total_uptime_count_val := node_total_uptime_count.value()
 	__uptime_ratio_val := node_uptime_ratio.value()
+	__audit_reputation_alpha_val := node_audit_reputation_alpha.value()
+	__audit_reputation_beta_val := node_audit_reputation_beta.value()
+	__uptime_reputation_alpha_val := node_uptime_reputation_alpha.value()
+	__uptime_reputation_beta_val := node_uptime_reputation_beta.value()
+	__state_val := node_state.value()
+	__state_reason_val := node_state_reason.value()
+	__state_changed_at_val := node_state_changed_at.value()
 	__created_at_val := __now
 	__updated_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO nodes ( id, audit_success_count, total_audit_count, audit_success_ratio, uptime_success_count, total_uptime_count, uptime_ratio, audit_reputation_alpha, audit_reputation_beta, uptime_reputation_alpha, uptime_reputation_beta, state, state_reason, state_changed_at, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val)
+	obj.logStmt(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __state_val, __state_reason_val, __state_changed_at_val, __created_at_val, __updated_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __created_at_val, __updated_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __audit_success_count_val, __total_audit_count_val, __audit_success_ratio_val, __uptime_success_count_val, __total_uptime_count_val, __uptime_ratio_val, __audit_reputation_alpha_val, __audit_reputation_beta_val, __uptime_reputation_alpha_val, __uptime_reputation_beta_val, __state_val, __state_reason_val, __state_changed_at_val, __created_at_val, __updated_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4795,7 +6407,8 @@ func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
 	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
 	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
 	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
-	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
+	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+	overlay_cache_node_last_net OverlayCacheNode_LastNet_Field) (
 	overlay_cache_node *OverlayCacheNode, err error) {
 	__node_id_val := overlay_cache_node_node_id.value()
 	__node_type_val := overlay_cache_node_node_type.value()
@@ -4812,13 +6425,14 @@ func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
 	__audit_success_count_val := overlay_cache_node_audit_success_count.value()
 	__uptime_count_val := overlay_cache_node_uptime_count.value()
 	__uptime_success_count_val := overlay_cache_node_uptime_success_count.value()
+	__last_net_val := overlay_cache_node_last_net.value()
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO overlay_cache_nodes ( node_id, node_type, address, protocol, operator_email, operator_wallet, free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio, audit_count, audit_success_count, uptime_count, uptime_success_count, last_net ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val)
+	obj.logStmt(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __last_net_val)
 
-	__res, err := obj.driver.Exec(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val)
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __node_type_val, __address_val, __protocol_val, __operator_email_val, __operator_wallet_val, __free_bandwidth_val, __free_disk_val, __latency_90_val, __audit_success_ratio_val, __audit_uptime_ratio_val, __audit_count_val, __audit_success_count_val, __uptime_count_val, __uptime_success_count_val, __last_net_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -4830,6 +6444,42 @@ func (obj *sqlite3Impl) Create_OverlayCacheNode(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Create_GracefulExit(ctx context.Context,
+	graceful_exit_node_id GracefulExit_NodeId_Field,
+	graceful_exit_pieces_transferred GracefulExit_PiecesTransferred_Field,
+	graceful_exit_pieces_failed GracefulExit_PiecesFailed_Field,
+	graceful_exit_exited GracefulExit_Exited_Field,
+	graceful_exit_transfers_cursor GracefulExit_TransfersCursor_Field,
+	graceful_exit_transfers_listed GracefulExit_TransfersListed_Field) (
+	graceful_exit *GracefulExit, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__node_id_val := graceful_exit_node_id.value()
+	__pieces_transferred_val := graceful_exit_pieces_transferred.value()
+	__pieces_failed_val := graceful_exit_pieces_failed.value()
+	__exited_val := graceful_exit_exited.value()
+	__transfers_cursor_val := graceful_exit_transfers_cursor.value()
+	__transfers_listed_val := graceful_exit_transfers_listed.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO graceful_exits ( node_id, pieces_transferred, pieces_failed, exited, transfers_cursor, transfers_listed, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __node_id_val, __pieces_transferred_val, __pieces_failed_val, __exited_val, __transfers_cursor_val, __transfers_listed_val, __created_at_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __node_id_val, __pieces_transferred_val, __pieces_failed_val, __exited_val, __transfers_cursor_val, __transfers_listed_val, __created_at_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastGracefulExit(ctx, __pk)
+
+}
+
 func (obj *sqlite3Impl) Create_Injuredsegment(ctx context.Context,
 	injuredsegment_info Injuredsegment_Info_Field) (
 	injuredsegment *Injuredsegment, err error) {
@@ -4889,7 +6539,8 @@ func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
 	project_description Project_Description_Field,
-	project_terms_accepted Project_TermsAccepted_Field) (
+	project_terms_accepted Project_TermsAccepted_Field,
+	project_owner_id Project_OwnerId_Field) (
 	project *Project, err error) {
 
 	__now := obj.db.Hooks.Now().UTC()
@@ -4897,14 +6548,15 @@ func (obj *sqlite3Impl) Create_Project(ctx context.Context,
 	__name_val := project_name.value()
 	__description_val := project_description.value()
 	__terms_accepted_val := project_terms_accepted.value()
+	__owner_id_val := project_owner_id.value()
 	__created_at_val := __now
 
-	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, terms_accepted, created_at ) VALUES ( ?, ?, ?, ?, ? )")
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO projects ( id, name, description, terms_accepted, owner_id, created_at ) VALUES ( ?, ?, ?, ?, ?, ? )")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
-	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __terms_accepted_val, __created_at_val)
+	obj.logStmt(__stmt, __id_val, __name_val, __description_val, __terms_accepted_val, __owner_id_val, __created_at_val)
 
-	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __terms_accepted_val, __created_at_val)
+	__res, err := obj.driver.Exec(__stmt, __id_val, __name_val, __description_val, __terms_accepted_val, __owner_id_val, __created_at_val)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5001,6 +6653,57 @@ func (obj *sqlite3Impl) Create_BucketInfo(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) Create_ProjectLimit(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	project_limit_storage_limit ProjectLimit_StorageLimit_Field,
+	project_limit_egress_limit ProjectLimit_EgressLimit_Field) (
+	project_limit *ProjectLimit, err error) {
+
+	__now := obj.db.Hooks.Now().UTC()
+	__project_id_val := project_limit_project_id.value()
+	__storage_limit_val := project_limit_storage_limit.value()
+	__egress_limit_val := project_limit_egress_limit.value()
+	__created_at_val := __now
+	__updated_at_val := __now
+
+	var __embed_stmt = __sqlbundle_Literal("INSERT INTO project_limits ( project_id, storage_limit, egress_limit, created_at, updated_at ) VALUES ( ?, ?, ?, ?, ? )")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __project_id_val, __storage_limit_val, __egress_limit_val, __created_at_val, __updated_at_val)
+
+	__res, err := obj.driver.Exec(__stmt, __project_id_val, __storage_limit_val, __egress_limit_val, __created_at_val, __updated_at_val)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	__pk, err := __res.LastInsertId()
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return obj.getLastProjectLimit(ctx, __pk)
+
+}
+
+func (obj *sqlite3Impl) Get_Bwagreement_By_Serialnum(ctx context.Context,
+	bwagreement_serialnum Bwagreement_Serialnum_Field) (
+	bwagreement *Bwagreement, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bwagreements.signature, bwagreements.serialnum, bwagreements.data, bwagreements.created_at, bwagreements.expires_at FROM bwagreements WHERE bwagreements.serialnum = ?")
+
+	var __values []interface{}
+	__values = append(__values, bwagreement_serialnum.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	bwagreement = &Bwagreement{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&bwagreement.Signature, &bwagreement.Serialnum, &bwagreement.Data, &bwagreement.CreatedAt, &bwagreement.ExpiresAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bwagreement, nil
+
+}
+
 func (obj *sqlite3Impl) Get_Bwagreement_By_Signature(ctx context.Context,
 	bwagreement_signature Bwagreement_Signature_Field) (
 	bwagreement *Bwagreement, err error) {
@@ -5307,11 +7010,81 @@ func (obj *sqlite3Impl) All_AccountingRaw_By_IntervalEndTime_GreaterOrEqual(ctx
 
 }
 
+func (obj *sqlite3Impl) All_BucketStorageTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx context.Context,
+	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
+	bucket_storage_tally_interval_end_time_greater_or_equal BucketStorageTally_IntervalEndTime_Field,
+	bucket_storage_tally_interval_end_time_less BucketStorageTally_IntervalEndTime_Field) (
+	rows []*BucketStorageTally, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.id, bucket_storage_tallies.project_id, bucket_storage_tallies.bucket_name, bucket_storage_tallies.interval_end_time, bucket_storage_tallies.stored_bytes, bucket_storage_tallies.object_count, bucket_storage_tallies.created_at FROM bucket_storage_tallies WHERE bucket_storage_tallies.project_id = ? AND bucket_storage_tallies.interval_end_time >= ? AND bucket_storage_tallies.interval_end_time < ? ORDER BY bucket_storage_tallies.interval_end_time")
+
+	var __values []interface{}
+	__values = append(__values, bucket_storage_tally_project_id.value(), bucket_storage_tally_interval_end_time_greater_or_equal.value(), bucket_storage_tally_interval_end_time_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_storage_tally := &BucketStorageTally{}
+		err = __rows.Scan(&bucket_storage_tally.Id, &bucket_storage_tally.ProjectId, &bucket_storage_tally.BucketName, &bucket_storage_tally.IntervalEndTime, &bucket_storage_tally.StoredBytes, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_storage_tally)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
+func (obj *sqlite3Impl) All_BucketBandwidthTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx context.Context,
+	bucket_bandwidth_tally_project_id BucketBandwidthTally_ProjectId_Field,
+	bucket_bandwidth_tally_interval_end_time_greater_or_equal BucketBandwidthTally_IntervalEndTime_Field,
+	bucket_bandwidth_tally_interval_end_time_less BucketBandwidthTally_IntervalEndTime_Field) (
+	rows []*BucketBandwidthTally, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_tallies.id, bucket_bandwidth_tallies.project_id, bucket_bandwidth_tallies.bucket_name, bucket_bandwidth_tallies.interval_end_time, bucket_bandwidth_tallies.action, bucket_bandwidth_tallies.total, bucket_bandwidth_tallies.created_at FROM bucket_bandwidth_tallies WHERE bucket_bandwidth_tallies.project_id = ? AND bucket_bandwidth_tallies.interval_end_time >= ? AND bucket_bandwidth_tallies.interval_end_time < ? ORDER BY bucket_bandwidth_tallies.interval_end_time")
+
+	var __values []interface{}
+	__values = append(__values, bucket_bandwidth_tally_project_id.value(), bucket_bandwidth_tally_interval_end_time_greater_or_equal.value(), bucket_bandwidth_tally_interval_end_time_less.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		bucket_bandwidth_tally := &BucketBandwidthTally{}
+		err = __rows.Scan(&bucket_bandwidth_tally.Id, &bucket_bandwidth_tally.ProjectId, &bucket_bandwidth_tally.BucketName, &bucket_bandwidth_tally.IntervalEndTime, &bucket_bandwidth_tally.Action, &bucket_bandwidth_tally.Total, &bucket_bandwidth_tally.CreatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, bucket_bandwidth_tally)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
 func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	node_id Node_Id_Field) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.state, nodes.state_reason, nodes.state_changed_at, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, node_id.value())
@@ -5320,7 +7093,7 @@ func (obj *sqlite3Impl) Get_Node_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.State, &node.StateReason, &node.StateChangedAt, &node.CreatedAt, &node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5398,7 +7171,7 @@ func (obj *sqlite3Impl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
 	overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
 	overlay_cache_node *OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.last_net FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
 
 	var __values []interface{}
 	__values = append(__values, overlay_cache_node_node_id.value())
@@ -5407,7 +7180,7 @@ func (obj *sqlite3Impl) Get_OverlayCacheNode_By_NodeId(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.LastNet)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5441,7 +7214,7 @@ func (obj *sqlite3Impl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx co
 	limit int, offset int64) (
 	rows []*OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.last_net FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id >= ? LIMIT ? OFFSET ?")
 
 	var __values []interface{}
 	__values = append(__values, overlay_cache_node_node_id_greater_or_equal.value())
@@ -5459,7 +7232,7 @@ func (obj *sqlite3Impl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx co
 
 	for __rows.Next() {
 		overlay_cache_node := &OverlayCacheNode{}
-		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+		err = __rows.Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.LastNet)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5472,6 +7245,59 @@ func (obj *sqlite3Impl) Limited_OverlayCacheNode_By_NodeId_GreaterOrEqual(ctx co
 
 }
 
+func (obj *sqlite3Impl) Get_GracefulExit_By_NodeId(ctx context.Context,
+	graceful_exit_node_id GracefulExit_NodeId_Field) (
+	graceful_exit *GracefulExit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exits.node_id, graceful_exits.pieces_transferred, graceful_exits.pieces_failed, graceful_exits.exited, graceful_exits.transfers_cursor, graceful_exits.transfers_listed, graceful_exits.created_at, graceful_exits.updated_at FROM graceful_exits WHERE graceful_exits.node_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, graceful_exit_node_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	graceful_exit = &GracefulExit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&graceful_exit.NodeId, &graceful_exit.PiecesTransferred, &graceful_exit.PiecesFailed, &graceful_exit.Exited, &graceful_exit.TransfersCursor, &graceful_exit.TransfersListed, &graceful_exit.CreatedAt, &graceful_exit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return graceful_exit, nil
+
+}
+
+func (obj *sqlite3Impl) All_GracefulExit(ctx context.Context) (
+	rows []*GracefulExit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exits.node_id, graceful_exits.pieces_transferred, graceful_exits.pieces_failed, graceful_exits.exited, graceful_exits.transfers_cursor, graceful_exits.transfers_listed, graceful_exits.created_at, graceful_exits.updated_at FROM graceful_exits")
+
+	var __values []interface{}
+	__values = append(__values)
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	__rows, err := obj.driver.Query(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	defer __rows.Close()
+
+	for __rows.Next() {
+		graceful_exit := &GracefulExit{}
+		err = __rows.Scan(&graceful_exit.NodeId, &graceful_exit.PiecesTransferred, &graceful_exit.PiecesFailed, &graceful_exit.Exited, &graceful_exit.TransfersCursor, &graceful_exit.TransfersListed, &graceful_exit.CreatedAt, &graceful_exit.UpdatedAt)
+		if err != nil {
+			return nil, obj.makeErr(err)
+		}
+		rows = append(rows, graceful_exit)
+	}
+	if err := __rows.Err(); err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return rows, nil
+
+}
+
 func (obj *sqlite3Impl) First_Injuredsegment(ctx context.Context) (
 	injuredsegment *Injuredsegment, err error) {
 
@@ -5586,7 +7412,7 @@ func (obj *sqlite3Impl) Get_User_By_Id(ctx context.Context,
 func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at FROM projects")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at FROM projects")
 
 	var __values []interface{}
 	__values = append(__values)
@@ -5602,7 +7428,7 @@ func (obj *sqlite3Impl) All_Project(ctx context.Context) (
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5619,7 +7445,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __values []interface{}
 	__values = append(__values, project_id.value())
@@ -5628,7 +7454,7 @@ func (obj *sqlite3Impl) Get_Project_By_Id(ctx context.Context,
 	obj.logStmt(__stmt, __values...)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -5640,7 +7466,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 	project_member_member_id ProjectMember_MemberId_Field) (
 	rows []*Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at FROM projects  JOIN project_members ON projects.id = project_members.project_id WHERE project_members.member_id = ? ORDER BY projects.name")
 
 	var __values []interface{}
 	__values = append(__values, project_member_member_id.value())
@@ -5656,7 +7482,7 @@ func (obj *sqlite3Impl) All_Project_By_ProjectMember_MemberId_OrderBy_Asc_Projec
 
 	for __rows.Next() {
 		project := &Project{}
-		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+		err = __rows.Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 		if err != nil {
 			return nil, obj.makeErr(err)
 		}
@@ -5867,6 +7693,27 @@ func (obj *sqlite3Impl) All_BucketInfo_By_ProjectId_OrderBy_Asc_Name(ctx context
 
 }
 
+func (obj *sqlite3Impl) Get_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field) (
+	project_limit *ProjectLimit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_limits.project_id, project_limits.storage_limit, project_limits.egress_limit, project_limits.created_at, project_limits.updated_at FROM project_limits WHERE project_limits.project_id = ?")
+
+	var __values []interface{}
+	__values = append(__values, project_limit_project_id.value())
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_limit = &ProjectLimit{}
+	err = obj.driver.QueryRow(__stmt, __values...).Scan(&project_limit.ProjectId, &project_limit.StorageLimit, &project_limit.EgressLimit, &project_limit.CreatedAt, &project_limit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_limit, nil
+
+}
+
 func (obj *sqlite3Impl) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 	update Irreparabledb_Update_Fields) (
@@ -6024,6 +7871,41 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_ratio = ?"))
 	}
 
+	if update.AuditReputationAlpha._set {
+		__values = append(__values, update.AuditReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_alpha = ?"))
+	}
+
+	if update.AuditReputationBeta._set {
+		__values = append(__values, update.AuditReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_reputation_beta = ?"))
+	}
+
+	if update.UptimeReputationAlpha._set {
+		__values = append(__values, update.UptimeReputationAlpha.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_alpha = ?"))
+	}
+
+	if update.UptimeReputationBeta._set {
+		__values = append(__values, update.UptimeReputationBeta.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_reputation_beta = ?"))
+	}
+
+	if update.State._set {
+		__values = append(__values, update.State.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state = ?"))
+	}
+
+	if update.StateReason._set {
+		__values = append(__values, update.StateReason.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state_reason = ?"))
+	}
+
+	if update.StateChangedAt._set {
+		__values = append(__values, update.StateChangedAt.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("state_changed_at = ?"))
+	}
+
 	__now := obj.db.Hooks.Now().UTC()
 
 	__values = append(__values, __now)
@@ -6043,12 +7925,12 @@ func (obj *sqlite3Impl) Update_Node_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.state, nodes.state_reason, nodes.state_changed_at, nodes.created_at, nodes.updated_at FROM nodes WHERE nodes.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.State, &node.StateReason, &node.StateChangedAt, &node.CreatedAt, &node.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -6105,41 +7987,117 @@ func (obj *sqlite3Impl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("latency_90 = ?"))
 	}
 
-	if update.AuditSuccessRatio._set {
-		__values = append(__values, update.AuditSuccessRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
-	}
+	if update.AuditSuccessRatio._set {
+		__values = append(__values, update.AuditSuccessRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_ratio = ?"))
+	}
+
+	if update.AuditUptimeRatio._set {
+		__values = append(__values, update.AuditUptimeRatio.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_uptime_ratio = ?"))
+	}
+
+	if update.AuditCount._set {
+		__values = append(__values, update.AuditCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_count = ?"))
+	}
+
+	if update.AuditSuccessCount._set {
+		__values = append(__values, update.AuditSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	}
+
+	if update.UptimeCount._set {
+		__values = append(__values, update.UptimeCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_count = ?"))
+	}
+
+	if update.UptimeSuccessCount._set {
+		__values = append(__values, update.UptimeSuccessCount.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	}
+
+	if update.LastNet._set {
+		__values = append(__values, update.LastNet.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("last_net = ?"))
+	}
+
+	if len(__sets_sql.SQLs) == 0 {
+		return nil, emptyUpdate()
+	}
+
+	__args = append(__args, overlay_cache_node_node_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	overlay_cache_node = &OverlayCacheNode{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.last_net FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.LastNet)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return overlay_cache_node, nil
+}
+
+func (obj *sqlite3Impl) Update_GracefulExit_By_NodeId(ctx context.Context,
+	graceful_exit_node_id GracefulExit_NodeId_Field,
+	update GracefulExit_Update_Fields) (
+	graceful_exit *GracefulExit, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE graceful_exits SET "), __sets, __sqlbundle_Literal(" WHERE graceful_exits.node_id = ?")}}
 
-	if update.AuditUptimeRatio._set {
-		__values = append(__values, update.AuditUptimeRatio.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_uptime_ratio = ?"))
-	}
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
 
-	if update.AuditCount._set {
-		__values = append(__values, update.AuditCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_count = ?"))
+	if update.PiecesTransferred._set {
+		__values = append(__values, update.PiecesTransferred.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_transferred = ?"))
 	}
 
-	if update.AuditSuccessCount._set {
-		__values = append(__values, update.AuditSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("audit_success_count = ?"))
+	if update.PiecesFailed._set {
+		__values = append(__values, update.PiecesFailed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("pieces_failed = ?"))
 	}
 
-	if update.UptimeCount._set {
-		__values = append(__values, update.UptimeCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_count = ?"))
+	if update.Exited._set {
+		__values = append(__values, update.Exited.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("exited = ?"))
 	}
 
-	if update.UptimeSuccessCount._set {
-		__values = append(__values, update.UptimeSuccessCount.value())
-		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("uptime_success_count = ?"))
+	if update.TransfersCursor._set {
+		__values = append(__values, update.TransfersCursor.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("transfers_cursor = ?"))
 	}
 
-	if len(__sets_sql.SQLs) == 0 {
-		return nil, emptyUpdate()
+	if update.TransfersListed._set {
+		__values = append(__values, update.TransfersListed.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("transfers_listed = ?"))
 	}
 
-	__args = append(__args, overlay_cache_node_node_id.value())
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, graceful_exit_node_id.value())
 
 	__values = append(__values, __args...)
 	__sets.SQL = __sets_sql
@@ -6147,25 +8105,25 @@ func (obj *sqlite3Impl) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, __values...)
 
-	overlay_cache_node = &OverlayCacheNode{}
+	graceful_exit = &GracefulExit{}
 	_, err = obj.driver.Exec(__stmt, __values...)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE overlay_cache_nodes.node_id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT graceful_exits.node_id, graceful_exits.pieces_transferred, graceful_exits.pieces_failed, graceful_exits.exited, graceful_exits.transfers_cursor, graceful_exits.transfers_listed, graceful_exits.created_at, graceful_exits.updated_at FROM graceful_exits WHERE graceful_exits.node_id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&graceful_exit.NodeId, &graceful_exit.PiecesTransferred, &graceful_exit.PiecesFailed, &graceful_exit.Exited, &graceful_exit.TransfersCursor, &graceful_exit.TransfersListed, &graceful_exit.CreatedAt, &graceful_exit.UpdatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
-	return overlay_cache_node, nil
+	return graceful_exit, nil
 }
 
 func (obj *sqlite3Impl) Update_User_By_Id(ctx context.Context,
@@ -6273,12 +8231,12 @@ func (obj *sqlite3Impl) Update_Project_By_Id(ctx context.Context,
 		return nil, obj.makeErr(err)
 	}
 
-	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at FROM projects WHERE projects.id = ?")
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at FROM projects WHERE projects.id = ?")
 
 	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
 	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
 
-	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 	if err == sql.ErrNoRows {
 		return nil, nil
 	}
@@ -6338,6 +8296,62 @@ func (obj *sqlite3Impl) Update_ApiKey_By_Id(ctx context.Context,
 	return api_key, nil
 }
 
+func (obj *sqlite3Impl) Update_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	update ProjectLimit_Update_Fields) (
+	project_limit *ProjectLimit, err error) {
+	var __sets = &__sqlbundle_Hole{}
+
+	var __embed_stmt = __sqlbundle_Literals{Join: "", SQLs: []__sqlbundle_SQL{__sqlbundle_Literal("UPDATE project_limits SET "), __sets, __sqlbundle_Literal(" WHERE project_limits.project_id = ?")}}
+
+	__sets_sql := __sqlbundle_Literals{Join: ", "}
+	var __values []interface{}
+	var __args []interface{}
+
+	if update.StorageLimit._set {
+		__values = append(__values, update.StorageLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("storage_limit = ?"))
+	}
+
+	if update.EgressLimit._set {
+		__values = append(__values, update.EgressLimit.value())
+		__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("egress_limit = ?"))
+	}
+
+	__now := obj.db.Hooks.Now().UTC()
+
+	__values = append(__values, __now)
+	__sets_sql.SQLs = append(__sets_sql.SQLs, __sqlbundle_Literal("updated_at = ?"))
+
+	__args = append(__args, project_limit_project_id.value())
+
+	__values = append(__values, __args...)
+	__sets.SQL = __sets_sql
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, __values...)
+
+	project_limit = &ProjectLimit{}
+	_, err = obj.driver.Exec(__stmt, __values...)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+
+	var __embed_stmt_get = __sqlbundle_Literal("SELECT project_limits.project_id, project_limits.storage_limit, project_limits.egress_limit, project_limits.created_at, project_limits.updated_at FROM project_limits WHERE project_limits.project_id = ?")
+
+	var __stmt_get = __sqlbundle_Render(obj.dialect, __embed_stmt_get)
+	obj.logStmt("(IMPLIED) "+__stmt_get, __args...)
+
+	err = obj.driver.QueryRow(__stmt_get, __args...).Scan(&project_limit.ProjectId, &project_limit.StorageLimit, &project_limit.EgressLimit, &project_limit.CreatedAt, &project_limit.UpdatedAt)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_limit, nil
+}
+
 func (obj *sqlite3Impl) Delete_Bwagreement_By_Signature(ctx context.Context,
 	bwagreement_signature Bwagreement_Signature_Field) (
 	deleted bool, err error) {
@@ -6767,17 +8781,53 @@ func (obj *sqlite3Impl) getLastAccountingRaw(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) getLastBucketStorageTally(ctx context.Context,
+	pk int64) (
+	bucket_storage_tally *BucketStorageTally, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_storage_tallies.id, bucket_storage_tallies.project_id, bucket_storage_tallies.bucket_name, bucket_storage_tallies.interval_end_time, bucket_storage_tallies.stored_bytes, bucket_storage_tallies.object_count, bucket_storage_tallies.created_at FROM bucket_storage_tallies WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bucket_storage_tally = &BucketStorageTally{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_storage_tally.Id, &bucket_storage_tally.ProjectId, &bucket_storage_tally.BucketName, &bucket_storage_tally.IntervalEndTime, &bucket_storage_tally.StoredBytes, &bucket_storage_tally.ObjectCount, &bucket_storage_tally.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_storage_tally, nil
+
+}
+
+func (obj *sqlite3Impl) getLastBucketBandwidthTally(ctx context.Context,
+	pk int64) (
+	bucket_bandwidth_tally *BucketBandwidthTally, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT bucket_bandwidth_tallies.id, bucket_bandwidth_tallies.project_id, bucket_bandwidth_tallies.bucket_name, bucket_bandwidth_tallies.interval_end_time, bucket_bandwidth_tallies.action, bucket_bandwidth_tallies.total, bucket_bandwidth_tallies.created_at FROM bucket_bandwidth_tallies WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	bucket_bandwidth_tally = &BucketBandwidthTally{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&bucket_bandwidth_tally.Id, &bucket_bandwidth_tally.ProjectId, &bucket_bandwidth_tally.BucketName, &bucket_bandwidth_tally.IntervalEndTime, &bucket_bandwidth_tally.Action, &bucket_bandwidth_tally.Total, &bucket_bandwidth_tally.CreatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return bucket_bandwidth_tally, nil
+
+}
+
 func (obj *sqlite3Impl) getLastNode(ctx context.Context,
 	pk int64) (
 	node *Node, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.created_at, nodes.updated_at FROM nodes WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count, nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio, nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta, nodes.state, nodes.state_reason, nodes.state_changed_at, nodes.created_at, nodes.updated_at FROM nodes WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	node = &Node{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.CreatedAt, &node.UpdatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount, &node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio, &node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta, &node.State, &node.StateReason, &node.StateChangedAt, &node.CreatedAt, &node.UpdatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6789,13 +8839,13 @@ func (obj *sqlite3Impl) getLastOverlayCacheNode(ctx context.Context,
 	pk int64) (
 	overlay_cache_node *OverlayCacheNode, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count FROM overlay_cache_nodes WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT overlay_cache_nodes.node_id, overlay_cache_nodes.node_type, overlay_cache_nodes.address, overlay_cache_nodes.protocol, overlay_cache_nodes.operator_email, overlay_cache_nodes.operator_wallet, overlay_cache_nodes.free_bandwidth, overlay_cache_nodes.free_disk, overlay_cache_nodes.latency_90, overlay_cache_nodes.audit_success_ratio, overlay_cache_nodes.audit_uptime_ratio, overlay_cache_nodes.audit_count, overlay_cache_nodes.audit_success_count, overlay_cache_nodes.uptime_count, overlay_cache_nodes.uptime_success_count, overlay_cache_nodes.last_net FROM overlay_cache_nodes WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	overlay_cache_node = &OverlayCacheNode{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&overlay_cache_node.NodeId, &overlay_cache_node.NodeType, &overlay_cache_node.Address, &overlay_cache_node.Protocol, &overlay_cache_node.OperatorEmail, &overlay_cache_node.OperatorWallet, &overlay_cache_node.FreeBandwidth, &overlay_cache_node.FreeDisk, &overlay_cache_node.Latency90, &overlay_cache_node.AuditSuccessRatio, &overlay_cache_node.AuditUptimeRatio, &overlay_cache_node.AuditCount, &overlay_cache_node.AuditSuccessCount, &overlay_cache_node.UptimeCount, &overlay_cache_node.UptimeSuccessCount, &overlay_cache_node.LastNet)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6803,6 +8853,24 @@ func (obj *sqlite3Impl) getLastOverlayCacheNode(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) getLastGracefulExit(ctx context.Context,
+	pk int64) (
+	graceful_exit *GracefulExit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT graceful_exits.node_id, graceful_exits.pieces_transferred, graceful_exits.pieces_failed, graceful_exits.exited, graceful_exits.transfers_cursor, graceful_exits.transfers_listed, graceful_exits.created_at, graceful_exits.updated_at FROM graceful_exits WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	graceful_exit = &GracefulExit{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&graceful_exit.NodeId, &graceful_exit.PiecesTransferred, &graceful_exit.PiecesFailed, &graceful_exit.Exited, &graceful_exit.TransfersCursor, &graceful_exit.TransfersListed, &graceful_exit.CreatedAt, &graceful_exit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return graceful_exit, nil
+
+}
+
 func (obj *sqlite3Impl) getLastInjuredsegment(ctx context.Context,
 	pk int64) (
 	injuredsegment *Injuredsegment, err error) {
@@ -6843,13 +8911,13 @@ func (obj *sqlite3Impl) getLastProject(ctx context.Context,
 	pk int64) (
 	project *Project, err error) {
 
-	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.created_at FROM projects WHERE _rowid_ = ?")
+	var __embed_stmt = __sqlbundle_Literal("SELECT projects.id, projects.name, projects.description, projects.terms_accepted, projects.owner_id, projects.created_at FROM projects WHERE _rowid_ = ?")
 
 	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
 	obj.logStmt(__stmt, pk)
 
 	project = &Project{}
-	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.CreatedAt)
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project.Id, &project.Name, &project.Description, &project.TermsAccepted, &project.OwnerId, &project.CreatedAt)
 	if err != nil {
 		return nil, obj.makeErr(err)
 	}
@@ -6911,6 +8979,24 @@ func (obj *sqlite3Impl) getLastBucketInfo(ctx context.Context,
 
 }
 
+func (obj *sqlite3Impl) getLastProjectLimit(ctx context.Context,
+	pk int64) (
+	project_limit *ProjectLimit, err error) {
+
+	var __embed_stmt = __sqlbundle_Literal("SELECT project_limits.project_id, project_limits.storage_limit, project_limits.egress_limit, project_limits.created_at, project_limits.updated_at FROM project_limits WHERE _rowid_ = ?")
+
+	var __stmt = __sqlbundle_Render(obj.dialect, __embed_stmt)
+	obj.logStmt(__stmt, pk)
+
+	project_limit = &ProjectLimit{}
+	err = obj.driver.QueryRow(__stmt, pk).Scan(&project_limit.ProjectId, &project_limit.StorageLimit, &project_limit.EgressLimit, &project_limit.CreatedAt, &project_limit.UpdatedAt)
+	if err != nil {
+		return nil, obj.makeErr(err)
+	}
+	return project_limit, nil
+
+}
+
 func (impl sqlite3Impl) isConstraintError(err error) (
 	constraint string, ok bool) {
 	if e, ok := err.(sqlite3.Error); ok {
@@ -6934,6 +9020,16 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM project_limits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -7014,6 +9110,26 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM graceful_exits;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM graceful_exit_transfers;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -7024,6 +9140,26 @@ func (obj *sqlite3Impl) deleteAll(ctx context.Context) (count int64, err error)
 		return 0, obj.makeErr(err)
 	}
 
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_storage_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
+	__count, err = __res.RowsAffected()
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+	count += __count
+	__res, err = obj.driver.Exec("DELETE FROM bucket_bandwidth_tallies;")
+	if err != nil {
+		return 0, obj.makeErr(err)
+	}
+
 	__count, err = __res.RowsAffected()
 	if err != nil {
 		return 0, obj.makeErr(err)
@@ -7145,6 +9281,18 @@ func (rx *Rx) All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 	return tx.All_ApiKey_By_ProjectId_OrderBy_Asc_Name(ctx, api_key_project_id)
 }
 
+func (rx *Rx) All_BucketBandwidthTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx context.Context,
+	bucket_bandwidth_tally_project_id BucketBandwidthTally_ProjectId_Field,
+	bucket_bandwidth_tally_interval_end_time_greater_or_equal BucketBandwidthTally_IntervalEndTime_Field,
+	bucket_bandwidth_tally_interval_end_time_less BucketBandwidthTally_IntervalEndTime_Field) (
+	rows []*BucketBandwidthTally, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_BucketBandwidthTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx, bucket_bandwidth_tally_project_id, bucket_bandwidth_tally_interval_end_time_greater_or_equal, bucket_bandwidth_tally_interval_end_time_less)
+}
+
 func (rx *Rx) All_BucketInfo_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 	bucket_info_project_id BucketInfo_ProjectId_Field) (
 	rows []*BucketInfo, err error) {
@@ -7155,6 +9303,18 @@ func (rx *Rx) All_BucketInfo_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 	return tx.All_BucketInfo_By_ProjectId_OrderBy_Asc_Name(ctx, bucket_info_project_id)
 }
 
+func (rx *Rx) All_BucketStorageTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx context.Context,
+	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
+	bucket_storage_tally_interval_end_time_greater_or_equal BucketStorageTally_IntervalEndTime_Field,
+	bucket_storage_tally_interval_end_time_less BucketStorageTally_IntervalEndTime_Field) (
+	rows []*BucketStorageTally, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_BucketStorageTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx, bucket_storage_tally_project_id, bucket_storage_tally_interval_end_time_greater_or_equal, bucket_storage_tally_interval_end_time_less)
+}
+
 func (rx *Rx) All_Bwagreement(ctx context.Context) (
 	rows []*Bwagreement, err error) {
 	var tx *Tx
@@ -7174,6 +9334,15 @@ func (rx *Rx) All_Bwagreement_By_CreatedAt_Greater(ctx context.Context,
 	return tx.All_Bwagreement_By_CreatedAt_Greater(ctx, bwagreement_created_at_greater)
 }
 
+func (rx *Rx) All_GracefulExit(ctx context.Context) (
+	rows []*GracefulExit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.All_GracefulExit(ctx)
+}
+
 func (rx *Rx) All_Node_Id(ctx context.Context) (
 	rows []*Id_Row, err error) {
 	var tx *Tx
@@ -7281,6 +9450,21 @@ func (rx *Rx) Create_ApiKey(ctx context.Context,
 
 }
 
+func (rx *Rx) Create_BucketBandwidthTally(ctx context.Context,
+	bucket_bandwidth_tally_project_id BucketBandwidthTally_ProjectId_Field,
+	bucket_bandwidth_tally_bucket_name BucketBandwidthTally_BucketName_Field,
+	bucket_bandwidth_tally_interval_end_time BucketBandwidthTally_IntervalEndTime_Field,
+	bucket_bandwidth_tally_action BucketBandwidthTally_Action_Field,
+	bucket_bandwidth_tally_total BucketBandwidthTally_Total_Field) (
+	bucket_bandwidth_tally *BucketBandwidthTally, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BucketBandwidthTally(ctx, bucket_bandwidth_tally_project_id, bucket_bandwidth_tally_bucket_name, bucket_bandwidth_tally_interval_end_time, bucket_bandwidth_tally_action, bucket_bandwidth_tally_total)
+
+}
+
 func (rx *Rx) Create_BucketInfo(ctx context.Context,
 	bucket_info_project_id BucketInfo_ProjectId_Field,
 	bucket_info_name BucketInfo_Name_Field) (
@@ -7293,6 +9477,21 @@ func (rx *Rx) Create_BucketInfo(ctx context.Context,
 
 }
 
+func (rx *Rx) Create_BucketStorageTally(ctx context.Context,
+	bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
+	bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
+	bucket_storage_tally_interval_end_time BucketStorageTally_IntervalEndTime_Field,
+	bucket_storage_tally_stored_bytes BucketStorageTally_StoredBytes_Field,
+	bucket_storage_tally_object_count BucketStorageTally_ObjectCount_Field) (
+	bucket_storage_tally *BucketStorageTally, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_BucketStorageTally(ctx, bucket_storage_tally_project_id, bucket_storage_tally_bucket_name, bucket_storage_tally_interval_end_time, bucket_storage_tally_stored_bytes, bucket_storage_tally_object_count)
+
+}
+
 func (rx *Rx) Create_Bwagreement(ctx context.Context,
 	bwagreement_signature Bwagreement_Signature_Field,
 	bwagreement_serialnum Bwagreement_Serialnum_Field,
@@ -7307,6 +9506,22 @@ func (rx *Rx) Create_Bwagreement(ctx context.Context,
 
 }
 
+func (rx *Rx) Create_GracefulExit(ctx context.Context,
+	graceful_exit_node_id GracefulExit_NodeId_Field,
+	graceful_exit_pieces_transferred GracefulExit_PiecesTransferred_Field,
+	graceful_exit_pieces_failed GracefulExit_PiecesFailed_Field,
+	graceful_exit_exited GracefulExit_Exited_Field,
+	graceful_exit_transfers_cursor GracefulExit_TransfersCursor_Field,
+	graceful_exit_transfers_listed GracefulExit_TransfersListed_Field) (
+	graceful_exit *GracefulExit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_GracefulExit(ctx, graceful_exit_node_id, graceful_exit_pieces_transferred, graceful_exit_pieces_failed, graceful_exit_exited, graceful_exit_transfers_cursor, graceful_exit_transfers_listed)
+
+}
+
 func (rx *Rx) Create_Injuredsegment(ctx context.Context,
 	injuredsegment_info Injuredsegment_Info_Field) (
 	injuredsegment *Injuredsegment, err error) {
@@ -7340,13 +9555,20 @@ func (rx *Rx) Create_Node(ctx context.Context,
 	node_audit_success_ratio Node_AuditSuccessRatio_Field,
 	node_uptime_success_count Node_UptimeSuccessCount_Field,
 	node_total_uptime_count Node_TotalUptimeCount_Field,
-	node_uptime_ratio Node_UptimeRatio_Field) (
+	node_uptime_ratio Node_UptimeRatio_Field,
+	node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+	node_audit_reputation_beta Node_AuditReputationBeta_Field,
+	node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+	node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+	node_state Node_State_Field,
+	node_state_reason Node_StateReason_Field,
+	node_state_changed_at Node_StateChangedAt_Field) (
 	node *Node, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Node(ctx, node_id, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio)
+	return tx.Create_Node(ctx, node_id, node_audit_success_count, node_total_audit_count, node_audit_success_ratio, node_uptime_success_count, node_total_uptime_count, node_uptime_ratio, node_audit_reputation_alpha, node_audit_reputation_beta, node_uptime_reputation_alpha, node_uptime_reputation_beta, node_state, node_state_reason, node_state_changed_at)
 
 }
 
@@ -7365,13 +9587,14 @@ func (rx *Rx) Create_OverlayCacheNode(ctx context.Context,
 	overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
 	overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
 	overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
-	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
+	overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+	overlay_cache_node_last_net OverlayCacheNode_LastNet_Field) (
 	overlay_cache_node *OverlayCacheNode, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_OverlayCacheNode(ctx, overlay_cache_node_node_id, overlay_cache_node_node_type, overlay_cache_node_address, overlay_cache_node_protocol, overlay_cache_node_operator_email, overlay_cache_node_operator_wallet, overlay_cache_node_free_bandwidth, overlay_cache_node_free_disk, overlay_cache_node_latency_90, overlay_cache_node_audit_success_ratio, overlay_cache_node_audit_uptime_ratio, overlay_cache_node_audit_count, overlay_cache_node_audit_success_count, overlay_cache_node_uptime_count, overlay_cache_node_uptime_success_count)
+	return tx.Create_OverlayCacheNode(ctx, overlay_cache_node_node_id, overlay_cache_node_node_type, overlay_cache_node_address, overlay_cache_node_protocol, overlay_cache_node_operator_email, overlay_cache_node_operator_wallet, overlay_cache_node_free_bandwidth, overlay_cache_node_free_disk, overlay_cache_node_latency_90, overlay_cache_node_audit_success_ratio, overlay_cache_node_audit_uptime_ratio, overlay_cache_node_audit_count, overlay_cache_node_audit_success_count, overlay_cache_node_uptime_count, overlay_cache_node_uptime_success_count, overlay_cache_node_last_net)
 
 }
 
@@ -7379,13 +9602,27 @@ func (rx *Rx) Create_Project(ctx context.Context,
 	project_id Project_Id_Field,
 	project_name Project_Name_Field,
 	project_description Project_Description_Field,
-	project_terms_accepted Project_TermsAccepted_Field) (
+	project_terms_accepted Project_TermsAccepted_Field,
+	project_owner_id Project_OwnerId_Field) (
 	project *Project, err error) {
 	var tx *Tx
 	if tx, err = rx.getTx(ctx); err != nil {
 		return
 	}
-	return tx.Create_Project(ctx, project_id, project_name, project_description, project_terms_accepted)
+	return tx.Create_Project(ctx, project_id, project_name, project_description, project_terms_accepted, project_owner_id)
+
+}
+
+func (rx *Rx) Create_ProjectLimit(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	project_limit_storage_limit ProjectLimit_StorageLimit_Field,
+	project_limit_egress_limit ProjectLimit_EgressLimit_Field) (
+	project_limit *ProjectLimit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Create_ProjectLimit(ctx, project_limit_project_id, project_limit_storage_limit, project_limit_egress_limit)
 
 }
 
@@ -7617,6 +9854,16 @@ func (rx *Rx) Get_BucketInfo_By_Name(ctx context.Context,
 	return tx.Get_BucketInfo_By_Name(ctx, bucket_info_name)
 }
 
+func (rx *Rx) Get_Bwagreement_By_Serialnum(ctx context.Context,
+	bwagreement_serialnum Bwagreement_Serialnum_Field) (
+	bwagreement *Bwagreement, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_Bwagreement_By_Serialnum(ctx, bwagreement_serialnum)
+}
+
 func (rx *Rx) Get_Bwagreement_By_Signature(ctx context.Context,
 	bwagreement_signature Bwagreement_Signature_Field) (
 	bwagreement *Bwagreement, err error) {
@@ -7627,6 +9874,16 @@ func (rx *Rx) Get_Bwagreement_By_Signature(ctx context.Context,
 	return tx.Get_Bwagreement_By_Signature(ctx, bwagreement_signature)
 }
 
+func (rx *Rx) Get_GracefulExit_By_NodeId(ctx context.Context,
+	graceful_exit_node_id GracefulExit_NodeId_Field) (
+	graceful_exit *GracefulExit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_GracefulExit_By_NodeId(ctx, graceful_exit_node_id)
+}
+
 func (rx *Rx) Get_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 	irreparabledb *Irreparabledb, err error) {
@@ -7667,6 +9924,16 @@ func (rx *Rx) Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx context.Context,
 	return tx.Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx, overlay_cache_node_node_id)
 }
 
+func (rx *Rx) Get_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field) (
+	project_limit *ProjectLimit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Get_ProjectLimit_By_ProjectId(ctx, project_limit_project_id)
+}
+
 func (rx *Rx) Get_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field) (
 	project *Project, err error) {
@@ -7761,6 +10028,17 @@ func (rx *Rx) Update_ApiKey_By_Id(ctx context.Context,
 	return tx.Update_ApiKey_By_Id(ctx, api_key_id, update)
 }
 
+func (rx *Rx) Update_GracefulExit_By_NodeId(ctx context.Context,
+	graceful_exit_node_id GracefulExit_NodeId_Field,
+	update GracefulExit_Update_Fields) (
+	graceful_exit *GracefulExit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_GracefulExit_By_NodeId(ctx, graceful_exit_node_id, update)
+}
+
 func (rx *Rx) Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 	irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 	update Irreparabledb_Update_Fields) (
@@ -7794,6 +10072,17 @@ func (rx *Rx) Update_OverlayCacheNode_By_NodeId(ctx context.Context,
 	return tx.Update_OverlayCacheNode_By_NodeId(ctx, overlay_cache_node_node_id, update)
 }
 
+func (rx *Rx) Update_ProjectLimit_By_ProjectId(ctx context.Context,
+	project_limit_project_id ProjectLimit_ProjectId_Field,
+	update ProjectLimit_Update_Fields) (
+	project_limit *ProjectLimit, err error) {
+	var tx *Tx
+	if tx, err = rx.getTx(ctx); err != nil {
+		return
+	}
+	return tx.Update_ProjectLimit_By_ProjectId(ctx, project_limit_project_id, update)
+}
+
 func (rx *Rx) Update_Project_By_Id(ctx context.Context,
 	project_id Project_Id_Field,
 	update Project_Update_Fields) (
@@ -7832,10 +10121,22 @@ type Methods interface {
 		api_key_project_id ApiKey_ProjectId_Field) (
 		rows []*ApiKey, err error)
 
+	All_BucketBandwidthTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx context.Context,
+		bucket_bandwidth_tally_project_id BucketBandwidthTally_ProjectId_Field,
+		bucket_bandwidth_tally_interval_end_time_greater_or_equal BucketBandwidthTally_IntervalEndTime_Field,
+		bucket_bandwidth_tally_interval_end_time_less BucketBandwidthTally_IntervalEndTime_Field) (
+		rows []*BucketBandwidthTally, err error)
+
 	All_BucketInfo_By_ProjectId_OrderBy_Asc_Name(ctx context.Context,
 		bucket_info_project_id BucketInfo_ProjectId_Field) (
 		rows []*BucketInfo, err error)
 
+	All_BucketStorageTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(ctx context.Context,
+		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
+		bucket_storage_tally_interval_end_time_greater_or_equal BucketStorageTally_IntervalEndTime_Field,
+		bucket_storage_tally_interval_end_time_less BucketStorageTally_IntervalEndTime_Field) (
+		rows []*BucketStorageTally, err error)
+
 	All_Bwagreement(ctx context.Context) (
 		rows []*Bwagreement, err error)
 
@@ -7843,6 +10144,9 @@ type Methods interface {
 		bwagreement_created_at_greater Bwagreement_CreatedAt_Field) (
 		rows []*Bwagreement, err error)
 
+	All_GracefulExit(ctx context.Context) (
+		rows []*GracefulExit, err error)
+
 	All_Node_Id(ctx context.Context) (
 		rows []*Id_Row, err error)
 
@@ -7892,11 +10196,27 @@ type Methods interface {
 		api_key_name ApiKey_Name_Field) (
 		api_key *ApiKey, err error)
 
+	Create_BucketBandwidthTally(ctx context.Context,
+		bucket_bandwidth_tally_project_id BucketBandwidthTally_ProjectId_Field,
+		bucket_bandwidth_tally_bucket_name BucketBandwidthTally_BucketName_Field,
+		bucket_bandwidth_tally_interval_end_time BucketBandwidthTally_IntervalEndTime_Field,
+		bucket_bandwidth_tally_action BucketBandwidthTally_Action_Field,
+		bucket_bandwidth_tally_total BucketBandwidthTally_Total_Field) (
+		bucket_bandwidth_tally *BucketBandwidthTally, err error)
+
 	Create_BucketInfo(ctx context.Context,
 		bucket_info_project_id BucketInfo_ProjectId_Field,
 		bucket_info_name BucketInfo_Name_Field) (
 		bucket_info *BucketInfo, err error)
 
+	Create_BucketStorageTally(ctx context.Context,
+		bucket_storage_tally_project_id BucketStorageTally_ProjectId_Field,
+		bucket_storage_tally_bucket_name BucketStorageTally_BucketName_Field,
+		bucket_storage_tally_interval_end_time BucketStorageTally_IntervalEndTime_Field,
+		bucket_storage_tally_stored_bytes BucketStorageTally_StoredBytes_Field,
+		bucket_storage_tally_object_count BucketStorageTally_ObjectCount_Field) (
+		bucket_storage_tally *BucketStorageTally, err error)
+
 	Create_Bwagreement(ctx context.Context,
 		bwagreement_signature Bwagreement_Signature_Field,
 		bwagreement_serialnum Bwagreement_Serialnum_Field,
@@ -7904,6 +10224,15 @@ type Methods interface {
 		bwagreement_expires_at Bwagreement_ExpiresAt_Field) (
 		bwagreement *Bwagreement, err error)
 
+	Create_GracefulExit(ctx context.Context,
+		graceful_exit_node_id GracefulExit_NodeId_Field,
+		graceful_exit_pieces_transferred GracefulExit_PiecesTransferred_Field,
+		graceful_exit_pieces_failed GracefulExit_PiecesFailed_Field,
+		graceful_exit_exited GracefulExit_Exited_Field,
+		graceful_exit_transfers_cursor GracefulExit_TransfersCursor_Field,
+		graceful_exit_transfers_listed GracefulExit_TransfersListed_Field) (
+		graceful_exit *GracefulExit, err error)
+
 	Create_Injuredsegment(ctx context.Context,
 		injuredsegment_info Injuredsegment_Info_Field) (
 		injuredsegment *Injuredsegment, err error)
@@ -7923,7 +10252,14 @@ type Methods interface {
 		node_audit_success_ratio Node_AuditSuccessRatio_Field,
 		node_uptime_success_count Node_UptimeSuccessCount_Field,
 		node_total_uptime_count Node_TotalUptimeCount_Field,
-		node_uptime_ratio Node_UptimeRatio_Field) (
+		node_uptime_ratio Node_UptimeRatio_Field,
+		node_audit_reputation_alpha Node_AuditReputationAlpha_Field,
+		node_audit_reputation_beta Node_AuditReputationBeta_Field,
+		node_uptime_reputation_alpha Node_UptimeReputationAlpha_Field,
+		node_uptime_reputation_beta Node_UptimeReputationBeta_Field,
+		node_state Node_State_Field,
+		node_state_reason Node_StateReason_Field,
+		node_state_changed_at Node_StateChangedAt_Field) (
 		node *Node, err error)
 
 	Create_OverlayCacheNode(ctx context.Context,
@@ -7941,16 +10277,24 @@ type Methods interface {
 		overlay_cache_node_audit_count OverlayCacheNode_AuditCount_Field,
 		overlay_cache_node_audit_success_count OverlayCacheNode_AuditSuccessCount_Field,
 		overlay_cache_node_uptime_count OverlayCacheNode_UptimeCount_Field,
-		overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field) (
+		overlay_cache_node_uptime_success_count OverlayCacheNode_UptimeSuccessCount_Field,
+		overlay_cache_node_last_net OverlayCacheNode_LastNet_Field) (
 		overlay_cache_node *OverlayCacheNode, err error)
 
 	Create_Project(ctx context.Context,
 		project_id Project_Id_Field,
 		project_name Project_Name_Field,
 		project_description Project_Description_Field,
-		project_terms_accepted Project_TermsAccepted_Field) (
+		project_terms_accepted Project_TermsAccepted_Field,
+		project_owner_id Project_OwnerId_Field) (
 		project *Project, err error)
 
+	Create_ProjectLimit(ctx context.Context,
+		project_limit_project_id ProjectLimit_ProjectId_Field,
+		project_limit_storage_limit ProjectLimit_StorageLimit_Field,
+		project_limit_egress_limit ProjectLimit_EgressLimit_Field) (
+		project_limit *ProjectLimit, err error)
+
 	Create_ProjectMember(ctx context.Context,
 		project_member_member_id ProjectMember_MemberId_Field,
 		project_member_project_id ProjectMember_ProjectId_Field) (
@@ -8044,10 +10388,18 @@ type Methods interface {
 		bucket_info_name BucketInfo_Name_Field) (
 		bucket_info *BucketInfo, err error)
 
+	Get_Bwagreement_By_Serialnum(ctx context.Context,
+		bwagreement_serialnum Bwagreement_Serialnum_Field) (
+		bwagreement *Bwagreement, err error)
+
 	Get_Bwagreement_By_Signature(ctx context.Context,
 		bwagreement_signature Bwagreement_Signature_Field) (
 		bwagreement *Bwagreement, err error)
 
+	Get_GracefulExit_By_NodeId(ctx context.Context,
+		graceful_exit_node_id GracefulExit_NodeId_Field) (
+		graceful_exit *GracefulExit, err error)
+
 	Get_Irreparabledb_By_Segmentpath(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field) (
 		irreparabledb *Irreparabledb, err error)
@@ -8064,6 +10416,10 @@ type Methods interface {
 		overlay_cache_node_node_id OverlayCacheNode_NodeId_Field) (
 		row *OperatorWallet_Row, err error)
 
+	Get_ProjectLimit_By_ProjectId(ctx context.Context,
+		project_limit_project_id ProjectLimit_ProjectId_Field) (
+		project_limit *ProjectLimit, err error)
+
 	Get_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field) (
 		project *Project, err error)
@@ -8104,6 +10460,11 @@ type Methods interface {
 		update ApiKey_Update_Fields) (
 		api_key *ApiKey, err error)
 
+	Update_GracefulExit_By_NodeId(ctx context.Context,
+		graceful_exit_node_id GracefulExit_NodeId_Field,
+		update GracefulExit_Update_Fields) (
+		graceful_exit *GracefulExit, err error)
+
 	Update_Irreparabledb_By_Segmentpath(ctx context.Context,
 		irreparabledb_segmentpath Irreparabledb_Segmentpath_Field,
 		update Irreparabledb_Update_Fields) (
@@ -8119,6 +10480,11 @@ type Methods interface {
 		update OverlayCacheNode_Update_Fields) (
 		overlay_cache_node *OverlayCacheNode, err error)
 
+	Update_ProjectLimit_By_ProjectId(ctx context.Context,
+		project_limit_project_id ProjectLimit_ProjectId_Field,
+		update ProjectLimit_Update_Fields) (
+		project_limit *ProjectLimit, err error)
+
 	Update_Project_By_Id(ctx context.Context,
 		project_id Project_Id_Field,
 		update Project_Update_Fields) (
@@ -26,6 +26,26 @@ CREATE TABLE accounting_timestamps (
 	value timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE bucket_bandwidth_tallies (
+	id bigserial NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	interval_end_time timestamp with time zone NOT NULL,
+	action integer NOT NULL,
+	total bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE bucket_storage_tallies (
+	id bigserial NOT NULL,
+	project_id bytea NOT NULL,
+	bucket_name text NOT NULL,
+	interval_end_time timestamp with time zone NOT NULL,
+	stored_bytes bigint NOT NULL,
+	object_count bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( id )
+);
 CREATE TABLE bwagreements (
 	signature bytea NOT NULL,
 	serialnum text NOT NULL,
@@ -35,6 +55,27 @@ CREATE TABLE bwagreements (
 	PRIMARY KEY ( signature ),
 	UNIQUE ( serialnum )
 );
+CREATE TABLE graceful_exit_transfers (
+	node_id bytea NOT NULL,
+	path bytea NOT NULL,
+	piece_num integer NOT NULL,
+	target_id bytea NOT NULL,
+	state integer NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id, path, piece_num )
+);
+CREATE TABLE graceful_exits (
+	node_id bytea NOT NULL,
+	pieces_transferred bigint NOT NULL,
+	pieces_failed bigint NOT NULL,
+	exited boolean NOT NULL,
+	transfers_cursor bytea NOT NULL,
+	transfers_listed boolean NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( node_id )
+);
 CREATE TABLE injuredsegments (
 	id bigserial NOT NULL,
 	info bytea NOT NULL,
@@ -56,6 +97,13 @@ CREATE TABLE nodes (
 	uptime_success_count bigint NOT NULL,
 	total_uptime_count bigint NOT NULL,
 	uptime_ratio double precision NOT NULL,
+	audit_reputation_alpha double precision NOT NULL,
+	audit_reputation_beta double precision NOT NULL,
+	uptime_reputation_alpha double precision NOT NULL,
+	uptime_reputation_beta double precision NOT NULL,
+	state integer NOT NULL,
+	state_reason text NOT NULL,
+	state_changed_at timestamp with time zone NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	updated_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
@@ -76,6 +124,7 @@ CREATE TABLE overlay_cache_nodes (
 	audit_success_count bigint NOT NULL,
 	uptime_count bigint NOT NULL,
 	uptime_success_count bigint NOT NULL,
+	last_net text NOT NULL,
 	PRIMARY KEY ( node_id ),
 	UNIQUE ( node_id )
 );
@@ -84,6 +133,7 @@ CREATE TABLE projects (
 	name text NOT NULL,
 	description text NOT NULL,
 	terms_accepted integer NOT NULL,
+	owner_id bytea NOT NULL,
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( id )
 );
@@ -113,6 +163,14 @@ CREATE TABLE bucket_infos (
 	created_at timestamp with time zone NOT NULL,
 	PRIMARY KEY ( name )
 );
+CREATE TABLE project_limits (
+	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	storage_limit bigint NOT NULL,
+	egress_limit bigint NOT NULL,
+	created_at timestamp with time zone NOT NULL,
+	updated_at timestamp with time zone NOT NULL,
+	PRIMARY KEY ( project_id )
+);
 CREATE TABLE project_members (
 	member_id bytea NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
 	project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
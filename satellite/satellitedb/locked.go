@@ -69,18 +69,18 @@ func (m *lockedAccounting) LastRawTime(ctx context.Context, timestampType string
 	return m.db.LastRawTime(ctx, timestampType)
 }
 
-// SaveAtRestRaw records raw tallies of at-rest-data.
-func (m *lockedAccounting) SaveAtRestRaw(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64) error {
+// SaveAtRestRaw records raw tallies of at-rest-data and the at-rest data of the buckets of every project.
+func (m *lockedAccounting) SaveAtRestRaw(ctx context.Context, latestTally time.Time, nodeData map[storj.NodeID]float64, bucketTallies []*accounting.BucketTally) error {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.SaveAtRestRaw(ctx, latestTally, nodeData)
+	return m.db.SaveAtRestRaw(ctx, latestTally, nodeData, bucketTallies)
 }
 
-// SaveBWRaw records raw sums of agreement values to the database and updates the LastRawTime.
-func (m *lockedAccounting) SaveBWRaw(ctx context.Context, latestBwa time.Time, bwTotals accounting.BWTally) error {
+// SaveBWRaw records raw sums of agreement values and the bandwidth used by the buckets of every project to the database and updates the LastRawTime.
+func (m *lockedAccounting) SaveBWRaw(ctx context.Context, latestBwa time.Time, bwTotals accounting.BWTally, bucketBandwidth []*accounting.BucketBandwidth) error {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.SaveBWRaw(ctx, latestBwa, bwTotals)
+	return m.db.SaveBWRaw(ctx, latestBwa, bwTotals, bucketBandwidth)
 }
 
 // SaveRollup records raw tallies of at rest data to the database
@@ -110,6 +110,15 @@ func (m *lockedBandwidthAgreement) CreateAgreement(ctx context.Context, a1 strin
 	return m.db.CreateAgreement(ctx, a1, a2)
 }
 
+// CreateAgreements adds the bandwidth agreements by serial number in one
+// transaction, the serial numbers which exist already are returned as
+// duplicates and their agreements aren't added.
+func (m *lockedBandwidthAgreement) CreateAgreements(ctx context.Context, a1 map[string]bwagreement.Agreement) ([]string, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CreateAgreements(ctx, a1)
+}
+
 // GetAgreements gets all bandwidth agreements.
 func (m *lockedBandwidthAgreement) GetAgreements(ctx context.Context) ([]bwagreement.Agreement, error) {
 	m.Lock()
@@ -291,6 +300,26 @@ func (m *lockedProjectMembers) Insert(ctx context.Context, memberID uuid.UUID, p
 	return m.db.Insert(ctx, memberID, projectID)
 }
 
+// ProjectUsage is a getter for ProjectUsage repository
+func (m *lockedConsole) ProjectUsage() console.ProjectUsage {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedProjectUsage{m.Locker, m.db.ProjectUsage()}
+}
+
+// lockedProjectUsage implements locking wrapper for console.ProjectUsage
+type lockedProjectUsage struct {
+	sync.Locker
+	db console.ProjectUsage
+}
+
+// GetDailyUsage returns the usage of a project for every day of the given period
+func (m *lockedProjectUsage) GetDailyUsage(ctx context.Context, projectID uuid.UUID, since time.Time, before time.Time) ([]console.DailyUsage, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetDailyUsage(ctx, projectID, since, before)
+}
+
 // Projects is a getter for Projects repository
 func (m *lockedConsole) Projects() console.Projects {
 	m.Lock()
@@ -360,6 +389,33 @@ func (m *lockedAccounting) TestPayments(ctx context.Context) error {
 	return m.db.TestPayments(ctx)
 }
 
+// UsageLimits is a getter for UsageLimits repository
+func (m *lockedConsole) UsageLimits() console.UsageLimits {
+	m.Lock()
+	defer m.Unlock()
+	return &lockedUsageLimits{m.Locker, m.db.UsageLimits()}
+}
+
+// lockedUsageLimits implements locking wrapper for console.UsageLimits
+type lockedUsageLimits struct {
+	sync.Locker
+	db console.UsageLimits
+}
+
+// GetLimits returns the limits of a project, zero limits when none were set
+func (m *lockedUsageLimits) GetLimits(ctx context.Context, projectID uuid.UUID) (*console.UsageLimit, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetLimits(ctx, projectID)
+}
+
+// SetLimits sets the limits of a project
+func (m *lockedUsageLimits) SetLimits(ctx context.Context, projectID uuid.UUID, limit console.UsageLimit) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SetLimits(ctx, projectID, limit)
+}
+
 // Users is a getter for Users repository
 func (m *lockedConsole) Users() console.Users {
 	m.Lock()
@@ -462,6 +518,34 @@ type lockedOverlayCache struct {
 	db overlay.DB
 }
 
+// AddExitTransfers records the transfers of the pieces of the node in the segments up to cursor, listed is set once all segments were listed
+func (m *lockedOverlayCache) AddExitTransfers(ctx context.Context, id storj.NodeID, transfers []*overlay.ExitTransfer, cursor string, listed bool) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.AddExitTransfers(ctx, id, transfers, cursor, listed)
+}
+
+// CompleteExit marks the node as exited, it fails with ErrExitIncomplete unless all segments were listed and no transfer is pending
+func (m *lockedOverlayCache) CompleteExit(ctx context.Context, id storj.NodeID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CompleteExit(ctx, id)
+}
+
+// CountPendingExitTransfers counts the transfers of the node, which weren't finished
+func (m *lockedOverlayCache) CountPendingExitTransfers(ctx context.Context, id storj.NodeID) (int64, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CountPendingExitTransfers(ctx, id)
+}
+
+// CreateExitStatus marks the node as exiting, the progress of an exit initiated earlier is kept
+func (m *lockedOverlayCache) CreateExitStatus(ctx context.Context, id storj.NodeID) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.CreateExitStatus(ctx, id)
+}
+
 // Delete deletes node based on id
 func (m *lockedOverlayCache) Delete(ctx context.Context, id storj.NodeID) error {
 	m.Lock()
@@ -469,6 +553,13 @@ func (m *lockedOverlayCache) Delete(ctx context.Context, id storj.NodeID) error
 	return m.db.Delete(ctx, id)
 }
 
+// FinishExitTransfer sets the state of a pending transfer and counts it in the progress of the exit, it fails with ErrExitTransferFinished if the transfer isn't pending
+func (m *lockedOverlayCache) FinishExitTransfer(ctx context.Context, id storj.NodeID, path string, pieceNum int32, state overlay.ExitTransferState) error {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.FinishExitTransfer(ctx, id, path, pieceNum, state)
+}
+
 // Get looks up the node by nodeID
 func (m *lockedOverlayCache) Get(ctx context.Context, nodeID storj.NodeID) (*pb.Node, error) {
 	m.Lock()
@@ -483,6 +574,34 @@ func (m *lockedOverlayCache) GetAll(ctx context.Context, nodeIDs storj.NodeIDLis
 	return m.db.GetAll(ctx, nodeIDs)
 }
 
+// GetExitStatus returns the graceful exit progress of the node
+func (m *lockedOverlayCache) GetExitStatus(ctx context.Context, id storj.NodeID) (*overlay.ExitStatus, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetExitStatus(ctx, id)
+}
+
+// GetExitTransfer returns the transfer of a piece of the node
+func (m *lockedOverlayCache) GetExitTransfer(ctx context.Context, id storj.NodeID, path string, pieceNum int32) (*overlay.ExitTransfer, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetExitTransfer(ctx, id, path, pieceNum)
+}
+
+// GetExitingNodes returns the nodes which initiated a graceful exit, including the exited ones
+func (m *lockedOverlayCache) GetExitingNodes(ctx context.Context) (storj.NodeIDList, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetExitingNodes(ctx)
+}
+
+// GetPendingExitTransfers returns up to limit transfers of the node, which weren't finished
+func (m *lockedOverlayCache) GetPendingExitTransfers(ctx context.Context, id storj.NodeID, limit int) ([]*overlay.ExitTransfer, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.GetPendingExitTransfers(ctx, id, limit)
+}
+
 // List lists nodes starting from cursor
 func (m *lockedOverlayCache) List(ctx context.Context, cursor storj.NodeID, limit int) ([]*pb.Node, error) {
 	m.Lock()
@@ -490,6 +609,13 @@ func (m *lockedOverlayCache) List(ctx context.Context, cursor storj.NodeID, limi
 	return m.db.List(ctx, cursor, limit)
 }
 
+// SelectStorageNodes randomly selects up to count storage nodes meeting the criteria, the nodes leaving the network aren't selected
+func (m *lockedOverlayCache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) ([]*pb.Node, error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.SelectStorageNodes(ctx, count, criteria)
+}
+
 // Update updates node information
 func (m *lockedOverlayCache) Update(ctx context.Context, value *pb.Node) error {
 	m.Lock()
@@ -572,6 +698,13 @@ func (m *lockedStatDB) FindInvalidNodes(ctx context.Context, nodeIDs storj.NodeI
 	return m.db.FindInvalidNodes(ctx, nodeIDs, maxStats)
 }
 
+// FindNodesInState finds a subset of storagenodes in the state.
+func (m *lockedStatDB) FindNodesInState(ctx context.Context, nodeIDs storj.NodeIDList, state statdb.NodeState) (ids storj.NodeIDList, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.FindNodesInState(ctx, nodeIDs, state)
+}
+
 // Get returns node stats.
 func (m *lockedStatDB) Get(ctx context.Context, nodeID storj.NodeID) (stats *statdb.NodeStats, err error) {
 	m.Lock()
@@ -579,6 +712,13 @@ func (m *lockedStatDB) Get(ctx context.Context, nodeID storj.NodeID) (stats *sta
 	return m.db.Get(ctx, nodeID)
 }
 
+// ListByState lists the stats of all storagenodes in the state.
+func (m *lockedStatDB) ListByState(ctx context.Context, state statdb.NodeState) (statslist []*statdb.NodeStats, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.ListByState(ctx, state)
+}
+
 // Update all parts of single storagenode's stats.
 func (m *lockedStatDB) Update(ctx context.Context, request *statdb.UpdateRequest) (stats *statdb.NodeStats, err error) {
 	m.Lock()
@@ -586,11 +726,11 @@ func (m *lockedStatDB) Update(ctx context.Context, request *statdb.UpdateRequest
 	return m.db.Update(ctx, request)
 }
 
-// UpdateAuditSuccess updates a single storagenode's audit stats.
-func (m *lockedStatDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *statdb.NodeStats, err error) {
+// UpdateAuditSuccess updates a single storagenode's audit stats and reputation.
+func (m *lockedStatDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool, params statdb.ReputationParams) (stats *statdb.NodeStats, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateAuditSuccess(ctx, nodeID, auditSuccess)
+	return m.db.UpdateAuditSuccess(ctx, nodeID, auditSuccess, params)
 }
 
 // UpdateBatch for updating multiple storage nodes' stats.
@@ -600,9 +740,16 @@ func (m *lockedStatDB) UpdateBatch(ctx context.Context, requests []*statdb.Updat
 	return m.db.UpdateBatch(ctx, requests)
 }
 
-// UpdateUptime updates a single storagenode's uptime stats.
-func (m *lockedStatDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *statdb.NodeStats, err error) {
+// UpdateState sets the state of a single storagenode and the reason of the change.
+func (m *lockedStatDB) UpdateState(ctx context.Context, nodeID storj.NodeID, state statdb.NodeState, reason string) (stats *statdb.NodeStats, err error) {
+	m.Lock()
+	defer m.Unlock()
+	return m.db.UpdateState(ctx, nodeID, state, reason)
+}
+
+// UpdateUptime updates a single storagenode's uptime stats and reputation.
+func (m *lockedStatDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, params statdb.ReputationParams) (stats *statdb.NodeStats, err error) {
 	m.Lock()
 	defer m.Unlock()
-	return m.db.UpdateUptime(ctx, nodeID, isUp)
+	return m.db.UpdateUptime(ctx, nodeID, isUp, params)
 }
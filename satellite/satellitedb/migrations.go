@@ -0,0 +1,361 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+
+	"storj.io/storj/pkg/utils"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+// migration is a step of the schema migrations, it migrates the schema from
+// the previous version to its version with the statements of the driver
+type migration struct {
+	version     int
+	description string
+	statements  map[string][]string
+}
+
+// migrations migrate the schema of a database from the legacy schema, which
+// is version 0, to the current one. New databases are created with the
+// current schema and the version of the last migration. The columns added to
+// existing tables get defaults for the existing rows.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "add the bucket tallies",
+		statements: map[string][]string{
+			"postgres": {
+				`CREATE TABLE bucket_bandwidth_tallies (
+					id bigserial NOT NULL,
+					project_id bytea NOT NULL,
+					bucket_name text NOT NULL,
+					interval_end_time timestamp with time zone NOT NULL,
+					action integer NOT NULL,
+					total bigint NOT NULL,
+					created_at timestamp with time zone NOT NULL,
+					PRIMARY KEY ( id )
+				)`,
+				`CREATE TABLE bucket_storage_tallies (
+					id bigserial NOT NULL,
+					project_id bytea NOT NULL,
+					bucket_name text NOT NULL,
+					interval_end_time timestamp with time zone NOT NULL,
+					stored_bytes bigint NOT NULL,
+					object_count bigint NOT NULL,
+					created_at timestamp with time zone NOT NULL,
+					PRIMARY KEY ( id )
+				)`,
+			},
+			"sqlite3": {
+				`CREATE TABLE bucket_bandwidth_tallies (
+					id INTEGER NOT NULL,
+					project_id BLOB NOT NULL,
+					bucket_name TEXT NOT NULL,
+					interval_end_time TIMESTAMP NOT NULL,
+					action INTEGER NOT NULL,
+					total INTEGER NOT NULL,
+					created_at TIMESTAMP NOT NULL,
+					PRIMARY KEY ( id )
+				)`,
+				`CREATE TABLE bucket_storage_tallies (
+					id INTEGER NOT NULL,
+					project_id BLOB NOT NULL,
+					bucket_name TEXT NOT NULL,
+					interval_end_time TIMESTAMP NOT NULL,
+					stored_bytes INTEGER NOT NULL,
+					object_count INTEGER NOT NULL,
+					created_at TIMESTAMP NOT NULL,
+					PRIMARY KEY ( id )
+				)`,
+			},
+		},
+	},
+	{
+		version:     2,
+		description: "add the project limits",
+		statements: map[string][]string{
+			"postgres": {
+				`CREATE TABLE project_limits (
+					project_id bytea NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+					storage_limit bigint NOT NULL,
+					egress_limit bigint NOT NULL,
+					created_at timestamp with time zone NOT NULL,
+					updated_at timestamp with time zone NOT NULL,
+					PRIMARY KEY ( project_id )
+				)`,
+			},
+			"sqlite3": {
+				`CREATE TABLE project_limits (
+					project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+					storage_limit INTEGER NOT NULL,
+					egress_limit INTEGER NOT NULL,
+					created_at TIMESTAMP NOT NULL,
+					updated_at TIMESTAMP NOT NULL,
+					PRIMARY KEY ( project_id )
+				)`,
+			},
+		},
+	},
+	{
+		version:     3,
+		description: "add the graceful exits",
+		statements: map[string][]string{
+			"postgres": {
+				`CREATE TABLE graceful_exits (
+					node_id bytea NOT NULL,
+					pieces_transferred bigint NOT NULL,
+					pieces_failed bigint NOT NULL,
+					exited boolean NOT NULL,
+					created_at timestamp with time zone NOT NULL,
+					updated_at timestamp with time zone NOT NULL,
+					PRIMARY KEY ( node_id )
+				)`,
+			},
+			"sqlite3": {
+				`CREATE TABLE graceful_exits (
+					node_id BLOB NOT NULL,
+					pieces_transferred INTEGER NOT NULL,
+					pieces_failed INTEGER NOT NULL,
+					exited INTEGER NOT NULL,
+					created_at TIMESTAMP NOT NULL,
+					updated_at TIMESTAMP NOT NULL,
+					PRIMARY KEY ( node_id )
+				)`,
+			},
+		},
+	},
+	{
+		// the networks are resolved again, when the nodes are updated
+		version:     4,
+		description: "add the networks of the nodes",
+		statements: map[string][]string{
+			"postgres": {
+				`ALTER TABLE overlay_cache_nodes ADD COLUMN last_net text NOT NULL DEFAULT ''`,
+				`ALTER TABLE overlay_cache_nodes ALTER COLUMN last_net DROP DEFAULT`,
+			},
+			"sqlite3": {
+				`ALTER TABLE overlay_cache_nodes ADD COLUMN last_net TEXT NOT NULL DEFAULT ''`,
+			},
+		},
+	},
+	{
+		// the existing nodes are active since their creation
+		version:     5,
+		description: "add the states of the nodes",
+		statements: map[string][]string{
+			"postgres": {
+				`ALTER TABLE nodes ADD COLUMN state integer NOT NULL DEFAULT 0`,
+				`ALTER TABLE nodes ADD COLUMN state_reason text NOT NULL DEFAULT ''`,
+				`ALTER TABLE nodes ADD COLUMN state_changed_at timestamp with time zone NOT NULL DEFAULT '1970-01-01 00:00:00+00'`,
+				`UPDATE nodes SET state_changed_at = created_at`,
+				`ALTER TABLE nodes ALTER COLUMN state DROP DEFAULT`,
+				`ALTER TABLE nodes ALTER COLUMN state_reason DROP DEFAULT`,
+				`ALTER TABLE nodes ALTER COLUMN state_changed_at DROP DEFAULT`,
+			},
+			"sqlite3": {
+				`ALTER TABLE nodes ADD COLUMN state INTEGER NOT NULL DEFAULT 0`,
+				`ALTER TABLE nodes ADD COLUMN state_reason TEXT NOT NULL DEFAULT ''`,
+				`ALTER TABLE nodes ADD COLUMN state_changed_at TIMESTAMP NOT NULL DEFAULT '1970-01-01 00:00:00+00:00'`,
+				`UPDATE nodes SET state_changed_at = created_at`,
+			},
+		},
+	},
+	{
+		// the reputations are seeded with the success and failure counts
+		version:     6,
+		description: "add the reputations of the nodes",
+		statements: map[string][]string{
+			"postgres": {
+				`ALTER TABLE nodes ADD COLUMN audit_reputation_alpha double precision NOT NULL DEFAULT 0`,
+				`ALTER TABLE nodes ADD COLUMN audit_reputation_beta double precision NOT NULL DEFAULT 0`,
+				`ALTER TABLE nodes ADD COLUMN uptime_reputation_alpha double precision NOT NULL DEFAULT 0`,
+				`ALTER TABLE nodes ADD COLUMN uptime_reputation_beta double precision NOT NULL DEFAULT 0`,
+				seedReputations,
+				`ALTER TABLE nodes ALTER COLUMN audit_reputation_alpha DROP DEFAULT`,
+				`ALTER TABLE nodes ALTER COLUMN audit_reputation_beta DROP DEFAULT`,
+				`ALTER TABLE nodes ALTER COLUMN uptime_reputation_alpha DROP DEFAULT`,
+				`ALTER TABLE nodes ALTER COLUMN uptime_reputation_beta DROP DEFAULT`,
+			},
+			"sqlite3": {
+				`ALTER TABLE nodes ADD COLUMN audit_reputation_alpha REAL NOT NULL DEFAULT 0`,
+				`ALTER TABLE nodes ADD COLUMN audit_reputation_beta REAL NOT NULL DEFAULT 0`,
+				`ALTER TABLE nodes ADD COLUMN uptime_reputation_alpha REAL NOT NULL DEFAULT 0`,
+				`ALTER TABLE nodes ADD COLUMN uptime_reputation_beta REAL NOT NULL DEFAULT 0`,
+				seedReputations,
+			},
+		},
+	},
+	{
+		// the exits, which were initiated before, list their transfers
+		// from the beginning
+		version:     7,
+		description: "add the transfers of the graceful exits",
+		statements: map[string][]string{
+			"postgres": {
+				`ALTER TABLE graceful_exits ADD COLUMN transfers_cursor bytea NOT NULL DEFAULT ''`,
+				`ALTER TABLE graceful_exits ADD COLUMN transfers_listed boolean NOT NULL DEFAULT false`,
+				`ALTER TABLE graceful_exits ALTER COLUMN transfers_cursor DROP DEFAULT`,
+				`ALTER TABLE graceful_exits ALTER COLUMN transfers_listed DROP DEFAULT`,
+				`CREATE TABLE graceful_exit_transfers (
+					node_id bytea NOT NULL,
+					path bytea NOT NULL,
+					piece_num integer NOT NULL,
+					target_id bytea NOT NULL,
+					state integer NOT NULL,
+					created_at timestamp with time zone NOT NULL,
+					updated_at timestamp with time zone NOT NULL,
+					PRIMARY KEY ( node_id, path, piece_num )
+				)`,
+			},
+			"sqlite3": {
+				`ALTER TABLE graceful_exits ADD COLUMN transfers_cursor BLOB NOT NULL DEFAULT X''`,
+				`ALTER TABLE graceful_exits ADD COLUMN transfers_listed INTEGER NOT NULL DEFAULT 0`,
+				`CREATE TABLE graceful_exit_transfers (
+					node_id BLOB NOT NULL,
+					path BLOB NOT NULL,
+					piece_num INTEGER NOT NULL,
+					target_id BLOB NOT NULL,
+					state INTEGER NOT NULL,
+					created_at TIMESTAMP NOT NULL,
+					updated_at TIMESTAMP NOT NULL,
+					PRIMARY KEY ( node_id, path, piece_num )
+				)`,
+			},
+		},
+	},
+	{
+		// the owners of the existing projects are their earliest members
+		version:     8,
+		description: "add the owners of the projects",
+		statements: map[string][]string{
+			"postgres": {
+				`ALTER TABLE projects ADD COLUMN owner_id bytea NOT NULL DEFAULT ''`,
+				seedOwners,
+				`ALTER TABLE projects ALTER COLUMN owner_id DROP DEFAULT`,
+			},
+			"sqlite3": {
+				`ALTER TABLE projects ADD COLUMN owner_id BLOB NOT NULL DEFAULT X''`,
+				seedOwners,
+			},
+		},
+	},
+}
+
+// seedOwners sets the owners of the projects with members to their earliest
+// member
+const seedOwners = `UPDATE projects SET owner_id = (
+		SELECT member_id FROM project_members
+		WHERE project_members.project_id = projects.id
+		ORDER BY created_at LIMIT 1
+	) WHERE EXISTS (SELECT 1 FROM project_members WHERE project_members.project_id = projects.id)`
+
+// seedReputations seeds the alphas and betas of the reputations with the
+// success and failure counts
+const seedReputations = `UPDATE nodes SET
+	audit_reputation_alpha = audit_success_count,
+	audit_reputation_beta = total_audit_count - audit_success_count,
+	uptime_reputation_alpha = uptime_success_count,
+	uptime_reputation_beta = total_uptime_count - uptime_success_count`
+
+// legacySchemas are the sha256 hashes of the schemas by driver, which the
+// databases created before the schema versions were stored have
+var legacySchemas = map[string]string{
+	"postgres": "2ede90a6c58a820fa9ae6cca0fa37a7aaf0594b7a1eeaac2928a77e2444a5c18",
+	"sqlite3":  "d6a29bf40f071d1a05246ec2433e547566423b85ba326b22a73f8d64ceb0909f",
+}
+
+// migrateSchema creates the schema of a new database or migrates the schema
+// of an existing database to the current version in one transaction.
+func migrateSchema(db *dbx.DB, driver string) (err error) {
+	tx, err := db.Begin()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	defer func() {
+		if err != nil {
+			err = Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
+			return
+		}
+		err = Error.Wrap(tx.Commit())
+	}()
+
+	latest := migrations[len(migrations)-1].version
+
+	version, err := schemaVersion(db, tx, driver)
+	if err != nil {
+		return err
+	}
+
+	if version < 0 {
+		if _, err := tx.Exec(db.Schema()); err != nil {
+			return err
+		}
+		_, err = tx.Exec(db.Rebind(`INSERT INTO schema_versions (version) VALUES (?)`), latest)
+		return err
+	}
+
+	if version > latest {
+		return Error.New("schema version %d is newer than the supported version %d", version, latest)
+	}
+
+	for _, step := range migrations {
+		if step.version <= version {
+			continue
+		}
+
+		statements, ok := step.statements[driver]
+		if !ok {
+			return Error.New("migration %d %q doesn't support %q", step.version, step.description, driver)
+		}
+		for _, statement := range statements {
+			if _, err := tx.Exec(statement); err != nil {
+				return Error.New("migration %d %q failed: %v", step.version, step.description, err)
+			}
+		}
+	}
+
+	_, err = tx.Exec(db.Rebind(`UPDATE schema_versions SET version = ?`), latest)
+	return err
+}
+
+// schemaVersion returns the version of the schema of the database, which is
+// -1 for a new database. The databases created before the versions were
+// stored are version 0, when they have the legacy schema.
+func schemaVersion(db *dbx.DB, tx *sql.Tx, driver string) (version int, err error) {
+	_, err = tx.Exec(`CREATE TABLE IF NOT EXISTS schema_versions (version integer NOT NULL)`)
+	if err != nil {
+		return 0, err
+	}
+
+	err = tx.QueryRow(`SELECT version FROM schema_versions`).Scan(&version)
+	if err != sql.ErrNoRows {
+		return version, err
+	}
+
+	_, err = tx.Exec(`CREATE TABLE IF NOT EXISTS table_schemas (id text, schemaText text)`)
+	if err != nil {
+		return 0, err
+	}
+
+	var schema string
+	err = tx.QueryRow(db.Rebind(`SELECT schemaText FROM table_schemas WHERE id = ?`), "database").Scan(&schema)
+	if err == sql.ErrNoRows {
+		return -1, nil
+	}
+	if err != nil {
+		return 0, err
+	}
+
+	hash := sha256.Sum256([]byte(schema))
+	if hex.EncodeToString(hash[:]) != legacySchemas[driver] {
+		return 0, Error.New("the schema of the database is unknown and can't be migrated")
+	}
+
+	_, err = tx.Exec(db.Rebind(`INSERT INTO schema_versions (version) VALUES (?)`), 0)
+	return 0, err
+}
@@ -0,0 +1,254 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"storj.io/storj/pkg/statdb"
+	"storj.io/storj/pkg/storj"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+func TestMigrateSchema(t *testing.T) {
+	ctx := context.Background()
+
+	legacy, err := dbx.Open("sqlite3", "file:legacy?mode=memory")
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, legacy.Close()) }()
+
+	// create a database with the legacy schema
+	_, err = legacy.Exec(legacySQLite3Schema)
+	require.NoError(t, err)
+	_, err = legacy.Exec(`CREATE TABLE table_schemas (id text, schemaText text);`)
+	require.NoError(t, err)
+	_, err = legacy.Exec(`INSERT INTO table_schemas(id, schemaText) VALUES (?, ?);`, "database", legacySQLite3Schema)
+	require.NoError(t, err)
+
+	nodeID := storj.NodeID{1}
+	createdAt := legacy.Hooks.Now().UTC()
+	_, err = legacy.Exec(`INSERT INTO nodes (id, audit_success_count, total_audit_count, audit_success_ratio,
+		uptime_success_count, total_uptime_count, uptime_ratio, created_at, updated_at)
+		VALUES (?, 8, 10, 0.8, 3, 4, 0.75, ?, ?)`, nodeID.Bytes(), createdAt, createdAt)
+	require.NoError(t, err)
+
+	// the earliest member of a project becomes its owner
+	projectID, ownerID, memberID := []byte("project"), []byte("owner"), []byte("member")
+	_, err = legacy.Exec(`INSERT INTO users (id, first_name, last_name, email, password_hash, created_at) VALUES (?, '', '', 'owner', X'', ?), (?, '', '', 'member', X'', ?)`,
+		ownerID, createdAt, memberID, createdAt)
+	require.NoError(t, err)
+	_, err = legacy.Exec(`INSERT INTO projects (id, name, description, terms_accepted, created_at) VALUES (?, 'legacy', '', 1, ?)`, projectID, createdAt)
+	require.NoError(t, err)
+	_, err = legacy.Exec(`INSERT INTO project_members (member_id, project_id, created_at) VALUES (?, ?, ?), (?, ?, ?)`,
+		memberID, projectID, createdAt.Add(time.Hour), ownerID, projectID, createdAt)
+	require.NoError(t, err)
+
+	require.NoError(t, (&DB{db: legacy, driver: "sqlite3"}).CreateTables())
+
+	project, err := legacy.Get_Project_By_Id(ctx, dbx.Project_Id(projectID))
+	require.NoError(t, err)
+	assert.Equal(t, ownerID, project.OwnerId)
+
+	node, err := legacy.Get_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()))
+	require.NoError(t, err)
+	assert.EqualValues(t, 8, node.AuditReputationAlpha)
+	assert.EqualValues(t, 2, node.AuditReputationBeta)
+	assert.EqualValues(t, 3, node.UptimeReputationAlpha)
+	assert.EqualValues(t, 1, node.UptimeReputationBeta)
+	assert.EqualValues(t, statdb.Active, node.State)
+	assert.True(t, createdAt.Equal(node.StateChangedAt))
+
+	// the migrated database has the tables and columns of a new one
+	current, err := dbx.Open("sqlite3", "file:current?mode=memory")
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, current.Close()) }()
+
+	require.NoError(t, (&DB{db: current, driver: "sqlite3"}).CreateTables())
+	assert.Equal(t, sqliteColumns(t, current), sqliteColumns(t, legacy))
+
+	// the migrated and new databases aren't migrated again
+	require.NoError(t, (&DB{db: legacy, driver: "sqlite3"}).CreateTables())
+	require.NoError(t, (&DB{db: current, driver: "sqlite3"}).CreateTables())
+
+	var version int
+	require.NoError(t, legacy.QueryRow(`SELECT version FROM schema_versions`).Scan(&version))
+	assert.Equal(t, migrations[len(migrations)-1].version, version)
+}
+
+func TestMigrateUnknownSchema(t *testing.T) {
+	db, err := dbx.Open("sqlite3", "file::memory:?mode=memory")
+	require.NoError(t, err)
+	defer func() { assert.NoError(t, db.Close()) }()
+
+	_, err = db.Exec(`CREATE TABLE table_schemas (id text, schemaText text);`)
+	require.NoError(t, err)
+	_, err = db.Exec(`INSERT INTO table_schemas(id, schemaText) VALUES (?, ?);`, "database", "CREATE TABLE unknown ( id TEXT );")
+	require.NoError(t, err)
+
+	assert.Error(t, (&DB{db: db, driver: "sqlite3"}).CreateTables())
+}
+
+// sqliteColumns returns the sorted definitions of the columns of every table
+func sqliteColumns(t *testing.T, db *dbx.DB) map[string][]string {
+	rows, err := db.Query(`SELECT name FROM sqlite_master WHERE type = 'table'`)
+	require.NoError(t, err)
+
+	var tables []string
+	for rows.Next() {
+		var table string
+		require.NoError(t, rows.Scan(&table))
+		tables = append(tables, table)
+	}
+	require.NoError(t, rows.Err())
+	require.NoError(t, rows.Close())
+
+	columns := make(map[string][]string)
+	for _, table := range tables {
+		rows, err := db.Query(`PRAGMA table_info(` + table + `)`)
+		require.NoError(t, err)
+
+		for rows.Next() {
+			var cid, notNull, pk int
+			var name, kind string
+			var defaultValue interface{}
+			require.NoError(t, rows.Scan(&cid, &name, &kind, &notNull, &defaultValue, &pk))
+			columns[table] = append(columns[table], fmt.Sprintf("%s %s not null %d pk %d", name, strings.ToUpper(kind), notNull, pk))
+		}
+		require.NoError(t, rows.Err())
+		require.NoError(t, rows.Close())
+
+		sort.Strings(columns[table])
+	}
+	return columns
+}
+
+// legacySQLite3Schema is the schema of the databases created before the
+// schema versions were stored
+const legacySQLite3Schema = `CREATE TABLE accounting_raws (
+	id INTEGER NOT NULL,
+	node_id BLOB NOT NULL,
+	interval_end_time TIMESTAMP NOT NULL,
+	data_total REAL NOT NULL,
+	data_type INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE accounting_rollups (
+	id INTEGER NOT NULL,
+	node_id BLOB NOT NULL,
+	start_time TIMESTAMP NOT NULL,
+	put_total INTEGER NOT NULL,
+	get_total INTEGER NOT NULL,
+	get_audit_total INTEGER NOT NULL,
+	get_repair_total INTEGER NOT NULL,
+	put_repair_total INTEGER NOT NULL,
+	at_rest_total REAL NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE accounting_timestamps (
+	name TEXT NOT NULL,
+	value TIMESTAMP NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE bwagreements (
+	signature BLOB NOT NULL,
+	serialnum TEXT NOT NULL,
+	data BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	expires_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( signature ),
+	UNIQUE ( serialnum )
+);
+CREATE TABLE injuredsegments (
+	id INTEGER NOT NULL,
+	info BLOB NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE irreparabledbs (
+	segmentpath BLOB NOT NULL,
+	segmentdetail BLOB NOT NULL,
+	pieces_lost_count INTEGER NOT NULL,
+	seg_damaged_unix_sec INTEGER NOT NULL,
+	repair_attempt_count INTEGER NOT NULL,
+	PRIMARY KEY ( segmentpath )
+);
+CREATE TABLE nodes (
+	id BLOB NOT NULL,
+	audit_success_count INTEGER NOT NULL,
+	total_audit_count INTEGER NOT NULL,
+	audit_success_ratio REAL NOT NULL,
+	uptime_success_count INTEGER NOT NULL,
+	total_uptime_count INTEGER NOT NULL,
+	uptime_ratio REAL NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	updated_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE overlay_cache_nodes (
+	node_id BLOB NOT NULL,
+	node_type INTEGER NOT NULL,
+	address TEXT NOT NULL,
+	protocol INTEGER NOT NULL,
+	operator_email TEXT NOT NULL,
+	operator_wallet TEXT NOT NULL,
+	free_bandwidth INTEGER NOT NULL,
+	free_disk INTEGER NOT NULL,
+	latency_90 INTEGER NOT NULL,
+	audit_success_ratio REAL NOT NULL,
+	audit_uptime_ratio REAL NOT NULL,
+	audit_count INTEGER NOT NULL,
+	audit_success_count INTEGER NOT NULL,
+	uptime_count INTEGER NOT NULL,
+	uptime_success_count INTEGER NOT NULL,
+	PRIMARY KEY ( node_id ),
+	UNIQUE ( node_id )
+);
+CREATE TABLE projects (
+	id BLOB NOT NULL,
+	name TEXT NOT NULL,
+	description TEXT NOT NULL,
+	terms_accepted INTEGER NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id )
+);
+CREATE TABLE users (
+	id BLOB NOT NULL,
+	first_name TEXT NOT NULL,
+	last_name TEXT NOT NULL,
+	email TEXT NOT NULL,
+	password_hash BLOB NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( email )
+);
+CREATE TABLE api_keys (
+	id BLOB NOT NULL,
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	key BLOB NOT NULL,
+	name TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( id ),
+	UNIQUE ( key ),
+	UNIQUE ( name, project_id )
+);
+CREATE TABLE bucket_infos (
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	name TEXT NOT NULL,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( name )
+);
+CREATE TABLE project_members (
+	member_id BLOB NOT NULL REFERENCES users( id ) ON DELETE CASCADE,
+	project_id BLOB NOT NULL REFERENCES projects( id ) ON DELETE CASCADE,
+	created_at TIMESTAMP NOT NULL,
+	PRIMARY KEY ( member_id, project_id )
+);`
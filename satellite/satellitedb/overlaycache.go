@@ -6,11 +6,18 @@ package satellitedb
 import (
 	"context"
 	"database/sql"
+	"math"
+	"math/rand"
+	"sort"
+	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 
+	"storj.io/storj/internal/memory"
 	"storj.io/storj/pkg/overlay"
 	"storj.io/storj/pkg/pb"
+	"storj.io/storj/pkg/statdb"
 	"storj.io/storj/pkg/storj"
 	dbx "storj.io/storj/satellite/satellitedb/dbx"
 	"storj.io/storj/storage"
@@ -80,6 +87,229 @@ func (cache *overlaycache) List(ctx context.Context, cursor storj.NodeID, limit
 	return infos, nil
 }
 
+// weightedSelectionFactor is how many more candidates than requested are
+// sampled for a weighted selection
+const weightedSelectionFactor = 4
+
+// maxExcludedArgs is the maximum number of excluded nodes, addresses,
+// networks and wallets bound as arguments of the selection query, SQLite
+// limits the arguments of a statement to 999
+const maxExcludedArgs = 900
+
+// SelectStorageNodes randomly selects up to count storage nodes meeting the
+// criteria, the nodes leaving the network or which are suspended or
+// disqualified aren't selected. The reputations are compared as
+// alpha >= min * (alpha + beta), so nodes without results pass. A weighted
+// selection samples more candidates and picks from them by weight.
+func (cache *overlaycache) SelectStorageNodes(ctx context.Context, count int, criteria *overlay.NodeCriteria) (nodes []*pb.Node, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if count <= 0 {
+		return nil, nil
+	}
+
+	query := `SELECT node_id, node_type, address, protocol, operator_email, operator_wallet,
+		free_bandwidth, free_disk, latency_90, audit_success_ratio, audit_uptime_ratio,
+		audit_count, audit_success_count, uptime_count, uptime_success_count, last_net
+		FROM overlay_cache_nodes
+		WHERE node_type = ?
+		AND audit_count >= ? AND audit_success_ratio >= ?
+		AND uptime_count >= ? AND audit_uptime_ratio >= ?
+		AND node_id NOT IN (SELECT node_id FROM graceful_exits)
+		AND node_id NOT IN (SELECT id FROM nodes WHERE state <> ?
+			OR audit_reputation_alpha < ? * (audit_reputation_alpha + audit_reputation_beta)
+			OR uptime_reputation_alpha < ? * (uptime_reputation_alpha + uptime_reputation_beta))`
+	args := []interface{}{
+		int(pb.NodeType_STORAGE),
+		criteria.AuditCount, criteria.AuditSuccessRatio,
+		criteria.UptimeCount, criteria.UptimeRatio,
+		int(statdb.Active), criteria.AuditReputation, criteria.UptimeReputation,
+	}
+
+	// the restrictions of nodes which didn't report them are negative
+	if criteria.FreeBandwidth > 0 {
+		query += ` AND free_bandwidth >= ?`
+		args = append(args, criteria.FreeBandwidth)
+	}
+	if criteria.FreeDisk > 0 {
+		query += ` AND free_disk >= ?`
+		args = append(args, criteria.FreeDisk)
+	}
+	if criteria.MaxAuditCount > 0 {
+		query += ` AND audit_count < ?`
+		args = append(args, criteria.MaxAuditCount)
+	}
+	if criteria.RequireNetwork {
+		query += ` AND last_net <> ''`
+	}
+
+	// the exclusions, which don't fit in the arguments of the query, are
+	// filtered from the results
+	filter := selectionFilter{
+		nodes:     map[storj.NodeID]bool{},
+		addresses: map[string]bool{},
+		networks:  map[string]bool{},
+		wallets:   map[string]bool{},
+	}
+	budget := maxExcludedArgs
+	notIn := func(column string, values []interface{}) (rest []interface{}) {
+		bound := len(values)
+		if bound > budget {
+			bound = budget
+		}
+		if bound > 0 {
+			query += ` AND ` + column + ` NOT IN (?` + strings.Repeat(", ?", bound-1) + `)`
+			args = append(args, values[:bound]...)
+			budget -= bound
+		}
+		return values[bound:]
+	}
+
+	excluded := make([]interface{}, len(criteria.Excluded))
+	for i, id := range criteria.Excluded {
+		excluded[i] = id.Bytes()
+	}
+	rest := notIn("node_id", excluded)
+	for _, id := range criteria.Excluded[len(criteria.Excluded)-len(rest):] {
+		filter.nodes[id] = true
+	}
+
+	addresses := make([]interface{}, len(criteria.ExcludedAddresses))
+	for i, address := range criteria.ExcludedAddresses {
+		addresses[i] = address
+	}
+	for _, address := range notIn("address", addresses) {
+		filter.addresses[address.(string)] = true
+	}
+
+	networks := make([]interface{}, len(criteria.ExcludedNetworks))
+	for i, network := range criteria.ExcludedNetworks {
+		networks[i] = network
+	}
+	for _, network := range notIn("last_net", networks) {
+		filter.networks[network.(string)] = true
+	}
+
+	wallets := make([]interface{}, len(criteria.ExcludedWallets))
+	for i, wallet := range criteria.ExcludedWallets {
+		wallets[i] = wallet
+	}
+	for _, wallet := range notIn("operator_wallet", wallets) {
+		filter.wallets[wallet.(string)] = true
+	}
+
+	wanted := count
+	if criteria.Weighted {
+		wanted *= weightedSelectionFactor
+	}
+	query = cache.db.Rebind(query + ` ORDER BY RANDOM() LIMIT ?`)
+
+	// the sample is grown until enough nodes are left after filtering or
+	// all matching nodes were sampled
+	for limit := wanted; ; limit *= 2 {
+		candidates, err := cache.queryStorageNodes(ctx, query, append(args, limit)...)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = nodes[:0]
+		for _, node := range candidates {
+			if !filter.excludes(node) {
+				nodes = append(nodes, node)
+			}
+		}
+		if len(nodes) >= wanted || len(candidates) < limit {
+			break
+		}
+	}
+	if len(nodes) > wanted {
+		nodes = nodes[:wanted]
+	}
+
+	if criteria.Weighted {
+		nodes = selectWeighted(nodes, count)
+	}
+	return nodes, nil
+}
+
+// queryStorageNodes returns the nodes of the selection query
+func (cache *overlaycache) queryStorageNodes(ctx context.Context, query string, args ...interface{}) (nodes []*pb.Node, err error) {
+	rows, err := cache.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		info := &dbx.OverlayCacheNode{}
+		err := rows.Scan(&info.NodeId, &info.NodeType, &info.Address, &info.Protocol, &info.OperatorEmail, &info.OperatorWallet,
+			&info.FreeBandwidth, &info.FreeDisk, &info.Latency90, &info.AuditSuccessRatio, &info.AuditUptimeRatio,
+			&info.AuditCount, &info.AuditSuccessCount, &info.UptimeCount, &info.UptimeSuccessCount, &info.LastNet)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		node, err := convertOverlayNode(info)
+		if err != nil {
+			return nil, err
+		}
+		nodes = append(nodes, node)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, Error.Wrap(err)
+	}
+	return nodes, nil
+}
+
+// selectionFilter holds the excluded nodes, addresses, networks and wallets
+// of a selection, which are filtered from the results of the query
+type selectionFilter struct {
+	nodes     map[storj.NodeID]bool
+	addresses map[string]bool
+	networks  map[string]bool
+	wallets   map[string]bool
+}
+
+// excludes returns whether the node was excluded
+func (filter *selectionFilter) excludes(node *pb.Node) bool {
+	return filter.nodes[node.Id] ||
+		filter.addresses[node.Address.GetAddress()] ||
+		filter.networks[node.LastNet] ||
+		filter.wallets[node.GetMetadata().GetWallet()]
+}
+
+// selectWeighted picks count of the nodes at random without replacement,
+// the probability of a node is proportional to its weight. Every node gets
+// the key u^(1/weight) for a uniform random u and the nodes with the largest
+// keys are picked.
+func selectWeighted(nodes []*pb.Node, count int) []*pb.Node {
+	if len(nodes) <= count {
+		return nodes
+	}
+
+	keys := make(map[*pb.Node]float64, len(nodes))
+	for _, node := range nodes {
+		keys[node] = math.Pow(rand.Float64(), 1/nodeWeight(node))
+	}
+
+	sort.Slice(nodes, func(i, k int) bool {
+		return keys[nodes[i]] > keys[nodes[k]]
+	})
+	return nodes[:count]
+}
+
+// nodeWeight is the weight of the node in a weighted selection. Nodes with
+// more free disk space and a better reputation weigh more, nodes without
+// free disk space or reputation keep a small weight, so they're still
+// selected to build up their reputation.
+func nodeWeight(node *pb.Node) float64 {
+	freeDisk := math.Max(float64(node.GetRestrictions().GetFreeDisk()), 0)
+	reputation := node.GetReputation()
+	return (1 + freeDisk/float64(memory.GB)) *
+		(0.1 + reputation.GetAuditSuccessRatio()) *
+		(0.1 + reputation.GetUptimeRatio())
+}
+
 // Update updates node information
 func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error) {
 	if info == nil || info.Id.IsZero() {
@@ -142,6 +372,8 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 
 			dbx.OverlayCacheNode_UptimeCount(reputation.UptimeCount),
 			dbx.OverlayCacheNode_UptimeSuccessCount(reputation.UptimeSuccessCount),
+
+			dbx.OverlayCacheNode_LastNet(info.LastNet),
 		)
 		if err != nil {
 			return Error.Wrap(errs.Combine(err, tx.Rollback()))
@@ -159,6 +391,8 @@ func (cache *overlaycache) Update(ctx context.Context, info *pb.Node) (err error
 			AuditSuccessCount:  dbx.OverlayCacheNode_AuditSuccessCount(info.Reputation.AuditSuccessCount),
 			UptimeCount:        dbx.OverlayCacheNode_UptimeCount(info.Reputation.UptimeCount),
 			UptimeSuccessCount: dbx.OverlayCacheNode_UptimeSuccessCount(info.Reputation.UptimeSuccessCount),
+
+			LastNet: dbx.OverlayCacheNode_LastNet(info.LastNet),
 		}
 
 		if info.Metadata != nil {
@@ -226,6 +460,7 @@ func convertOverlayNode(info *dbx.OverlayCacheNode) (*pb.Node, error) {
 			UptimeCount:        info.UptimeCount,
 			UptimeSuccessCount: info.UptimeSuccessCount,
 		},
+		LastNet: info.LastNet,
 	}
 
 	if node.Address.Address == "" {
@@ -244,7 +479,7 @@ func convertOverlayNode(info *dbx.OverlayCacheNode) (*pb.Node, error) {
 	return node, nil
 }
 
-//GetWalletAddress gets the node's wallet address
+// GetWalletAddress gets the node's wallet address
 func (cache *overlaycache) GetWalletAddress(ctx context.Context, id storj.NodeID) (string, error) {
 	w, err := cache.db.Get_OverlayCacheNode_OperatorWallet_By_NodeId(ctx, dbx.OverlayCacheNode_NodeId(id.Bytes()))
 	if err != nil {
@@ -252,3 +487,272 @@ func (cache *overlaycache) GetWalletAddress(ctx context.Context, id storj.NodeID
 	}
 	return w.OperatorWallet, nil
 }
+
+// GetExitStatus returns the graceful exit progress of the node
+func (cache *overlaycache) GetExitStatus(ctx context.Context, id storj.NodeID) (*overlay.ExitStatus, error) {
+	exit, err := cache.db.Get_GracefulExit_By_NodeId(ctx, dbx.GracefulExit_NodeId(id.Bytes()))
+	if err == sql.ErrNoRows {
+		return nil, overlay.ErrExitNotFound
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return convertExitStatus(exit)
+}
+
+// CreateExitStatus marks the node as exiting, the progress of an exit initiated earlier is kept
+func (cache *overlaycache) CreateExitStatus(ctx context.Context, id storj.NodeID) (err error) {
+	tx, err := cache.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	_, err = tx.Get_GracefulExit_By_NodeId(ctx, dbx.GracefulExit_NodeId(id.Bytes()))
+	if err == sql.ErrNoRows {
+		_, err = tx.Create_GracefulExit(ctx,
+			dbx.GracefulExit_NodeId(id.Bytes()),
+			dbx.GracefulExit_PiecesTransferred(0),
+			dbx.GracefulExit_PiecesFailed(0),
+			dbx.GracefulExit_Exited(false),
+			dbx.GracefulExit_TransfersCursor([]byte{}),
+			dbx.GracefulExit_TransfersListed(false),
+		)
+	}
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// GetExitingNodes returns the nodes which initiated a graceful exit, including the exited ones
+func (cache *overlaycache) GetExitingNodes(ctx context.Context) (storj.NodeIDList, error) {
+	exits, err := cache.db.All_GracefulExit(ctx)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	ids := make(storj.NodeIDList, 0, len(exits))
+	for _, exit := range exits {
+		id, err := storj.NodeIDFromBytes(exit.NodeId)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, nil
+}
+
+func convertExitStatus(exit *dbx.GracefulExit) (*overlay.ExitStatus, error) {
+	id, err := storj.NodeIDFromBytes(exit.NodeId)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &overlay.ExitStatus{
+		NodeID:            id,
+		PiecesTransferred: exit.PiecesTransferred,
+		PiecesFailed:      exit.PiecesFailed,
+		Exited:            exit.Exited,
+		TransfersCursor:   string(exit.TransfersCursor),
+		TransfersListed:   exit.TransfersListed,
+		InitiatedAt:       exit.CreatedAt,
+		UpdatedAt:         exit.UpdatedAt,
+	}, nil
+}
+
+// CompleteExit marks the node as exited, it fails with ErrExitIncomplete unless all segments were listed and no transfer is pending
+func (cache *overlaycache) CompleteExit(ctx context.Context, id storj.NodeID) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	result, err := cache.db.ExecContext(ctx, cache.db.Rebind(`UPDATE graceful_exits
+		SET exited = ?, updated_at = ?
+		WHERE node_id = ? AND exited = ? AND transfers_listed = ?
+		AND NOT EXISTS (SELECT 1 FROM graceful_exit_transfers WHERE node_id = ? AND state = ?)`),
+		true, time.Now().UTC(), id.Bytes(), false, true, id.Bytes(), int(overlay.ExitTransferPending))
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	completed, err := result.RowsAffected()
+	if err != nil {
+		return Error.Wrap(err)
+	}
+	if completed == 0 {
+		return overlay.ErrExitIncomplete
+	}
+	return nil
+}
+
+// AddExitTransfers records the transfers of the pieces of the node in the segments up to cursor, listed is set once all segments were listed
+func (cache *overlaycache) AddExitTransfers(ctx context.Context, id storj.NodeID, transfers []*overlay.ExitTransfer, cursor string, listed bool) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	tx, err := cache.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := time.Now().UTC()
+
+	// the transfers, which failed before they were handed out, are counted
+	var failed int64
+	for _, transfer := range transfers {
+		_, err = tx.Tx.ExecContext(ctx, cache.db.Rebind(`INSERT INTO graceful_exit_transfers
+			( node_id, path, piece_num, target_id, state, created_at, updated_at )
+			VALUES ( ?, ?, ?, ?, ?, ?, ? )`),
+			id.Bytes(), []byte(transfer.Path), int(transfer.PieceNum), transfer.TargetID.Bytes(), int(transfer.State), now, now)
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+		if transfer.State == overlay.ExitTransferFailed {
+			failed++
+		}
+	}
+
+	result, err := tx.Tx.ExecContext(ctx, cache.db.Rebind(`UPDATE graceful_exits
+		SET transfers_cursor = ?, transfers_listed = ?, pieces_failed = pieces_failed + ?, updated_at = ?
+		WHERE node_id = ?`),
+		[]byte(cursor), listed, failed, now, id.Bytes())
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+	if updated, err := result.RowsAffected(); err != nil || updated == 0 {
+		if err == nil {
+			return errs.Combine(overlay.ErrExitNotFound, tx.Rollback())
+		}
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	return Error.Wrap(tx.Commit())
+}
+
+// GetExitTransfer returns the transfer of a piece of the node
+func (cache *overlaycache) GetExitTransfer(ctx context.Context, id storj.NodeID, path string, pieceNum int32) (_ *overlay.ExitTransfer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var targetID []byte
+	var state int
+	err = cache.db.QueryRowContext(ctx, cache.db.Rebind(`SELECT target_id, state
+		FROM graceful_exit_transfers
+		WHERE node_id = ? AND path = ? AND piece_num = ?`),
+		id.Bytes(), []byte(path), int(pieceNum)).Scan(&targetID, &state)
+	if err == sql.ErrNoRows {
+		return nil, overlay.ErrExitTransferNotFound
+	}
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	target, err := storj.NodeIDFromBytes(targetID)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+
+	return &overlay.ExitTransfer{
+		NodeID:   id,
+		Path:     path,
+		PieceNum: pieceNum,
+		TargetID: target,
+		State:    overlay.ExitTransferState(state),
+	}, nil
+}
+
+// GetPendingExitTransfers returns up to limit transfers of the node, which weren't finished
+func (cache *overlaycache) GetPendingExitTransfers(ctx context.Context, id storj.NodeID, limit int) (transfers []*overlay.ExitTransfer, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := cache.db.QueryContext(ctx, cache.db.Rebind(`SELECT path, piece_num, target_id
+		FROM graceful_exit_transfers
+		WHERE node_id = ? AND state = ?
+		ORDER BY path, piece_num LIMIT ?`),
+		id.Bytes(), int(overlay.ExitTransferPending), limit)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var path, targetID []byte
+		var pieceNum int
+		if err := rows.Scan(&path, &pieceNum, &targetID); err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		target, err := storj.NodeIDFromBytes(targetID)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+
+		transfers = append(transfers, &overlay.ExitTransfer{
+			NodeID:   id,
+			Path:     string(path),
+			PieceNum: int32(pieceNum),
+			TargetID: target,
+			State:    overlay.ExitTransferPending,
+		})
+	}
+	return transfers, Error.Wrap(rows.Err())
+}
+
+// CountPendingExitTransfers counts the transfers of the node, which weren't finished
+func (cache *overlaycache) CountPendingExitTransfers(ctx context.Context, id storj.NodeID) (count int64, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	err = cache.db.QueryRowContext(ctx, cache.db.Rebind(`SELECT COUNT(*)
+		FROM graceful_exit_transfers
+		WHERE node_id = ? AND state = ?`),
+		id.Bytes(), int(overlay.ExitTransferPending)).Scan(&count)
+	return count, Error.Wrap(err)
+}
+
+// FinishExitTransfer sets the state of a pending transfer and counts it in the progress of the exit, it fails with ErrExitTransferFinished if the transfer isn't pending
+func (cache *overlaycache) FinishExitTransfer(ctx context.Context, id storj.NodeID, path string, pieceNum int32, state overlay.ExitTransferState) (err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	var counter string
+	switch state {
+	case overlay.ExitTransferSucceeded:
+		counter = "pieces_transferred"
+	case overlay.ExitTransferFailed:
+		counter = "pieces_failed"
+	case overlay.ExitTransferObsolete:
+	default:
+		return Error.New("invalid transfer state %d", state)
+	}
+
+	tx, err := cache.db.Open(ctx)
+	if err != nil {
+		return Error.Wrap(err)
+	}
+
+	now := time.Now().UTC()
+
+	// only a pending transfer is finished, so that every piece is counted once
+	result, err := tx.Tx.ExecContext(ctx, cache.db.Rebind(`UPDATE graceful_exit_transfers
+		SET state = ?, updated_at = ?
+		WHERE node_id = ? AND path = ? AND piece_num = ? AND state = ?`),
+		int(state), now, id.Bytes(), []byte(path), int(pieceNum), int(overlay.ExitTransferPending))
+	if err != nil {
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+	if updated, err := result.RowsAffected(); err != nil || updated == 0 {
+		if err == nil {
+			return errs.Combine(overlay.ErrExitTransferFinished, tx.Rollback())
+		}
+		return Error.Wrap(errs.Combine(err, tx.Rollback()))
+	}
+
+	if counter != "" {
+		_, err = tx.Tx.ExecContext(ctx, cache.db.Rebind(`UPDATE graceful_exits
+			SET `+counter+` = `+counter+` + 1, updated_at = ?
+			WHERE node_id = ?`),
+			now, id.Bytes())
+		if err != nil {
+			return Error.Wrap(errs.Combine(err, tx.Rollback()))
+		}
+	}
+
+	return Error.Wrap(tx.Commit())
+}
@@ -60,7 +60,8 @@ func (projects *projects) Insert(ctx context.Context, project *console.Project)
 		dbx.Project_Id(projectID[:]),
 		dbx.Project_Name(project.Name),
 		dbx.Project_Description(project.Description),
-		dbx.Project_TermsAccepted(project.TermsAccepted))
+		dbx.Project_TermsAccepted(project.TermsAccepted),
+		dbx.Project_OwnerId(project.OwnerID[:]))
 
 	if err != nil {
 		return nil, err
@@ -109,6 +110,15 @@ func projectFromDBX(project *dbx.Project) (*console.Project, error) {
 		CreatedAt:     project.CreatedAt,
 	}
 
+	// the projects without members, which were created before the owners
+	// were stored, don't have an owner
+	if len(project.OwnerId) > 0 {
+		u.OwnerID, err = bytesToUUID(project.OwnerId)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return u, nil
 }
 
@@ -67,6 +67,7 @@ func TestProjectsRepository(t *testing.T) {
 			Name:          name,
 			Description:   description,
 			TermsAccepted: 1,
+			OwnerID:       owner.ID,
 		}
 
 		project, err = projects.Insert(ctx, project)
@@ -81,6 +82,7 @@ func TestProjectsRepository(t *testing.T) {
 		assert.Equal(t, projectByID.Name, name)
 		assert.Equal(t, projectByID.Description, description)
 		assert.Equal(t, projectByID.TermsAccepted, 1)
+		assert.Equal(t, projectByID.OwnerID, owner.ID)
 	})
 
 	t.Run("Get by projectID success", func(t *testing.T) {
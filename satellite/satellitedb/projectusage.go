@@ -0,0 +1,99 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/pkg/pb"
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type projectUsage struct {
+	db dbx.Methods
+}
+
+// GetDailyUsage returns the usage of a project for every day of the given period
+func (usage *projectUsage) GetDailyUsage(ctx context.Context, projectID uuid.UUID, since, before time.Time) ([]console.DailyUsage, error) {
+	tallies, err := usage.db.All_BucketStorageTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(
+		ctx,
+		dbx.BucketStorageTally_ProjectId(projectID[:]),
+		dbx.BucketStorageTally_IntervalEndTime(since),
+		dbx.BucketStorageTally_IntervalEndTime(before),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	bandwidth, err := usage.db.All_BucketBandwidthTally_By_ProjectId_And_IntervalEndTime_GreaterOrEqual_And_IntervalEndTime_Less_OrderBy_Asc_IntervalEndTime(
+		ctx,
+		dbx.BucketBandwidthTally_ProjectId(projectID[:]),
+		dbx.BucketBandwidthTally_IntervalEndTime(since),
+		dbx.BucketBandwidthTally_IntervalEndTime(before),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	days := make(map[time.Time]*console.DailyUsage)
+	getDay := func(t time.Time) *console.DailyUsage {
+		date := t.UTC().Truncate(24 * time.Hour)
+		day, ok := days[date]
+		if !ok {
+			day = &console.DailyUsage{Date: date}
+			days[date] = day
+		}
+		return day
+	}
+
+	// every tally run stores a row per bucket, so sum up the buckets of
+	// each run before averaging the runs of a day
+	type run struct {
+		end     time.Time
+		bytes   int64
+		objects int64
+	}
+	var runs []*run
+	for _, tally := range tallies {
+		if len(runs) == 0 || !runs[len(runs)-1].end.Equal(tally.IntervalEndTime) {
+			runs = append(runs, &run{end: tally.IntervalEndTime})
+		}
+		last := runs[len(runs)-1]
+		last.bytes += tally.StoredBytes
+		last.objects += tally.ObjectCount
+	}
+
+	runCounts := make(map[*console.DailyUsage]int)
+	for _, r := range runs {
+		day := getDay(r.end)
+		day.Storage += float64(r.bytes)
+		day.ObjectCount = r.objects
+		runCounts[day]++
+	}
+	for day, count := range runCounts {
+		day.Storage /= float64(count)
+	}
+
+	for _, bw := range bandwidth {
+		if bw.Action != int(pb.PayerBandwidthAllocation_GET) {
+			continue
+		}
+		getDay(bw.IntervalEndTime).Egress += bw.Total
+	}
+
+	dailyUsage := make([]console.DailyUsage, 0, len(days))
+	for _, day := range days {
+		dailyUsage = append(dailyUsage, *day)
+	}
+	sort.Slice(dailyUsage, func(i, k int) bool {
+		return dailyUsage[i].Date.Before(dailyUsage[k].Date)
+	})
+
+	return dailyUsage, nil
+}
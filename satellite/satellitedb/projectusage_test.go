@@ -0,0 +1,100 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+	"time"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/pkg/accounting"
+	"storj.io/storj/pkg/pb"
+)
+
+func TestProjectUsage(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := NewInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(db.Close)
+
+	if err = db.CreateTables(); err != nil {
+		t.Fatal(err)
+	}
+
+	projectID, err := uuid.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherProjectID, err := uuid.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	day := time.Date(2019, 1, 1, 0, 0, 0, 0, time.UTC)
+	nextDay := day.Add(24 * time.Hour)
+
+	tallies := []struct {
+		end     time.Time
+		tallies []*accounting.BucketTally
+	}{
+		{day.Add(time.Hour), []*accounting.BucketTally{
+			{ProjectID: *projectID, BucketName: "bucket1", Bytes: 100, ObjectCount: 1},
+			{ProjectID: *projectID, BucketName: "bucket2", Bytes: 100, ObjectCount: 1},
+			{ProjectID: *otherProjectID, BucketName: "bucket3", Bytes: 1000, ObjectCount: 10},
+		}},
+		{day.Add(2 * time.Hour), []*accounting.BucketTally{
+			{ProjectID: *projectID, BucketName: "bucket1", Bytes: 400, ObjectCount: 3},
+		}},
+		{nextDay.Add(time.Hour), []*accounting.BucketTally{
+			{ProjectID: *projectID, BucketName: "bucket1", Bytes: 50, ObjectCount: 1},
+		}},
+	}
+	for _, tally := range tallies {
+		err = db.Accounting().SaveAtRestRaw(ctx, tally.end, nil, tally.tallies)
+		assert.NoError(t, err)
+	}
+
+	err = db.Accounting().SaveBWRaw(ctx, day.Add(time.Hour), accounting.BWTally{}, []*accounting.BucketBandwidth{
+		{ProjectID: *projectID, BucketName: "bucket1", Action: pb.PayerBandwidthAllocation_GET, Total: 10},
+		{ProjectID: *projectID, BucketName: "bucket2", Action: pb.PayerBandwidthAllocation_GET, Total: 20},
+		{ProjectID: *projectID, BucketName: "", Action: pb.PayerBandwidthAllocation_PUT, Total: 1000},
+		{ProjectID: *otherProjectID, BucketName: "bucket3", Action: pb.PayerBandwidthAllocation_GET, Total: 1000},
+	})
+	assert.NoError(t, err)
+
+	usage := db.Console().ProjectUsage()
+
+	t.Run("Daily usage", func(t *testing.T) {
+		dailyUsage, err := usage.GetDailyUsage(ctx, *projectID, day, nextDay.Add(24*time.Hour))
+		assert.NoError(t, err)
+
+		if assert.Equal(t, 2, len(dailyUsage)) {
+			assert.True(t, day.Equal(dailyUsage[0].Date))
+			assert.Equal(t, float64(300), dailyUsage[0].Storage)
+			assert.Equal(t, int64(30), dailyUsage[0].Egress)
+			assert.Equal(t, int64(3), dailyUsage[0].ObjectCount)
+
+			assert.True(t, nextDay.Equal(dailyUsage[1].Date))
+			assert.Equal(t, float64(50), dailyUsage[1].Storage)
+			assert.Equal(t, int64(0), dailyUsage[1].Egress)
+			assert.Equal(t, int64(1), dailyUsage[1].ObjectCount)
+		}
+	})
+
+	t.Run("Daily usage of period", func(t *testing.T) {
+		dailyUsage, err := usage.GetDailyUsage(ctx, *projectID, nextDay, nextDay.Add(24*time.Hour))
+		assert.NoError(t, err)
+
+		if assert.Equal(t, 1, len(dailyUsage)) {
+			assert.True(t, nextDay.Equal(dailyUsage[0].Date))
+		}
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"context"
 	"database/sql"
 	"strings"
+	"time"
 
 	"github.com/zeebo/errs"
 	monkit "gopkg.in/spacemonkeygo/monkit.v2"
@@ -37,6 +38,15 @@ func getNodeStats(nodeID storj.NodeID, dbNode *dbx.Node) *statdb.NodeStats {
 		UptimeRatio:        dbNode.UptimeRatio,
 		UptimeSuccessCount: dbNode.UptimeSuccessCount,
 		UptimeCount:        dbNode.TotalUptimeCount,
+
+		AuditReputationAlpha:  dbNode.AuditReputationAlpha,
+		AuditReputationBeta:   dbNode.AuditReputationBeta,
+		UptimeReputationAlpha: dbNode.UptimeReputationAlpha,
+		UptimeReputationBeta:  dbNode.UptimeReputationBeta,
+
+		State:          statdb.NodeState(dbNode.State),
+		StateReason:    dbNode.StateReason,
+		StateChangedAt: dbNode.StateChangedAt,
 	}
 	return nodeStats
 }
@@ -52,6 +62,12 @@ func (s *statDB) Create(ctx context.Context, nodeID storj.NodeID, startingStats
 		totalUptimeCount   int64
 		uptimeSuccessCount int64
 		uptimeRatio        float64
+		auditAlpha         float64
+		auditBeta          float64
+		uptimeAlpha        float64
+		uptimeBeta         float64
+		state              statdb.NodeState
+		stateReason        string
 	)
 
 	if startingStats != nil {
@@ -68,6 +84,19 @@ func (s *statDB) Create(ctx context.Context, nodeID storj.NodeID, startingStats
 		if err != nil {
 			return nil, errUptime.Wrap(err)
 		}
+
+		// the reputations are seeded with the counts, unless they are given
+		auditAlpha, auditBeta = startingStats.AuditReputationAlpha, startingStats.AuditReputationBeta
+		if auditAlpha == 0 && auditBeta == 0 {
+			auditAlpha, auditBeta = float64(auditSuccessCount), float64(totalAuditCount-auditSuccessCount)
+		}
+		uptimeAlpha, uptimeBeta = startingStats.UptimeReputationAlpha, startingStats.UptimeReputationBeta
+		if uptimeAlpha == 0 && uptimeBeta == 0 {
+			uptimeAlpha, uptimeBeta = float64(uptimeSuccessCount), float64(totalUptimeCount-uptimeSuccessCount)
+		}
+
+		state = startingStats.State
+		stateReason = startingStats.StateReason
 	}
 
 	dbNode, err := s.db.Create_Node(
@@ -79,6 +108,13 @@ func (s *statDB) Create(ctx context.Context, nodeID storj.NodeID, startingStats
 		dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
 		dbx.Node_TotalUptimeCount(totalUptimeCount),
 		dbx.Node_UptimeRatio(uptimeRatio),
+		dbx.Node_AuditReputationAlpha(auditAlpha),
+		dbx.Node_AuditReputationBeta(auditBeta),
+		dbx.Node_UptimeReputationAlpha(uptimeAlpha),
+		dbx.Node_UptimeReputationBeta(uptimeBeta),
+		dbx.Node_State(int(state)),
+		dbx.Node_StateReason(stateReason),
+		dbx.Node_StateChangedAt(time.Now().UTC()),
 	)
 	if err != nil {
 		return nil, Error.Wrap(err)
@@ -191,13 +227,20 @@ func (s *statDB) Update(ctx context.Context, updateReq *statdb.UpdateRequest) (s
 		totalUptimeCount,
 	)
 
+	auditAlpha, auditBeta := updateReq.AuditReputation.Update(dbNode.AuditReputationAlpha, dbNode.AuditReputationBeta, updateReq.AuditSuccess)
+	uptimeAlpha, uptimeBeta := updateReq.UptimeReputation.Update(dbNode.UptimeReputationAlpha, dbNode.UptimeReputationBeta, updateReq.IsUp)
+
 	updateFields := dbx.Node_Update_Fields{
-		AuditSuccessCount:  dbx.Node_AuditSuccessCount(auditSuccessCount),
-		TotalAuditCount:    dbx.Node_TotalAuditCount(totalAuditCount),
-		AuditSuccessRatio:  dbx.Node_AuditSuccessRatio(auditSuccessRatio),
-		UptimeSuccessCount: dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
-		TotalUptimeCount:   dbx.Node_TotalUptimeCount(totalUptimeCount),
-		UptimeRatio:        dbx.Node_UptimeRatio(uptimeRatio),
+		AuditSuccessCount:     dbx.Node_AuditSuccessCount(auditSuccessCount),
+		TotalAuditCount:       dbx.Node_TotalAuditCount(totalAuditCount),
+		AuditSuccessRatio:     dbx.Node_AuditSuccessRatio(auditSuccessRatio),
+		UptimeSuccessCount:    dbx.Node_UptimeSuccessCount(uptimeSuccessCount),
+		TotalUptimeCount:      dbx.Node_TotalUptimeCount(totalUptimeCount),
+		UptimeRatio:           dbx.Node_UptimeRatio(uptimeRatio),
+		AuditReputationAlpha:  dbx.Node_AuditReputationAlpha(auditAlpha),
+		AuditReputationBeta:   dbx.Node_AuditReputationBeta(auditBeta),
+		UptimeReputationAlpha: dbx.Node_UptimeReputationAlpha(uptimeAlpha),
+		UptimeReputationBeta:  dbx.Node_UptimeReputationBeta(uptimeBeta),
 	}
 
 	updateFields.UptimeSuccessCount = dbx.Node_UptimeSuccessCount(uptimeSuccessCount)
@@ -213,8 +256,8 @@ func (s *statDB) Update(ctx context.Context, updateReq *statdb.UpdateRequest) (s
 	return nodeStats, Error.Wrap(tx.Commit())
 }
 
-// UpdateUptime updates a single storagenode's uptime stats in the db
-func (s *statDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool) (stats *statdb.NodeStats, err error) {
+// UpdateUptime updates a single storagenode's uptime stats and reputation in the db
+func (s *statDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp bool, params statdb.ReputationParams) (stats *statdb.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	tx, err := s.db.Open(ctx)
@@ -242,6 +285,10 @@ func (s *statDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp boo
 	updateFields.TotalUptimeCount = dbx.Node_TotalUptimeCount(totalUptimeCount)
 	updateFields.UptimeRatio = dbx.Node_UptimeRatio(uptimeRatio)
 
+	uptimeAlpha, uptimeBeta := params.Update(dbNode.UptimeReputationAlpha, dbNode.UptimeReputationBeta, isUp)
+	updateFields.UptimeReputationAlpha = dbx.Node_UptimeReputationAlpha(uptimeAlpha)
+	updateFields.UptimeReputationBeta = dbx.Node_UptimeReputationBeta(uptimeBeta)
+
 	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
 	if err != nil {
 		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
@@ -251,8 +298,8 @@ func (s *statDB) UpdateUptime(ctx context.Context, nodeID storj.NodeID, isUp boo
 	return nodeStats, Error.Wrap(tx.Commit())
 }
 
-// UpdateAuditSuccess updates a single storagenode's uptime stats in the db
-func (s *statDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool) (stats *statdb.NodeStats, err error) {
+// UpdateAuditSuccess updates a single storagenode's audit stats and reputation in the db
+func (s *statDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, auditSuccess bool, params statdb.ReputationParams) (stats *statdb.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
 
 	tx, err := s.db.Open(ctx)
@@ -280,6 +327,10 @@ func (s *statDB) UpdateAuditSuccess(ctx context.Context, nodeID storj.NodeID, au
 	updateFields.TotalAuditCount = dbx.Node_TotalAuditCount(totalAuditCount)
 	updateFields.AuditSuccessRatio = dbx.Node_AuditSuccessRatio(auditRatio)
 
+	auditAlpha, auditBeta := params.Update(dbNode.AuditReputationAlpha, dbNode.AuditReputationBeta, auditSuccess)
+	updateFields.AuditReputationAlpha = dbx.Node_AuditReputationAlpha(auditAlpha)
+	updateFields.AuditReputationBeta = dbx.Node_AuditReputationBeta(auditBeta)
+
 	dbNode, err = tx.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), updateFields)
 	if err != nil {
 		return nil, Error.Wrap(utils.CombineErrors(err, tx.Rollback()))
@@ -314,6 +365,93 @@ func (s *statDB) UpdateBatch(ctx context.Context, updateReqList []*statdb.Update
 	return nodeStatsList, nil, nil
 }
 
+// UpdateState sets the state of a single storagenode and the reason of the change in the db
+func (s *statDB) UpdateState(ctx context.Context, nodeID storj.NodeID, state statdb.NodeState, reason string) (stats *statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	dbNode, err := s.db.Update_Node_By_Id(ctx, dbx.Node_Id(nodeID.Bytes()), dbx.Node_Update_Fields{
+		State:          dbx.Node_State(int(state)),
+		StateReason:    dbx.Node_StateReason(reason),
+		StateChangedAt: dbx.Node_StateChangedAt(time.Now().UTC()),
+	})
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	if dbNode == nil {
+		return nil, Error.New("node %s not found", nodeID)
+	}
+
+	return getNodeStats(nodeID, dbNode), nil
+}
+
+// FindNodesInState finds a subset of storagenodes in the state
+func (s *statDB) FindNodesInState(ctx context.Context, nodeIDs storj.NodeIDList, state statdb.NodeState) (ids storj.NodeIDList, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	if len(nodeIDs) == 0 {
+		return nil, nil
+	}
+
+	args := make([]interface{}, 0, len(nodeIDs)+1)
+	for _, id := range nodeIDs {
+		args = append(args, id.Bytes())
+	}
+	args = append(args, int(state))
+
+	rows, err := s.db.QueryContext(ctx, s.db.Rebind(`SELECT nodes.id FROM nodes
+		WHERE nodes.id IN (?`+strings.Repeat(", ?", len(nodeIDs)-1)+`)
+		AND nodes.state = ?`), args...)
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		var idBytes []byte
+		if err := rows.Scan(&idBytes); err != nil {
+			return nil, Error.Wrap(err)
+		}
+		id, err := storj.NodeIDFromBytes(idBytes)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		ids = append(ids, id)
+	}
+	return ids, Error.Wrap(rows.Err())
+}
+
+// ListByState lists the stats of all storagenodes in the state
+func (s *statDB) ListByState(ctx context.Context, state statdb.NodeState) (statsList []*statdb.NodeStats, err error) {
+	defer mon.Task()(&ctx)(&err)
+
+	rows, err := s.db.QueryContext(ctx, s.db.Rebind(`SELECT nodes.id, nodes.audit_success_count, nodes.total_audit_count,
+		nodes.audit_success_ratio, nodes.uptime_success_count, nodes.total_uptime_count, nodes.uptime_ratio,
+		nodes.audit_reputation_alpha, nodes.audit_reputation_beta, nodes.uptime_reputation_alpha, nodes.uptime_reputation_beta,
+		nodes.state, nodes.state_reason, nodes.state_changed_at
+		FROM nodes WHERE nodes.state = ? ORDER BY nodes.id`), int(state))
+	if err != nil {
+		return nil, Error.Wrap(err)
+	}
+	defer func() { err = errs.Combine(err, rows.Close()) }()
+
+	for rows.Next() {
+		node := &dbx.Node{}
+		err := rows.Scan(&node.Id, &node.AuditSuccessCount, &node.TotalAuditCount,
+			&node.AuditSuccessRatio, &node.UptimeSuccessCount, &node.TotalUptimeCount, &node.UptimeRatio,
+			&node.AuditReputationAlpha, &node.AuditReputationBeta, &node.UptimeReputationAlpha, &node.UptimeReputationBeta,
+			&node.State, &node.StateReason, &node.StateChangedAt)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		id, err := storj.NodeIDFromBytes(node.Id)
+		if err != nil {
+			return nil, Error.Wrap(err)
+		}
+		statsList = append(statsList, getNodeStats(id, node))
+	}
+	return statsList, Error.Wrap(rows.Err())
+}
+
 // CreateEntryIfNotExists creates a statdb node entry and saves to statdb if it didn't already exist
 func (s *statDB) CreateEntryIfNotExists(ctx context.Context, nodeID storj.NodeID) (stats *statdb.NodeStats, err error) {
 	defer mon.Task()(&ctx)(&err)
@@ -0,0 +1,55 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/skyrings/skyring-common/tools/uuid"
+
+	"storj.io/storj/satellite/console"
+	dbx "storj.io/storj/satellite/satellitedb/dbx"
+)
+
+type usageLimits struct {
+	db dbx.Methods
+}
+
+// GetLimits returns the limits of a project, zero limits when none were set
+func (limits *usageLimits) GetLimits(ctx context.Context, projectID uuid.UUID) (*console.UsageLimit, error) {
+	limit, err := limits.db.Get_ProjectLimit_By_ProjectId(ctx, dbx.ProjectLimit_ProjectId(projectID[:]))
+	if err == sql.ErrNoRows {
+		return &console.UsageLimit{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &console.UsageLimit{
+		Storage: limit.StorageLimit,
+		Egress:  limit.EgressLimit,
+	}, nil
+}
+
+// SetLimits sets the limits of a project
+func (limits *usageLimits) SetLimits(ctx context.Context, projectID uuid.UUID, limit console.UsageLimit) error {
+	updated, err := limits.db.Update_ProjectLimit_By_ProjectId(ctx,
+		dbx.ProjectLimit_ProjectId(projectID[:]),
+		dbx.ProjectLimit_Update_Fields{
+			StorageLimit: dbx.ProjectLimit_StorageLimit(limit.Storage),
+			EgressLimit:  dbx.ProjectLimit_EgressLimit(limit.Egress),
+		},
+	)
+	if err != nil || updated != nil {
+		return err
+	}
+
+	_, err = limits.db.Create_ProjectLimit(ctx,
+		dbx.ProjectLimit_ProjectId(projectID[:]),
+		dbx.ProjectLimit_StorageLimit(limit.Storage),
+		dbx.ProjectLimit_EgressLimit(limit.Egress),
+	)
+	return err
+}
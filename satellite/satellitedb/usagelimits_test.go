@@ -0,0 +1,57 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package satellitedb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"storj.io/storj/internal/testcontext"
+	"storj.io/storj/satellite/console"
+)
+
+func TestUsageLimits(t *testing.T) {
+	ctx := testcontext.New(t)
+	defer ctx.Cleanup()
+
+	db, err := NewInMemory()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer ctx.Check(db.Close)
+
+	if err = db.CreateTables(); err != nil {
+		t.Fatal(err)
+	}
+
+	project, err := db.Console().Projects().Insert(ctx, &console.Project{Name: "limited"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limits := db.Console().UsageLimits()
+
+	t.Run("Unset limits are zero", func(t *testing.T) {
+		limit, err := limits.GetLimits(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &console.UsageLimit{}, limit)
+	})
+
+	t.Run("Set and update limits", func(t *testing.T) {
+		err := limits.SetLimits(ctx, project.ID, console.UsageLimit{Storage: 100, Egress: 200})
+		assert.NoError(t, err)
+
+		limit, err := limits.GetLimits(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &console.UsageLimit{Storage: 100, Egress: 200}, limit)
+
+		err = limits.SetLimits(ctx, project.ID, console.UsageLimit{Storage: 300})
+		assert.NoError(t, err)
+
+		limit, err = limits.GetLimits(ctx, project.ID)
+		assert.NoError(t, err)
+		assert.Equal(t, &console.UsageLimit{Storage: 300}, limit)
+	})
+}
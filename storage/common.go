@@ -22,6 +22,10 @@ var ErrEmptyKey = errs.Class("empty key")
 // ErrEmptyQueue is returned when attempting to Dequeue from an empty queue
 var ErrEmptyQueue = errs.Class("empty queue")
 
+// ErrValueChanged is returned when the current value of the key does not
+// match the old value passed to CompareAndSwap
+var ErrValueChanged = errs.Class("value changed")
+
 // ErrLimitExceeded is returned when request limit is exceeded
 var ErrLimitExceeded = errors.New("limit exceeded")
 
@@ -60,6 +64,11 @@ type KeyValueStore interface {
 	GetAll(Keys) (Values, error)
 	// Delete deletes key and the value
 	Delete(Key) error
+	// CompareAndSwap atomically replaces the value of the key with newValue
+	// if its current value is oldValue, failing with ErrValueChanged
+	// otherwise. A nil oldValue stands for a missing key, a nil newValue
+	// deletes the key.
+	CompareAndSwap(key Key, oldValue, newValue Value) error
 	// List lists all keys starting from start and upto limit items
 	List(start Key, limit int) (Keys, error)
 	// ReverseList lists all keys in revers order
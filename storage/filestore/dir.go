@@ -115,6 +115,49 @@ func (dir *Dir) Commit(file *os.File, ref storage.BlobRef) error {
 	return nil
 }
 
+// Move moves an existing file to the permanent storage as the blob with the
+// specified ref, the file stays in place when moving fails
+func (dir *Dir) Move(path string, ref storage.BlobRef) error {
+	target := dir.refToPath(ref)
+	mkdirErr := os.MkdirAll(filepath.Dir(target), dirPermission)
+	if os.IsExist(mkdirErr) {
+		mkdirErr = nil
+	}
+	if mkdirErr != nil {
+		return mkdirErr
+	}
+
+	return os.Rename(path, target)
+}
+
+// SpaceUsed returns the total size of the committed blobs
+func (dir *Dir) SpaceUsed() (int64, error) {
+	prefixes, err := ioutil.ReadDir(dir.blobdir())
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, prefix := range prefixes {
+		// blobs are stored in directories named by the first byte of their ref
+		if !prefix.IsDir() || len(prefix.Name()) != 2 {
+			continue
+		}
+
+		blobs, err := ioutil.ReadDir(filepath.Join(dir.blobdir(), prefix.Name()))
+		if err != nil {
+			return 0, err
+		}
+		for _, blob := range blobs {
+			if !blob.IsDir() {
+				total += blob.Size()
+			}
+		}
+	}
+
+	return total, nil
+}
+
 // Open opens the file with the specified ref
 func (dir *Dir) Open(ref storage.BlobRef) (*os.File, error) {
 	path := dir.refToPath(ref)
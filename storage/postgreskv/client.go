@@ -6,6 +6,7 @@ package postgreskv
 import (
 	"database/sql"
 	"fmt"
+	"sync/atomic"
 
 	"github.com/lib/pq"
 	"github.com/zeebo/errs"
@@ -23,6 +24,9 @@ const (
 type Client struct {
 	URL    string
 	pgConn *sql.DB
+	bucket storage.Key
+
+	referenceCount *int32
 }
 
 // New instantiates a new postgreskv client given db URL
@@ -35,15 +39,42 @@ func New(dbURL string) (*Client, error) {
 	if err != nil {
 		return nil, err
 	}
+	refCount := new(int32)
+	*refCount = 1
+
 	return &Client{
-		URL:    dbURL,
-		pgConn: pgConn,
+		URL:            dbURL,
+		pgConn:         pgConn,
+		bucket:         storage.Key(defaultBucket),
+		referenceCount: refCount,
 	}, nil
 }
 
+// NewShared instantiates postgreskv clients for the given buckets sharing
+// a single connection. The connection is closed with the last client.
+func NewShared(dbURL string, buckets ...string) ([]*Client, error) {
+	client, err := New(dbURL)
+	if err != nil {
+		return nil, err
+	}
+
+	*client.referenceCount = int32(len(buckets))
+
+	clients := []*Client{}
+	for _, bucket := range buckets {
+		clients = append(clients, &Client{
+			URL:            client.URL,
+			pgConn:         client.pgConn,
+			bucket:         storage.Key(bucket),
+			referenceCount: client.referenceCount,
+		})
+	}
+	return clients, nil
+}
+
 // Put sets the value for the provided key.
 func (client *Client) Put(key storage.Key, value storage.Value) error {
-	return client.PutPath(storage.Key(defaultBucket), key, value)
+	return client.PutPath(client.bucket, key, value)
 }
 
 // PutPath sets the value for the provided key (in the given bucket).
@@ -62,7 +93,7 @@ func (client *Client) PutPath(bucket, key storage.Key, value storage.Value) erro
 
 // Get looks up the provided key and returns its value (or an error).
 func (client *Client) Get(key storage.Key) (storage.Value, error) {
-	return client.GetPath(storage.Key(defaultBucket), key)
+	return client.GetPath(client.bucket, key)
 }
 
 // GetPath looks up the provided key (in the given bucket) and returns its value (or an error).
@@ -86,7 +117,7 @@ func (client *Client) GetPath(bucket, key storage.Key) (storage.Value, error) {
 
 // Delete deletes the given key and its associated value.
 func (client *Client) Delete(key storage.Key) error {
-	return client.DeletePath(storage.Key(defaultBucket), key)
+	return client.DeletePath(client.bucket, key)
 }
 
 // DeletePath deletes the given key (in the given bucket) and its associated value.
@@ -110,6 +141,59 @@ func (client *Client) DeletePath(bucket, key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap replaces the value of the key with newValue if its current
+// value is oldValue
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	return client.CompareAndSwapPath(client.bucket, key, oldValue, newValue)
+}
+
+// CompareAndSwapPath replaces the value of the key (in the given bucket) with
+// newValue if its current value is oldValue
+func (client *Client) CompareAndSwapPath(bucket, key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	var result sql.Result
+	var err error
+	switch {
+	case oldValue == nil && newValue == nil:
+		_, err = client.GetPath(bucket, key)
+		if storage.ErrKeyNotFound.Has(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		return storage.ErrValueChanged.New(key.String())
+	case oldValue == nil:
+		q := `
+			INSERT INTO pathdata (bucket, fullpath, metadata)
+				VALUES ($1::BYTEA, $2::BYTEA, $3::BYTEA)
+				ON CONFLICT DO NOTHING
+		`
+		result, err = client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(newValue))
+	case newValue == nil:
+		q := "DELETE FROM pathdata WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $3::BYTEA"
+		result, err = client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(oldValue))
+	default:
+		q := "UPDATE pathdata SET metadata = $4::BYTEA WHERE bucket = $1::BYTEA AND fullpath = $2::BYTEA AND metadata = $3::BYTEA"
+		result, err = client.pgConn.Exec(q, []byte(bucket), []byte(key), []byte(oldValue), []byte(newValue))
+	}
+	if err != nil {
+		return err
+	}
+
+	numRows, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if numRows == 0 {
+		return storage.ErrValueChanged.New(key.String())
+	}
+	return nil
+}
+
 // List returns either a list of known keys, in order, or an error.
 func (client *Client) List(first storage.Key, limit int) (storage.Keys, error) {
 	return storage.ListKeys(client, first, limit)
@@ -123,13 +207,16 @@ func (client *Client) ReverseList(first storage.Key, limit int) (storage.Keys, e
 
 // Close closes the client
 func (client *Client) Close() error {
-	return client.pgConn.Close()
+	if atomic.AddInt32(client.referenceCount, -1) == 0 {
+		return client.pgConn.Close()
+	}
+	return nil
 }
 
 // GetAll finds all values for the provided keys (up to storage.LookupLimit).
 // If more keys are provided than the maximum, an error will be returned.
 func (client *Client) GetAll(keys storage.Keys) (storage.Values, error) {
-	return client.GetAllPath(storage.Key(defaultBucket), keys)
+	return client.GetAllPath(client.bucket, keys)
 }
 
 // GetAllPath finds all values for the provided keys (up to storage.LookupLimit)
@@ -274,7 +361,7 @@ func newOrderedPostgresIterator(pgClient *Client, opts storage.IterateOptions, b
 	opi := &orderedPostgresIterator{
 		client:    pgClient,
 		opts:      &opts,
-		bucket:    storage.Key(defaultBucket),
+		bucket:    pgClient.bucket,
 		delimiter: byte('/'),
 		batchSize: batchSize,
 		curIndex:  0,
@@ -4,6 +4,7 @@
 package redis
 
 import (
+	"bytes"
 	"net/url"
 	"sort"
 	"strconv"
@@ -123,6 +124,44 @@ func (client *Client) Delete(key storage.Key) error {
 	return nil
 }
 
+// CompareAndSwap replaces the value of the key with newValue if its current
+// value is oldValue
+func (client *Client) CompareAndSwap(key storage.Key, oldValue, newValue storage.Value) error {
+	if key.IsZero() {
+		return storage.ErrEmptyKey.New("")
+	}
+
+	err := client.db.Watch(func(tx *redis.Tx) error {
+		value, err := tx.Get(key.String()).Bytes()
+		if err == redis.Nil {
+			value = nil
+		} else if err != nil {
+			return err
+		}
+
+		if oldValue == nil && value != nil || oldValue != nil && !bytes.Equal(value, oldValue) {
+			return storage.ErrValueChanged.New(key.String())
+		}
+
+		_, err = tx.Pipelined(func(pipe redis.Pipeliner) error {
+			if newValue == nil {
+				pipe.Del(key.String())
+			} else {
+				pipe.Set(key.String(), []byte(newValue), client.TTL)
+			}
+			return nil
+		})
+		return err
+	}, key.String())
+	if err == redis.TxFailedErr {
+		return storage.ErrValueChanged.New(key.String())
+	}
+	if err != nil && !storage.ErrValueChanged.Has(err) {
+		return Error.New("compare and swap error: %v", err)
+	}
+	return err
+}
+
 // Close closes a redis client
 func (client *Client) Close() error {
 	return client.db.Close()
@@ -0,0 +1,58 @@
+// Copyright (C) 2019 Storj Labs, Inc.
+// See LICENSE for copying information.
+
+package testsuite
+
+import (
+	"bytes"
+	"testing"
+
+	"storj.io/storj/storage"
+)
+
+func testCompareAndSwap(t *testing.T, store storage.KeyValueStore) {
+	key := storage.Key("compare-and-swap")
+	defer func() { _ = store.Delete(key) }()
+
+	// a missing key is only created when nil is expected
+	err := store.CompareAndSwap(key, storage.Value("old"), storage.Value("new"))
+	if !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("swapping a missing key should fail with value changed: %v", err)
+	}
+	if err := store.CompareAndSwap(key, nil, storage.Value("first")); err != nil {
+		t.Fatalf("failed to create %q: %v", key, err)
+	}
+	err = store.CompareAndSwap(key, nil, storage.Value("second"))
+	if !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("creating an existing key should fail with value changed: %v", err)
+	}
+
+	// the value is only replaced when it matches the old value
+	err = store.CompareAndSwap(key, storage.Value("other"), storage.Value("second"))
+	if !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("swapping with a wrong old value should fail with value changed: %v", err)
+	}
+	if err := store.CompareAndSwap(key, storage.Value("first"), storage.Value("second")); err != nil {
+		t.Fatalf("failed to swap %q: %v", key, err)
+	}
+	value, err := store.Get(key)
+	if err != nil || !bytes.Equal(value, storage.Value("second")) {
+		t.Fatalf("invalid value for %q: got %q, %v", key, value, err)
+	}
+
+	// a nil new value deletes the key
+	err = store.CompareAndSwap(key, storage.Value("first"), nil)
+	if !storage.ErrValueChanged.Has(err) {
+		t.Fatalf("deleting with a wrong old value should fail with value changed: %v", err)
+	}
+	if err := store.CompareAndSwap(key, storage.Value("second"), nil); err != nil {
+		t.Fatalf("failed to delete %q: %v", key, err)
+	}
+	_, err = store.Get(key)
+	if !storage.ErrKeyNotFound.Has(err) {
+		t.Fatalf("deleted key %q should not be found: %v", key, err)
+	}
+	if err := store.CompareAndSwap(key, nil, nil); err != nil {
+		t.Fatalf("expecting a missing key should succeed: %v", err)
+	}
+}
@@ -19,8 +19,10 @@ import (
 	pstore "storj.io/storj/pkg/piecestore"
 	"storj.io/storj/pkg/piecestore/psserver"
 	"storj.io/storj/pkg/piecestore/psserver/psdb"
+	"storj.io/storj/pkg/piecestore/psserver/webdashboard"
 	"storj.io/storj/pkg/server"
 	"storj.io/storj/pkg/storj"
+	"storj.io/storj/pkg/transport"
 	"storj.io/storj/storage"
 )
 
@@ -65,7 +67,11 @@ type Peer struct {
 	Kademlia         *kademlia.Kademlia
 	KademliaEndpoint *node.Server
 
-	Piecestore *psserver.Server // TODO: separate into endpoint and service
+	Piecestore        *psserver.Server // TODO: separate into endpoint and service
+	PiecestoreRefresh *psserver.RefreshService
+
+	// Dashboard is nil when the web dashboard isn't enabled
+	Dashboard *webdashboard.Server
 }
 
 // New creates a new Storage Node.
@@ -135,9 +141,27 @@ func New(log *zap.Logger, full *identity.FullIdentity, db DB, config Config) (*P
 		// TODO: move this setup logic into psstore package
 		config := config.Storage
 
+		transportClient := transport.NewClient(peer.Identity)
+		trust, err := psserver.NewTrustedSatellites(peer.Log.Named("piecestore:trust"), config.TrustedSatellites, config.TrustUnknownSatellites, peer.Kademlia, transportClient)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
+
 		// TODO: psserver shouldn't need the private key
-		peer.Piecestore = psserver.New(peer.Log.Named("piecestore"), peer.DB.Storage(), peer.DB.PSDB(), config, peer.Identity.Key)
+		peer.Piecestore, err = psserver.New(peer.Log.Named("piecestore"), peer.DB.Storage(), peer.DB.PSDB(), config, peer.Identity.Key, trust)
+		if err != nil {
+			return nil, errs.Combine(err, peer.Close())
+		}
 		pb.RegisterPieceStoreRoutesServer(peer.Public.Server.GRPC(), peer.Piecestore)
+
+		peer.PiecestoreRefresh = psserver.NewRefreshService(peer.Log.Named("piecestore:refresh"), config.KBucketRefreshInterval, peer.RoutingTable, peer.Piecestore)
+
+		if config.Dashboard.Address != "" {
+			peer.Dashboard = webdashboard.NewServer(peer.Log.Named("webdashboard"), config.Dashboard, peer.DB.PSDB(), trust, transportClient, webdashboard.Allocation{
+				Space:     config.AllocatedDiskSpace.Int64(),
+				Bandwidth: config.AllocatedBandwidth.Int64(),
+			})
+		}
 	}
 
 	return peer, nil
@@ -161,6 +185,15 @@ func (peer *Peer) Run(ctx context.Context) error {
 		peer.Kademlia.StartRefresh(ctx)
 		return nil
 	})
+	group.Go(func() error {
+		peer.PiecestoreRefresh.Run(ctx)
+		return nil
+	})
+	if peer.Dashboard != nil {
+		group.Go(func() error {
+			return peer.Dashboard.Run(ctx)
+		})
+	}
 	group.Go(func() error {
 		err := peer.Public.Server.Run(ctx)
 		if err == context.Canceled || err == grpc.ErrServerStopped {
@@ -27,7 +27,10 @@ type DB struct {
 // NewInMemory creates new inmemory database for storagenode
 // TODO: still stores data on disk
 func NewInMemory(storageDir string) (*DB, error) {
-	storage := pstore.NewStorage(storageDir)
+	storage, err := pstore.NewStorage(storageDir)
+	if err != nil {
+		return nil, err
+	}
 
 	// TODO: OpenInMemory shouldn't need context argument
 	psdb, err := psdb.OpenInMemory(context.TODO(), storage)